@@ -42,12 +42,54 @@ const (
 	// ProductServiceGetProductProcedure is the fully-qualified name of the ProductService's GetProduct
 	// RPC.
 	ProductServiceGetProductProcedure = "/catalog.v1.ProductService/GetProduct"
+	// ProductServiceBatchGetProductsProcedure is the fully-qualified name of the ProductService's
+	// BatchGetProducts RPC.
+	ProductServiceBatchGetProductsProcedure = "/catalog.v1.ProductService/BatchGetProducts"
 	// ProductServiceUpdateProductProcedure is the fully-qualified name of the ProductService's
 	// UpdateProduct RPC.
 	ProductServiceUpdateProductProcedure = "/catalog.v1.ProductService/UpdateProduct"
 	// ProductServiceDeleteProductProcedure is the fully-qualified name of the ProductService's
 	// DeleteProduct RPC.
 	ProductServiceDeleteProductProcedure = "/catalog.v1.ProductService/DeleteProduct"
+	// ProductServiceImportProductsProcedure is the fully-qualified name of the ProductService's
+	// ImportProducts RPC.
+	ProductServiceImportProductsProcedure = "/catalog.v1.ProductService/ImportProducts"
+	// ProductServiceExportProductsProcedure is the fully-qualified name of the ProductService's
+	// ExportProducts RPC.
+	ProductServiceExportProductsProcedure = "/catalog.v1.ProductService/ExportProducts"
+	// ProductServiceCheckCompatibilityProcedure is the fully-qualified name of the ProductService's
+	// CheckCompatibility RPC.
+	ProductServiceCheckCompatibilityProcedure = "/catalog.v1.ProductService/CheckCompatibility"
+	// ProductServiceListEligibleProductsProcedure is the fully-qualified name of the ProductService's
+	// ListEligibleProducts RPC.
+	ProductServiceListEligibleProductsProcedure = "/catalog.v1.ProductService/ListEligibleProducts"
+	// ProductServiceSearchProductsProcedure is the fully-qualified name of the ProductService's
+	// SearchProducts RPC.
+	ProductServiceSearchProductsProcedure = "/catalog.v1.ProductService/SearchProducts"
+	// ProductServiceListProductFacetsProcedure is the fully-qualified name of the ProductService's
+	// ListProductFacets RPC.
+	ProductServiceListProductFacetsProcedure = "/catalog.v1.ProductService/ListProductFacets"
+	// ProductServiceGetPriceHistoryProcedure is the fully-qualified name of the ProductService's
+	// GetPriceHistory RPC.
+	ProductServiceGetPriceHistoryProcedure = "/catalog.v1.ProductService/GetPriceHistory"
+	// ProductServiceListPriceDropsProcedure is the fully-qualified name of the ProductService's
+	// ListPriceDrops RPC.
+	ProductServiceListPriceDropsProcedure = "/catalog.v1.ProductService/ListPriceDrops"
+	// ProductServiceGetPurchaseLinksProcedure is the fully-qualified name of the ProductService's
+	// GetPurchaseLinks RPC.
+	ProductServiceGetPurchaseLinksProcedure = "/catalog.v1.ProductService/GetPurchaseLinks"
+	// ProductServiceGetClickReportProcedure is the fully-qualified name of the ProductService's
+	// GetClickReport RPC.
+	ProductServiceGetClickReportProcedure = "/catalog.v1.ProductService/GetClickReport"
+	// ProductServiceListProductIssuesProcedure is the fully-qualified name of the ProductService's
+	// ListProductIssues RPC.
+	ProductServiceListProductIssuesProcedure = "/catalog.v1.ProductService/ListProductIssues"
+	// ProductServiceUploadProductImageProcedure is the fully-qualified name of the ProductService's
+	// UploadProductImage RPC.
+	ProductServiceUploadProductImageProcedure = "/catalog.v1.ProductService/UploadProductImage"
+	// ProductServiceImportProductImageProcedure is the fully-qualified name of the ProductService's
+	// ImportProductImage RPC.
+	ProductServiceImportProductImageProcedure = "/catalog.v1.ProductService/ImportProductImage"
 )
 
 // ProductServiceClient is a client for the catalog.v1.ProductService service.
@@ -61,10 +103,54 @@ type ProductServiceClient interface {
 	// GetProduct: 指定されたIDの製品詳細を取得します。
 	// 製品詳細ページなどで使用されます。
 	GetProduct(context.Context, *connect.Request[v1.GetProductRequest]) (*connect.Response[v1.Product], error)
+	// BatchGetProducts: 複数の製品をまとめて取得します (提案プランの表示用)。
+	// 製品はリクエストの順序で返し (重複したIDは1つにまとめます)、存在しないIDはエラーにせず missing_ids で返します。
+	// 一度に指定できるIDは500件までです。
+	BatchGetProducts(context.Context, *connect.Request[v1.BatchGetProductsRequest]) (*connect.Response[v1.BatchGetProductsResponse], error)
 	// UpdateProduct: 既存の製品情報を更新します。
 	UpdateProduct(context.Context, *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.Product], error)
 	// DeleteProduct: 製品を削除します。
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
+	// ImportProducts: CSV または NDJSON のファイルから製品を一括登録します (Admin)。
+	// 最初のメッセージで形式とドライランの有無 (options) を送り、続けてファイルの中身を chunk で分割して送ります。
+	// 製品は sku で特定し、既存なら上書き、なければ作成します。不正な行はその行だけを飛ばし、行番号付きで返します。
+	ImportProducts(context.Context) *connect.ClientStreamForClient[v1.ImportProductsRequest, v1.ImportProductsResponse]
+	// ExportProducts: 全製品を ImportProducts と同じ形式で出力します (Admin)。ファイルの中身を chunk に分けて返します。
+	ExportProducts(context.Context, *connect.Request[v1.ExportProductsRequest]) (*connect.ServerStreamForClient[v1.ExportProductsResponse], error)
+	// CheckCompatibility: 製品の組み合わせと住居のWi-Fi環境の互換性をチェックします。
+	// 不足しているブリッジがあれば、補うハブを「management」グループとして提案します。
+	CheckCompatibility(context.Context, *connect.Request[v1.CheckCompatibilityRequest]) (*connect.Response[v1.CheckCompatibilityResponse], error)
+	// ListEligibleProducts: 住環境に設置できる製品だけを返します。
+	// 提案できない製品は、ユーザーに説明できる理由付きで別リストに入ります。どちらのリストも製品IDの順です。
+	ListEligibleProducts(context.Context, *connect.Request[v1.ListEligibleProductsRequest]) (*connect.Response[v1.ListEligibleProductsResponse], error)
+	// SearchProducts: 製品名・説明・メーカー・特長・注意点をキーワードで全文検索します。
+	// 日本語は2文字ずつ (bigram) に分割して照合し、全角/半角・カタカナ/ひらがなの違いは区別しません。
+	// 結果は関連度の高い順で、カテゴリ・価格帯・設置難易度で絞り込めます。
+	SearchProducts(context.Context, *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error)
+	// ListProductFacets: 絞り込み条件に対する、カテゴリ・メーカー・設置難易度・価格帯・対応規格ごとの製品数を返します。
+	// 各項目の件数は、その項目自身の条件だけを外して数えます (選択中のカテゴリ以外の件数も分かるようにするため)。
+	ListProductFacets(context.Context, *connect.Request[v1.ListProductFacetsRequest]) (*connect.Response[v1.ListProductFacetsResponse], error)
+	// GetPriceHistory: 製品の価格の変更履歴を古い順で返します。
+	// 価格は変わったときだけ記録されます。since を指定した場合は、それ以降の変更と、その時点でついていた価格を返します。
+	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
+	// ListPriceDrops: 価格が直近90日の最安値を下回った値下がりを、検出した順で返します。
+	// 採用計画で購入前の製品を持つユーザーに知らせるため、製品IDで絞り込めます。
+	ListPriceDrops(context.Context, *connect.Request[v1.ListPriceDropsRequest]) (*connect.Response[v1.ListPriceDropsResponse], error)
+	// GetPurchaseLinks: 製品の購入リンクを販売サイトごとに返します。
+	// redirect_url (/r/{token}) はクリックを記録してからアフィリエイトリンクへ遷移します。トークンにはログイン中の利用者と、その利用者の採用計画が署名つきで入ります。
+	// 他人の採用計画や、ログイン中の利用者と違う user_id を指定すると PermissionDenied です。
+	GetPurchaseLinks(context.Context, *connect.Request[v1.GetPurchaseLinksRequest]) (*connect.Response[v1.GetPurchaseLinksResponse], error)
+	// GetClickReport: 購入リンクのクリック数を製品ごと・採用計画ごとに集計します。
+	GetClickReport(context.Context, *connect.Request[v1.GetClickReportRequest]) (*connect.Response[v1.GetClickReportResponse], error)
+	// ListProductIssues: 購入リンクの定期確認で問題の見つかった製品を、製品IDの順で返します (Admin)。
+	// 404 / 410、「ページが見つかりません」などの内容やトップページへの転送 (soft_404)、在庫切れ、接続できないリンクが対象です。
+	ListProductIssues(context.Context, *connect.Request[v1.ListProductIssuesRequest]) (*connect.Response[v1.ListProductIssuesResponse], error)
+	// UploadProductImage: 製品画像をアップロードし、サムネイルを作って image_url を差し替えます (Admin)。
+	// JPEG / PNG / GIF / WebP (10MB まで、短い辺が100px 以上) に対応し、EXIF などのメタデータは取り除きます。
+	UploadProductImage(context.Context, *connect.Request[v1.UploadProductImageRequest]) (*connect.Response[v1.Product], error)
+	// ImportProductImage: URL から製品画像を取得して、UploadProductImage と同じように取り込みます (Admin)。
+	// 接続するのは公開アドレスだけです (ループバック・プライベート・リンクローカルのアドレスには、転送先も含めて接続しません)。
+	ImportProductImage(context.Context, *connect.Request[v1.ImportProductImageRequest]) (*connect.Response[v1.Product], error)
 }
 
 // NewProductServiceClient constructs a client for the catalog.v1.ProductService service. By
@@ -96,6 +182,12 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("GetProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		batchGetProducts: connect.NewClient[v1.BatchGetProductsRequest, v1.BatchGetProductsResponse](
+			httpClient,
+			baseURL+ProductServiceBatchGetProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("BatchGetProducts")),
+			connect.WithClientOptions(opts...),
+		),
 		updateProduct: connect.NewClient[v1.UpdateProductRequest, v1.Product](
 			httpClient,
 			baseURL+ProductServiceUpdateProductProcedure,
@@ -108,16 +200,108 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("DeleteProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		importProducts: connect.NewClient[v1.ImportProductsRequest, v1.ImportProductsResponse](
+			httpClient,
+			baseURL+ProductServiceImportProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ImportProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		exportProducts: connect.NewClient[v1.ExportProductsRequest, v1.ExportProductsResponse](
+			httpClient,
+			baseURL+ProductServiceExportProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ExportProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		checkCompatibility: connect.NewClient[v1.CheckCompatibilityRequest, v1.CheckCompatibilityResponse](
+			httpClient,
+			baseURL+ProductServiceCheckCompatibilityProcedure,
+			connect.WithSchema(productServiceMethods.ByName("CheckCompatibility")),
+			connect.WithClientOptions(opts...),
+		),
+		listEligibleProducts: connect.NewClient[v1.ListEligibleProductsRequest, v1.ListEligibleProductsResponse](
+			httpClient,
+			baseURL+ProductServiceListEligibleProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListEligibleProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		searchProducts: connect.NewClient[v1.SearchProductsRequest, v1.SearchProductsResponse](
+			httpClient,
+			baseURL+ProductServiceSearchProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("SearchProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		listProductFacets: connect.NewClient[v1.ListProductFacetsRequest, v1.ListProductFacetsResponse](
+			httpClient,
+			baseURL+ProductServiceListProductFacetsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListProductFacets")),
+			connect.WithClientOptions(opts...),
+		),
+		getPriceHistory: connect.NewClient[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse](
+			httpClient,
+			baseURL+ProductServiceGetPriceHistoryProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetPriceHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listPriceDrops: connect.NewClient[v1.ListPriceDropsRequest, v1.ListPriceDropsResponse](
+			httpClient,
+			baseURL+ProductServiceListPriceDropsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListPriceDrops")),
+			connect.WithClientOptions(opts...),
+		),
+		getPurchaseLinks: connect.NewClient[v1.GetPurchaseLinksRequest, v1.GetPurchaseLinksResponse](
+			httpClient,
+			baseURL+ProductServiceGetPurchaseLinksProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetPurchaseLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		getClickReport: connect.NewClient[v1.GetClickReportRequest, v1.GetClickReportResponse](
+			httpClient,
+			baseURL+ProductServiceGetClickReportProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetClickReport")),
+			connect.WithClientOptions(opts...),
+		),
+		listProductIssues: connect.NewClient[v1.ListProductIssuesRequest, v1.ListProductIssuesResponse](
+			httpClient,
+			baseURL+ProductServiceListProductIssuesProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListProductIssues")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadProductImage: connect.NewClient[v1.UploadProductImageRequest, v1.Product](
+			httpClient,
+			baseURL+ProductServiceUploadProductImageProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UploadProductImage")),
+			connect.WithClientOptions(opts...),
+		),
+		importProductImage: connect.NewClient[v1.ImportProductImageRequest, v1.Product](
+			httpClient,
+			baseURL+ProductServiceImportProductImageProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ImportProductImage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // productServiceClient implements ProductServiceClient.
 type productServiceClient struct {
-	listProducts  *connect.Client[v1.ListProductsRequest, v1.ListProductsResponse]
-	createProduct *connect.Client[v1.CreateProductRequest, v1.Product]
-	getProduct    *connect.Client[v1.GetProductRequest, v1.Product]
-	updateProduct *connect.Client[v1.UpdateProductRequest, v1.Product]
-	deleteProduct *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	listProducts         *connect.Client[v1.ListProductsRequest, v1.ListProductsResponse]
+	createProduct        *connect.Client[v1.CreateProductRequest, v1.Product]
+	getProduct           *connect.Client[v1.GetProductRequest, v1.Product]
+	batchGetProducts     *connect.Client[v1.BatchGetProductsRequest, v1.BatchGetProductsResponse]
+	updateProduct        *connect.Client[v1.UpdateProductRequest, v1.Product]
+	deleteProduct        *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	importProducts       *connect.Client[v1.ImportProductsRequest, v1.ImportProductsResponse]
+	exportProducts       *connect.Client[v1.ExportProductsRequest, v1.ExportProductsResponse]
+	checkCompatibility   *connect.Client[v1.CheckCompatibilityRequest, v1.CheckCompatibilityResponse]
+	listEligibleProducts *connect.Client[v1.ListEligibleProductsRequest, v1.ListEligibleProductsResponse]
+	searchProducts       *connect.Client[v1.SearchProductsRequest, v1.SearchProductsResponse]
+	listProductFacets    *connect.Client[v1.ListProductFacetsRequest, v1.ListProductFacetsResponse]
+	getPriceHistory      *connect.Client[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse]
+	listPriceDrops       *connect.Client[v1.ListPriceDropsRequest, v1.ListPriceDropsResponse]
+	getPurchaseLinks     *connect.Client[v1.GetPurchaseLinksRequest, v1.GetPurchaseLinksResponse]
+	getClickReport       *connect.Client[v1.GetClickReportRequest, v1.GetClickReportResponse]
+	listProductIssues    *connect.Client[v1.ListProductIssuesRequest, v1.ListProductIssuesResponse]
+	uploadProductImage   *connect.Client[v1.UploadProductImageRequest, v1.Product]
+	importProductImage   *connect.Client[v1.ImportProductImageRequest, v1.Product]
 }
 
 // ListProducts calls catalog.v1.ProductService.ListProducts.
@@ -135,6 +319,11 @@ func (c *productServiceClient) GetProduct(ctx context.Context, req *connect.Requ
 	return c.getProduct.CallUnary(ctx, req)
 }
 
+// BatchGetProducts calls catalog.v1.ProductService.BatchGetProducts.
+func (c *productServiceClient) BatchGetProducts(ctx context.Context, req *connect.Request[v1.BatchGetProductsRequest]) (*connect.Response[v1.BatchGetProductsResponse], error) {
+	return c.batchGetProducts.CallUnary(ctx, req)
+}
+
 // UpdateProduct calls catalog.v1.ProductService.UpdateProduct.
 func (c *productServiceClient) UpdateProduct(ctx context.Context, req *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.Product], error) {
 	return c.updateProduct.CallUnary(ctx, req)
@@ -145,6 +334,71 @@ func (c *productServiceClient) DeleteProduct(ctx context.Context, req *connect.R
 	return c.deleteProduct.CallUnary(ctx, req)
 }
 
+// ImportProducts calls catalog.v1.ProductService.ImportProducts.
+func (c *productServiceClient) ImportProducts(ctx context.Context) *connect.ClientStreamForClient[v1.ImportProductsRequest, v1.ImportProductsResponse] {
+	return c.importProducts.CallClientStream(ctx)
+}
+
+// ExportProducts calls catalog.v1.ProductService.ExportProducts.
+func (c *productServiceClient) ExportProducts(ctx context.Context, req *connect.Request[v1.ExportProductsRequest]) (*connect.ServerStreamForClient[v1.ExportProductsResponse], error) {
+	return c.exportProducts.CallServerStream(ctx, req)
+}
+
+// CheckCompatibility calls catalog.v1.ProductService.CheckCompatibility.
+func (c *productServiceClient) CheckCompatibility(ctx context.Context, req *connect.Request[v1.CheckCompatibilityRequest]) (*connect.Response[v1.CheckCompatibilityResponse], error) {
+	return c.checkCompatibility.CallUnary(ctx, req)
+}
+
+// ListEligibleProducts calls catalog.v1.ProductService.ListEligibleProducts.
+func (c *productServiceClient) ListEligibleProducts(ctx context.Context, req *connect.Request[v1.ListEligibleProductsRequest]) (*connect.Response[v1.ListEligibleProductsResponse], error) {
+	return c.listEligibleProducts.CallUnary(ctx, req)
+}
+
+// SearchProducts calls catalog.v1.ProductService.SearchProducts.
+func (c *productServiceClient) SearchProducts(ctx context.Context, req *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error) {
+	return c.searchProducts.CallUnary(ctx, req)
+}
+
+// ListProductFacets calls catalog.v1.ProductService.ListProductFacets.
+func (c *productServiceClient) ListProductFacets(ctx context.Context, req *connect.Request[v1.ListProductFacetsRequest]) (*connect.Response[v1.ListProductFacetsResponse], error) {
+	return c.listProductFacets.CallUnary(ctx, req)
+}
+
+// GetPriceHistory calls catalog.v1.ProductService.GetPriceHistory.
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, req *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error) {
+	return c.getPriceHistory.CallUnary(ctx, req)
+}
+
+// ListPriceDrops calls catalog.v1.ProductService.ListPriceDrops.
+func (c *productServiceClient) ListPriceDrops(ctx context.Context, req *connect.Request[v1.ListPriceDropsRequest]) (*connect.Response[v1.ListPriceDropsResponse], error) {
+	return c.listPriceDrops.CallUnary(ctx, req)
+}
+
+// GetPurchaseLinks calls catalog.v1.ProductService.GetPurchaseLinks.
+func (c *productServiceClient) GetPurchaseLinks(ctx context.Context, req *connect.Request[v1.GetPurchaseLinksRequest]) (*connect.Response[v1.GetPurchaseLinksResponse], error) {
+	return c.getPurchaseLinks.CallUnary(ctx, req)
+}
+
+// GetClickReport calls catalog.v1.ProductService.GetClickReport.
+func (c *productServiceClient) GetClickReport(ctx context.Context, req *connect.Request[v1.GetClickReportRequest]) (*connect.Response[v1.GetClickReportResponse], error) {
+	return c.getClickReport.CallUnary(ctx, req)
+}
+
+// ListProductIssues calls catalog.v1.ProductService.ListProductIssues.
+func (c *productServiceClient) ListProductIssues(ctx context.Context, req *connect.Request[v1.ListProductIssuesRequest]) (*connect.Response[v1.ListProductIssuesResponse], error) {
+	return c.listProductIssues.CallUnary(ctx, req)
+}
+
+// UploadProductImage calls catalog.v1.ProductService.UploadProductImage.
+func (c *productServiceClient) UploadProductImage(ctx context.Context, req *connect.Request[v1.UploadProductImageRequest]) (*connect.Response[v1.Product], error) {
+	return c.uploadProductImage.CallUnary(ctx, req)
+}
+
+// ImportProductImage calls catalog.v1.ProductService.ImportProductImage.
+func (c *productServiceClient) ImportProductImage(ctx context.Context, req *connect.Request[v1.ImportProductImageRequest]) (*connect.Response[v1.Product], error) {
+	return c.importProductImage.CallUnary(ctx, req)
+}
+
 // ProductServiceHandler is an implementation of the catalog.v1.ProductService service.
 type ProductServiceHandler interface {
 	// ListProducts: 利用可能な製品の一覧を取得します。
@@ -156,10 +410,54 @@ type ProductServiceHandler interface {
 	// GetProduct: 指定されたIDの製品詳細を取得します。
 	// 製品詳細ページなどで使用されます。
 	GetProduct(context.Context, *connect.Request[v1.GetProductRequest]) (*connect.Response[v1.Product], error)
+	// BatchGetProducts: 複数の製品をまとめて取得します (提案プランの表示用)。
+	// 製品はリクエストの順序で返し (重複したIDは1つにまとめます)、存在しないIDはエラーにせず missing_ids で返します。
+	// 一度に指定できるIDは500件までです。
+	BatchGetProducts(context.Context, *connect.Request[v1.BatchGetProductsRequest]) (*connect.Response[v1.BatchGetProductsResponse], error)
 	// UpdateProduct: 既存の製品情報を更新します。
 	UpdateProduct(context.Context, *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.Product], error)
 	// DeleteProduct: 製品を削除します。
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
+	// ImportProducts: CSV または NDJSON のファイルから製品を一括登録します (Admin)。
+	// 最初のメッセージで形式とドライランの有無 (options) を送り、続けてファイルの中身を chunk で分割して送ります。
+	// 製品は sku で特定し、既存なら上書き、なければ作成します。不正な行はその行だけを飛ばし、行番号付きで返します。
+	ImportProducts(context.Context, *connect.ClientStream[v1.ImportProductsRequest]) (*connect.Response[v1.ImportProductsResponse], error)
+	// ExportProducts: 全製品を ImportProducts と同じ形式で出力します (Admin)。ファイルの中身を chunk に分けて返します。
+	ExportProducts(context.Context, *connect.Request[v1.ExportProductsRequest], *connect.ServerStream[v1.ExportProductsResponse]) error
+	// CheckCompatibility: 製品の組み合わせと住居のWi-Fi環境の互換性をチェックします。
+	// 不足しているブリッジがあれば、補うハブを「management」グループとして提案します。
+	CheckCompatibility(context.Context, *connect.Request[v1.CheckCompatibilityRequest]) (*connect.Response[v1.CheckCompatibilityResponse], error)
+	// ListEligibleProducts: 住環境に設置できる製品だけを返します。
+	// 提案できない製品は、ユーザーに説明できる理由付きで別リストに入ります。どちらのリストも製品IDの順です。
+	ListEligibleProducts(context.Context, *connect.Request[v1.ListEligibleProductsRequest]) (*connect.Response[v1.ListEligibleProductsResponse], error)
+	// SearchProducts: 製品名・説明・メーカー・特長・注意点をキーワードで全文検索します。
+	// 日本語は2文字ずつ (bigram) に分割して照合し、全角/半角・カタカナ/ひらがなの違いは区別しません。
+	// 結果は関連度の高い順で、カテゴリ・価格帯・設置難易度で絞り込めます。
+	SearchProducts(context.Context, *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error)
+	// ListProductFacets: 絞り込み条件に対する、カテゴリ・メーカー・設置難易度・価格帯・対応規格ごとの製品数を返します。
+	// 各項目の件数は、その項目自身の条件だけを外して数えます (選択中のカテゴリ以外の件数も分かるようにするため)。
+	ListProductFacets(context.Context, *connect.Request[v1.ListProductFacetsRequest]) (*connect.Response[v1.ListProductFacetsResponse], error)
+	// GetPriceHistory: 製品の価格の変更履歴を古い順で返します。
+	// 価格は変わったときだけ記録されます。since を指定した場合は、それ以降の変更と、その時点でついていた価格を返します。
+	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
+	// ListPriceDrops: 価格が直近90日の最安値を下回った値下がりを、検出した順で返します。
+	// 採用計画で購入前の製品を持つユーザーに知らせるため、製品IDで絞り込めます。
+	ListPriceDrops(context.Context, *connect.Request[v1.ListPriceDropsRequest]) (*connect.Response[v1.ListPriceDropsResponse], error)
+	// GetPurchaseLinks: 製品の購入リンクを販売サイトごとに返します。
+	// redirect_url (/r/{token}) はクリックを記録してからアフィリエイトリンクへ遷移します。トークンにはログイン中の利用者と、その利用者の採用計画が署名つきで入ります。
+	// 他人の採用計画や、ログイン中の利用者と違う user_id を指定すると PermissionDenied です。
+	GetPurchaseLinks(context.Context, *connect.Request[v1.GetPurchaseLinksRequest]) (*connect.Response[v1.GetPurchaseLinksResponse], error)
+	// GetClickReport: 購入リンクのクリック数を製品ごと・採用計画ごとに集計します。
+	GetClickReport(context.Context, *connect.Request[v1.GetClickReportRequest]) (*connect.Response[v1.GetClickReportResponse], error)
+	// ListProductIssues: 購入リンクの定期確認で問題の見つかった製品を、製品IDの順で返します (Admin)。
+	// 404 / 410、「ページが見つかりません」などの内容やトップページへの転送 (soft_404)、在庫切れ、接続できないリンクが対象です。
+	ListProductIssues(context.Context, *connect.Request[v1.ListProductIssuesRequest]) (*connect.Response[v1.ListProductIssuesResponse], error)
+	// UploadProductImage: 製品画像をアップロードし、サムネイルを作って image_url を差し替えます (Admin)。
+	// JPEG / PNG / GIF / WebP (10MB まで、短い辺が100px 以上) に対応し、EXIF などのメタデータは取り除きます。
+	UploadProductImage(context.Context, *connect.Request[v1.UploadProductImageRequest]) (*connect.Response[v1.Product], error)
+	// ImportProductImage: URL から製品画像を取得して、UploadProductImage と同じように取り込みます (Admin)。
+	// 接続するのは公開アドレスだけです (ループバック・プライベート・リンクローカルのアドレスには、転送先も含めて接続しません)。
+	ImportProductImage(context.Context, *connect.Request[v1.ImportProductImageRequest]) (*connect.Response[v1.Product], error)
 }
 
 // NewProductServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -187,6 +485,12 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("GetProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceBatchGetProductsHandler := connect.NewUnaryHandler(
+		ProductServiceBatchGetProductsProcedure,
+		svc.BatchGetProducts,
+		connect.WithSchema(productServiceMethods.ByName("BatchGetProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
 	productServiceUpdateProductHandler := connect.NewUnaryHandler(
 		ProductServiceUpdateProductProcedure,
 		svc.UpdateProduct,
@@ -199,6 +503,84 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("DeleteProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceImportProductsHandler := connect.NewClientStreamHandler(
+		ProductServiceImportProductsProcedure,
+		svc.ImportProducts,
+		connect.WithSchema(productServiceMethods.ByName("ImportProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceExportProductsHandler := connect.NewServerStreamHandler(
+		ProductServiceExportProductsProcedure,
+		svc.ExportProducts,
+		connect.WithSchema(productServiceMethods.ByName("ExportProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceCheckCompatibilityHandler := connect.NewUnaryHandler(
+		ProductServiceCheckCompatibilityProcedure,
+		svc.CheckCompatibility,
+		connect.WithSchema(productServiceMethods.ByName("CheckCompatibility")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListEligibleProductsHandler := connect.NewUnaryHandler(
+		ProductServiceListEligibleProductsProcedure,
+		svc.ListEligibleProducts,
+		connect.WithSchema(productServiceMethods.ByName("ListEligibleProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceSearchProductsHandler := connect.NewUnaryHandler(
+		ProductServiceSearchProductsProcedure,
+		svc.SearchProducts,
+		connect.WithSchema(productServiceMethods.ByName("SearchProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListProductFacetsHandler := connect.NewUnaryHandler(
+		ProductServiceListProductFacetsProcedure,
+		svc.ListProductFacets,
+		connect.WithSchema(productServiceMethods.ByName("ListProductFacets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetPriceHistoryHandler := connect.NewUnaryHandler(
+		ProductServiceGetPriceHistoryProcedure,
+		svc.GetPriceHistory,
+		connect.WithSchema(productServiceMethods.ByName("GetPriceHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListPriceDropsHandler := connect.NewUnaryHandler(
+		ProductServiceListPriceDropsProcedure,
+		svc.ListPriceDrops,
+		connect.WithSchema(productServiceMethods.ByName("ListPriceDrops")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetPurchaseLinksHandler := connect.NewUnaryHandler(
+		ProductServiceGetPurchaseLinksProcedure,
+		svc.GetPurchaseLinks,
+		connect.WithSchema(productServiceMethods.ByName("GetPurchaseLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetClickReportHandler := connect.NewUnaryHandler(
+		ProductServiceGetClickReportProcedure,
+		svc.GetClickReport,
+		connect.WithSchema(productServiceMethods.ByName("GetClickReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListProductIssuesHandler := connect.NewUnaryHandler(
+		ProductServiceListProductIssuesProcedure,
+		svc.ListProductIssues,
+		connect.WithSchema(productServiceMethods.ByName("ListProductIssues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUploadProductImageHandler := connect.NewUnaryHandler(
+		ProductServiceUploadProductImageProcedure,
+		svc.UploadProductImage,
+		connect.WithSchema(productServiceMethods.ByName("UploadProductImage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceImportProductImageHandler := connect.NewUnaryHandler(
+		ProductServiceImportProductImageProcedure,
+		svc.ImportProductImage,
+		connect.WithSchema(productServiceMethods.ByName("ImportProductImage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/catalog.v1.ProductService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProductServiceListProductsProcedure:
@@ -207,10 +589,38 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 			productServiceCreateProductHandler.ServeHTTP(w, r)
 		case ProductServiceGetProductProcedure:
 			productServiceGetProductHandler.ServeHTTP(w, r)
+		case ProductServiceBatchGetProductsProcedure:
+			productServiceBatchGetProductsHandler.ServeHTTP(w, r)
 		case ProductServiceUpdateProductProcedure:
 			productServiceUpdateProductHandler.ServeHTTP(w, r)
 		case ProductServiceDeleteProductProcedure:
 			productServiceDeleteProductHandler.ServeHTTP(w, r)
+		case ProductServiceImportProductsProcedure:
+			productServiceImportProductsHandler.ServeHTTP(w, r)
+		case ProductServiceExportProductsProcedure:
+			productServiceExportProductsHandler.ServeHTTP(w, r)
+		case ProductServiceCheckCompatibilityProcedure:
+			productServiceCheckCompatibilityHandler.ServeHTTP(w, r)
+		case ProductServiceListEligibleProductsProcedure:
+			productServiceListEligibleProductsHandler.ServeHTTP(w, r)
+		case ProductServiceSearchProductsProcedure:
+			productServiceSearchProductsHandler.ServeHTTP(w, r)
+		case ProductServiceListProductFacetsProcedure:
+			productServiceListProductFacetsHandler.ServeHTTP(w, r)
+		case ProductServiceGetPriceHistoryProcedure:
+			productServiceGetPriceHistoryHandler.ServeHTTP(w, r)
+		case ProductServiceListPriceDropsProcedure:
+			productServiceListPriceDropsHandler.ServeHTTP(w, r)
+		case ProductServiceGetPurchaseLinksProcedure:
+			productServiceGetPurchaseLinksHandler.ServeHTTP(w, r)
+		case ProductServiceGetClickReportProcedure:
+			productServiceGetClickReportHandler.ServeHTTP(w, r)
+		case ProductServiceListProductIssuesProcedure:
+			productServiceListProductIssuesHandler.ServeHTTP(w, r)
+		case ProductServiceUploadProductImageProcedure:
+			productServiceUploadProductImageHandler.ServeHTTP(w, r)
+		case ProductServiceImportProductImageProcedure:
+			productServiceImportProductImageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -232,6 +642,10 @@ func (UnimplementedProductServiceHandler) GetProduct(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.GetProduct is not implemented"))
 }
 
+func (UnimplementedProductServiceHandler) BatchGetProducts(context.Context, *connect.Request[v1.BatchGetProductsRequest]) (*connect.Response[v1.BatchGetProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.BatchGetProducts is not implemented"))
+}
+
 func (UnimplementedProductServiceHandler) UpdateProduct(context.Context, *connect.Request[v1.UpdateProductRequest]) (*connect.Response[v1.Product], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.UpdateProduct is not implemented"))
 }
@@ -239,3 +653,55 @@ func (UnimplementedProductServiceHandler) UpdateProduct(context.Context, *connec
 func (UnimplementedProductServiceHandler) DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.DeleteProduct is not implemented"))
 }
+
+func (UnimplementedProductServiceHandler) ImportProducts(context.Context, *connect.ClientStream[v1.ImportProductsRequest]) (*connect.Response[v1.ImportProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ImportProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ExportProducts(context.Context, *connect.Request[v1.ExportProductsRequest], *connect.ServerStream[v1.ExportProductsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ExportProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) CheckCompatibility(context.Context, *connect.Request[v1.CheckCompatibilityRequest]) (*connect.Response[v1.CheckCompatibilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.CheckCompatibility is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListEligibleProducts(context.Context, *connect.Request[v1.ListEligibleProductsRequest]) (*connect.Response[v1.ListEligibleProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ListEligibleProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) SearchProducts(context.Context, *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.SearchProducts is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListProductFacets(context.Context, *connect.Request[v1.ListProductFacetsRequest]) (*connect.Response[v1.ListProductFacetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ListProductFacets is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.GetPriceHistory is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListPriceDrops(context.Context, *connect.Request[v1.ListPriceDropsRequest]) (*connect.Response[v1.ListPriceDropsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ListPriceDrops is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetPurchaseLinks(context.Context, *connect.Request[v1.GetPurchaseLinksRequest]) (*connect.Response[v1.GetPurchaseLinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.GetPurchaseLinks is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetClickReport(context.Context, *connect.Request[v1.GetClickReportRequest]) (*connect.Response[v1.GetClickReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.GetClickReport is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListProductIssues(context.Context, *connect.Request[v1.ListProductIssuesRequest]) (*connect.Response[v1.ListProductIssuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ListProductIssues is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UploadProductImage(context.Context, *connect.Request[v1.UploadProductImageRequest]) (*connect.Response[v1.Product], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.UploadProductImage is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ImportProductImage(context.Context, *connect.Request[v1.ImportProductImageRequest]) (*connect.Response[v1.Product], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("catalog.v1.ProductService.ImportProductImage is not implemented"))
+}
@@ -37,8 +37,21 @@ type Product struct {
 	StrongPoints           []string `protobuf:"bytes,9,rep,name=strong_points,json=strongPoints,proto3" json:"strong_points,omitempty"`                                // 強み・メリット
 	InstallationDifficulty string   `protobuf:"bytes,10,opt,name=installation_difficulty,json=installationDifficulty,proto3" json:"installation_difficulty,omitempty"` // 設置難易度 (例: "low", "medium", "high")
 	Category               string   `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`                                                           // 製品カテゴリ (例: "robot_vacuum", "smart_lock")
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// ROI計算・提案ロジックで使用する自動化効果
+	AutomationEffect *AutomationEffect `protobuf:"bytes,12,opt,name=automation_effect,json=automationEffect,proto3" json:"automation_effect,omitempty"`
+	// 互換性チェックで使用する接続性情報
+	Connectivity *Connectivity `protobuf:"bytes,13,opt,name=connectivity,proto3" json:"connectivity,omitempty"`
+	// 住環境との適合判定で使用する設置要件
+	InstallationRequirements *InstallationRequirements `protobuf:"bytes,14,opt,name=installation_requirements,json=installationRequirements,proto3" json:"installation_requirements,omitempty"`
+	Sku                      string                    `protobuf:"bytes,15,opt,name=sku,proto3" json:"sku,omitempty"` // 管理用の外部SKU (一括登録で既存製品を特定するキー)
+	// 販売サイトでの在庫状況 (価格の定期取得で更新。管理画面・一括登録では変更できない)
+	Availability *Availability `protobuf:"bytes,16,opt,name=availability,proto3" json:"availability,omitempty"`
+	// 販売サイトごとの商品ページとアフィリエイトリンクの作り方 (一括登録では変更できない)
+	Offers []*MerchantOffer `protobuf:"bytes,17,rep,name=offers,proto3" json:"offers,omitempty"`
+	// 取り込んだ製品画像のサムネイル (取り込んでいなければ空。画像の取り込みでだけ変更できる)
+	Image         *ProductImage `protobuf:"bytes,18,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -148,28 +161,83 @@ func (x *Product) GetCategory() string {
 	return ""
 }
 
-// GetProductRequest: ID指定で製品を取得するリクエスト
-type GetProductRequest struct {
+func (x *Product) GetAutomationEffect() *AutomationEffect {
+	if x != nil {
+		return x.AutomationEffect
+	}
+	return nil
+}
+
+func (x *Product) GetConnectivity() *Connectivity {
+	if x != nil {
+		return x.Connectivity
+	}
+	return nil
+}
+
+func (x *Product) GetInstallationRequirements() *InstallationRequirements {
+	if x != nil {
+		return x.InstallationRequirements
+	}
+	return nil
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *Product) GetOffers() []*MerchantOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *Product) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+// ProductImage: 取り込んだ製品画像
+type ProductImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 取得したい製品のID
+	SourceHash    string                 `protobuf:"bytes,1,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty"` // 元画像の SHA-256
+	SourceUrl     string                 `protobuf:"bytes,2,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`    // 取得元のURL (アップロードなら空)
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`       // 元画像の形式 (例: "image/png")
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`                            // 向きを直した後の元画像の幅
+	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Variants      []*ImageVariant        `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty"`
+	IngestedAt    string                 `protobuf:"bytes,7,opt,name=ingested_at,json=ingestedAt,proto3" json:"ingested_at,omitempty"` // RFC 3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
 	mi := &file_catalog_v1_product_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *ProductImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*ProductImage) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_product_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -181,42 +249,87 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_product_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetProductRequest) GetId() string {
+func (x *ProductImage) GetSourceHash() string {
 	if x != nil {
-		return x.Id
+		return x.SourceHash
 	}
 	return ""
 }
 
-// ListProductsRequest: 一覧取得APIのリクエストパラメータ
-type ListProductsRequest struct {
+func (x *ProductImage) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *ProductImage) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ProductImage) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProductImage) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProductImage) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ProductImage) GetIngestedAt() string {
+	if x != nil {
+		return x.IngestedAt
+	}
+	return ""
+}
+
+// ImageVariant: 大きさ・形式ごとのサムネイル
+type ImageVariant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1ページあたりの取得件数 (ページネーション用)
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 次のページを取得するためのトークン
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                    // カテゴリフィルタ
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // "small" (長い辺160px) / "medium" (480px) / "large" (1200px)。元画像より大きくはしない
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "jpeg" / "webp" (可逆圧縮)
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Bytes         int32                  `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"` // 内容のハッシュを含むURL。内容が変わればURLも変わるので長期間キャッシュできる
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
 	mi := &file_catalog_v1_product_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ImageVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ImageVariant) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_product_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -228,55 +341,79 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_product_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListProductsRequest) GetPageSize() int32 {
+func (x *ImageVariant) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetPageToken() string {
+func (x *ImageVariant) GetFormat() string {
 	if x != nil {
-		return x.PageToken
+		return x.Format
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetCategory() string {
+func (x *ImageVariant) GetWidth() int32 {
 	if x != nil {
-		return x.Category
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetBytes() int32 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-// ListProductsResponse: 一覧取得APIのレスポンス
-type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                                  // 取得された製品のリスト
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次のページを取得するためのトークン (空なら最後のページ)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// MerchantOffer: 製品を販売している販売サイトと、その商品ページ
+type MerchantOffer struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Merchant string                 `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"` // 販売サイトの名前 (製品の中で一意)
+	Url      string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`           // 商品ページのURL
+	// アフィリエイトリンクの作り方。{url} / {url_encoded} / {product_id} を置き換えます。空なら url をそのまま使います
+	// 例: "{url}?tag=opti-22"、"https://ck.example.com/referral?sid=1&vc_url={url_encoded}"
+	AffiliateTemplate string `protobuf:"bytes,3,opt,name=affiliate_template,json=affiliateTemplate,proto3" json:"affiliate_template,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
+func (x *MerchantOffer) Reset() {
+	*x = MerchantOffer{}
 	mi := &file_catalog_v1_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *MerchantOffer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*MerchantOffer) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+func (x *MerchantOffer) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,56 +425,56 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MerchantOffer.ProtoReflect.Descriptor instead.
+func (*MerchantOffer) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_product_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *MerchantOffer) GetMerchant() string {
 	if x != nil {
-		return x.Products
+		return x.Merchant
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProductsResponse) GetNextPageToken() string {
+func (x *MerchantOffer) GetUrl() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Url
 	}
 	return ""
 }
 
-// CreateProductRequest: 作成時のリクエスト。IDはサーバー側で生成するため含みません。
-type CreateProductRequest struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description            string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price                  int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Manufacturer           string                 `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
-	PurchaseLink           string                 `protobuf:"bytes,5,opt,name=purchase_link,json=purchaseLink,proto3" json:"purchase_link,omitempty"`
-	ImageUrl               string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	WeakPoints             []string               `protobuf:"bytes,7,rep,name=weak_points,json=weakPoints,proto3" json:"weak_points,omitempty"`
-	StrongPoints           []string               `protobuf:"bytes,8,rep,name=strong_points,json=strongPoints,proto3" json:"strong_points,omitempty"`
-	InstallationDifficulty string                 `protobuf:"bytes,9,opt,name=installation_difficulty,json=installationDifficulty,proto3" json:"installation_difficulty,omitempty"`
-	Category               string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *MerchantOffer) GetAffiliateTemplate() string {
+	if x != nil {
+		return x.AffiliateTemplate
+	}
+	return ""
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
+// Availability: 販売サイトでの在庫状況
+type Availability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                        // "in_stock", "out_of_stock", "preorder", "discontinued"。空なら未確認
+	Merchant      string                 `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`                    // 確認した販売サイト
+	CheckedAt     string                 `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // 最後に確認した日時 (RFC 3339)。未確認なら空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Availability) Reset() {
+	*x = Availability{}
 	mi := &file_catalog_v1_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *Availability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*Availability) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *Availability) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,114 +486,111 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Availability.ProtoReflect.Descriptor instead.
+func (*Availability) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_product_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *Availability) GetStatus() string {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *Availability) GetMerchant() string {
 	if x != nil {
-		return x.Description
+		return x.Merchant
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetPrice() int32 {
+func (x *Availability) GetCheckedAt() string {
 	if x != nil {
-		return x.Price
+		return x.CheckedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateProductRequest) GetManufacturer() string {
-	if x != nil {
-		return x.Manufacturer
-	}
-	return ""
+// ChoreEffect: ある家事カテゴリの時間をどれだけ削減できるか
+type ChoreEffect struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ChoreCategory        string                 `protobuf:"bytes,1,opt,name=chore_category,json=choreCategory,proto3" json:"chore_category,omitempty"`                         // 家事カテゴリ (例: "cleaning", "laundry")
+	TimeReductionPercent int32                  `protobuf:"varint,2,opt,name=time_reduction_percent,json=timeReductionPercent,proto3" json:"time_reduction_percent,omitempty"` // 推定時間削減率 (0〜100)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) GetPurchaseLink() string {
-	if x != nil {
-		return x.PurchaseLink
-	}
-	return ""
+func (x *ChoreEffect) Reset() {
+	*x = ChoreEffect{}
+	mi := &file_catalog_v1_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) GetImageUrl() string {
-	if x != nil {
-		return x.ImageUrl
-	}
-	return ""
+func (x *ChoreEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateProductRequest) GetWeakPoints() []string {
+func (*ChoreEffect) ProtoMessage() {}
+
+func (x *ChoreEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[5]
 	if x != nil {
-		return x.WeakPoints
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateProductRequest) GetStrongPoints() []string {
-	if x != nil {
-		return x.StrongPoints
-	}
-	return nil
+// Deprecated: Use ChoreEffect.ProtoReflect.Descriptor instead.
+func (*ChoreEffect) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateProductRequest) GetInstallationDifficulty() string {
+func (x *ChoreEffect) GetChoreCategory() string {
 	if x != nil {
-		return x.InstallationDifficulty
+		return x.ChoreCategory
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetCategory() string {
+func (x *ChoreEffect) GetTimeReductionPercent() int32 {
 	if x != nil {
-		return x.Category
+		return x.TimeReductionPercent
 	}
-	return ""
+	return 0
 }
 
-// UpdateProductRequest: 更新時のリクエスト。対象を特定するためIDが必須です。
-type UpdateProductRequest struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 更新対象のID
-	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description            string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price                  int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Manufacturer           string                 `protobuf:"bytes,5,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
-	PurchaseLink           string                 `protobuf:"bytes,6,opt,name=purchase_link,json=purchaseLink,proto3" json:"purchase_link,omitempty"`
-	ImageUrl               string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	WeakPoints             []string               `protobuf:"bytes,8,rep,name=weak_points,json=weakPoints,proto3" json:"weak_points,omitempty"`
-	StrongPoints           []string               `protobuf:"bytes,9,rep,name=strong_points,json=strongPoints,proto3" json:"strong_points,omitempty"`
-	InstallationDifficulty string                 `protobuf:"bytes,10,opt,name=installation_difficulty,json=installationDifficulty,proto3" json:"installation_difficulty,omitempty"`
-	Category               string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+// AutomationEffect: 製品の自動化効果とランニングコスト
+type AutomationEffect struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	ChoreEffects               []*ChoreEffect         `protobuf:"bytes,1,rep,name=chore_effects,json=choreEffects,proto3" json:"chore_effects,omitempty"`                                                // 効果のある家事カテゴリ
+	MaintenanceMinutesPerMonth int32                  `protobuf:"varint,2,opt,name=maintenance_minutes_per_month,json=maintenanceMinutesPerMonth,proto3" json:"maintenance_minutes_per_month,omitempty"` // 定期メンテナンス時間 (分/月)
+	PowerWatts                 float64                `protobuf:"fixed64,3,opt,name=power_watts,json=powerWatts,proto3" json:"power_watts,omitempty"`                                                    // 平均消費電力 (W)
+	ConsumableCostPerMonth     int32                  `protobuf:"varint,4,opt,name=consumable_cost_per_month,json=consumableCostPerMonth,proto3" json:"consumable_cost_per_month,omitempty"`             // 消耗品コスト (円/月)
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_catalog_v1_product_proto_msgTypes[5]
+func (x *AutomationEffect) Reset() {
+	*x = AutomationEffect{}
+	mi := &file_catalog_v1_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *AutomationEffect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*AutomationEffect) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_product_proto_msgTypes[5]
+func (x *AutomationEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,111 +601,3354 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AutomationEffect.ProtoReflect.Descriptor instead.
+func (*AutomationEffect) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateProductRequest) GetId() string {
+func (x *AutomationEffect) GetChoreEffects() []*ChoreEffect {
 	if x != nil {
-		return x.Id
+		return x.ChoreEffects
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *AutomationEffect) GetMaintenanceMinutesPerMonth() int32 {
 	if x != nil {
-		return x.Name
+		return x.MaintenanceMinutesPerMonth
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
+func (x *AutomationEffect) GetPowerWatts() float64 {
 	if x != nil {
-		return x.Description
+		return x.PowerWatts
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateProductRequest) GetPrice() int32 {
+func (x *AutomationEffect) GetConsumableCostPerMonth() int32 {
 	if x != nil {
-		return x.Price
+		return x.ConsumableCostPerMonth
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetManufacturer() string {
-	if x != nil {
-		return x.Manufacturer
-	}
-	return ""
+// Connectivity: 製品の接続性情報
+// 規格の値: "matter", "thread", "zigbee", "wifi_2_4ghz", "wifi_5ghz",
+//
+//	"bluetooth", "homekit", "alexa", "google_home"
+type Connectivity struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Protocols            []string               `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`                                                     // 対応している通信規格・エコシステム
+	RequiredHubProtocols []string               `protobuf:"bytes,2,rep,name=required_hub_protocols,json=requiredHubProtocols,proto3" json:"required_hub_protocols,omitempty"` // 動作に必要なハブの規格 (いずれか1つ。空ならハブ不要)
+	BridgedProtocols     []string               `protobuf:"bytes,3,rep,name=bridged_protocols,json=bridgedProtocols,proto3" json:"bridged_protocols,omitempty"`               // ハブとして仲介できる規格 (ハブ製品のみ)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) GetPurchaseLink() string {
-	if x != nil {
-		return x.PurchaseLink
-	}
-	return ""
+func (x *Connectivity) Reset() {
+	*x = Connectivity{}
+	mi := &file_catalog_v1_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) GetImageUrl() string {
-	if x != nil {
-		return x.ImageUrl
-	}
-	return ""
+func (x *Connectivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateProductRequest) GetWeakPoints() []string {
-	if x != nil {
-		return x.WeakPoints
-	}
-	return nil
-}
+func (*Connectivity) ProtoMessage() {}
+
+func (x *Connectivity) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connectivity.ProtoReflect.Descriptor instead.
+func (*Connectivity) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Connectivity) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *Connectivity) GetRequiredHubProtocols() []string {
+	if x != nil {
+		return x.RequiredHubProtocols
+	}
+	return nil
+}
+
+func (x *Connectivity) GetBridgedProtocols() []string {
+	if x != nil {
+		return x.BridgedProtocols
+	}
+	return nil
+}
+
+// InstallationRequirements: 製品の設置要件
+type InstallationRequirements struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	RequiresDrilling        bool                   `protobuf:"varint,1,opt,name=requires_drilling,json=requiresDrilling,proto3" json:"requires_drilling,omitempty"`                       // 壁やドアへの穴あけが必要
+	RequiresElectricalWork  bool                   `protobuf:"varint,2,opt,name=requires_electrical_work,json=requiresElectricalWork,proto3" json:"requires_electrical_work,omitempty"`   // 電気工事が必要
+	StepSensitive           bool                   `protobuf:"varint,3,opt,name=step_sensitive,json=stepSensitive,proto3" json:"step_sensitive,omitempty"`                                // 段差の影響を受ける (ロボット掃除機など)
+	ClimbableStepMm         int32                  `protobuf:"varint,4,opt,name=climbable_step_mm,json=climbableStepMm,proto3" json:"climbable_step_mm,omitempty"`                        // 乗り越えられる段差の高さ (mm)
+	SupportedFloorTypes     []string               `protobuf:"bytes,5,rep,name=supported_floor_types,json=supportedFloorTypes,proto3" json:"supported_floor_types,omitempty"`             // 対応床材 ("flooring", "tatami", "carpet")。空なら問わない
+	SupportedResidenceTypes []string               `protobuf:"bytes,6,rep,name=supported_residence_types,json=supportedResidenceTypes,proto3" json:"supported_residence_types,omitempty"` // 設置できる住居の種類。空なら問わない
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *InstallationRequirements) Reset() {
+	*x = InstallationRequirements{}
+	mi := &file_catalog_v1_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallationRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallationRequirements) ProtoMessage() {}
+
+func (x *InstallationRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallationRequirements.ProtoReflect.Descriptor instead.
+func (*InstallationRequirements) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InstallationRequirements) GetRequiresDrilling() bool {
+	if x != nil {
+		return x.RequiresDrilling
+	}
+	return false
+}
+
+func (x *InstallationRequirements) GetRequiresElectricalWork() bool {
+	if x != nil {
+		return x.RequiresElectricalWork
+	}
+	return false
+}
+
+func (x *InstallationRequirements) GetStepSensitive() bool {
+	if x != nil {
+		return x.StepSensitive
+	}
+	return false
+}
+
+func (x *InstallationRequirements) GetClimbableStepMm() int32 {
+	if x != nil {
+		return x.ClimbableStepMm
+	}
+	return 0
+}
+
+func (x *InstallationRequirements) GetSupportedFloorTypes() []string {
+	if x != nil {
+		return x.SupportedFloorTypes
+	}
+	return nil
+}
+
+func (x *InstallationRequirements) GetSupportedResidenceTypes() []string {
+	if x != nil {
+		return x.SupportedResidenceTypes
+	}
+	return nil
+}
+
+// GetProductRequest: ID指定で製品を取得するリクエスト
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 取得したい製品のID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// BatchGetProductsRequest: 一括取得したい製品のID
+type BatchGetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsRequest) Reset() {
+	*x = BatchGetProductsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsRequest) ProtoMessage() {}
+
+func (x *BatchGetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchGetProductsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// BatchGetProductsResponse: 一括取得の結果
+type BatchGetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                       // 見つかった製品 (リクエストの順序)
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"` // 見つからなかった製品のID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsResponse) Reset() {
+	*x = BatchGetProductsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsResponse) ProtoMessage() {}
+
+func (x *BatchGetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BatchGetProductsResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+// ListProductsRequest: 一覧取得APIのリクエストパラメータ
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 1ページあたりの取得件数 (ページネーション用)
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 次のページを取得するためのトークン
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                    // カテゴリフィルタ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// ListProductsResponse: 一覧取得APIのレスポンス
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                                  // 取得された製品のリスト
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次のページを取得するためのトークン (空なら最後のページ)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateProductRequest: 作成時のリクエスト。IDはサーバー側で生成するため含みません。
+type CreateProductRequest struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	Name                     string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description              string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price                    int32                     `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Manufacturer             string                    `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	PurchaseLink             string                    `protobuf:"bytes,5,opt,name=purchase_link,json=purchaseLink,proto3" json:"purchase_link,omitempty"`
+	ImageUrl                 string                    `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	WeakPoints               []string                  `protobuf:"bytes,7,rep,name=weak_points,json=weakPoints,proto3" json:"weak_points,omitempty"`
+	StrongPoints             []string                  `protobuf:"bytes,8,rep,name=strong_points,json=strongPoints,proto3" json:"strong_points,omitempty"`
+	InstallationDifficulty   string                    `protobuf:"bytes,9,opt,name=installation_difficulty,json=installationDifficulty,proto3" json:"installation_difficulty,omitempty"`
+	Category                 string                    `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	AutomationEffect         *AutomationEffect         `protobuf:"bytes,11,opt,name=automation_effect,json=automationEffect,proto3" json:"automation_effect,omitempty"`
+	Connectivity             *Connectivity             `protobuf:"bytes,12,opt,name=connectivity,proto3" json:"connectivity,omitempty"`
+	InstallationRequirements *InstallationRequirements `protobuf:"bytes,13,opt,name=installation_requirements,json=installationRequirements,proto3" json:"installation_requirements,omitempty"`
+	Sku                      string                    `protobuf:"bytes,14,opt,name=sku,proto3" json:"sku,omitempty"`
+	Offers                   []*MerchantOffer          `protobuf:"bytes,15,rep,name=offers,proto3" json:"offers,omitempty"` // 販売サイト。空なら purchase_link をアフィリエイトなしで使う
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPurchaseLink() string {
+	if x != nil {
+		return x.PurchaseLink
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetWeakPoints() []string {
+	if x != nil {
+		return x.WeakPoints
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetStrongPoints() []string {
+	if x != nil {
+		return x.StrongPoints
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetInstallationDifficulty() string {
+	if x != nil {
+		return x.InstallationDifficulty
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetAutomationEffect() *AutomationEffect {
+	if x != nil {
+		return x.AutomationEffect
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetConnectivity() *Connectivity {
+	if x != nil {
+		return x.Connectivity
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetInstallationRequirements() *InstallationRequirements {
+	if x != nil {
+		return x.InstallationRequirements
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetOffers() []*MerchantOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+// UpdateProductRequest: 更新時のリクエスト。対象を特定するためIDが必須です。
+type UpdateProductRequest struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	Id                       string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 更新対象のID
+	Name                     string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description              string                    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price                    int32                     `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Manufacturer             string                    `protobuf:"bytes,5,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	PurchaseLink             string                    `protobuf:"bytes,6,opt,name=purchase_link,json=purchaseLink,proto3" json:"purchase_link,omitempty"`
+	ImageUrl                 string                    `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	WeakPoints               []string                  `protobuf:"bytes,8,rep,name=weak_points,json=weakPoints,proto3" json:"weak_points,omitempty"`
+	StrongPoints             []string                  `protobuf:"bytes,9,rep,name=strong_points,json=strongPoints,proto3" json:"strong_points,omitempty"`
+	InstallationDifficulty   string                    `protobuf:"bytes,10,opt,name=installation_difficulty,json=installationDifficulty,proto3" json:"installation_difficulty,omitempty"`
+	Category                 string                    `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	AutomationEffect         *AutomationEffect         `protobuf:"bytes,12,opt,name=automation_effect,json=automationEffect,proto3" json:"automation_effect,omitempty"`
+	Connectivity             *Connectivity             `protobuf:"bytes,13,opt,name=connectivity,proto3" json:"connectivity,omitempty"`
+	InstallationRequirements *InstallationRequirements `protobuf:"bytes,14,opt,name=installation_requirements,json=installationRequirements,proto3" json:"installation_requirements,omitempty"`
+	Sku                      string                    `protobuf:"bytes,15,opt,name=sku,proto3" json:"sku,omitempty"`
+	Offers                   []*MerchantOffer          `protobuf:"bytes,16,rep,name=offers,proto3" json:"offers,omitempty"` // 販売サイト。空なら purchase_link をアフィリエイトなしで使う
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPurchaseLink() string {
+	if x != nil {
+		return x.PurchaseLink
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetWeakPoints() []string {
+	if x != nil {
+		return x.WeakPoints
+	}
+	return nil
+}
 
 func (x *UpdateProductRequest) GetStrongPoints() []string {
 	if x != nil {
-		return x.StrongPoints
+		return x.StrongPoints
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetInstallationDifficulty() string {
+	if x != nil {
+		return x.InstallationDifficulty
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetAutomationEffect() *AutomationEffect {
+	if x != nil {
+		return x.AutomationEffect
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetConnectivity() *Connectivity {
+	if x != nil {
+		return x.Connectivity
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetInstallationRequirements() *InstallationRequirements {
+	if x != nil {
+		return x.InstallationRequirements
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetOffers() []*MerchantOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+// DeleteProductRequest: 削除時はIDだけ指定します。
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteProductResponse: 削除完了時は空のレスポンスを返します。
+// 必要に応じて削除されたIDなどを返すこともあります。
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{17}
+}
+
+// WifiEnvironment: 住居のWi-Fi環境
+type WifiEnvironment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"` // Wi-Fiがあるかどうか
+	Bands         []string               `protobuf:"bytes,2,rep,name=bands,proto3" json:"bands,omitempty"`          // 利用できる帯域 ("wifi_2_4ghz", "wifi_5ghz")。空なら両方
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WifiEnvironment) Reset() {
+	*x = WifiEnvironment{}
+	mi := &file_catalog_v1_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WifiEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WifiEnvironment) ProtoMessage() {}
+
+func (x *WifiEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WifiEnvironment.ProtoReflect.Descriptor instead.
+func (*WifiEnvironment) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WifiEnvironment) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *WifiEnvironment) GetBands() []string {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+// CheckCompatibilityRequest: 互換性チェックのリクエスト
+type CheckCompatibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 導入を検討している製品のID
+	Wifi          *WifiEnvironment       `protobuf:"bytes,2,opt,name=wifi,proto3" json:"wifi,omitempty"`                               // 住居のWi-Fi環境。省略すると不明として Wi-Fi の判定をしない
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCompatibilityRequest) Reset() {
+	*x = CheckCompatibilityRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCompatibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCompatibilityRequest) ProtoMessage() {}
+
+func (x *CheckCompatibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCompatibilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckCompatibilityRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *CheckCompatibilityRequest) GetWifi() *WifiEnvironment {
+	if x != nil {
+		return x.Wifi
+	}
+	return nil
+}
+
+// CompatibilityIssue: 製品1つに対する互換性の問題
+type CompatibilityIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`           // "no_wifi", "wifi_band_mismatch", "missing_bridge"
+	Protocols     []string               `protobuf:"bytes,3,rep,name=protocols,proto3" json:"protocols,omitempty"` // 問題に関係する規格
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`     // ユーザー向けの説明文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityIssue) Reset() {
+	*x = CompatibilityIssue{}
+	mi := &file_catalog_v1_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityIssue) ProtoMessage() {}
+
+func (x *CompatibilityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityIssue.ProtoReflect.Descriptor instead.
+func (*CompatibilityIssue) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompatibilityIssue) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CompatibilityIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CompatibilityIssue) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *CompatibilityIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// HubProposal: 不足しているブリッジを補うハブの提案
+type HubProposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProposalGroup string                 `protobuf:"bytes,2,opt,name=proposal_group,json=proposalGroup,proto3" json:"proposal_group,omitempty"` // 提案グループ (常に "management")
+	Covers        []string               `protobuf:"bytes,3,rep,name=covers,proto3" json:"covers,omitempty"`                                    // このハブで解消できる規格
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HubProposal) Reset() {
+	*x = HubProposal{}
+	mi := &file_catalog_v1_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubProposal) ProtoMessage() {}
+
+func (x *HubProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HubProposal.ProtoReflect.Descriptor instead.
+func (*HubProposal) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HubProposal) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *HubProposal) GetProposalGroup() string {
+	if x != nil {
+		return x.ProposalGroup
+	}
+	return ""
+}
+
+func (x *HubProposal) GetCovers() []string {
+	if x != nil {
+		return x.Covers
+	}
+	return nil
+}
+
+func (x *HubProposal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CheckCompatibilityResponse: 互換性チェックの結果
+type CheckCompatibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Compatible    bool                   `protobuf:"varint,1,opt,name=compatible,proto3" json:"compatible,omitempty"` // 問題が1つもなければ true
+	Issues        []*CompatibilityIssue  `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	ProposedHubs  []*HubProposal         `protobuf:"bytes,3,rep,name=proposed_hubs,json=proposedHubs,proto3" json:"proposed_hubs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCompatibilityResponse) Reset() {
+	*x = CheckCompatibilityResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCompatibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCompatibilityResponse) ProtoMessage() {}
+
+func (x *CheckCompatibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCompatibilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckCompatibilityResponse) GetCompatible() bool {
+	if x != nil {
+		return x.Compatible
+	}
+	return false
+}
+
+func (x *CheckCompatibilityResponse) GetIssues() []*CompatibilityIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *CheckCompatibilityResponse) GetProposedHubs() []*HubProposal {
+	if x != nil {
+		return x.ProposedHubs
+	}
+	return nil
+}
+
+// Residence: 製品の適合判定に使う住環境
+// User Service の ResidenceInfo から、判定に必要な項目を詰め替えて渡します。
+type Residence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                        // 住居の種類 ("apartment", "house", ...)
+	Ownership     string                 `protobuf:"bytes,2,opt,name=ownership,proto3" json:"ownership,omitempty"`                              // 所有形態 ("owned", "rented", ...)
+	HasSteps      bool                   `protobuf:"varint,3,opt,name=has_steps,json=hasSteps,proto3" json:"has_steps,omitempty"`               // 室内に段差があるか
+	StepHeightMm  int32                  `protobuf:"varint,4,opt,name=step_height_mm,json=stepHeightMm,proto3" json:"step_height_mm,omitempty"` // 最大の段差の高さ (mm)。0なら不明
+	FloorTypes    []string               `protobuf:"bytes,5,rep,name=floor_types,json=floorTypes,proto3" json:"floor_types,omitempty"`          // 家の中の床材
+	Wifi          *WifiEnvironment       `protobuf:"bytes,6,opt,name=wifi,proto3" json:"wifi,omitempty"`                                        // Wi-Fi環境 (未指定なら判定しません)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Residence) Reset() {
+	*x = Residence{}
+	mi := &file_catalog_v1_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Residence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Residence) ProtoMessage() {}
+
+func (x *Residence) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Residence.ProtoReflect.Descriptor instead.
+func (*Residence) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Residence) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Residence) GetOwnership() string {
+	if x != nil {
+		return x.Ownership
+	}
+	return ""
+}
+
+func (x *Residence) GetHasSteps() bool {
+	if x != nil {
+		return x.HasSteps
+	}
+	return false
+}
+
+func (x *Residence) GetStepHeightMm() int32 {
+	if x != nil {
+		return x.StepHeightMm
+	}
+	return 0
+}
+
+func (x *Residence) GetFloorTypes() []string {
+	if x != nil {
+		return x.FloorTypes
+	}
+	return nil
+}
+
+func (x *Residence) GetWifi() *WifiEnvironment {
+	if x != nil {
+		return x.Wifi
+	}
+	return nil
+}
+
+// ListEligibleProductsRequest: 住環境に合う製品の一覧取得リクエスト
+type ListEligibleProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Residence     *Residence             `protobuf:"bytes,1,opt,name=residence,proto3" json:"residence,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // カテゴリフィルタ (空なら全カテゴリ)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEligibleProductsRequest) Reset() {
+	*x = ListEligibleProductsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEligibleProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEligibleProductsRequest) ProtoMessage() {}
+
+func (x *ListEligibleProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEligibleProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListEligibleProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListEligibleProductsRequest) GetResidence() *Residence {
+	if x != nil {
+		return x.Residence
+	}
+	return nil
+}
+
+func (x *ListEligibleProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// Rejection: 製品を提案しない理由
+type Rejection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`       // "drilling_in_rental", "steps", "floor_type", ...
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // ユーザー向けの説明文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rejection) Reset() {
+	*x = Rejection{}
+	mi := &file_catalog_v1_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rejection) ProtoMessage() {}
+
+func (x *Rejection) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rejection.ProtoReflect.Descriptor instead.
+func (*Rejection) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Rejection) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Rejection) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// EligibleProduct: 提案できる製品と、その注意点
+type EligibleProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Notes         []string               `protobuf:"bytes,2,rep,name=notes,proto3" json:"notes,omitempty"` // 一部の部屋で使えないなどの注意点
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EligibleProduct) Reset() {
+	*x = EligibleProduct{}
+	mi := &file_catalog_v1_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EligibleProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibleProduct) ProtoMessage() {}
+
+func (x *EligibleProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibleProduct.ProtoReflect.Descriptor instead.
+func (*EligibleProduct) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EligibleProduct) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *EligibleProduct) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// RejectedProduct: 提案できない製品と、その理由
+type RejectedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Rejections    []*Rejection           `protobuf:"bytes,2,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedProduct) Reset() {
+	*x = RejectedProduct{}
+	mi := &file_catalog_v1_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedProduct) ProtoMessage() {}
+
+func (x *RejectedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedProduct.ProtoReflect.Descriptor instead.
+func (*RejectedProduct) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RejectedProduct) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *RejectedProduct) GetRejections() []*Rejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+// ListEligibleProductsResponse: 住環境に合う製品の一覧
+type ListEligibleProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eligible      []*EligibleProduct     `protobuf:"bytes,1,rep,name=eligible,proto3" json:"eligible,omitempty"`
+	Rejected      []*RejectedProduct     `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEligibleProductsResponse) Reset() {
+	*x = ListEligibleProductsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEligibleProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEligibleProductsResponse) ProtoMessage() {}
+
+func (x *ListEligibleProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEligibleProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListEligibleProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListEligibleProductsResponse) GetEligible() []*EligibleProduct {
+	if x != nil {
+		return x.Eligible
+	}
+	return nil
+}
+
+func (x *ListEligibleProductsResponse) GetRejected() []*RejectedProduct {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+// SearchProductsRequest: 製品検索の条件
+// query が空の場合は、絞り込み条件だけで製品名順に返します。
+type SearchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Filter        *ProductFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 既定20件、最大100件
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 前のレスポンスの next_page_token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ProductFilter: 製品の絞り込み条件
+// 同じ項目の中で複数指定した値は「いずれか」、項目どうしは「すべて」を満たす製品に絞り込みます。
+type ProductFilter struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Categories               []string               `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Manufacturers            []string               `protobuf:"bytes,2,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	MinPrice                 int32                  `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // 0なら下限なし
+	MaxPrice                 int32                  `protobuf:"varint,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // 0なら上限なし
+	InstallationDifficulties []string               `protobuf:"bytes,5,rep,name=installation_difficulties,json=installationDifficulties,proto3" json:"installation_difficulties,omitempty"`
+	Protocols                []string               `protobuf:"bytes,6,rep,name=protocols,proto3" json:"protocols,omitempty"` // いずれかの規格に対応している製品
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ProductFilter) Reset() {
+	*x = ProductFilter{}
+	mi := &file_catalog_v1_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFilter) ProtoMessage() {}
+
+func (x *ProductFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFilter.ProtoReflect.Descriptor instead.
+func (*ProductFilter) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProductFilter) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetManufacturers() []string {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetMinPrice() int32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetInstallationDifficulties() []string {
+	if x != nil {
+		return x.InstallationDifficulties
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+// SearchHighlight: 検索語に一致した箇所を含む抜粋
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`         // "name", "description", "manufacturer", "strong_points", "weak_points"
+	Fragments     []string               `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"` // HTMLエスケープ済み。一致箇所を <mark></mark> で囲んでいます
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_catalog_v1_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetFragments() []string {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+// ProductSearchHit: 検索にヒットした製品
+type ProductSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // 関連度 (大きいほど関連が強い)
+	Highlights    []*SearchHighlight     `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSearchHit) Reset() {
+	*x = ProductSearchHit{}
+	mi := &file_catalog_v1_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSearchHit) ProtoMessage() {}
+
+func (x *ProductSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSearchHit.ProtoReflect.Descriptor instead.
+func (*ProductSearchHit) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProductSearchHit) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProductSearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*ProductSearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // 条件に一致した全件数
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 空なら最後のページ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchProductsResponse) GetHits() []*ProductSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListProductFacetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductFacetsRequest) Reset() {
+	*x = ListProductFacetsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductFacetsRequest) ProtoMessage() {}
+
+func (x *ListProductFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductFacetsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListProductFacetsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// FacetValue: ファセットの値ごとの件数
+type FacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // 価格帯は "under_5000", "5000_9999", ..., "100000_plus"
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Selected      bool                   `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`                 // 絞り込み条件で指定されている値か
+	MinPrice      int32                  `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // 価格帯のみ: この金額以上
+	MaxPrice      int32                  `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // 価格帯のみ: この金額以下 (0なら上限なし)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
+	mi := &file_catalog_v1_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetValue) ProtoMessage() {}
+
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetValue) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FacetValue) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *FacetValue) GetMinPrice() int32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *FacetValue) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+// Facet: 1つの項目の集計結果
+type Facet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // "category", "manufacturer", "installation_difficulty", "price_bucket", "protocol"
+	Values        []*FacetValue          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // 件数の多い順 (価格帯は安い順)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_catalog_v1_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Facet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Facet) GetValues() []*FacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListProductFacetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    int32                  `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // すべての条件を満たす製品の数
+	Facets        []*Facet               `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductFacetsResponse) Reset() {
+	*x = ListProductFacetsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductFacetsResponse) ProtoMessage() {}
+
+func (x *ListProductFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductFacetsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListProductFacetsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListProductFacetsResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// ImportOptions: 一括登録の設定 (ImportProducts の最初のメッセージ)
+type ImportOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`                // "csv" または "ndjson"
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // true なら保存せず、件数とエラーだけを返す
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_catalog_v1_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportProductsRequest_Options
+	//	*ImportProductsRequest_Chunk
+	Payload       isImportProductsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportProductsRequest) GetPayload() isImportProductsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportProductsRequest_Payload interface {
+	isImportProductsRequest_Payload()
+}
+
+type ImportProductsRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"` // 最初のメッセージ
+}
+
+type ImportProductsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // ファイルの中身 (任意の位置で分割してよい)
+}
+
+func (*ImportProductsRequest_Options) isImportProductsRequest_Payload() {}
+
+func (*ImportProductsRequest_Chunk) isImportProductsRequest_Payload() {}
+
+// ImportRowError: 取り込めなかった行
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // ファイル上の行番号 (1始まり。CSVはヘッダーが1行目)
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_catalog_v1_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`        // 先頭の1000件まで
+	Unchanged     int32                  `protobuf:"varint,6,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // 既存の製品と内容が同じため保存しなかった件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportProductsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+type ExportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // "csv" または "ndjson"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsResponse) Reset() {
+	*x = ExportProductsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsResponse) ProtoMessage() {}
+
+func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportProductsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// PricePoint: ある時点で設定された価格 (次の変更まではこの価格だったことを表します)
+type PricePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         int32                  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`                            // 価格 (日本円)
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                           // 変更元 ("admin", "import" など)
+	RecordedAt    string                 `protobuf:"bytes,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"` // 変更された日時 (RFC 3339)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricePoint) Reset() {
+	*x = PricePoint{}
+	mi := &file_catalog_v1_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricePoint) ProtoMessage() {}
+
+func (x *PricePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PricePoint) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PricePoint) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PricePoint) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Since         string                 `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // RFC 3339。空なら全期間
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Points        []*PricePoint          `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"` // 古い順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPriceHistoryResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryResponse) GetPoints() []*PricePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// PriceDropEvent: 製品の価格が直近の最安値を下回ったことを表すイベント
+type PriceDropEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Price         int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                                      // 新しい価格
+	PreviousPrice int32                  `protobuf:"varint,5,opt,name=previous_price,json=previousPrice,proto3" json:"previous_price,omitempty"` // 直前の価格
+	TrailingMin   int32                  `protobuf:"varint,6,opt,name=trailing_min,json=trailingMin,proto3" json:"trailing_min,omitempty"`       // 直近 window_days 日間の最安値
+	WindowDays    int32                  `protobuf:"varint,7,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	DropPercent   float64                `protobuf:"fixed64,8,opt,name=drop_percent,json=dropPercent,proto3" json:"drop_percent,omitempty"` // 最安値からの値下がり率 (%)
+	Source        string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`                                // 価格の変更元
+	DetectedAt    string                 `protobuf:"bytes,10,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`     // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceDropEvent) Reset() {
+	*x = PriceDropEvent{}
+	mi := &file_catalog_v1_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceDropEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceDropEvent) ProtoMessage() {}
+
+func (x *PriceDropEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceDropEvent.ProtoReflect.Descriptor instead.
+func (*PriceDropEvent) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PriceDropEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceDropEvent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceDropEvent) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceDropEvent) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceDropEvent) GetPreviousPrice() int32 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+func (x *PriceDropEvent) GetTrailingMin() int32 {
+	if x != nil {
+		return x.TrailingMin
+	}
+	return 0
+}
+
+func (x *PriceDropEvent) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *PriceDropEvent) GetDropPercent() float64 {
+	if x != nil {
+		return x.DropPercent
+	}
+	return 0
+}
+
+func (x *PriceDropEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PriceDropEvent) GetDetectedAt() string {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return ""
+}
+
+type ListPriceDropsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`                             // RFC 3339。この日時より後に検出したものを返す (空なら全期間)
+	ProductIds    []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 空ならすべての製品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceDropsRequest) Reset() {
+	*x = ListPriceDropsRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceDropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceDropsRequest) ProtoMessage() {}
+
+func (x *ListPriceDropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceDropsRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceDropsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListPriceDropsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *ListPriceDropsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ListPriceDropsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*PriceDropEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceDropsResponse) Reset() {
+	*x = ListPriceDropsResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceDropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceDropsResponse) ProtoMessage() {}
+
+func (x *ListPriceDropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceDropsResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceDropsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListPriceDropsResponse) GetEvents() []*PriceDropEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetPurchaseLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 省略可。トークンに入れる利用者はアクセストークンから決める (未ログインなら匿名)
+	PlanId        string                 `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // リンクを表示した採用計画 (カタログ画面などでは空)。未ログインなら無視する
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseLinksRequest) Reset() {
+	*x = GetPurchaseLinksRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseLinksRequest) ProtoMessage() {}
+
+func (x *GetPurchaseLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseLinksRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPurchaseLinksRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPurchaseLinksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPurchaseLinksRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// PurchaseLink: 販売サイトごとの購入リンク
+type PurchaseLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      string                 `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	RedirectUrl   string                 `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"` // クリックを記録して遷移するリンク。画面ではこちらを使う
+	TaggedUrl     string                 `protobuf:"bytes,3,opt,name=tagged_url,json=taggedUrl,proto3" json:"tagged_url,omitempty"`       // 遷移先のアフィリエイトリンク (確認用。クリックは記録されない)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseLink) Reset() {
+	*x = PurchaseLink{}
+	mi := &file_catalog_v1_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseLink) ProtoMessage() {}
+
+func (x *PurchaseLink) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseLink.ProtoReflect.Descriptor instead.
+func (*PurchaseLink) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PurchaseLink) GetMerchant() string {
+	if x != nil {
+		return x.Merchant
+	}
+	return ""
+}
+
+func (x *PurchaseLink) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *PurchaseLink) GetTaggedUrl() string {
+	if x != nil {
+		return x.TaggedUrl
+	}
+	return ""
+}
+
+type GetPurchaseLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*PurchaseLink        `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseLinksResponse) Reset() {
+	*x = GetPurchaseLinksResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseLinksResponse) ProtoMessage() {}
+
+func (x *GetPurchaseLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseLinksResponse.ProtoReflect.Descriptor instead.
+func (*GetPurchaseLinksResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPurchaseLinksResponse) GetLinks() []*PurchaseLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type GetClickReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`                             // RFC 3339。この日時以降のクリック (空なら全期間)
+	Until         string                 `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`                             // RFC 3339。この日時より前のクリック (空なら現在まで)
+	ProductIds    []string               `protobuf:"bytes,3,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 空ならすべての製品
+	PlanIds       []string               `protobuf:"bytes,4,rep,name=plan_ids,json=planIds,proto3" json:"plan_ids,omitempty"`          // 空ならすべての採用計画
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClickReportRequest) Reset() {
+	*x = GetClickReportRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClickReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClickReportRequest) ProtoMessage() {}
+
+func (x *GetClickReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClickReportRequest.ProtoReflect.Descriptor instead.
+func (*GetClickReportRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetClickReportRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *GetClickReportRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *GetClickReportRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *GetClickReportRequest) GetPlanIds() []string {
+	if x != nil {
+		return x.PlanIds
+	}
+	return nil
+}
+
+// ClickCount: 製品 (または採用計画) ごとのクリック数
+type ClickCount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // 製品ID または 採用計画ID
+	Clicks           int32                  `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	UniqueUsers      int32                  `protobuf:"varint,3,opt,name=unique_users,json=uniqueUsers,proto3" json:"unique_users,omitempty"` // クリックした利用者の数 (未ログインのクリックは数えない)
+	ClicksByMerchant map[string]int32       `protobuf:"bytes,4,rep,name=clicks_by_merchant,json=clicksByMerchant,proto3" json:"clicks_by_merchant,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ClickCount) Reset() {
+	*x = ClickCount{}
+	mi := &file_catalog_v1_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClickCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickCount) ProtoMessage() {}
+
+func (x *ClickCount) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickCount.ProtoReflect.Descriptor instead.
+func (*ClickCount) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClickCount) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ClickCount) GetClicks() int32 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *ClickCount) GetUniqueUsers() int32 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+func (x *ClickCount) GetClicksByMerchant() map[string]int32 {
+	if x != nil {
+		return x.ClicksByMerchant
 	}
 	return nil
 }
 
-func (x *UpdateProductRequest) GetInstallationDifficulty() string {
+type GetClickReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	ByProduct     []*ClickCount          `protobuf:"bytes,2,rep,name=by_product,json=byProduct,proto3" json:"by_product,omitempty"` // クリック数の多い順
+	ByPlan        []*ClickCount          `protobuf:"bytes,3,rep,name=by_plan,json=byPlan,proto3" json:"by_plan,omitempty"`          // クリック数の多い順 (採用計画の外からのクリックは含まない)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClickReportResponse) Reset() {
+	*x = GetClickReportResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClickReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClickReportResponse) ProtoMessage() {}
+
+func (x *GetClickReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[55]
 	if x != nil {
-		return x.InstallationDifficulty
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClickReportResponse.ProtoReflect.Descriptor instead.
+func (*GetClickReportResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetClickReportResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetClickReportResponse) GetByProduct() []*ClickCount {
+	if x != nil {
+		return x.ByProduct
+	}
+	return nil
+}
+
+func (x *GetClickReportResponse) GetByPlan() []*ClickCount {
+	if x != nil {
+		return x.ByPlan
+	}
+	return nil
+}
+
+type ListProductIssuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 絞り込む問題の種類 ("not_found", "soft_404", "out_of_stock", "unreachable")。空ならすべて
+	Statuses      []string `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductIssuesRequest) Reset() {
+	*x = ListProductIssuesRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductIssuesRequest) ProtoMessage() {}
+
+func (x *ListProductIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListProductIssuesRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ProductIssue: 製品の購入リンクの問題
+type ProductIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Merchant      string                 `protobuf:"bytes,3,opt,name=merchant,proto3" json:"merchant,omitempty"` // リンクの販売サイト (販売サイト未登録の購入リンクなら "direct")
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                       // "not_found" / "soft_404" / "out_of_stock" / "unreachable"
+	HttpStatus    int32                  `protobuf:"varint,6,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`            // 最後に受け取ったステータスコード (受け取れなかったら0)
+	FinalUrl      string                 `protobuf:"bytes,7,opt,name=final_url,json=finalUrl,proto3" json:"final_url,omitempty"`                   // 転送をたどった後のURL
+	Detail        string                 `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`                                       // 判定の理由
+	FirstSeenAt   string                 `protobuf:"bytes,9,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`        // 問題を最初に見つけた日時 (RFC 3339)
+	LastCheckedAt string                 `protobuf:"bytes,10,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductIssue) Reset() {
+	*x = ProductIssue{}
+	mi := &file_catalog_v1_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductIssue) ProtoMessage() {}
+
+func (x *ProductIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductIssue.ProtoReflect.Descriptor instead.
+func (*ProductIssue) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProductIssue) GetProductId() string {
+	if x != nil {
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetCategory() string {
+func (x *ProductIssue) GetProductName() string {
 	if x != nil {
-		return x.Category
+		return x.ProductName
 	}
 	return ""
 }
 
-// DeleteProductRequest: 削除時はIDだけ指定します。
-type DeleteProductRequest struct {
+func (x *ProductIssue) GetMerchant() string {
+	if x != nil {
+		return x.Merchant
+	}
+	return ""
+}
+
+func (x *ProductIssue) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductIssue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductIssue) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *ProductIssue) GetFinalUrl() string {
+	if x != nil {
+		return x.FinalUrl
+	}
+	return ""
+}
+
+func (x *ProductIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ProductIssue) GetFirstSeenAt() string {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return ""
+}
+
+func (x *ProductIssue) GetLastCheckedAt() string {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return ""
+}
+
+type ListProductIssuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Issues        []*ProductIssue        `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_catalog_v1_product_proto_msgTypes[6]
+func (x *ListProductIssuesResponse) Reset() {
+	*x = ListProductIssuesResponse{}
+	mi := &file_catalog_v1_product_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *ListProductIssuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*ListProductIssuesResponse) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_product_proto_msgTypes[6]
+func (x *ListProductIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,41 +3959,93 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_product_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListProductIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteProductRequest) GetId() string {
+func (x *ListProductIssuesResponse) GetIssues() []*ProductIssue {
 	if x != nil {
-		return x.Id
+		return x.Issues
+	}
+	return nil
+}
+
+type UploadProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // 画像ファイルの中身
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProductImageRequest) Reset() {
+	*x = UploadProductImageRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProductImageRequest) ProtoMessage() {}
+
+func (x *UploadProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UploadProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
 	}
 	return ""
 }
 
-// DeleteProductResponse: 削除完了時は空のレスポンスを返します。
-// 必要に応じて削除されたIDなどを返すこともあります。
-type DeleteProductResponse struct {
+func (x *UploadProductImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportProductImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SourceUrl     string                 `protobuf:"bytes,2,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"` // http(s) のURL
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
-	mi := &file_catalog_v1_product_proto_msgTypes[7]
+func (x *ImportProductImageRequest) Reset() {
+	*x = ImportProductImageRequest{}
+	mi := &file_catalog_v1_product_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ImportProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ImportProductImageRequest) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_product_proto_msgTypes[7]
+func (x *ImportProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_product_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,9 +4056,23 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_product_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportProductImageRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ImportProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ImportProductImageRequest) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
 }
 
 var File_catalog_v1_product_proto protoreflect.FileDescriptor
@@ -637,7 +4080,7 @@ var File_catalog_v1_product_proto protoreflect.FileDescriptor
 const file_catalog_v1_product_proto_rawDesc = "" +
 	"\n" +
 	"\x18catalog/v1/product.proto\x12\n" +
-	"catalog.v1\"\xe6\x02\n" +
+	"catalog.v1\"\x85\x06\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -651,9 +4094,69 @@ const file_catalog_v1_product_proto_rawDesc = "" +
 	"\rstrong_points\x18\t \x03(\tR\fstrongPoints\x127\n" +
 	"\x17installation_difficulty\x18\n" +
 	" \x01(\tR\x16installationDifficulty\x12\x1a\n" +
-	"\bcategory\x18\v \x01(\tR\bcategory\"#\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\x12I\n" +
+	"\x11automation_effect\x18\f \x01(\v2\x1c.catalog.v1.AutomationEffectR\x10automationEffect\x12<\n" +
+	"\fconnectivity\x18\r \x01(\v2\x18.catalog.v1.ConnectivityR\fconnectivity\x12a\n" +
+	"\x19installation_requirements\x18\x0e \x01(\v2$.catalog.v1.InstallationRequirementsR\x18installationRequirements\x12\x10\n" +
+	"\x03sku\x18\x0f \x01(\tR\x03sku\x12<\n" +
+	"\favailability\x18\x10 \x01(\v2\x18.catalog.v1.AvailabilityR\favailability\x121\n" +
+	"\x06offers\x18\x11 \x03(\v2\x19.catalog.v1.MerchantOfferR\x06offers\x12.\n" +
+	"\x05image\x18\x12 \x01(\v2\x18.catalog.v1.ProductImageR\x05image\"\xf0\x01\n" +
+	"\fProductImage\x12\x1f\n" +
+	"\vsource_hash\x18\x01 \x01(\tR\n" +
+	"sourceHash\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x02 \x01(\tR\tsourceUrl\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x124\n" +
+	"\bvariants\x18\x06 \x03(\v2\x18.catalog.v1.ImageVariantR\bvariants\x12\x1f\n" +
+	"\vingested_at\x18\a \x01(\tR\n" +
+	"ingestedAt\"\x90\x01\n" +
+	"\fImageVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12\x14\n" +
+	"\x05bytes\x18\x05 \x01(\x05R\x05bytes\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\"l\n" +
+	"\rMerchantOffer\x12\x1a\n" +
+	"\bmerchant\x18\x01 \x01(\tR\bmerchant\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12-\n" +
+	"\x12affiliate_template\x18\x03 \x01(\tR\x11affiliateTemplate\"a\n" +
+	"\fAvailability\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1a\n" +
+	"\bmerchant\x18\x02 \x01(\tR\bmerchant\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\tR\tcheckedAt\"j\n" +
+	"\vChoreEffect\x12%\n" +
+	"\x0echore_category\x18\x01 \x01(\tR\rchoreCategory\x124\n" +
+	"\x16time_reduction_percent\x18\x02 \x01(\x05R\x14timeReductionPercent\"\xef\x01\n" +
+	"\x10AutomationEffect\x12<\n" +
+	"\rchore_effects\x18\x01 \x03(\v2\x17.catalog.v1.ChoreEffectR\fchoreEffects\x12A\n" +
+	"\x1dmaintenance_minutes_per_month\x18\x02 \x01(\x05R\x1amaintenanceMinutesPerMonth\x12\x1f\n" +
+	"\vpower_watts\x18\x03 \x01(\x01R\n" +
+	"powerWatts\x129\n" +
+	"\x19consumable_cost_per_month\x18\x04 \x01(\x05R\x16consumableCostPerMonth\"\x8f\x01\n" +
+	"\fConnectivity\x12\x1c\n" +
+	"\tprotocols\x18\x01 \x03(\tR\tprotocols\x124\n" +
+	"\x16required_hub_protocols\x18\x02 \x03(\tR\x14requiredHubProtocols\x12+\n" +
+	"\x11bridged_protocols\x18\x03 \x03(\tR\x10bridgedProtocols\"\xc4\x02\n" +
+	"\x18InstallationRequirements\x12+\n" +
+	"\x11requires_drilling\x18\x01 \x01(\bR\x10requiresDrilling\x128\n" +
+	"\x18requires_electrical_work\x18\x02 \x01(\bR\x16requiresElectricalWork\x12%\n" +
+	"\x0estep_sensitive\x18\x03 \x01(\bR\rstepSensitive\x12*\n" +
+	"\x11climbable_step_mm\x18\x04 \x01(\x05R\x0fclimbableStepMm\x122\n" +
+	"\x15supported_floor_types\x18\x05 \x03(\tR\x13supportedFloorTypes\x12:\n" +
+	"\x19supported_residence_types\x18\x06 \x03(\tR\x17supportedResidenceTypes\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"m\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x17BatchGetProductsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"l\n" +
+	"\x18BatchGetProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.catalog.v1.ProductR\bproducts\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"m\n" +
 	"\x13ListProductsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -661,7 +4164,7 @@ const file_catalog_v1_product_proto_rawDesc = "" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\"o\n" +
 	"\x14ListProductsResponse\x12/\n" +
 	"\bproducts\x18\x01 \x03(\v2\x13.catalog.v1.ProductR\bproducts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe3\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x94\x05\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
@@ -674,7 +4177,12 @@ const file_catalog_v1_product_proto_rawDesc = "" +
 	"\rstrong_points\x18\b \x03(\tR\fstrongPoints\x127\n" +
 	"\x17installation_difficulty\x18\t \x01(\tR\x16installationDifficulty\x12\x1a\n" +
 	"\bcategory\x18\n" +
-	" \x01(\tR\bcategory\"\xf3\x02\n" +
+	" \x01(\tR\bcategory\x12I\n" +
+	"\x11automation_effect\x18\v \x01(\v2\x1c.catalog.v1.AutomationEffectR\x10automationEffect\x12<\n" +
+	"\fconnectivity\x18\f \x01(\v2\x18.catalog.v1.ConnectivityR\fconnectivity\x12a\n" +
+	"\x19installation_requirements\x18\r \x01(\v2$.catalog.v1.InstallationRequirementsR\x18installationRequirements\x12\x10\n" +
+	"\x03sku\x18\x0e \x01(\tR\x03sku\x121\n" +
+	"\x06offers\x18\x0f \x03(\v2\x19.catalog.v1.MerchantOfferR\x06offers\"\xa4\x05\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -688,17 +4196,247 @@ const file_catalog_v1_product_proto_rawDesc = "" +
 	"\rstrong_points\x18\t \x03(\tR\fstrongPoints\x127\n" +
 	"\x17installation_difficulty\x18\n" +
 	" \x01(\tR\x16installationDifficulty\x12\x1a\n" +
-	"\bcategory\x18\v \x01(\tR\bcategory\"&\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\x12I\n" +
+	"\x11automation_effect\x18\f \x01(\v2\x1c.catalog.v1.AutomationEffectR\x10automationEffect\x12<\n" +
+	"\fconnectivity\x18\r \x01(\v2\x18.catalog.v1.ConnectivityR\fconnectivity\x12a\n" +
+	"\x19installation_requirements\x18\x0e \x01(\v2$.catalog.v1.InstallationRequirementsR\x18installationRequirements\x12\x10\n" +
+	"\x03sku\x18\x0f \x01(\tR\x03sku\x121\n" +
+	"\x06offers\x18\x10 \x03(\v2\x19.catalog.v1.MerchantOfferR\x06offers\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteProductResponse2\x8b\x03\n" +
+	"\x15DeleteProductResponse\"E\n" +
+	"\x0fWifiEnvironment\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05bands\x18\x02 \x03(\tR\x05bands\"m\n" +
+	"\x19CheckCompatibilityRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12/\n" +
+	"\x04wifi\x18\x02 \x01(\v2\x1b.catalog.v1.WifiEnvironmentR\x04wifi\"\x7f\n" +
+	"\x12CompatibilityIssue\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tprotocols\x18\x03 \x03(\tR\tprotocols\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x83\x01\n" +
+	"\vHubProposal\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
+	"\x0eproposal_group\x18\x02 \x01(\tR\rproposalGroup\x12\x16\n" +
+	"\x06covers\x18\x03 \x03(\tR\x06covers\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xb2\x01\n" +
+	"\x1aCheckCompatibilityResponse\x12\x1e\n" +
+	"\n" +
+	"compatible\x18\x01 \x01(\bR\n" +
+	"compatible\x126\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1e.catalog.v1.CompatibilityIssueR\x06issues\x12<\n" +
+	"\rproposed_hubs\x18\x03 \x03(\v2\x17.catalog.v1.HubProposalR\fproposedHubs\"\xd2\x01\n" +
+	"\tResidence\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
+	"\townership\x18\x02 \x01(\tR\townership\x12\x1b\n" +
+	"\thas_steps\x18\x03 \x01(\bR\bhasSteps\x12$\n" +
+	"\x0estep_height_mm\x18\x04 \x01(\x05R\fstepHeightMm\x12\x1f\n" +
+	"\vfloor_types\x18\x05 \x03(\tR\n" +
+	"floorTypes\x12/\n" +
+	"\x04wifi\x18\x06 \x01(\v2\x1b.catalog.v1.WifiEnvironmentR\x04wifi\"n\n" +
+	"\x1bListEligibleProductsRequest\x123\n" +
+	"\tresidence\x18\x01 \x01(\v2\x15.catalog.v1.ResidenceR\tresidence\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"9\n" +
+	"\tRejection\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"V\n" +
+	"\x0fEligibleProduct\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05notes\x18\x02 \x03(\tR\x05notes\"w\n" +
+	"\x0fRejectedProduct\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\x125\n" +
+	"\n" +
+	"rejections\x18\x02 \x03(\v2\x15.catalog.v1.RejectionR\n" +
+	"rejections\"\x90\x01\n" +
+	"\x1cListEligibleProductsResponse\x127\n" +
+	"\beligible\x18\x01 \x03(\v2\x1b.catalog.v1.EligibleProductR\beligible\x127\n" +
+	"\brejected\x18\x02 \x03(\v2\x1b.catalog.v1.RejectedProductR\brejected\"\x9c\x01\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x121\n" +
+	"\x06filter\x18\x02 \x01(\v2\x19.catalog.v1.ProductFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xea\x01\n" +
+	"\rProductFilter\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\tR\n" +
+	"categories\x12$\n" +
+	"\rmanufacturers\x18\x02 \x03(\tR\rmanufacturers\x12\x1b\n" +
+	"\tmin_price\x18\x03 \x01(\x05R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x04 \x01(\x05R\bmaxPrice\x12;\n" +
+	"\x19installation_difficulties\x18\x05 \x03(\tR\x18installationDifficulties\x12\x1c\n" +
+	"\tprotocols\x18\x06 \x03(\tR\tprotocols\"E\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1c\n" +
+	"\tfragments\x18\x02 \x03(\tR\tfragments\"\x94\x01\n" +
+	"\x10ProductSearchHit\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12;\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x1b.catalog.v1.SearchHighlightR\n" +
+	"highlights\"\x93\x01\n" +
+	"\x16SearchProductsResponse\x120\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1c.catalog.v1.ProductSearchHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"M\n" +
+	"\x18ListProductFacetsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.catalog.v1.ProductFilterR\x06filter\"\x8e\x01\n" +
+	"\n" +
+	"FacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1a\n" +
+	"\bselected\x18\x03 \x01(\bR\bselected\x12\x1b\n" +
+	"\tmin_price\x18\x04 \x01(\x05R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x05 \x01(\x05R\bmaxPrice\"K\n" +
+	"\x05Facet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\x06values\x18\x02 \x03(\v2\x16.catalog.v1.FacetValueR\x06values\"g\n" +
+	"\x19ListProductFacetsResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x05R\n" +
+	"totalCount\x12)\n" +
+	"\x06facets\x18\x02 \x03(\v2\x11.catalog.v1.FacetR\x06facets\"@\n" +
+	"\rImportOptions\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"q\n" +
+	"\x15ImportProductsRequest\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.catalog.v1.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"P\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xcf\x01\n" +
+	"\x16ImportProductsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x122\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1a.catalog.v1.ImportRowErrorR\x06errors\x12\x1c\n" +
+	"\tunchanged\x18\x06 \x01(\x05R\tunchanged\"/\n" +
+	"\x15ExportProductsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\".\n" +
+	"\x16ExportProductsResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"[\n" +
+	"\n" +
+	"PricePoint\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x05R\x05price\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vrecorded_at\x18\x03 \x01(\tR\n" +
+	"recordedAt\"M\n" +
+	"\x16GetPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\tR\x05since\"h\n" +
+	"\x17GetPriceHistoryResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12.\n" +
+	"\x06points\x18\x02 \x03(\v2\x16.catalog.v1.PricePointR\x06points\"\xbf\x02\n" +
+	"\x0ePriceDropEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x05R\x05price\x12%\n" +
+	"\x0eprevious_price\x18\x05 \x01(\x05R\rpreviousPrice\x12!\n" +
+	"\ftrailing_min\x18\x06 \x01(\x05R\vtrailingMin\x12\x1f\n" +
+	"\vwindow_days\x18\a \x01(\x05R\n" +
+	"windowDays\x12!\n" +
+	"\fdrop_percent\x18\b \x01(\x01R\vdropPercent\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12\x1f\n" +
+	"\vdetected_at\x18\n" +
+	" \x01(\tR\n" +
+	"detectedAt\"N\n" +
+	"\x15ListPriceDropsRequest\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\tR\n" +
+	"productIds\"L\n" +
+	"\x16ListPriceDropsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.catalog.v1.PriceDropEventR\x06events\"j\n" +
+	"\x17GetPurchaseLinksRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\aplan_id\x18\x03 \x01(\tR\x06planId\"l\n" +
+	"\fPurchaseLink\x12\x1a\n" +
+	"\bmerchant\x18\x01 \x01(\tR\bmerchant\x12!\n" +
+	"\fredirect_url\x18\x02 \x01(\tR\vredirectUrl\x12\x1d\n" +
+	"\n" +
+	"tagged_url\x18\x03 \x01(\tR\ttaggedUrl\"J\n" +
+	"\x18GetPurchaseLinksResponse\x12.\n" +
+	"\x05links\x18\x01 \x03(\v2\x18.catalog.v1.PurchaseLinkR\x05links\"\x7f\n" +
+	"\x15GetClickReportRequest\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x02 \x01(\tR\x05until\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\tR\n" +
+	"productIds\x12\x19\n" +
+	"\bplan_ids\x18\x04 \x03(\tR\aplanIds\"\xfa\x01\n" +
+	"\n" +
+	"ClickCount\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06clicks\x18\x02 \x01(\x05R\x06clicks\x12!\n" +
+	"\funique_users\x18\x03 \x01(\x05R\vuniqueUsers\x12Z\n" +
+	"\x12clicks_by_merchant\x18\x04 \x03(\v2,.catalog.v1.ClickCount.ClicksByMerchantEntryR\x10clicksByMerchant\x1aC\n" +
+	"\x15ClicksByMerchantEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x96\x01\n" +
+	"\x16GetClickReportResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x125\n" +
+	"\n" +
+	"by_product\x18\x02 \x03(\v2\x16.catalog.v1.ClickCountR\tbyProduct\x12/\n" +
+	"\aby_plan\x18\x03 \x03(\v2\x16.catalog.v1.ClickCountR\x06byPlan\"6\n" +
+	"\x18ListProductIssuesRequest\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\"\xb8\x02\n" +
+	"\fProductIssue\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bmerchant\x18\x03 \x01(\tR\bmerchant\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1f\n" +
+	"\vhttp_status\x18\x06 \x01(\x05R\n" +
+	"httpStatus\x12\x1b\n" +
+	"\tfinal_url\x18\a \x01(\tR\bfinalUrl\x12\x16\n" +
+	"\x06detail\x18\b \x01(\tR\x06detail\x12\"\n" +
+	"\rfirst_seen_at\x18\t \x01(\tR\vfirstSeenAt\x12&\n" +
+	"\x0flast_checked_at\x18\n" +
+	" \x01(\tR\rlastCheckedAt\"M\n" +
+	"\x19ListProductIssuesResponse\x120\n" +
+	"\x06issues\x18\x01 \x03(\v2\x18.catalog.v1.ProductIssueR\x06issues\"N\n" +
+	"\x19UploadProductImageRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"Y\n" +
+	"\x19ImportProductImageRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x02 \x01(\tR\tsourceUrl2\x9e\r\n" +
 	"\x0eProductService\x12Q\n" +
 	"\fListProducts\x12\x1f.catalog.v1.ListProductsRequest\x1a .catalog.v1.ListProductsResponse\x12F\n" +
 	"\rCreateProduct\x12 .catalog.v1.CreateProductRequest\x1a\x13.catalog.v1.Product\x12@\n" +
 	"\n" +
-	"GetProduct\x12\x1d.catalog.v1.GetProductRequest\x1a\x13.catalog.v1.Product\x12F\n" +
+	"GetProduct\x12\x1d.catalog.v1.GetProductRequest\x1a\x13.catalog.v1.Product\x12]\n" +
+	"\x10BatchGetProducts\x12#.catalog.v1.BatchGetProductsRequest\x1a$.catalog.v1.BatchGetProductsResponse\x12F\n" +
 	"\rUpdateProduct\x12 .catalog.v1.UpdateProductRequest\x1a\x13.catalog.v1.Product\x12T\n" +
-	"\rDeleteProduct\x12 .catalog.v1.DeleteProductRequest\x1a!.catalog.v1.DeleteProductResponseB=Z;github.com/kinoshitatakumi/opti/gen/go/catalog/v1;catalogv1b\x06proto3"
+	"\rDeleteProduct\x12 .catalog.v1.DeleteProductRequest\x1a!.catalog.v1.DeleteProductResponse\x12Y\n" +
+	"\x0eImportProducts\x12!.catalog.v1.ImportProductsRequest\x1a\".catalog.v1.ImportProductsResponse(\x01\x12Y\n" +
+	"\x0eExportProducts\x12!.catalog.v1.ExportProductsRequest\x1a\".catalog.v1.ExportProductsResponse0\x01\x12c\n" +
+	"\x12CheckCompatibility\x12%.catalog.v1.CheckCompatibilityRequest\x1a&.catalog.v1.CheckCompatibilityResponse\x12i\n" +
+	"\x14ListEligibleProducts\x12'.catalog.v1.ListEligibleProductsRequest\x1a(.catalog.v1.ListEligibleProductsResponse\x12W\n" +
+	"\x0eSearchProducts\x12!.catalog.v1.SearchProductsRequest\x1a\".catalog.v1.SearchProductsResponse\x12`\n" +
+	"\x11ListProductFacets\x12$.catalog.v1.ListProductFacetsRequest\x1a%.catalog.v1.ListProductFacetsResponse\x12Z\n" +
+	"\x0fGetPriceHistory\x12\".catalog.v1.GetPriceHistoryRequest\x1a#.catalog.v1.GetPriceHistoryResponse\x12W\n" +
+	"\x0eListPriceDrops\x12!.catalog.v1.ListPriceDropsRequest\x1a\".catalog.v1.ListPriceDropsResponse\x12]\n" +
+	"\x10GetPurchaseLinks\x12#.catalog.v1.GetPurchaseLinksRequest\x1a$.catalog.v1.GetPurchaseLinksResponse\x12W\n" +
+	"\x0eGetClickReport\x12!.catalog.v1.GetClickReportRequest\x1a\".catalog.v1.GetClickReportResponse\x12`\n" +
+	"\x11ListProductIssues\x12$.catalog.v1.ListProductIssuesRequest\x1a%.catalog.v1.ListProductIssuesResponse\x12P\n" +
+	"\x12UploadProductImage\x12%.catalog.v1.UploadProductImageRequest\x1a\x13.catalog.v1.Product\x12P\n" +
+	"\x12ImportProductImage\x12%.catalog.v1.ImportProductImageRequest\x1a\x13.catalog.v1.ProductB=Z;github.com/kinoshitatakumi/opti/gen/go/catalog/v1;catalogv1b\x06proto3"
 
 var (
 	file_catalog_v1_product_proto_rawDescOnce sync.Once
@@ -712,34 +4450,159 @@ func file_catalog_v1_product_proto_rawDescGZIP() []byte {
 	return file_catalog_v1_product_proto_rawDescData
 }
 
-var file_catalog_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_catalog_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_catalog_v1_product_proto_goTypes = []any{
-	(*Product)(nil),               // 0: catalog.v1.Product
-	(*GetProductRequest)(nil),     // 1: catalog.v1.GetProductRequest
-	(*ListProductsRequest)(nil),   // 2: catalog.v1.ListProductsRequest
-	(*ListProductsResponse)(nil),  // 3: catalog.v1.ListProductsResponse
-	(*CreateProductRequest)(nil),  // 4: catalog.v1.CreateProductRequest
-	(*UpdateProductRequest)(nil),  // 5: catalog.v1.UpdateProductRequest
-	(*DeleteProductRequest)(nil),  // 6: catalog.v1.DeleteProductRequest
-	(*DeleteProductResponse)(nil), // 7: catalog.v1.DeleteProductResponse
+	(*Product)(nil),                      // 0: catalog.v1.Product
+	(*ProductImage)(nil),                 // 1: catalog.v1.ProductImage
+	(*ImageVariant)(nil),                 // 2: catalog.v1.ImageVariant
+	(*MerchantOffer)(nil),                // 3: catalog.v1.MerchantOffer
+	(*Availability)(nil),                 // 4: catalog.v1.Availability
+	(*ChoreEffect)(nil),                  // 5: catalog.v1.ChoreEffect
+	(*AutomationEffect)(nil),             // 6: catalog.v1.AutomationEffect
+	(*Connectivity)(nil),                 // 7: catalog.v1.Connectivity
+	(*InstallationRequirements)(nil),     // 8: catalog.v1.InstallationRequirements
+	(*GetProductRequest)(nil),            // 9: catalog.v1.GetProductRequest
+	(*BatchGetProductsRequest)(nil),      // 10: catalog.v1.BatchGetProductsRequest
+	(*BatchGetProductsResponse)(nil),     // 11: catalog.v1.BatchGetProductsResponse
+	(*ListProductsRequest)(nil),          // 12: catalog.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),         // 13: catalog.v1.ListProductsResponse
+	(*CreateProductRequest)(nil),         // 14: catalog.v1.CreateProductRequest
+	(*UpdateProductRequest)(nil),         // 15: catalog.v1.UpdateProductRequest
+	(*DeleteProductRequest)(nil),         // 16: catalog.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),        // 17: catalog.v1.DeleteProductResponse
+	(*WifiEnvironment)(nil),              // 18: catalog.v1.WifiEnvironment
+	(*CheckCompatibilityRequest)(nil),    // 19: catalog.v1.CheckCompatibilityRequest
+	(*CompatibilityIssue)(nil),           // 20: catalog.v1.CompatibilityIssue
+	(*HubProposal)(nil),                  // 21: catalog.v1.HubProposal
+	(*CheckCompatibilityResponse)(nil),   // 22: catalog.v1.CheckCompatibilityResponse
+	(*Residence)(nil),                    // 23: catalog.v1.Residence
+	(*ListEligibleProductsRequest)(nil),  // 24: catalog.v1.ListEligibleProductsRequest
+	(*Rejection)(nil),                    // 25: catalog.v1.Rejection
+	(*EligibleProduct)(nil),              // 26: catalog.v1.EligibleProduct
+	(*RejectedProduct)(nil),              // 27: catalog.v1.RejectedProduct
+	(*ListEligibleProductsResponse)(nil), // 28: catalog.v1.ListEligibleProductsResponse
+	(*SearchProductsRequest)(nil),        // 29: catalog.v1.SearchProductsRequest
+	(*ProductFilter)(nil),                // 30: catalog.v1.ProductFilter
+	(*SearchHighlight)(nil),              // 31: catalog.v1.SearchHighlight
+	(*ProductSearchHit)(nil),             // 32: catalog.v1.ProductSearchHit
+	(*SearchProductsResponse)(nil),       // 33: catalog.v1.SearchProductsResponse
+	(*ListProductFacetsRequest)(nil),     // 34: catalog.v1.ListProductFacetsRequest
+	(*FacetValue)(nil),                   // 35: catalog.v1.FacetValue
+	(*Facet)(nil),                        // 36: catalog.v1.Facet
+	(*ListProductFacetsResponse)(nil),    // 37: catalog.v1.ListProductFacetsResponse
+	(*ImportOptions)(nil),                // 38: catalog.v1.ImportOptions
+	(*ImportProductsRequest)(nil),        // 39: catalog.v1.ImportProductsRequest
+	(*ImportRowError)(nil),               // 40: catalog.v1.ImportRowError
+	(*ImportProductsResponse)(nil),       // 41: catalog.v1.ImportProductsResponse
+	(*ExportProductsRequest)(nil),        // 42: catalog.v1.ExportProductsRequest
+	(*ExportProductsResponse)(nil),       // 43: catalog.v1.ExportProductsResponse
+	(*PricePoint)(nil),                   // 44: catalog.v1.PricePoint
+	(*GetPriceHistoryRequest)(nil),       // 45: catalog.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),      // 46: catalog.v1.GetPriceHistoryResponse
+	(*PriceDropEvent)(nil),               // 47: catalog.v1.PriceDropEvent
+	(*ListPriceDropsRequest)(nil),        // 48: catalog.v1.ListPriceDropsRequest
+	(*ListPriceDropsResponse)(nil),       // 49: catalog.v1.ListPriceDropsResponse
+	(*GetPurchaseLinksRequest)(nil),      // 50: catalog.v1.GetPurchaseLinksRequest
+	(*PurchaseLink)(nil),                 // 51: catalog.v1.PurchaseLink
+	(*GetPurchaseLinksResponse)(nil),     // 52: catalog.v1.GetPurchaseLinksResponse
+	(*GetClickReportRequest)(nil),        // 53: catalog.v1.GetClickReportRequest
+	(*ClickCount)(nil),                   // 54: catalog.v1.ClickCount
+	(*GetClickReportResponse)(nil),       // 55: catalog.v1.GetClickReportResponse
+	(*ListProductIssuesRequest)(nil),     // 56: catalog.v1.ListProductIssuesRequest
+	(*ProductIssue)(nil),                 // 57: catalog.v1.ProductIssue
+	(*ListProductIssuesResponse)(nil),    // 58: catalog.v1.ListProductIssuesResponse
+	(*UploadProductImageRequest)(nil),    // 59: catalog.v1.UploadProductImageRequest
+	(*ImportProductImageRequest)(nil),    // 60: catalog.v1.ImportProductImageRequest
+	nil,                                  // 61: catalog.v1.ClickCount.ClicksByMerchantEntry
 }
 var file_catalog_v1_product_proto_depIdxs = []int32{
-	0, // 0: catalog.v1.ListProductsResponse.products:type_name -> catalog.v1.Product
-	2, // 1: catalog.v1.ProductService.ListProducts:input_type -> catalog.v1.ListProductsRequest
-	4, // 2: catalog.v1.ProductService.CreateProduct:input_type -> catalog.v1.CreateProductRequest
-	1, // 3: catalog.v1.ProductService.GetProduct:input_type -> catalog.v1.GetProductRequest
-	5, // 4: catalog.v1.ProductService.UpdateProduct:input_type -> catalog.v1.UpdateProductRequest
-	6, // 5: catalog.v1.ProductService.DeleteProduct:input_type -> catalog.v1.DeleteProductRequest
-	3, // 6: catalog.v1.ProductService.ListProducts:output_type -> catalog.v1.ListProductsResponse
-	0, // 7: catalog.v1.ProductService.CreateProduct:output_type -> catalog.v1.Product
-	0, // 8: catalog.v1.ProductService.GetProduct:output_type -> catalog.v1.Product
-	0, // 9: catalog.v1.ProductService.UpdateProduct:output_type -> catalog.v1.Product
-	7, // 10: catalog.v1.ProductService.DeleteProduct:output_type -> catalog.v1.DeleteProductResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6,  // 0: catalog.v1.Product.automation_effect:type_name -> catalog.v1.AutomationEffect
+	7,  // 1: catalog.v1.Product.connectivity:type_name -> catalog.v1.Connectivity
+	8,  // 2: catalog.v1.Product.installation_requirements:type_name -> catalog.v1.InstallationRequirements
+	4,  // 3: catalog.v1.Product.availability:type_name -> catalog.v1.Availability
+	3,  // 4: catalog.v1.Product.offers:type_name -> catalog.v1.MerchantOffer
+	1,  // 5: catalog.v1.Product.image:type_name -> catalog.v1.ProductImage
+	2,  // 6: catalog.v1.ProductImage.variants:type_name -> catalog.v1.ImageVariant
+	5,  // 7: catalog.v1.AutomationEffect.chore_effects:type_name -> catalog.v1.ChoreEffect
+	0,  // 8: catalog.v1.BatchGetProductsResponse.products:type_name -> catalog.v1.Product
+	0,  // 9: catalog.v1.ListProductsResponse.products:type_name -> catalog.v1.Product
+	6,  // 10: catalog.v1.CreateProductRequest.automation_effect:type_name -> catalog.v1.AutomationEffect
+	7,  // 11: catalog.v1.CreateProductRequest.connectivity:type_name -> catalog.v1.Connectivity
+	8,  // 12: catalog.v1.CreateProductRequest.installation_requirements:type_name -> catalog.v1.InstallationRequirements
+	3,  // 13: catalog.v1.CreateProductRequest.offers:type_name -> catalog.v1.MerchantOffer
+	6,  // 14: catalog.v1.UpdateProductRequest.automation_effect:type_name -> catalog.v1.AutomationEffect
+	7,  // 15: catalog.v1.UpdateProductRequest.connectivity:type_name -> catalog.v1.Connectivity
+	8,  // 16: catalog.v1.UpdateProductRequest.installation_requirements:type_name -> catalog.v1.InstallationRequirements
+	3,  // 17: catalog.v1.UpdateProductRequest.offers:type_name -> catalog.v1.MerchantOffer
+	18, // 18: catalog.v1.CheckCompatibilityRequest.wifi:type_name -> catalog.v1.WifiEnvironment
+	20, // 19: catalog.v1.CheckCompatibilityResponse.issues:type_name -> catalog.v1.CompatibilityIssue
+	21, // 20: catalog.v1.CheckCompatibilityResponse.proposed_hubs:type_name -> catalog.v1.HubProposal
+	18, // 21: catalog.v1.Residence.wifi:type_name -> catalog.v1.WifiEnvironment
+	23, // 22: catalog.v1.ListEligibleProductsRequest.residence:type_name -> catalog.v1.Residence
+	0,  // 23: catalog.v1.EligibleProduct.product:type_name -> catalog.v1.Product
+	0,  // 24: catalog.v1.RejectedProduct.product:type_name -> catalog.v1.Product
+	25, // 25: catalog.v1.RejectedProduct.rejections:type_name -> catalog.v1.Rejection
+	26, // 26: catalog.v1.ListEligibleProductsResponse.eligible:type_name -> catalog.v1.EligibleProduct
+	27, // 27: catalog.v1.ListEligibleProductsResponse.rejected:type_name -> catalog.v1.RejectedProduct
+	30, // 28: catalog.v1.SearchProductsRequest.filter:type_name -> catalog.v1.ProductFilter
+	0,  // 29: catalog.v1.ProductSearchHit.product:type_name -> catalog.v1.Product
+	31, // 30: catalog.v1.ProductSearchHit.highlights:type_name -> catalog.v1.SearchHighlight
+	32, // 31: catalog.v1.SearchProductsResponse.hits:type_name -> catalog.v1.ProductSearchHit
+	30, // 32: catalog.v1.ListProductFacetsRequest.filter:type_name -> catalog.v1.ProductFilter
+	35, // 33: catalog.v1.Facet.values:type_name -> catalog.v1.FacetValue
+	36, // 34: catalog.v1.ListProductFacetsResponse.facets:type_name -> catalog.v1.Facet
+	38, // 35: catalog.v1.ImportProductsRequest.options:type_name -> catalog.v1.ImportOptions
+	40, // 36: catalog.v1.ImportProductsResponse.errors:type_name -> catalog.v1.ImportRowError
+	44, // 37: catalog.v1.GetPriceHistoryResponse.points:type_name -> catalog.v1.PricePoint
+	47, // 38: catalog.v1.ListPriceDropsResponse.events:type_name -> catalog.v1.PriceDropEvent
+	51, // 39: catalog.v1.GetPurchaseLinksResponse.links:type_name -> catalog.v1.PurchaseLink
+	61, // 40: catalog.v1.ClickCount.clicks_by_merchant:type_name -> catalog.v1.ClickCount.ClicksByMerchantEntry
+	54, // 41: catalog.v1.GetClickReportResponse.by_product:type_name -> catalog.v1.ClickCount
+	54, // 42: catalog.v1.GetClickReportResponse.by_plan:type_name -> catalog.v1.ClickCount
+	57, // 43: catalog.v1.ListProductIssuesResponse.issues:type_name -> catalog.v1.ProductIssue
+	12, // 44: catalog.v1.ProductService.ListProducts:input_type -> catalog.v1.ListProductsRequest
+	14, // 45: catalog.v1.ProductService.CreateProduct:input_type -> catalog.v1.CreateProductRequest
+	9,  // 46: catalog.v1.ProductService.GetProduct:input_type -> catalog.v1.GetProductRequest
+	10, // 47: catalog.v1.ProductService.BatchGetProducts:input_type -> catalog.v1.BatchGetProductsRequest
+	15, // 48: catalog.v1.ProductService.UpdateProduct:input_type -> catalog.v1.UpdateProductRequest
+	16, // 49: catalog.v1.ProductService.DeleteProduct:input_type -> catalog.v1.DeleteProductRequest
+	39, // 50: catalog.v1.ProductService.ImportProducts:input_type -> catalog.v1.ImportProductsRequest
+	42, // 51: catalog.v1.ProductService.ExportProducts:input_type -> catalog.v1.ExportProductsRequest
+	19, // 52: catalog.v1.ProductService.CheckCompatibility:input_type -> catalog.v1.CheckCompatibilityRequest
+	24, // 53: catalog.v1.ProductService.ListEligibleProducts:input_type -> catalog.v1.ListEligibleProductsRequest
+	29, // 54: catalog.v1.ProductService.SearchProducts:input_type -> catalog.v1.SearchProductsRequest
+	34, // 55: catalog.v1.ProductService.ListProductFacets:input_type -> catalog.v1.ListProductFacetsRequest
+	45, // 56: catalog.v1.ProductService.GetPriceHistory:input_type -> catalog.v1.GetPriceHistoryRequest
+	48, // 57: catalog.v1.ProductService.ListPriceDrops:input_type -> catalog.v1.ListPriceDropsRequest
+	50, // 58: catalog.v1.ProductService.GetPurchaseLinks:input_type -> catalog.v1.GetPurchaseLinksRequest
+	53, // 59: catalog.v1.ProductService.GetClickReport:input_type -> catalog.v1.GetClickReportRequest
+	56, // 60: catalog.v1.ProductService.ListProductIssues:input_type -> catalog.v1.ListProductIssuesRequest
+	59, // 61: catalog.v1.ProductService.UploadProductImage:input_type -> catalog.v1.UploadProductImageRequest
+	60, // 62: catalog.v1.ProductService.ImportProductImage:input_type -> catalog.v1.ImportProductImageRequest
+	13, // 63: catalog.v1.ProductService.ListProducts:output_type -> catalog.v1.ListProductsResponse
+	0,  // 64: catalog.v1.ProductService.CreateProduct:output_type -> catalog.v1.Product
+	0,  // 65: catalog.v1.ProductService.GetProduct:output_type -> catalog.v1.Product
+	11, // 66: catalog.v1.ProductService.BatchGetProducts:output_type -> catalog.v1.BatchGetProductsResponse
+	0,  // 67: catalog.v1.ProductService.UpdateProduct:output_type -> catalog.v1.Product
+	17, // 68: catalog.v1.ProductService.DeleteProduct:output_type -> catalog.v1.DeleteProductResponse
+	41, // 69: catalog.v1.ProductService.ImportProducts:output_type -> catalog.v1.ImportProductsResponse
+	43, // 70: catalog.v1.ProductService.ExportProducts:output_type -> catalog.v1.ExportProductsResponse
+	22, // 71: catalog.v1.ProductService.CheckCompatibility:output_type -> catalog.v1.CheckCompatibilityResponse
+	28, // 72: catalog.v1.ProductService.ListEligibleProducts:output_type -> catalog.v1.ListEligibleProductsResponse
+	33, // 73: catalog.v1.ProductService.SearchProducts:output_type -> catalog.v1.SearchProductsResponse
+	37, // 74: catalog.v1.ProductService.ListProductFacets:output_type -> catalog.v1.ListProductFacetsResponse
+	46, // 75: catalog.v1.ProductService.GetPriceHistory:output_type -> catalog.v1.GetPriceHistoryResponse
+	49, // 76: catalog.v1.ProductService.ListPriceDrops:output_type -> catalog.v1.ListPriceDropsResponse
+	52, // 77: catalog.v1.ProductService.GetPurchaseLinks:output_type -> catalog.v1.GetPurchaseLinksResponse
+	55, // 78: catalog.v1.ProductService.GetClickReport:output_type -> catalog.v1.GetClickReportResponse
+	58, // 79: catalog.v1.ProductService.ListProductIssues:output_type -> catalog.v1.ListProductIssuesResponse
+	0,  // 80: catalog.v1.ProductService.UploadProductImage:output_type -> catalog.v1.Product
+	0,  // 81: catalog.v1.ProductService.ImportProductImage:output_type -> catalog.v1.Product
+	63, // [63:82] is the sub-list for method output_type
+	44, // [44:63] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_catalog_v1_product_proto_init() }
@@ -747,13 +4610,17 @@ func file_catalog_v1_product_proto_init() {
 	if File_catalog_v1_product_proto != nil {
 		return
 	}
+	file_catalog_v1_product_proto_msgTypes[39].OneofWrappers = []any{
+		(*ImportProductsRequest_Options)(nil),
+		(*ImportProductsRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_v1_product_proto_rawDesc), len(file_catalog_v1_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
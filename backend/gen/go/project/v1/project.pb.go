@@ -0,0 +1,2083 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: project/v1/project.proto
+
+// パッケージ名はディレクトリ構成と一致させます
+
+package projectv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SelectedItemSelection: 提案プランから導入すると決めた製品
+type SelectedItemSelection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // 0なら提案どおりの数量
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`          // ユーザーメモ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectedItemSelection) Reset() {
+	*x = SelectedItemSelection{}
+	mi := &file_project_v1_project_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectedItemSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectedItemSelection) ProtoMessage() {}
+
+func (x *SelectedItemSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectedItemSelection.ProtoReflect.Descriptor instead.
+func (*SelectedItemSelection) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SelectedItemSelection) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SelectedItemSelection) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SelectedItemSelection) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateAdoptionProjectRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	SourcePlanId  string                   `protobuf:"bytes,1,opt,name=source_plan_id,json=sourcePlanId,proto3" json:"source_plan_id,omitempty"` // 元になった OptimizationPlan
+	Selections    []*SelectedItemSelection `protobuf:"bytes,2,rep,name=selections,proto3" json:"selections,omitempty"`                           // どのアイテムを採用するか
+	UserId        string                   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdoptionProjectRequest) Reset() {
+	*x = CreateAdoptionProjectRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdoptionProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdoptionProjectRequest) ProtoMessage() {}
+
+func (x *CreateAdoptionProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdoptionProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdoptionProjectRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAdoptionProjectRequest) GetSourcePlanId() string {
+	if x != nil {
+		return x.SourcePlanId
+	}
+	return ""
+}
+
+func (x *CreateAdoptionProjectRequest) GetSelections() []*SelectedItemSelection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+func (x *CreateAdoptionProjectRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAdoptionProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdoptionProjectRequest) Reset() {
+	*x = GetAdoptionProjectRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdoptionProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdoptionProjectRequest) ProtoMessage() {}
+
+func (x *GetAdoptionProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdoptionProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetAdoptionProjectRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAdoptionProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAdoptionProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdoptionProjectsRequest) Reset() {
+	*x = ListAdoptionProjectsRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdoptionProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdoptionProjectsRequest) ProtoMessage() {}
+
+func (x *ListAdoptionProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdoptionProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdoptionProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAdoptionProjectsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAdoptionProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*AdoptionPlan        `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdoptionProjectsResponse) Reset() {
+	*x = ListAdoptionProjectsResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdoptionProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdoptionProjectsResponse) ProtoMessage() {}
+
+func (x *ListAdoptionProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdoptionProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdoptionProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAdoptionProjectsResponse) GetProjects() []*AdoptionPlan {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type UpdateItemStatusRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId           string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProductId           string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status              string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                                         // "wont_do", "pending", "bought", "installed"
+	Note                *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`                                                       // 指定した場合だけメモを更新 (履歴にも残ります)
+	Actor               string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`                                                           // 省略可。変更者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	PricePaid           *int32                 `protobuf:"varint,6,opt,name=price_paid,json=pricePaid,proto3,oneof" json:"price_paid,omitempty"`                           // 実際に支払った単価 (bought / installed のときのみ)
+	Store               string                 `protobuf:"bytes,7,opt,name=store,proto3" json:"store,omitempty"`                                                           // 購入した店舗 (bought / installed のときのみ)
+	ConfirmSkipPurchase bool                   `protobuf:"varint,8,opt,name=confirm_skip_purchase,json=confirmSkipPurchase,proto3" json:"confirm_skip_purchase,omitempty"` // pending から直接 installed にする場合は true
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateItemStatusRequest) Reset() {
+	*x = UpdateItemStatusRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemStatusRequest) ProtoMessage() {}
+
+func (x *UpdateItemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateItemStatusRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateItemStatusRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateItemStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateItemStatusRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateItemStatusRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UpdateItemStatusRequest) GetPricePaid() int32 {
+	if x != nil && x.PricePaid != nil {
+		return *x.PricePaid
+	}
+	return 0
+}
+
+func (x *UpdateItemStatusRequest) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *UpdateItemStatusRequest) GetConfirmSkipPurchase() bool {
+	if x != nil {
+		return x.ConfirmSkipPurchase
+	}
+	return false
+}
+
+// ItemTransition: ステータス変更の記録
+type ItemTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // プロジェクト作成時の記録では空
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	At            string                 `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"` // RFC 3339
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	PricePaid     *int32                 `protobuf:"varint,5,opt,name=price_paid,json=pricePaid,proto3,oneof" json:"price_paid,omitempty"`
+	Store         string                 `protobuf:"bytes,6,opt,name=store,proto3" json:"store,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemTransition) Reset() {
+	*x = ItemTransition{}
+	mi := &file_project_v1_project_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemTransition) ProtoMessage() {}
+
+func (x *ItemTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemTransition.ProtoReflect.Descriptor instead.
+func (*ItemTransition) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ItemTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ItemTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ItemTransition) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *ItemTransition) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ItemTransition) GetPricePaid() int32 {
+	if x != nil && x.PricePaid != nil {
+		return *x.PricePaid
+	}
+	return 0
+}
+
+func (x *ItemTransition) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *ItemTransition) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// AdoptedItem: 採用した製品と、その購入・設置ステータス
+type AdoptedItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Category        string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // 提案グループのカテゴリ
+	Quantity        int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price           int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                         // 提案時点の単価
+	DependsOn       []string               `protobuf:"bytes,5,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"` // 先に導入が必要な製品のID
+	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // "wont_do", "pending", "bought", "installed"
+	Note            string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	History         []*ItemTransition      `protobuf:"bytes,8,rep,name=history,proto3" json:"history,omitempty"`                                        // ステータス変更の記録 (古い順)
+	ProductCategory string                 `protobuf:"bytes,9,opt,name=product_category,json=productCategory,proto3" json:"product_category,omitempty"` // 製品カテゴリ (例: "robot_vacuum")
+	ProductName     string                 `protobuf:"bytes,10,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	RoadmapMonth    int32                  `protobuf:"varint,11,opt,name=roadmap_month,json=roadmapMonth,proto3" json:"roadmap_month,omitempty"` // ロードマップで購入する月 (1 = 作成した月)。一括購入なら0
+	PurchaseDue     string                 `protobuf:"bytes,12,opt,name=purchase_due,json=purchaseDue,proto3" json:"purchase_due,omitempty"`     // 購入予定日 (YYYY-MM-DD、日本時間)
+	InstallDue      string                 `protobuf:"bytes,13,opt,name=install_due,json=installDue,proto3" json:"install_due,omitempty"`        // 設置予定日 (YYYY-MM-DD、日本時間)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdoptedItem) Reset() {
+	*x = AdoptedItem{}
+	mi := &file_project_v1_project_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptedItem) ProtoMessage() {}
+
+func (x *AdoptedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptedItem.ProtoReflect.Descriptor instead.
+func (*AdoptedItem) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdoptedItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AdoptedItem) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AdoptedItem) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *AdoptedItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetHistory() []*ItemTransition {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *AdoptedItem) GetProductCategory() string {
+	if x != nil {
+		return x.ProductCategory
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetRoadmapMonth() int32 {
+	if x != nil {
+		return x.RoadmapMonth
+	}
+	return 0
+}
+
+func (x *AdoptedItem) GetPurchaseDue() string {
+	if x != nil {
+		return x.PurchaseDue
+	}
+	return ""
+}
+
+func (x *AdoptedItem) GetInstallDue() string {
+	if x != nil {
+		return x.InstallDue
+	}
+	return ""
+}
+
+// AdoptionPlan: 導入プロジェクト
+type AdoptionPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "planning", "in_progress", "completed"
+	Items         []*AdoptedItem         `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	SourcePlanId  string                 `protobuf:"bytes,5,opt,name=source_plan_id,json=sourcePlanId,proto3" json:"source_plan_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdoptionPlan) Reset() {
+	*x = AdoptionPlan{}
+	mi := &file_project_v1_project_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptionPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptionPlan) ProtoMessage() {}
+
+func (x *AdoptionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptionPlan.ProtoReflect.Descriptor instead.
+func (*AdoptionPlan) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdoptionPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdoptionPlan) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdoptionPlan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdoptionPlan) GetItems() []*AdoptedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AdoptionPlan) GetSourcePlanId() string {
+	if x != nil {
+		return x.SourcePlanId
+	}
+	return ""
+}
+
+func (x *AdoptionPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdoptionPlan) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type RecordTimeMeasurementRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId         string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MinutesPerSession int32                  `protobuf:"varint,3,opt,name=minutes_per_session,json=minutesPerSession,proto3" json:"minutes_per_session,omitempty"` // 導入後の1回あたりの所要時間 (分)
+	FrequencyPerWeek  float64                `protobuf:"fixed64,4,opt,name=frequency_per_week,json=frequencyPerWeek,proto3" json:"frequency_per_week,omitempty"`   // 導入後の1週間あたりの実施回数
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecordTimeMeasurementRequest) Reset() {
+	*x = RecordTimeMeasurementRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTimeMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTimeMeasurementRequest) ProtoMessage() {}
+
+func (x *RecordTimeMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTimeMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*RecordTimeMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecordTimeMeasurementRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RecordTimeMeasurementRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RecordTimeMeasurementRequest) GetMinutesPerSession() int32 {
+	if x != nil {
+		return x.MinutesPerSession
+	}
+	return 0
+}
+
+func (x *RecordTimeMeasurementRequest) GetFrequencyPerWeek() float64 {
+	if x != nil {
+		return x.FrequencyPerWeek
+	}
+	return 0
+}
+
+// TimeMeasurement: 導入後の家事時間の実測値
+type TimeMeasurement struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId         string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ChoreCategory     string                 `protobuf:"bytes,4,opt,name=chore_category,json=choreCategory,proto3" json:"chore_category,omitempty"`
+	MinutesPerSession int32                  `protobuf:"varint,5,opt,name=minutes_per_session,json=minutesPerSession,proto3" json:"minutes_per_session,omitempty"`
+	FrequencyPerWeek  float64                `protobuf:"fixed64,6,opt,name=frequency_per_week,json=frequencyPerWeek,proto3" json:"frequency_per_week,omitempty"`
+	MinutesPerWeek    float64                `protobuf:"fixed64,7,opt,name=minutes_per_week,json=minutesPerWeek,proto3" json:"minutes_per_week,omitempty"`
+	MeasuredAt        string                 `protobuf:"bytes,8,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"` // RFC 3339
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TimeMeasurement) Reset() {
+	*x = TimeMeasurement{}
+	mi := &file_project_v1_project_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeMeasurement) ProtoMessage() {}
+
+func (x *TimeMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeMeasurement.ProtoReflect.Descriptor instead.
+func (*TimeMeasurement) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimeMeasurement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimeMeasurement) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TimeMeasurement) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *TimeMeasurement) GetChoreCategory() string {
+	if x != nil {
+		return x.ChoreCategory
+	}
+	return ""
+}
+
+func (x *TimeMeasurement) GetMinutesPerSession() int32 {
+	if x != nil {
+		return x.MinutesPerSession
+	}
+	return 0
+}
+
+func (x *TimeMeasurement) GetFrequencyPerWeek() float64 {
+	if x != nil {
+		return x.FrequencyPerWeek
+	}
+	return 0
+}
+
+func (x *TimeMeasurement) GetMinutesPerWeek() float64 {
+	if x != nil {
+		return x.MinutesPerWeek
+	}
+	return 0
+}
+
+func (x *TimeMeasurement) GetMeasuredAt() string {
+	if x != nil {
+		return x.MeasuredAt
+	}
+	return ""
+}
+
+type ListDueMeasurementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueMeasurementsRequest) Reset() {
+	*x = ListDueMeasurementsRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueMeasurementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueMeasurementsRequest) ProtoMessage() {}
+
+func (x *ListDueMeasurementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueMeasurementsRequest.ProtoReflect.Descriptor instead.
+func (*ListDueMeasurementsRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDueMeasurementsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DueMeasurement: 実測値の入力を依頼すべき製品
+type DueMeasurement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId      string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ChoreCategory  string                 `protobuf:"bytes,3,opt,name=chore_category,json=choreCategory,proto3" json:"chore_category,omitempty"`
+	InstalledAt    string                 `protobuf:"bytes,4,opt,name=installed_at,json=installedAt,proto3" json:"installed_at,omitempty"`            // RFC 3339
+	LastMeasuredAt string                 `protobuf:"bytes,5,opt,name=last_measured_at,json=lastMeasuredAt,proto3" json:"last_measured_at,omitempty"` // RFC 3339。未入力なら空
+	DueAt          string                 `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                              // RFC 3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DueMeasurement) Reset() {
+	*x = DueMeasurement{}
+	mi := &file_project_v1_project_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueMeasurement) ProtoMessage() {}
+
+func (x *DueMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueMeasurement.ProtoReflect.Descriptor instead.
+func (*DueMeasurement) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DueMeasurement) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DueMeasurement) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DueMeasurement) GetChoreCategory() string {
+	if x != nil {
+		return x.ChoreCategory
+	}
+	return ""
+}
+
+func (x *DueMeasurement) GetInstalledAt() string {
+	if x != nil {
+		return x.InstalledAt
+	}
+	return ""
+}
+
+func (x *DueMeasurement) GetLastMeasuredAt() string {
+	if x != nil {
+		return x.LastMeasuredAt
+	}
+	return ""
+}
+
+func (x *DueMeasurement) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+type ListDueMeasurementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Measurements  []*DueMeasurement      `protobuf:"bytes,1,rep,name=measurements,proto3" json:"measurements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueMeasurementsResponse) Reset() {
+	*x = ListDueMeasurementsResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueMeasurementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueMeasurementsResponse) ProtoMessage() {}
+
+func (x *ListDueMeasurementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueMeasurementsResponse.ProtoReflect.Descriptor instead.
+func (*ListDueMeasurementsResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDueMeasurementsResponse) GetMeasurements() []*DueMeasurement {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+type GetRoiReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoiReportRequest) Reset() {
+	*x = GetRoiReportRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoiReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoiReportRequest) ProtoMessage() {}
+
+func (x *GetRoiReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoiReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRoiReportRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRoiReportRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// ItemRoiReport: 製品ごとの予測と実測の比較
+// 削減率は家事カテゴリ単位の予測 (同じ家事に効く製品が複数ある場合は合成後の値) と比べます。
+type ItemRoiReport struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId                 string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductCategory           string                 `protobuf:"bytes,2,opt,name=product_category,json=productCategory,proto3" json:"product_category,omitempty"`
+	ChoreCategory             string                 `protobuf:"bytes,3,opt,name=chore_category,json=choreCategory,proto3" json:"chore_category,omitempty"`
+	InstalledAt               string                 `protobuf:"bytes,4,opt,name=installed_at,json=installedAt,proto3" json:"installed_at,omitempty"` // RFC 3339。未設置なら空
+	MeasurementCount          int32                  `protobuf:"varint,5,opt,name=measurement_count,json=measurementCount,proto3" json:"measurement_count,omitempty"`
+	LastMeasuredAt            string                 `protobuf:"bytes,6,opt,name=last_measured_at,json=lastMeasuredAt,proto3" json:"last_measured_at,omitempty"`                                // RFC 3339。未入力なら空
+	MinutesPerWeekBefore      float64                `protobuf:"fixed64,7,opt,name=minutes_per_week_before,json=minutesPerWeekBefore,proto3" json:"minutes_per_week_before,omitempty"`          // 導入前の週あたり時間 (分)
+	PredictedMinutesPerWeek   float64                `protobuf:"fixed64,8,opt,name=predicted_minutes_per_week,json=predictedMinutesPerWeek,proto3" json:"predicted_minutes_per_week,omitempty"` // 予測した導入後の週あたり時間 (分)
+	ActualMinutesPerWeek      float64                `protobuf:"fixed64,9,opt,name=actual_minutes_per_week,json=actualMinutesPerWeek,proto3" json:"actual_minutes_per_week,omitempty"`          // 最新の実測値 (分)
+	PredictedReductionRate    float64                `protobuf:"fixed64,10,opt,name=predicted_reduction_rate,json=predictedReductionRate,proto3" json:"predicted_reduction_rate,omitempty"`
+	ActualReductionRate       float64                `protobuf:"fixed64,11,opt,name=actual_reduction_rate,json=actualReductionRate,proto3" json:"actual_reduction_rate,omitempty"`
+	PredictedHoursSavedYearly float64                `protobuf:"fixed64,12,opt,name=predicted_hours_saved_yearly,json=predictedHoursSavedYearly,proto3" json:"predicted_hours_saved_yearly,omitempty"`
+	ActualHoursSavedYearly    float64                `protobuf:"fixed64,13,opt,name=actual_hours_saved_yearly,json=actualHoursSavedYearly,proto3" json:"actual_hours_saved_yearly,omitempty"`
+	ReductionRateError        float64                `protobuf:"fixed64,14,opt,name=reduction_rate_error,json=reductionRateError,proto3" json:"reduction_rate_error,omitempty"` // 実測の削減率 - 予測の削減率
+	Measured                  bool                   `protobuf:"varint,15,opt,name=measured,proto3" json:"measured,omitempty"`                                                  // 実測値があるか
+	CalibrationFactor         float64                `protobuf:"fixed64,16,opt,name=calibration_factor,json=calibrationFactor,proto3" json:"calibration_factor,omitempty"`      // 予測に含まれていた較正係数 (不明なら0)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ItemRoiReport) Reset() {
+	*x = ItemRoiReport{}
+	mi := &file_project_v1_project_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemRoiReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemRoiReport) ProtoMessage() {}
+
+func (x *ItemRoiReport) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemRoiReport.ProtoReflect.Descriptor instead.
+func (*ItemRoiReport) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ItemRoiReport) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ItemRoiReport) GetProductCategory() string {
+	if x != nil {
+		return x.ProductCategory
+	}
+	return ""
+}
+
+func (x *ItemRoiReport) GetChoreCategory() string {
+	if x != nil {
+		return x.ChoreCategory
+	}
+	return ""
+}
+
+func (x *ItemRoiReport) GetInstalledAt() string {
+	if x != nil {
+		return x.InstalledAt
+	}
+	return ""
+}
+
+func (x *ItemRoiReport) GetMeasurementCount() int32 {
+	if x != nil {
+		return x.MeasurementCount
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetLastMeasuredAt() string {
+	if x != nil {
+		return x.LastMeasuredAt
+	}
+	return ""
+}
+
+func (x *ItemRoiReport) GetMinutesPerWeekBefore() float64 {
+	if x != nil {
+		return x.MinutesPerWeekBefore
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetPredictedMinutesPerWeek() float64 {
+	if x != nil {
+		return x.PredictedMinutesPerWeek
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetActualMinutesPerWeek() float64 {
+	if x != nil {
+		return x.ActualMinutesPerWeek
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetPredictedReductionRate() float64 {
+	if x != nil {
+		return x.PredictedReductionRate
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetActualReductionRate() float64 {
+	if x != nil {
+		return x.ActualReductionRate
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetPredictedHoursSavedYearly() float64 {
+	if x != nil {
+		return x.PredictedHoursSavedYearly
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetActualHoursSavedYearly() float64 {
+	if x != nil {
+		return x.ActualHoursSavedYearly
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetReductionRateError() float64 {
+	if x != nil {
+		return x.ReductionRateError
+	}
+	return 0
+}
+
+func (x *ItemRoiReport) GetMeasured() bool {
+	if x != nil {
+		return x.Measured
+	}
+	return false
+}
+
+func (x *ItemRoiReport) GetCalibrationFactor() float64 {
+	if x != nil {
+		return x.CalibrationFactor
+	}
+	return 0
+}
+
+// RoiReport: プロジェクト全体の予測と実測の比較
+// 時間の合計は家事カテゴリ単位で集計し、実測値がないカテゴリは予測値で補完します。
+// 回収期間は、予測・実測とも削減時間の時給換算だけで計算する簡易値です (ランニングコストは含みません)。
+type RoiReport struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId                 string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Items                     []*ItemRoiReport       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	MeasuredItems             int32                  `protobuf:"varint,3,opt,name=measured_items,json=measuredItems,proto3" json:"measured_items,omitempty"`
+	PredictedHoursSavedYearly float64                `protobuf:"fixed64,4,opt,name=predicted_hours_saved_yearly,json=predictedHoursSavedYearly,proto3" json:"predicted_hours_saved_yearly,omitempty"`
+	ActualHoursSavedYearly    float64                `protobuf:"fixed64,5,opt,name=actual_hours_saved_yearly,json=actualHoursSavedYearly,proto3" json:"actual_hours_saved_yearly,omitempty"`
+	RealizationRate           float64                `protobuf:"fixed64,6,opt,name=realization_rate,json=realizationRate,proto3" json:"realization_rate,omitempty"`                         // 実測 / 予測 (1.0で予測どおり)
+	PredictedCost             int32                  `protobuf:"varint,7,opt,name=predicted_cost,json=predictedCost,proto3" json:"predicted_cost,omitempty"`                                // 提案時の価格での合計 (円)
+	ActualCost                int32                  `protobuf:"varint,8,opt,name=actual_cost,json=actualCost,proto3" json:"actual_cost,omitempty"`                                         // 実際に支払った価格での合計 (円)。未記録の製品は提案時の価格
+	HourlyWage                int32                  `protobuf:"varint,9,opt,name=hourly_wage,json=hourlyWage,proto3" json:"hourly_wage,omitempty"`                                         // 提案時の時給の前提 (円)
+	PredictedPaybackMonths    float64                `protobuf:"fixed64,10,opt,name=predicted_payback_months,json=predictedPaybackMonths,proto3" json:"predicted_payback_months,omitempty"` // 回収できない場合は0
+	ActualPaybackMonths       float64                `protobuf:"fixed64,11,opt,name=actual_payback_months,json=actualPaybackMonths,proto3" json:"actual_payback_months,omitempty"`          // 回収できない場合は0
+	ActualPaybackReachable    bool                   `protobuf:"varint,12,opt,name=actual_payback_reachable,json=actualPaybackReachable,proto3" json:"actual_payback_reachable,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RoiReport) Reset() {
+	*x = RoiReport{}
+	mi := &file_project_v1_project_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiReport) ProtoMessage() {}
+
+func (x *RoiReport) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiReport.ProtoReflect.Descriptor instead.
+func (*RoiReport) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RoiReport) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RoiReport) GetItems() []*ItemRoiReport {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RoiReport) GetMeasuredItems() int32 {
+	if x != nil {
+		return x.MeasuredItems
+	}
+	return 0
+}
+
+func (x *RoiReport) GetPredictedHoursSavedYearly() float64 {
+	if x != nil {
+		return x.PredictedHoursSavedYearly
+	}
+	return 0
+}
+
+func (x *RoiReport) GetActualHoursSavedYearly() float64 {
+	if x != nil {
+		return x.ActualHoursSavedYearly
+	}
+	return 0
+}
+
+func (x *RoiReport) GetRealizationRate() float64 {
+	if x != nil {
+		return x.RealizationRate
+	}
+	return 0
+}
+
+func (x *RoiReport) GetPredictedCost() int32 {
+	if x != nil {
+		return x.PredictedCost
+	}
+	return 0
+}
+
+func (x *RoiReport) GetActualCost() int32 {
+	if x != nil {
+		return x.ActualCost
+	}
+	return 0
+}
+
+func (x *RoiReport) GetHourlyWage() int32 {
+	if x != nil {
+		return x.HourlyWage
+	}
+	return 0
+}
+
+func (x *RoiReport) GetPredictedPaybackMonths() float64 {
+	if x != nil {
+		return x.PredictedPaybackMonths
+	}
+	return 0
+}
+
+func (x *RoiReport) GetActualPaybackMonths() float64 {
+	if x != nil {
+		return x.ActualPaybackMonths
+	}
+	return 0
+}
+
+func (x *RoiReport) GetActualPaybackReachable() bool {
+	if x != nil {
+		return x.ActualPaybackReachable
+	}
+	return false
+}
+
+type GetRoiCalibrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoiCalibrationRequest) Reset() {
+	*x = GetRoiCalibrationRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoiCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoiCalibrationRequest) ProtoMessage() {}
+
+func (x *GetRoiCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoiCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*GetRoiCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{17}
+}
+
+// CategoryCalibration: 製品カテゴリごとの較正係数
+type CategoryCalibration struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductCategory string                 `protobuf:"bytes,1,opt,name=product_category,json=productCategory,proto3" json:"product_category,omitempty"`
+	Factor          float64                `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`                                          // カタログの削減率に掛ける係数
+	SampleCount     int32                  `protobuf:"varint,3,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`              // 実測値のある製品数
+	MeanRealization float64                `protobuf:"fixed64,4,opt,name=mean_realization,json=meanRealization,proto3" json:"mean_realization,omitempty"` // 実測の削減率 / カタログの削減率 (較正前) の平均
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CategoryCalibration) Reset() {
+	*x = CategoryCalibration{}
+	mi := &file_project_v1_project_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryCalibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryCalibration) ProtoMessage() {}
+
+func (x *CategoryCalibration) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryCalibration.ProtoReflect.Descriptor instead.
+func (*CategoryCalibration) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CategoryCalibration) GetProductCategory() string {
+	if x != nil {
+		return x.ProductCategory
+	}
+	return ""
+}
+
+func (x *CategoryCalibration) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *CategoryCalibration) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *CategoryCalibration) GetMeanRealization() float64 {
+	if x != nil {
+		return x.MeanRealization
+	}
+	return 0
+}
+
+type RoiCalibration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryCalibration `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	ComputedAt    string                 `protobuf:"bytes,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoiCalibration) Reset() {
+	*x = RoiCalibration{}
+	mi := &file_project_v1_project_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiCalibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiCalibration) ProtoMessage() {}
+
+func (x *RoiCalibration) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiCalibration.ProtoReflect.Descriptor instead.
+func (*RoiCalibration) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RoiCalibration) GetCategories() []*CategoryCalibration {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *RoiCalibration) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+type RescheduleItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PurchaseDue   string                 `protobuf:"bytes,3,opt,name=purchase_due,json=purchaseDue,proto3" json:"purchase_due,omitempty"` // YYYY-MM-DD (日本時間)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleItemRequest) Reset() {
+	*x = RescheduleItemRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleItemRequest) ProtoMessage() {}
+
+func (x *RescheduleItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleItemRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleItemRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RescheduleItemRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RescheduleItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RescheduleItemRequest) GetPurchaseDue() string {
+	if x != nil {
+		return x.PurchaseDue
+	}
+	return ""
+}
+
+type CreateCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
+
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateCalendarFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// CalendarFeed: 発行したカレンダーフィード
+type CalendarFeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                              // カレンダーアプリに登録するURL (.ics)
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_project_v1_project_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CalendarFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RevokeCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedRequest) Reset() {
+	*x = RevokeCalendarFeedRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeCalendarFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevokedCount  int32                  `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedResponse) Reset() {
+	*x = RevokeCalendarFeedResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedResponse) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeCalendarFeedResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+var File_project_v1_project_proto protoreflect.FileDescriptor
+
+const file_project_v1_project_proto_rawDesc = "" +
+	"\n" +
+	"\x18project/v1/project.proto\x12\n" +
+	"project.v1\"f\n" +
+	"\x15SelectedItemSelection\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xa0\x01\n" +
+	"\x1cCreateAdoptionProjectRequest\x12$\n" +
+	"\x0esource_plan_id\x18\x01 \x01(\tR\fsourcePlanId\x12A\n" +
+	"\n" +
+	"selections\x18\x02 \x03(\v2!.project.v1.SelectedItemSelectionR\n" +
+	"selections\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"+\n" +
+	"\x19GetAdoptionProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x1bListAdoptionProjectsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"T\n" +
+	"\x1cListAdoptionProjectsResponse\x124\n" +
+	"\bprojects\x18\x01 \x03(\v2\x18.project.v1.AdoptionPlanR\bprojects\"\xa4\x02\n" +
+	"\x17UpdateItemStatusRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x00R\x04note\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\"\n" +
+	"\n" +
+	"price_paid\x18\x06 \x01(\x05H\x01R\tpricePaid\x88\x01\x01\x12\x14\n" +
+	"\x05store\x18\a \x01(\tR\x05store\x122\n" +
+	"\x15confirm_skip_purchase\x18\b \x01(\bR\x13confirmSkipPurchaseB\a\n" +
+	"\x05_noteB\r\n" +
+	"\v_price_paid\"\xb7\x01\n" +
+	"\x0eItemTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x0e\n" +
+	"\x02at\x18\x03 \x01(\tR\x02at\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\"\n" +
+	"\n" +
+	"price_paid\x18\x05 \x01(\x05H\x00R\tpricePaid\x88\x01\x01\x12\x14\n" +
+	"\x05store\x18\x06 \x01(\tR\x05store\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04noteB\r\n" +
+	"\v_price_paid\"\xb2\x03\n" +
+	"\vAdoptedItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x05R\x05price\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x05 \x03(\tR\tdependsOn\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x124\n" +
+	"\ahistory\x18\b \x03(\v2\x1a.project.v1.ItemTransitionR\ahistory\x12)\n" +
+	"\x10product_category\x18\t \x01(\tR\x0fproductCategory\x12!\n" +
+	"\fproduct_name\x18\n" +
+	" \x01(\tR\vproductName\x12#\n" +
+	"\rroadmap_month\x18\v \x01(\x05R\froadmapMonth\x12!\n" +
+	"\fpurchase_due\x18\f \x01(\tR\vpurchaseDue\x12\x1f\n" +
+	"\vinstall_due\x18\r \x01(\tR\n" +
+	"installDue\"\xe2\x01\n" +
+	"\fAdoptionPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12-\n" +
+	"\x05items\x18\x04 \x03(\v2\x17.project.v1.AdoptedItemR\x05items\x12$\n" +
+	"\x0esource_plan_id\x18\x05 \x01(\tR\fsourcePlanId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xba\x01\n" +
+	"\x1cRecordTimeMeasurementRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12.\n" +
+	"\x13minutes_per_session\x18\x03 \x01(\x05R\x11minutesPerSession\x12,\n" +
+	"\x12frequency_per_week\x18\x04 \x01(\x01R\x10frequencyPerWeek\"\xaf\x02\n" +
+	"\x0fTimeMeasurement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12%\n" +
+	"\x0echore_category\x18\x04 \x01(\tR\rchoreCategory\x12.\n" +
+	"\x13minutes_per_session\x18\x05 \x01(\x05R\x11minutesPerSession\x12,\n" +
+	"\x12frequency_per_week\x18\x06 \x01(\x01R\x10frequencyPerWeek\x12(\n" +
+	"\x10minutes_per_week\x18\a \x01(\x01R\x0eminutesPerWeek\x12\x1f\n" +
+	"\vmeasured_at\x18\b \x01(\tR\n" +
+	"measuredAt\"5\n" +
+	"\x1aListDueMeasurementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd9\x01\n" +
+	"\x0eDueMeasurement\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12%\n" +
+	"\x0echore_category\x18\x03 \x01(\tR\rchoreCategory\x12!\n" +
+	"\finstalled_at\x18\x04 \x01(\tR\vinstalledAt\x12(\n" +
+	"\x10last_measured_at\x18\x05 \x01(\tR\x0elastMeasuredAt\x12\x15\n" +
+	"\x06due_at\x18\x06 \x01(\tR\x05dueAt\"]\n" +
+	"\x1bListDueMeasurementsResponse\x12>\n" +
+	"\fmeasurements\x18\x01 \x03(\v2\x1a.project.v1.DueMeasurementR\fmeasurements\"4\n" +
+	"\x13GetRoiReportRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"\x8c\x06\n" +
+	"\rItemRoiReport\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10product_category\x18\x02 \x01(\tR\x0fproductCategory\x12%\n" +
+	"\x0echore_category\x18\x03 \x01(\tR\rchoreCategory\x12!\n" +
+	"\finstalled_at\x18\x04 \x01(\tR\vinstalledAt\x12+\n" +
+	"\x11measurement_count\x18\x05 \x01(\x05R\x10measurementCount\x12(\n" +
+	"\x10last_measured_at\x18\x06 \x01(\tR\x0elastMeasuredAt\x125\n" +
+	"\x17minutes_per_week_before\x18\a \x01(\x01R\x14minutesPerWeekBefore\x12;\n" +
+	"\x1apredicted_minutes_per_week\x18\b \x01(\x01R\x17predictedMinutesPerWeek\x125\n" +
+	"\x17actual_minutes_per_week\x18\t \x01(\x01R\x14actualMinutesPerWeek\x128\n" +
+	"\x18predicted_reduction_rate\x18\n" +
+	" \x01(\x01R\x16predictedReductionRate\x122\n" +
+	"\x15actual_reduction_rate\x18\v \x01(\x01R\x13actualReductionRate\x12?\n" +
+	"\x1cpredicted_hours_saved_yearly\x18\f \x01(\x01R\x19predictedHoursSavedYearly\x129\n" +
+	"\x19actual_hours_saved_yearly\x18\r \x01(\x01R\x16actualHoursSavedYearly\x120\n" +
+	"\x14reduction_rate_error\x18\x0e \x01(\x01R\x12reductionRateError\x12\x1a\n" +
+	"\bmeasured\x18\x0f \x01(\bR\bmeasured\x12-\n" +
+	"\x12calibration_factor\x18\x10 \x01(\x01R\x11calibrationFactor\"\xba\x04\n" +
+	"\tRoiReport\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.project.v1.ItemRoiReportR\x05items\x12%\n" +
+	"\x0emeasured_items\x18\x03 \x01(\x05R\rmeasuredItems\x12?\n" +
+	"\x1cpredicted_hours_saved_yearly\x18\x04 \x01(\x01R\x19predictedHoursSavedYearly\x129\n" +
+	"\x19actual_hours_saved_yearly\x18\x05 \x01(\x01R\x16actualHoursSavedYearly\x12)\n" +
+	"\x10realization_rate\x18\x06 \x01(\x01R\x0frealizationRate\x12%\n" +
+	"\x0epredicted_cost\x18\a \x01(\x05R\rpredictedCost\x12\x1f\n" +
+	"\vactual_cost\x18\b \x01(\x05R\n" +
+	"actualCost\x12\x1f\n" +
+	"\vhourly_wage\x18\t \x01(\x05R\n" +
+	"hourlyWage\x128\n" +
+	"\x18predicted_payback_months\x18\n" +
+	" \x01(\x01R\x16predictedPaybackMonths\x122\n" +
+	"\x15actual_payback_months\x18\v \x01(\x01R\x13actualPaybackMonths\x128\n" +
+	"\x18actual_payback_reachable\x18\f \x01(\bR\x16actualPaybackReachable\"\x1a\n" +
+	"\x18GetRoiCalibrationRequest\"\xa6\x01\n" +
+	"\x13CategoryCalibration\x12)\n" +
+	"\x10product_category\x18\x01 \x01(\tR\x0fproductCategory\x12\x16\n" +
+	"\x06factor\x18\x02 \x01(\x01R\x06factor\x12!\n" +
+	"\fsample_count\x18\x03 \x01(\x05R\vsampleCount\x12)\n" +
+	"\x10mean_realization\x18\x04 \x01(\x01R\x0fmeanRealization\"r\n" +
+	"\x0eRoiCalibration\x12?\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1f.project.v1.CategoryCalibrationR\n" +
+	"categories\x12\x1f\n" +
+	"\vcomputed_at\x18\x02 \x01(\tR\n" +
+	"computedAt\"x\n" +
+	"\x15RescheduleItemRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fpurchase_due\x18\x03 \x01(\tR\vpurchaseDue\"4\n" +
+	"\x19CreateCalendarFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"?\n" +
+	"\fCalendarFeed\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\"4\n" +
+	"\x19RevokeCalendarFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"A\n" +
+	"\x1aRevokeCalendarFeedResponse\x12#\n" +
+	"\rrevoked_count\x18\x01 \x01(\x05R\frevokedCount2\xf4\a\n" +
+	"\x0eProjectService\x12[\n" +
+	"\x15CreateAdoptionProject\x12(.project.v1.CreateAdoptionProjectRequest\x1a\x18.project.v1.AdoptionPlan\x12U\n" +
+	"\x12GetAdoptionProject\x12%.project.v1.GetAdoptionProjectRequest\x1a\x18.project.v1.AdoptionPlan\x12i\n" +
+	"\x14ListAdoptionProjects\x12'.project.v1.ListAdoptionProjectsRequest\x1a(.project.v1.ListAdoptionProjectsResponse\x12Q\n" +
+	"\x10UpdateItemStatus\x12#.project.v1.UpdateItemStatusRequest\x1a\x18.project.v1.AdoptionPlan\x12^\n" +
+	"\x15RecordTimeMeasurement\x12(.project.v1.RecordTimeMeasurementRequest\x1a\x1b.project.v1.TimeMeasurement\x12f\n" +
+	"\x13ListDueMeasurements\x12&.project.v1.ListDueMeasurementsRequest\x1a'.project.v1.ListDueMeasurementsResponse\x12F\n" +
+	"\fGetRoiReport\x12\x1f.project.v1.GetRoiReportRequest\x1a\x15.project.v1.RoiReport\x12U\n" +
+	"\x11GetRoiCalibration\x12$.project.v1.GetRoiCalibrationRequest\x1a\x1a.project.v1.RoiCalibration\x12M\n" +
+	"\x0eRescheduleItem\x12!.project.v1.RescheduleItemRequest\x1a\x18.project.v1.AdoptionPlan\x12U\n" +
+	"\x12CreateCalendarFeed\x12%.project.v1.CreateCalendarFeedRequest\x1a\x18.project.v1.CalendarFeed\x12c\n" +
+	"\x12RevokeCalendarFeed\x12%.project.v1.RevokeCalendarFeedRequest\x1a&.project.v1.RevokeCalendarFeedResponseB=Z;github.com/kinoshitatakumi/opti/gen/go/project/v1;projectv1b\x06proto3"
+
+var (
+	file_project_v1_project_proto_rawDescOnce sync.Once
+	file_project_v1_project_proto_rawDescData []byte
+)
+
+func file_project_v1_project_proto_rawDescGZIP() []byte {
+	file_project_v1_project_proto_rawDescOnce.Do(func() {
+		file_project_v1_project_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_project_v1_project_proto_rawDesc), len(file_project_v1_project_proto_rawDesc)))
+	})
+	return file_project_v1_project_proto_rawDescData
+}
+
+var file_project_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_project_v1_project_proto_goTypes = []any{
+	(*SelectedItemSelection)(nil),        // 0: project.v1.SelectedItemSelection
+	(*CreateAdoptionProjectRequest)(nil), // 1: project.v1.CreateAdoptionProjectRequest
+	(*GetAdoptionProjectRequest)(nil),    // 2: project.v1.GetAdoptionProjectRequest
+	(*ListAdoptionProjectsRequest)(nil),  // 3: project.v1.ListAdoptionProjectsRequest
+	(*ListAdoptionProjectsResponse)(nil), // 4: project.v1.ListAdoptionProjectsResponse
+	(*UpdateItemStatusRequest)(nil),      // 5: project.v1.UpdateItemStatusRequest
+	(*ItemTransition)(nil),               // 6: project.v1.ItemTransition
+	(*AdoptedItem)(nil),                  // 7: project.v1.AdoptedItem
+	(*AdoptionPlan)(nil),                 // 8: project.v1.AdoptionPlan
+	(*RecordTimeMeasurementRequest)(nil), // 9: project.v1.RecordTimeMeasurementRequest
+	(*TimeMeasurement)(nil),              // 10: project.v1.TimeMeasurement
+	(*ListDueMeasurementsRequest)(nil),   // 11: project.v1.ListDueMeasurementsRequest
+	(*DueMeasurement)(nil),               // 12: project.v1.DueMeasurement
+	(*ListDueMeasurementsResponse)(nil),  // 13: project.v1.ListDueMeasurementsResponse
+	(*GetRoiReportRequest)(nil),          // 14: project.v1.GetRoiReportRequest
+	(*ItemRoiReport)(nil),                // 15: project.v1.ItemRoiReport
+	(*RoiReport)(nil),                    // 16: project.v1.RoiReport
+	(*GetRoiCalibrationRequest)(nil),     // 17: project.v1.GetRoiCalibrationRequest
+	(*CategoryCalibration)(nil),          // 18: project.v1.CategoryCalibration
+	(*RoiCalibration)(nil),               // 19: project.v1.RoiCalibration
+	(*RescheduleItemRequest)(nil),        // 20: project.v1.RescheduleItemRequest
+	(*CreateCalendarFeedRequest)(nil),    // 21: project.v1.CreateCalendarFeedRequest
+	(*CalendarFeed)(nil),                 // 22: project.v1.CalendarFeed
+	(*RevokeCalendarFeedRequest)(nil),    // 23: project.v1.RevokeCalendarFeedRequest
+	(*RevokeCalendarFeedResponse)(nil),   // 24: project.v1.RevokeCalendarFeedResponse
+}
+var file_project_v1_project_proto_depIdxs = []int32{
+	0,  // 0: project.v1.CreateAdoptionProjectRequest.selections:type_name -> project.v1.SelectedItemSelection
+	8,  // 1: project.v1.ListAdoptionProjectsResponse.projects:type_name -> project.v1.AdoptionPlan
+	6,  // 2: project.v1.AdoptedItem.history:type_name -> project.v1.ItemTransition
+	7,  // 3: project.v1.AdoptionPlan.items:type_name -> project.v1.AdoptedItem
+	12, // 4: project.v1.ListDueMeasurementsResponse.measurements:type_name -> project.v1.DueMeasurement
+	15, // 5: project.v1.RoiReport.items:type_name -> project.v1.ItemRoiReport
+	18, // 6: project.v1.RoiCalibration.categories:type_name -> project.v1.CategoryCalibration
+	1,  // 7: project.v1.ProjectService.CreateAdoptionProject:input_type -> project.v1.CreateAdoptionProjectRequest
+	2,  // 8: project.v1.ProjectService.GetAdoptionProject:input_type -> project.v1.GetAdoptionProjectRequest
+	3,  // 9: project.v1.ProjectService.ListAdoptionProjects:input_type -> project.v1.ListAdoptionProjectsRequest
+	5,  // 10: project.v1.ProjectService.UpdateItemStatus:input_type -> project.v1.UpdateItemStatusRequest
+	9,  // 11: project.v1.ProjectService.RecordTimeMeasurement:input_type -> project.v1.RecordTimeMeasurementRequest
+	11, // 12: project.v1.ProjectService.ListDueMeasurements:input_type -> project.v1.ListDueMeasurementsRequest
+	14, // 13: project.v1.ProjectService.GetRoiReport:input_type -> project.v1.GetRoiReportRequest
+	17, // 14: project.v1.ProjectService.GetRoiCalibration:input_type -> project.v1.GetRoiCalibrationRequest
+	20, // 15: project.v1.ProjectService.RescheduleItem:input_type -> project.v1.RescheduleItemRequest
+	21, // 16: project.v1.ProjectService.CreateCalendarFeed:input_type -> project.v1.CreateCalendarFeedRequest
+	23, // 17: project.v1.ProjectService.RevokeCalendarFeed:input_type -> project.v1.RevokeCalendarFeedRequest
+	8,  // 18: project.v1.ProjectService.CreateAdoptionProject:output_type -> project.v1.AdoptionPlan
+	8,  // 19: project.v1.ProjectService.GetAdoptionProject:output_type -> project.v1.AdoptionPlan
+	4,  // 20: project.v1.ProjectService.ListAdoptionProjects:output_type -> project.v1.ListAdoptionProjectsResponse
+	8,  // 21: project.v1.ProjectService.UpdateItemStatus:output_type -> project.v1.AdoptionPlan
+	10, // 22: project.v1.ProjectService.RecordTimeMeasurement:output_type -> project.v1.TimeMeasurement
+	13, // 23: project.v1.ProjectService.ListDueMeasurements:output_type -> project.v1.ListDueMeasurementsResponse
+	16, // 24: project.v1.ProjectService.GetRoiReport:output_type -> project.v1.RoiReport
+	19, // 25: project.v1.ProjectService.GetRoiCalibration:output_type -> project.v1.RoiCalibration
+	8,  // 26: project.v1.ProjectService.RescheduleItem:output_type -> project.v1.AdoptionPlan
+	22, // 27: project.v1.ProjectService.CreateCalendarFeed:output_type -> project.v1.CalendarFeed
+	24, // 28: project.v1.ProjectService.RevokeCalendarFeed:output_type -> project.v1.RevokeCalendarFeedResponse
+	18, // [18:29] is the sub-list for method output_type
+	7,  // [7:18] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_project_v1_project_proto_init() }
+func file_project_v1_project_proto_init() {
+	if File_project_v1_project_proto != nil {
+		return
+	}
+	file_project_v1_project_proto_msgTypes[5].OneofWrappers = []any{}
+	file_project_v1_project_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_project_v1_project_proto_rawDesc), len(file_project_v1_project_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_project_v1_project_proto_goTypes,
+		DependencyIndexes: file_project_v1_project_proto_depIdxs,
+		MessageInfos:      file_project_v1_project_proto_msgTypes,
+	}.Build()
+	File_project_v1_project_proto = out.File
+	file_project_v1_project_proto_goTypes = nil
+	file_project_v1_project_proto_depIdxs = nil
+}
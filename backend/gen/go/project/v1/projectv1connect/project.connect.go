@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: project/v1/project.proto
+
+// パッケージ名はディレクトリ構成と一致させます
+package projectv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/kinoshitatakumi/opti/gen/go/project/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProjectServiceName is the fully-qualified name of the ProjectService service.
+	ProjectServiceName = "project.v1.ProjectService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProjectServiceCreateAdoptionProjectProcedure is the fully-qualified name of the ProjectService's
+	// CreateAdoptionProject RPC.
+	ProjectServiceCreateAdoptionProjectProcedure = "/project.v1.ProjectService/CreateAdoptionProject"
+	// ProjectServiceGetAdoptionProjectProcedure is the fully-qualified name of the ProjectService's
+	// GetAdoptionProject RPC.
+	ProjectServiceGetAdoptionProjectProcedure = "/project.v1.ProjectService/GetAdoptionProject"
+	// ProjectServiceListAdoptionProjectsProcedure is the fully-qualified name of the ProjectService's
+	// ListAdoptionProjects RPC.
+	ProjectServiceListAdoptionProjectsProcedure = "/project.v1.ProjectService/ListAdoptionProjects"
+	// ProjectServiceUpdateItemStatusProcedure is the fully-qualified name of the ProjectService's
+	// UpdateItemStatus RPC.
+	ProjectServiceUpdateItemStatusProcedure = "/project.v1.ProjectService/UpdateItemStatus"
+	// ProjectServiceRecordTimeMeasurementProcedure is the fully-qualified name of the ProjectService's
+	// RecordTimeMeasurement RPC.
+	ProjectServiceRecordTimeMeasurementProcedure = "/project.v1.ProjectService/RecordTimeMeasurement"
+	// ProjectServiceListDueMeasurementsProcedure is the fully-qualified name of the ProjectService's
+	// ListDueMeasurements RPC.
+	ProjectServiceListDueMeasurementsProcedure = "/project.v1.ProjectService/ListDueMeasurements"
+	// ProjectServiceGetRoiReportProcedure is the fully-qualified name of the ProjectService's
+	// GetRoiReport RPC.
+	ProjectServiceGetRoiReportProcedure = "/project.v1.ProjectService/GetRoiReport"
+	// ProjectServiceGetRoiCalibrationProcedure is the fully-qualified name of the ProjectService's
+	// GetRoiCalibration RPC.
+	ProjectServiceGetRoiCalibrationProcedure = "/project.v1.ProjectService/GetRoiCalibration"
+	// ProjectServiceRescheduleItemProcedure is the fully-qualified name of the ProjectService's
+	// RescheduleItem RPC.
+	ProjectServiceRescheduleItemProcedure = "/project.v1.ProjectService/RescheduleItem"
+	// ProjectServiceCreateCalendarFeedProcedure is the fully-qualified name of the ProjectService's
+	// CreateCalendarFeed RPC.
+	ProjectServiceCreateCalendarFeedProcedure = "/project.v1.ProjectService/CreateCalendarFeed"
+	// ProjectServiceRevokeCalendarFeedProcedure is the fully-qualified name of the ProjectService's
+	// RevokeCalendarFeed RPC.
+	ProjectServiceRevokeCalendarFeedProcedure = "/project.v1.ProjectService/RevokeCalendarFeed"
+)
+
+// ProjectServiceClient is a client for the project.v1.ProjectService service.
+type ProjectServiceClient interface {
+	// CreateAdoptionProject: 提案プランの中から導入する製品を選び、プロジェクトとして確定します (UC-03)。
+	// 選べるのは元の提案プランに含まれる製品だけです。選ばなかった製品は "wont_do" として残ります。
+	// ログインが必要で、プロジェクトの所有者はアクセストークンの利用者です。他の利用者の提案プランからは作れません。
+	CreateAdoptionProject(context.Context, *connect.Request[v1.CreateAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// GetAdoptionProject: プロジェクトを取得します。ログインが必要で、他の利用者のプロジェクトは PermissionDenied です。
+	// 他サービスからは、受け付けたリクエストのアクセストークンを引き継いで呼び出してください (利用者のプロジェクトかの確認に使えます)。
+	GetAdoptionProject(context.Context, *connect.Request[v1.GetAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// ListAdoptionProjects: ログイン中の利用者のプロジェクトを新しい順に返します (Dashboard)。
+	ListAdoptionProjects(context.Context, *connect.Request[v1.ListAdoptionProjectsRequest]) (*connect.Response[v1.ListAdoptionProjectsResponse], error)
+	// UpdateItemStatus: 製品の購入・設置ステータスを更新します (UC-04)。
+	// 変更できるのは状態遷移表にある組み合わせだけで、変更は履歴 (history) に記録されます。
+	//   pending → bought / wont_do / installed (confirm_skip_purchase が必要)
+	//   bought → installed / pending、installed → bought
+	//   wont_do → pending (プロジェクトが completed でない間だけ)
+	// 遷移できない場合は FailedPrecondition を返します。
+	// 同じプロジェクトへの別の変更と重なった場合は Aborted を返すので、取得し直してから再度変更してください。
+	// ログインが必要で、履歴の変更者 (actor) はアクセストークンの利用者になります。他の利用者のプロジェクトは PermissionDenied です。
+	// プロジェクト全体のステータスは、製品のステータスから自動的に決まります。
+	UpdateItemStatus(context.Context, *connect.Request[v1.UpdateItemStatusRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// RecordTimeMeasurement: 設置済みの製品について、導入後に家事にかかっている時間を記録します。
+	// 設置済み (installed) でない製品や、家事を直接減らさない製品 (ハブなど) には記録できません。
+	// ログインが必要で、記録できるのはプロジェクトの所有者だけです (他の利用者は PermissionDenied)。
+	RecordTimeMeasurement(context.Context, *connect.Request[v1.RecordTimeMeasurementRequest]) (*connect.Response[v1.TimeMeasurement], error)
+	// ListDueMeasurements: 実測値の入力をユーザーに依頼すべき製品を返します。
+	// 設置から2週間後に最初の入力を、その後は30日ごとに入力を依頼します。ログインが必要です。
+	ListDueMeasurements(context.Context, *connect.Request[v1.ListDueMeasurementsRequest]) (*connect.Response[v1.ListDueMeasurementsResponse], error)
+	// GetRoiReport: 提案プランの予測と実測値を比較したROIレポートを、製品ごととプロジェクト全体で返します。
+	// ログインが必要で、見られるのはプロジェクトの所有者だけです (他の利用者は PermissionDenied)。
+	GetRoiReport(context.Context, *connect.Request[v1.GetRoiReportRequest]) (*connect.Response[v1.RoiReport], error)
+	// GetRoiCalibration: 全プロジェクトの実測値から求めた、製品カテゴリごとの削減率の較正係数を返します。
+	// Simulation Service が定期的に取得し、ROI計算に反映します。
+	GetRoiCalibration(context.Context, *connect.Request[v1.GetRoiCalibrationRequest]) (*connect.Response[v1.RoiCalibration], error)
+	// RescheduleItem: 製品の購入予定日を変更します。設置予定日も購入予定日の1週間後に合わせて移動します。
+	// ログインが必要で、変更できるのはプロジェクトの所有者だけです (他の利用者は PermissionDenied)。
+	RescheduleItem(context.Context, *connect.Request[v1.RescheduleItemRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// CreateCalendarFeed: ロードマップと購入・設置の予定を購読できる iCalendar (.ics) フィードのURLを発行します。
+	// URLはユーザーごとの秘密のURLで、発行し直すと以前のURLは無効になります。URLはこのレスポンスでしか返しません。
+	// ログインが必要で、フィードはアクセストークンの利用者のものになります。
+	CreateCalendarFeed(context.Context, *connect.Request[v1.CreateCalendarFeedRequest]) (*connect.Response[v1.CalendarFeed], error)
+	// RevokeCalendarFeed: ユーザーのカレンダーフィードを無効化します。以降、URLへのアクセスは 404 になります。
+	// ログインが必要で、無効化できるのはアクセストークンの利用者のフィードだけです。
+	RevokeCalendarFeed(context.Context, *connect.Request[v1.RevokeCalendarFeedRequest]) (*connect.Response[v1.RevokeCalendarFeedResponse], error)
+}
+
+// NewProjectServiceClient constructs a client for the project.v1.ProjectService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProjectServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	projectServiceMethods := v1.File_project_v1_project_proto.Services().ByName("ProjectService").Methods()
+	return &projectServiceClient{
+		createAdoptionProject: connect.NewClient[v1.CreateAdoptionProjectRequest, v1.AdoptionPlan](
+			httpClient,
+			baseURL+ProjectServiceCreateAdoptionProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("CreateAdoptionProject")),
+			connect.WithClientOptions(opts...),
+		),
+		getAdoptionProject: connect.NewClient[v1.GetAdoptionProjectRequest, v1.AdoptionPlan](
+			httpClient,
+			baseURL+ProjectServiceGetAdoptionProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("GetAdoptionProject")),
+			connect.WithClientOptions(opts...),
+		),
+		listAdoptionProjects: connect.NewClient[v1.ListAdoptionProjectsRequest, v1.ListAdoptionProjectsResponse](
+			httpClient,
+			baseURL+ProjectServiceListAdoptionProjectsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListAdoptionProjects")),
+			connect.WithClientOptions(opts...),
+		),
+		updateItemStatus: connect.NewClient[v1.UpdateItemStatusRequest, v1.AdoptionPlan](
+			httpClient,
+			baseURL+ProjectServiceUpdateItemStatusProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("UpdateItemStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		recordTimeMeasurement: connect.NewClient[v1.RecordTimeMeasurementRequest, v1.TimeMeasurement](
+			httpClient,
+			baseURL+ProjectServiceRecordTimeMeasurementProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RecordTimeMeasurement")),
+			connect.WithClientOptions(opts...),
+		),
+		listDueMeasurements: connect.NewClient[v1.ListDueMeasurementsRequest, v1.ListDueMeasurementsResponse](
+			httpClient,
+			baseURL+ProjectServiceListDueMeasurementsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListDueMeasurements")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoiReport: connect.NewClient[v1.GetRoiReportRequest, v1.RoiReport](
+			httpClient,
+			baseURL+ProjectServiceGetRoiReportProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("GetRoiReport")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoiCalibration: connect.NewClient[v1.GetRoiCalibrationRequest, v1.RoiCalibration](
+			httpClient,
+			baseURL+ProjectServiceGetRoiCalibrationProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("GetRoiCalibration")),
+			connect.WithClientOptions(opts...),
+		),
+		rescheduleItem: connect.NewClient[v1.RescheduleItemRequest, v1.AdoptionPlan](
+			httpClient,
+			baseURL+ProjectServiceRescheduleItemProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RescheduleItem")),
+			connect.WithClientOptions(opts...),
+		),
+		createCalendarFeed: connect.NewClient[v1.CreateCalendarFeedRequest, v1.CalendarFeed](
+			httpClient,
+			baseURL+ProjectServiceCreateCalendarFeedProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("CreateCalendarFeed")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeCalendarFeed: connect.NewClient[v1.RevokeCalendarFeedRequest, v1.RevokeCalendarFeedResponse](
+			httpClient,
+			baseURL+ProjectServiceRevokeCalendarFeedProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RevokeCalendarFeed")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// projectServiceClient implements ProjectServiceClient.
+type projectServiceClient struct {
+	createAdoptionProject *connect.Client[v1.CreateAdoptionProjectRequest, v1.AdoptionPlan]
+	getAdoptionProject    *connect.Client[v1.GetAdoptionProjectRequest, v1.AdoptionPlan]
+	listAdoptionProjects  *connect.Client[v1.ListAdoptionProjectsRequest, v1.ListAdoptionProjectsResponse]
+	updateItemStatus      *connect.Client[v1.UpdateItemStatusRequest, v1.AdoptionPlan]
+	recordTimeMeasurement *connect.Client[v1.RecordTimeMeasurementRequest, v1.TimeMeasurement]
+	listDueMeasurements   *connect.Client[v1.ListDueMeasurementsRequest, v1.ListDueMeasurementsResponse]
+	getRoiReport          *connect.Client[v1.GetRoiReportRequest, v1.RoiReport]
+	getRoiCalibration     *connect.Client[v1.GetRoiCalibrationRequest, v1.RoiCalibration]
+	rescheduleItem        *connect.Client[v1.RescheduleItemRequest, v1.AdoptionPlan]
+	createCalendarFeed    *connect.Client[v1.CreateCalendarFeedRequest, v1.CalendarFeed]
+	revokeCalendarFeed    *connect.Client[v1.RevokeCalendarFeedRequest, v1.RevokeCalendarFeedResponse]
+}
+
+// CreateAdoptionProject calls project.v1.ProjectService.CreateAdoptionProject.
+func (c *projectServiceClient) CreateAdoptionProject(ctx context.Context, req *connect.Request[v1.CreateAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return c.createAdoptionProject.CallUnary(ctx, req)
+}
+
+// GetAdoptionProject calls project.v1.ProjectService.GetAdoptionProject.
+func (c *projectServiceClient) GetAdoptionProject(ctx context.Context, req *connect.Request[v1.GetAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return c.getAdoptionProject.CallUnary(ctx, req)
+}
+
+// ListAdoptionProjects calls project.v1.ProjectService.ListAdoptionProjects.
+func (c *projectServiceClient) ListAdoptionProjects(ctx context.Context, req *connect.Request[v1.ListAdoptionProjectsRequest]) (*connect.Response[v1.ListAdoptionProjectsResponse], error) {
+	return c.listAdoptionProjects.CallUnary(ctx, req)
+}
+
+// UpdateItemStatus calls project.v1.ProjectService.UpdateItemStatus.
+func (c *projectServiceClient) UpdateItemStatus(ctx context.Context, req *connect.Request[v1.UpdateItemStatusRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return c.updateItemStatus.CallUnary(ctx, req)
+}
+
+// RecordTimeMeasurement calls project.v1.ProjectService.RecordTimeMeasurement.
+func (c *projectServiceClient) RecordTimeMeasurement(ctx context.Context, req *connect.Request[v1.RecordTimeMeasurementRequest]) (*connect.Response[v1.TimeMeasurement], error) {
+	return c.recordTimeMeasurement.CallUnary(ctx, req)
+}
+
+// ListDueMeasurements calls project.v1.ProjectService.ListDueMeasurements.
+func (c *projectServiceClient) ListDueMeasurements(ctx context.Context, req *connect.Request[v1.ListDueMeasurementsRequest]) (*connect.Response[v1.ListDueMeasurementsResponse], error) {
+	return c.listDueMeasurements.CallUnary(ctx, req)
+}
+
+// GetRoiReport calls project.v1.ProjectService.GetRoiReport.
+func (c *projectServiceClient) GetRoiReport(ctx context.Context, req *connect.Request[v1.GetRoiReportRequest]) (*connect.Response[v1.RoiReport], error) {
+	return c.getRoiReport.CallUnary(ctx, req)
+}
+
+// GetRoiCalibration calls project.v1.ProjectService.GetRoiCalibration.
+func (c *projectServiceClient) GetRoiCalibration(ctx context.Context, req *connect.Request[v1.GetRoiCalibrationRequest]) (*connect.Response[v1.RoiCalibration], error) {
+	return c.getRoiCalibration.CallUnary(ctx, req)
+}
+
+// RescheduleItem calls project.v1.ProjectService.RescheduleItem.
+func (c *projectServiceClient) RescheduleItem(ctx context.Context, req *connect.Request[v1.RescheduleItemRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return c.rescheduleItem.CallUnary(ctx, req)
+}
+
+// CreateCalendarFeed calls project.v1.ProjectService.CreateCalendarFeed.
+func (c *projectServiceClient) CreateCalendarFeed(ctx context.Context, req *connect.Request[v1.CreateCalendarFeedRequest]) (*connect.Response[v1.CalendarFeed], error) {
+	return c.createCalendarFeed.CallUnary(ctx, req)
+}
+
+// RevokeCalendarFeed calls project.v1.ProjectService.RevokeCalendarFeed.
+func (c *projectServiceClient) RevokeCalendarFeed(ctx context.Context, req *connect.Request[v1.RevokeCalendarFeedRequest]) (*connect.Response[v1.RevokeCalendarFeedResponse], error) {
+	return c.revokeCalendarFeed.CallUnary(ctx, req)
+}
+
+// ProjectServiceHandler is an implementation of the project.v1.ProjectService service.
+type ProjectServiceHandler interface {
+	// CreateAdoptionProject: 提案プランの中から導入する製品を選び、プロジェクトとして確定します (UC-03)。
+	// 選べるのは元の提案プランに含まれる製品だけです。選ばなかった製品は "wont_do" として残ります。
+	// ログインが必要で、プロジェクトの所有者はアクセストークンの利用者です。他の利用者の提案プランからは作れません。
+	CreateAdoptionProject(context.Context, *connect.Request[v1.CreateAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// GetAdoptionProject: プロジェクトを取得します。ログインが必要で、他の利用者のプロジェクトは PermissionDenied です。
+	// 他サービスからは、受け付けたリクエストのアクセストークンを引き継いで呼び出してください (利用者のプロジェクトかの確認に使えます)。
+	GetAdoptionProject(context.Context, *connect.Request[v1.GetAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// ListAdoptionProjects: ログイン中の利用者のプロジェクトを新しい順に返します (Dashboard)。
+	ListAdoptionProjects(context.Context, *connect.Request[v1.ListAdoptionProjectsRequest]) (*connect.Response[v1.ListAdoptionProjectsResponse], error)
+	// UpdateItemStatus: 製品の購入・設置ステータスを更新します (UC-04)。
+	// 変更できるのは状態遷移表にある組み合わせだけで、変更は履歴 (history) に記録されます。
+	//   pending → bought / wont_do / installed (confirm_skip_purchase が必要)
+	//   bought → installed / pending、installed → bought
+	//   wont_do → pending (プロジェクトが completed でない間だけ)
+	// 遷移できない場合は FailedPrecondition を返します。
+	// 同じプロジェクトへの別の変更と重なった場合は Aborted を返すので、取得し直してから再度変更してください。
+	// ログインが必要で、履歴の変更者 (actor) はアクセストークンの利用者になります。他の利用者のプロジェクトは PermissionDenied です。
+	// プロジェクト全体のステータスは、製品のステータスから自動的に決まります。
+	UpdateItemStatus(context.Context, *connect.Request[v1.UpdateItemStatusRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// RecordTimeMeasurement: 設置済みの製品について、導入後に家事にかかっている時間を記録します。
+	// 設置済み (installed) でない製品や、家事を直接減らさない製品 (ハブなど) には記録できません。
+	// ログインが必要で、記録できるのはプロジェクトの所有者だけです (他の利用者は PermissionDenied)。
+	RecordTimeMeasurement(context.Context, *connect.Request[v1.RecordTimeMeasurementRequest]) (*connect.Response[v1.TimeMeasurement], error)
+	// ListDueMeasurements: 実測値の入力をユーザーに依頼すべき製品を返します。
+	// 設置から2週間後に最初の入力を、その後は30日ごとに入力を依頼します。ログインが必要です。
+	ListDueMeasurements(context.Context, *connect.Request[v1.ListDueMeasurementsRequest]) (*connect.Response[v1.ListDueMeasurementsResponse], error)
+	// GetRoiReport: 提案プランの予測と実測値を比較したROIレポートを、製品ごととプロジェクト全体で返します。
+	// ログインが必要で、見られるのはプロジェクトの所有者だけです (他の利用者は PermissionDenied)。
+	GetRoiReport(context.Context, *connect.Request[v1.GetRoiReportRequest]) (*connect.Response[v1.RoiReport], error)
+	// GetRoiCalibration: 全プロジェクトの実測値から求めた、製品カテゴリごとの削減率の較正係数を返します。
+	// Simulation Service が定期的に取得し、ROI計算に反映します。
+	GetRoiCalibration(context.Context, *connect.Request[v1.GetRoiCalibrationRequest]) (*connect.Response[v1.RoiCalibration], error)
+	// RescheduleItem: 製品の購入予定日を変更します。設置予定日も購入予定日の1週間後に合わせて移動します。
+	// ログインが必要で、変更できるのはプロジェクトの所有者だけです (他の利用者は PermissionDenied)。
+	RescheduleItem(context.Context, *connect.Request[v1.RescheduleItemRequest]) (*connect.Response[v1.AdoptionPlan], error)
+	// CreateCalendarFeed: ロードマップと購入・設置の予定を購読できる iCalendar (.ics) フィードのURLを発行します。
+	// URLはユーザーごとの秘密のURLで、発行し直すと以前のURLは無効になります。URLはこのレスポンスでしか返しません。
+	// ログインが必要で、フィードはアクセストークンの利用者のものになります。
+	CreateCalendarFeed(context.Context, *connect.Request[v1.CreateCalendarFeedRequest]) (*connect.Response[v1.CalendarFeed], error)
+	// RevokeCalendarFeed: ユーザーのカレンダーフィードを無効化します。以降、URLへのアクセスは 404 になります。
+	// ログインが必要で、無効化できるのはアクセストークンの利用者のフィードだけです。
+	RevokeCalendarFeed(context.Context, *connect.Request[v1.RevokeCalendarFeedRequest]) (*connect.Response[v1.RevokeCalendarFeedResponse], error)
+}
+
+// NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	projectServiceMethods := v1.File_project_v1_project_proto.Services().ByName("ProjectService").Methods()
+	projectServiceCreateAdoptionProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceCreateAdoptionProjectProcedure,
+		svc.CreateAdoptionProject,
+		connect.WithSchema(projectServiceMethods.ByName("CreateAdoptionProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetAdoptionProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceGetAdoptionProjectProcedure,
+		svc.GetAdoptionProject,
+		connect.WithSchema(projectServiceMethods.ByName("GetAdoptionProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListAdoptionProjectsHandler := connect.NewUnaryHandler(
+		ProjectServiceListAdoptionProjectsProcedure,
+		svc.ListAdoptionProjects,
+		connect.WithSchema(projectServiceMethods.ByName("ListAdoptionProjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUpdateItemStatusHandler := connect.NewUnaryHandler(
+		ProjectServiceUpdateItemStatusProcedure,
+		svc.UpdateItemStatus,
+		connect.WithSchema(projectServiceMethods.ByName("UpdateItemStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRecordTimeMeasurementHandler := connect.NewUnaryHandler(
+		ProjectServiceRecordTimeMeasurementProcedure,
+		svc.RecordTimeMeasurement,
+		connect.WithSchema(projectServiceMethods.ByName("RecordTimeMeasurement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListDueMeasurementsHandler := connect.NewUnaryHandler(
+		ProjectServiceListDueMeasurementsProcedure,
+		svc.ListDueMeasurements,
+		connect.WithSchema(projectServiceMethods.ByName("ListDueMeasurements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetRoiReportHandler := connect.NewUnaryHandler(
+		ProjectServiceGetRoiReportProcedure,
+		svc.GetRoiReport,
+		connect.WithSchema(projectServiceMethods.ByName("GetRoiReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetRoiCalibrationHandler := connect.NewUnaryHandler(
+		ProjectServiceGetRoiCalibrationProcedure,
+		svc.GetRoiCalibration,
+		connect.WithSchema(projectServiceMethods.ByName("GetRoiCalibration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRescheduleItemHandler := connect.NewUnaryHandler(
+		ProjectServiceRescheduleItemProcedure,
+		svc.RescheduleItem,
+		connect.WithSchema(projectServiceMethods.ByName("RescheduleItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceCreateCalendarFeedHandler := connect.NewUnaryHandler(
+		ProjectServiceCreateCalendarFeedProcedure,
+		svc.CreateCalendarFeed,
+		connect.WithSchema(projectServiceMethods.ByName("CreateCalendarFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRevokeCalendarFeedHandler := connect.NewUnaryHandler(
+		ProjectServiceRevokeCalendarFeedProcedure,
+		svc.RevokeCalendarFeed,
+		connect.WithSchema(projectServiceMethods.ByName("RevokeCalendarFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/project.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProjectServiceCreateAdoptionProjectProcedure:
+			projectServiceCreateAdoptionProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceGetAdoptionProjectProcedure:
+			projectServiceGetAdoptionProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceListAdoptionProjectsProcedure:
+			projectServiceListAdoptionProjectsHandler.ServeHTTP(w, r)
+		case ProjectServiceUpdateItemStatusProcedure:
+			projectServiceUpdateItemStatusHandler.ServeHTTP(w, r)
+		case ProjectServiceRecordTimeMeasurementProcedure:
+			projectServiceRecordTimeMeasurementHandler.ServeHTTP(w, r)
+		case ProjectServiceListDueMeasurementsProcedure:
+			projectServiceListDueMeasurementsHandler.ServeHTTP(w, r)
+		case ProjectServiceGetRoiReportProcedure:
+			projectServiceGetRoiReportHandler.ServeHTTP(w, r)
+		case ProjectServiceGetRoiCalibrationProcedure:
+			projectServiceGetRoiCalibrationHandler.ServeHTTP(w, r)
+		case ProjectServiceRescheduleItemProcedure:
+			projectServiceRescheduleItemHandler.ServeHTTP(w, r)
+		case ProjectServiceCreateCalendarFeedProcedure:
+			projectServiceCreateCalendarFeedHandler.ServeHTTP(w, r)
+		case ProjectServiceRevokeCalendarFeedProcedure:
+			projectServiceRevokeCalendarFeedHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProjectServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProjectServiceHandler struct{}
+
+func (UnimplementedProjectServiceHandler) CreateAdoptionProject(context.Context, *connect.Request[v1.CreateAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.CreateAdoptionProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetAdoptionProject(context.Context, *connect.Request[v1.GetAdoptionProjectRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.GetAdoptionProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListAdoptionProjects(context.Context, *connect.Request[v1.ListAdoptionProjectsRequest]) (*connect.Response[v1.ListAdoptionProjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.ListAdoptionProjects is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UpdateItemStatus(context.Context, *connect.Request[v1.UpdateItemStatusRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.UpdateItemStatus is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RecordTimeMeasurement(context.Context, *connect.Request[v1.RecordTimeMeasurementRequest]) (*connect.Response[v1.TimeMeasurement], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.RecordTimeMeasurement is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListDueMeasurements(context.Context, *connect.Request[v1.ListDueMeasurementsRequest]) (*connect.Response[v1.ListDueMeasurementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.ListDueMeasurements is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetRoiReport(context.Context, *connect.Request[v1.GetRoiReportRequest]) (*connect.Response[v1.RoiReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.GetRoiReport is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetRoiCalibration(context.Context, *connect.Request[v1.GetRoiCalibrationRequest]) (*connect.Response[v1.RoiCalibration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.GetRoiCalibration is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RescheduleItem(context.Context, *connect.Request[v1.RescheduleItemRequest]) (*connect.Response[v1.AdoptionPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.RescheduleItem is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) CreateCalendarFeed(context.Context, *connect.Request[v1.CreateCalendarFeedRequest]) (*connect.Response[v1.CalendarFeed], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.CreateCalendarFeed is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RevokeCalendarFeed(context.Context, *connect.Request[v1.RevokeCalendarFeedRequest]) (*connect.Response[v1.RevokeCalendarFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("project.v1.ProjectService.RevokeCalendarFeed is not implemented"))
+}
@@ -0,0 +1,3643 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: simulation/v1/simulation.proto
+
+// パッケージ名はディレクトリ構成と一致させます
+
+package simulationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChoreInput: 家事1種類あたりの負担入力
+type ChoreInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 家事カテゴリ (例: "cleaning", "laundry", "cooking", "security", "other")
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// 1回あたりの所要時間 (分)
+	MinutesPerSession int32 `protobuf:"varint,2,opt,name=minutes_per_session,json=minutesPerSession,proto3" json:"minutes_per_session,omitempty"`
+	// 1週間あたりの実施回数 (隔週なら0.5)
+	FrequencyPerWeek float64 `protobuf:"fixed64,3,opt,name=frequency_per_week,json=frequencyPerWeek,proto3" json:"frequency_per_week,omitempty"`
+	// 苦痛度 (1〜5)
+	PainLevel int32 `protobuf:"varint,4,opt,name=pain_level,json=painLevel,proto3" json:"pain_level,omitempty"`
+	// なぜ嫌いか (腰が痛い、判断が面倒など)
+	PainReason    string `protobuf:"bytes,5,opt,name=pain_reason,json=painReason,proto3" json:"pain_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoreInput) Reset() {
+	*x = ChoreInput{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoreInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoreInput) ProtoMessage() {}
+
+func (x *ChoreInput) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoreInput.ProtoReflect.Descriptor instead.
+func (*ChoreInput) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChoreInput) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ChoreInput) GetMinutesPerSession() int32 {
+	if x != nil {
+		return x.MinutesPerSession
+	}
+	return 0
+}
+
+func (x *ChoreInput) GetFrequencyPerWeek() float64 {
+	if x != nil {
+		return x.FrequencyPerWeek
+	}
+	return 0
+}
+
+func (x *ChoreInput) GetPainLevel() int32 {
+	if x != nil {
+		return x.PainLevel
+	}
+	return 0
+}
+
+func (x *ChoreInput) GetPainReason() string {
+	if x != nil {
+		return x.PainReason
+	}
+	return ""
+}
+
+// TimeReduction: 製品がある家事カテゴリの時間をどれだけ削減するか
+type TimeReduction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // 家事カテゴリ
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`       // 削減率 (0.0〜1.0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeReduction) Reset() {
+	*x = TimeReduction{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeReduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeReduction) ProtoMessage() {}
+
+func (x *TimeReduction) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeReduction.ProtoReflect.Descriptor instead.
+func (*TimeReduction) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeReduction) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TimeReduction) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// RoiProduct: ROI計算の対象となる導入製品
+type RoiProduct struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	ProductId                  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price                      int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`       // 単価 (日本円)
+	Quantity                   int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // 個数 (0の場合は1として扱います)
+	TimeReductions             []*TimeReduction       `protobuf:"bytes,4,rep,name=time_reductions,json=timeReductions,proto3" json:"time_reductions,omitempty"`
+	MaintenanceMinutesPerMonth int32                  `protobuf:"varint,5,opt,name=maintenance_minutes_per_month,json=maintenanceMinutesPerMonth,proto3" json:"maintenance_minutes_per_month,omitempty"` // 月あたりのメンテナンス時間 (分)
+	PowerWatts                 float64                `protobuf:"fixed64,6,opt,name=power_watts,json=powerWatts,proto3" json:"power_watts,omitempty"`                                                    // 平均消費電力 (W)
+	ConsumableCostPerMonth     int32                  `protobuf:"varint,7,opt,name=consumable_cost_per_month,json=consumableCostPerMonth,proto3" json:"consumable_cost_per_month,omitempty"`             // 消耗品コスト (円/月)
+	Category                   string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`                                                                            // 製品カテゴリ。指定すると導入実績による較正係数を適用します
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *RoiProduct) Reset() {
+	*x = RoiProduct{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiProduct) ProtoMessage() {}
+
+func (x *RoiProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiProduct.ProtoReflect.Descriptor instead.
+func (*RoiProduct) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoiProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RoiProduct) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RoiProduct) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RoiProduct) GetTimeReductions() []*TimeReduction {
+	if x != nil {
+		return x.TimeReductions
+	}
+	return nil
+}
+
+func (x *RoiProduct) GetMaintenanceMinutesPerMonth() int32 {
+	if x != nil {
+		return x.MaintenanceMinutesPerMonth
+	}
+	return 0
+}
+
+func (x *RoiProduct) GetPowerWatts() float64 {
+	if x != nil {
+		return x.PowerWatts
+	}
+	return 0
+}
+
+func (x *RoiProduct) GetConsumableCostPerMonth() int32 {
+	if x != nil {
+		return x.ConsumableCostPerMonth
+	}
+	return 0
+}
+
+func (x *RoiProduct) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// RoiAssumptions: 計算の前提条件。0の項目はサーバー側のデフォルト値を使います。
+// discount_rate だけは0 (割引なし) も意味のある値なので、未指定の場合にデフォルト値を使います。
+type RoiAssumptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HourlyWage      int32                  `protobuf:"varint,1,opt,name=hourly_wage,json=hourlyWage,proto3" json:"hourly_wage,omitempty"`                 // ユーザーの時給 (円)
+	ElectricityRate float64                `protobuf:"fixed64,2,opt,name=electricity_rate,json=electricityRate,proto3" json:"electricity_rate,omitempty"` // 電気料金単価 (円/kWh)
+	DiscountRate    *float64               `protobuf:"fixed64,3,opt,name=discount_rate,json=discountRate,proto3,oneof" json:"discount_rate,omitempty"`    // NPV計算用の年間割引率 (例: 0.03)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoiAssumptions) Reset() {
+	*x = RoiAssumptions{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiAssumptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiAssumptions) ProtoMessage() {}
+
+func (x *RoiAssumptions) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiAssumptions.ProtoReflect.Descriptor instead.
+func (*RoiAssumptions) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RoiAssumptions) GetHourlyWage() int32 {
+	if x != nil {
+		return x.HourlyWage
+	}
+	return 0
+}
+
+func (x *RoiAssumptions) GetElectricityRate() float64 {
+	if x != nil {
+		return x.ElectricityRate
+	}
+	return 0
+}
+
+func (x *RoiAssumptions) GetDiscountRate() float64 {
+	if x != nil && x.DiscountRate != nil {
+		return *x.DiscountRate
+	}
+	return 0
+}
+
+type CalculateRoiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chores        []*ChoreInput          `protobuf:"bytes,1,rep,name=chores,proto3" json:"chores,omitempty"`
+	Products      []*RoiProduct          `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Assumptions   *RoiAssumptions        `protobuf:"bytes,3,opt,name=assumptions,proto3" json:"assumptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateRoiRequest) Reset() {
+	*x = CalculateRoiRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateRoiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRoiRequest) ProtoMessage() {}
+
+func (x *CalculateRoiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRoiRequest.ProtoReflect.Descriptor instead.
+func (*CalculateRoiRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CalculateRoiRequest) GetChores() []*ChoreInput {
+	if x != nil {
+		return x.Chores
+	}
+	return nil
+}
+
+func (x *CalculateRoiRequest) GetProducts() []*RoiProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *CalculateRoiRequest) GetAssumptions() *RoiAssumptions {
+	if x != nil {
+		return x.Assumptions
+	}
+	return nil
+}
+
+// ChoreSaving: 家事カテゴリごとの削減効果
+type ChoreSaving struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Category             string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	MinutesPerWeekBefore int32                  `protobuf:"varint,2,opt,name=minutes_per_week_before,json=minutesPerWeekBefore,proto3" json:"minutes_per_week_before,omitempty"` // 導入前の週あたり時間 (分)
+	ReductionRate        float64                `protobuf:"fixed64,3,opt,name=reduction_rate,json=reductionRate,proto3" json:"reduction_rate,omitempty"`                         // 削減率 (0.0〜1.0)
+	HoursSavedYearly     float64                `protobuf:"fixed64,4,opt,name=hours_saved_yearly,json=hoursSavedYearly,proto3" json:"hours_saved_yearly,omitempty"`              // 年間削減時間 (時間)
+	TimeValueYearly      int32                  `protobuf:"varint,5,opt,name=time_value_yearly,json=timeValueYearly,proto3" json:"time_value_yearly,omitempty"`                  // 時給換算した年間価値 (円)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChoreSaving) Reset() {
+	*x = ChoreSaving{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoreSaving) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoreSaving) ProtoMessage() {}
+
+func (x *ChoreSaving) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoreSaving.ProtoReflect.Descriptor instead.
+func (*ChoreSaving) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChoreSaving) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ChoreSaving) GetMinutesPerWeekBefore() int32 {
+	if x != nil {
+		return x.MinutesPerWeekBefore
+	}
+	return 0
+}
+
+func (x *ChoreSaving) GetReductionRate() float64 {
+	if x != nil {
+		return x.ReductionRate
+	}
+	return 0
+}
+
+func (x *ChoreSaving) GetHoursSavedYearly() float64 {
+	if x != nil {
+		return x.HoursSavedYearly
+	}
+	return 0
+}
+
+func (x *ChoreSaving) GetTimeValueYearly() int32 {
+	if x != nil {
+		return x.TimeValueYearly
+	}
+	return 0
+}
+
+// ScoreComponent: ROIスコアの内訳。各項目の加点理由をユーザーに説明するために使います。
+type ScoreComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                // 項目キー (例: "payback", "npv", "mental")
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                            // 表示名
+	Points        float64                `protobuf:"fixed64,3,opt,name=points,proto3" json:"points,omitempty"`                        // 獲得点
+	MaxPoints     float64                `protobuf:"fixed64,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"` // 満点
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`                          // 加点理由の説明文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreComponent) Reset() {
+	*x = ScoreComponent{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreComponent) ProtoMessage() {}
+
+func (x *ScoreComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreComponent.ProtoReflect.Descriptor instead.
+func (*ScoreComponent) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScoreComponent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ScoreComponent) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ScoreComponent) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *ScoreComponent) GetMaxPoints() float64 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *ScoreComponent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// RoiProjection: ROI計算結果
+type RoiProjection struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	TotalInitialCost         int32                  `protobuf:"varint,1,opt,name=total_initial_cost,json=totalInitialCost,proto3" json:"total_initial_cost,omitempty"`                            // 初期費用の合計 (円)
+	EstimatedTimeSavedYearly float64                `protobuf:"fixed64,2,opt,name=estimated_time_saved_yearly,json=estimatedTimeSavedYearly,proto3" json:"estimated_time_saved_yearly,omitempty"` // 年間削減時間 (時間/年、メンテナンス時間控除後)
+	RoiScore                 int32                  `protobuf:"varint,3,opt,name=roi_score,json=roiScore,proto3" json:"roi_score,omitempty"`                                                      // 総合ROIスコア (0〜100)
+	MentalImpact             string                 `protobuf:"bytes,4,opt,name=mental_impact,json=mentalImpact,proto3" json:"mental_impact,omitempty"`                                           // 精神的ROIの説明文
+	MentalImpactScore        int32                  `protobuf:"varint,5,opt,name=mental_impact_score,json=mentalImpactScore,proto3" json:"mental_impact_score,omitempty"`                         // 精神的ROIの指標 (0〜100)
+	TimeValueYearly          int32                  `protobuf:"varint,6,opt,name=time_value_yearly,json=timeValueYearly,proto3" json:"time_value_yearly,omitempty"`                               // 削減時間の時給換算 (円/年)
+	RunningCostYearly        int32                  `protobuf:"varint,7,opt,name=running_cost_yearly,json=runningCostYearly,proto3" json:"running_cost_yearly,omitempty"`                         // 電気代と消耗品の合計 (円/年)
+	NetBenefitYearly         int32                  `protobuf:"varint,8,opt,name=net_benefit_yearly,json=netBenefitYearly,proto3" json:"net_benefit_yearly,omitempty"`                            // 年間の純便益 (円/年)
+	PaybackReachable         bool                   `protobuf:"varint,9,opt,name=payback_reachable,json=paybackReachable,proto3" json:"payback_reachable,omitempty"`                              // 投資回収が可能かどうか
+	PaybackMonths            float64                `protobuf:"fixed64,10,opt,name=payback_months,json=paybackMonths,proto3" json:"payback_months,omitempty"`                                     // 投資回収期間 (月)。回収不可の場合は0
+	NpvFiveYears             int32                  `protobuf:"varint,11,opt,name=npv_five_years,json=npvFiveYears,proto3" json:"npv_five_years,omitempty"`                                       // 5年間の正味現在価値 (円)
+	ChoreSavings             []*ChoreSaving         `protobuf:"bytes,12,rep,name=chore_savings,json=choreSavings,proto3" json:"chore_savings,omitempty"`                                          // 家事カテゴリ別の内訳
+	ScoreBreakdown           []*ScoreComponent      `protobuf:"bytes,13,rep,name=score_breakdown,json=scoreBreakdown,proto3" json:"score_breakdown,omitempty"`                                    // スコアの内訳
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RoiProjection) Reset() {
+	*x = RoiProjection{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiProjection) ProtoMessage() {}
+
+func (x *RoiProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiProjection.ProtoReflect.Descriptor instead.
+func (*RoiProjection) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RoiProjection) GetTotalInitialCost() int32 {
+	if x != nil {
+		return x.TotalInitialCost
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetEstimatedTimeSavedYearly() float64 {
+	if x != nil {
+		return x.EstimatedTimeSavedYearly
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetRoiScore() int32 {
+	if x != nil {
+		return x.RoiScore
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetMentalImpact() string {
+	if x != nil {
+		return x.MentalImpact
+	}
+	return ""
+}
+
+func (x *RoiProjection) GetMentalImpactScore() int32 {
+	if x != nil {
+		return x.MentalImpactScore
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetTimeValueYearly() int32 {
+	if x != nil {
+		return x.TimeValueYearly
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetRunningCostYearly() int32 {
+	if x != nil {
+		return x.RunningCostYearly
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetNetBenefitYearly() int32 {
+	if x != nil {
+		return x.NetBenefitYearly
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetPaybackReachable() bool {
+	if x != nil {
+		return x.PaybackReachable
+	}
+	return false
+}
+
+func (x *RoiProjection) GetPaybackMonths() float64 {
+	if x != nil {
+		return x.PaybackMonths
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetNpvFiveYears() int32 {
+	if x != nil {
+		return x.NpvFiveYears
+	}
+	return 0
+}
+
+func (x *RoiProjection) GetChoreSavings() []*ChoreSaving {
+	if x != nil {
+		return x.ChoreSavings
+	}
+	return nil
+}
+
+func (x *RoiProjection) GetScoreBreakdown() []*ScoreComponent {
+	if x != nil {
+		return x.ScoreBreakdown
+	}
+	return nil
+}
+
+// BudgetConstraint: 予算設定
+type BudgetConstraint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int32                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"` // 金額 (日本円)
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`      // "total_initial" (総額) または "monthly_allowance" (月々)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetConstraint) Reset() {
+	*x = BudgetConstraint{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetConstraint) ProtoMessage() {}
+
+func (x *BudgetConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetConstraint.ProtoReflect.Descriptor instead.
+func (*BudgetConstraint) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BudgetConstraint) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BudgetConstraint) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// RoadmapItem: ロードマップに並べる製品
+type RoadmapItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *RoiProduct            `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	DependsOn     []string               `protobuf:"bytes,2,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"` // 先に導入が必要な製品のID (ハブなど)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoadmapItem) Reset() {
+	*x = RoadmapItem{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoadmapItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoadmapItem) ProtoMessage() {}
+
+func (x *RoadmapItem) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoadmapItem.ProtoReflect.Descriptor instead.
+func (*RoadmapItem) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RoadmapItem) GetProduct() *RoiProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *RoadmapItem) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+type PlanRoadmapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *BudgetConstraint      `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	Chores        []*ChoreInput          `protobuf:"bytes,2,rep,name=chores,proto3" json:"chores,omitempty"`
+	Items         []*RoadmapItem         `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Assumptions   *RoiAssumptions        `protobuf:"bytes,4,opt,name=assumptions,proto3" json:"assumptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanRoadmapRequest) Reset() {
+	*x = PlanRoadmapRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRoadmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRoadmapRequest) ProtoMessage() {}
+
+func (x *PlanRoadmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRoadmapRequest.ProtoReflect.Descriptor instead.
+func (*PlanRoadmapRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlanRoadmapRequest) GetBudget() *BudgetConstraint {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *PlanRoadmapRequest) GetChores() []*ChoreInput {
+	if x != nil {
+		return x.Chores
+	}
+	return nil
+}
+
+func (x *PlanRoadmapRequest) GetItems() []*RoadmapItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PlanRoadmapRequest) GetAssumptions() *RoiAssumptions {
+	if x != nil {
+		return x.Assumptions
+	}
+	return nil
+}
+
+// RoadmapMonth: ロードマップの1ヶ月分
+type RoadmapMonth struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Month           int32                  `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`                                            // 1始まりの月番号 (1 = 今月)
+	ProductIds      []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`                 // この月に購入する製品 (依存関係順)
+	Spend           int32                  `protobuf:"varint,3,opt,name=spend,proto3" json:"spend,omitempty"`                                            // この月の支出
+	CumulativeSpend int32                  `protobuf:"varint,4,opt,name=cumulative_spend,json=cumulativeSpend,proto3" json:"cumulative_spend,omitempty"` // ここまでの累計支出
+	CarryOver       int32                  `protobuf:"varint,5,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`                   // 翌月に繰り越す予算
+	Roi             *RoiProjection         `protobuf:"bytes,6,opt,name=roi,proto3" json:"roi,omitempty"`                                                 // この月までに導入した製品でのROI
+	CumulativeValue int32                  `protobuf:"varint,7,opt,name=cumulative_value,json=cumulativeValue,proto3" json:"cumulative_value,omitempty"` // ここまでの便益から累計支出を引いた額
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoadmapMonth) Reset() {
+	*x = RoadmapMonth{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoadmapMonth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoadmapMonth) ProtoMessage() {}
+
+func (x *RoadmapMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoadmapMonth.ProtoReflect.Descriptor instead.
+func (*RoadmapMonth) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RoadmapMonth) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *RoadmapMonth) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *RoadmapMonth) GetSpend() int32 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *RoadmapMonth) GetCumulativeSpend() int32 {
+	if x != nil {
+		return x.CumulativeSpend
+	}
+	return 0
+}
+
+func (x *RoadmapMonth) GetCarryOver() int32 {
+	if x != nil {
+		return x.CarryOver
+	}
+	return 0
+}
+
+func (x *RoadmapMonth) GetRoi() *RoiProjection {
+	if x != nil {
+		return x.Roi
+	}
+	return nil
+}
+
+func (x *RoadmapMonth) GetCumulativeValue() int32 {
+	if x != nil {
+		return x.CumulativeValue
+	}
+	return 0
+}
+
+// UnscheduledItem: ロードマップに入れられなかった製品
+type UnscheduledItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnscheduledItem) Reset() {
+	*x = UnscheduledItem{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnscheduledItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnscheduledItem) ProtoMessage() {}
+
+func (x *UnscheduledItem) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnscheduledItem.ProtoReflect.Descriptor instead.
+func (*UnscheduledItem) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnscheduledItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UnscheduledItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// AdoptionRoadmap: 段階的導入ロードマップ
+type AdoptionRoadmap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *BudgetConstraint      `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	Months        []*RoadmapMonth        `protobuf:"bytes,2,rep,name=months,proto3" json:"months,omitempty"`
+	Unscheduled   []*UnscheduledItem     `protobuf:"bytes,3,rep,name=unscheduled,proto3" json:"unscheduled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdoptionRoadmap) Reset() {
+	*x = AdoptionRoadmap{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptionRoadmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptionRoadmap) ProtoMessage() {}
+
+func (x *AdoptionRoadmap) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptionRoadmap.ProtoReflect.Descriptor instead.
+func (*AdoptionRoadmap) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AdoptionRoadmap) GetBudget() *BudgetConstraint {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *AdoptionRoadmap) GetMonths() []*RoadmapMonth {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *AdoptionRoadmap) GetUnscheduled() []*UnscheduledItem {
+	if x != nil {
+		return x.Unscheduled
+	}
+	return nil
+}
+
+// WifiSituation: 住居のWi-Fi環境
+type WifiSituation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Bands         []string               `protobuf:"bytes,2,rep,name=bands,proto3" json:"bands,omitempty"` // "wifi_2_4ghz", "wifi_5ghz"。空なら両方
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WifiSituation) Reset() {
+	*x = WifiSituation{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WifiSituation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WifiSituation) ProtoMessage() {}
+
+func (x *WifiSituation) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WifiSituation.ProtoReflect.Descriptor instead.
+func (*WifiSituation) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WifiSituation) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *WifiSituation) GetBands() []string {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+// ResidenceSnapshot: 診断実行時点の住環境 (Catalog Service の Residence と同じ項目)
+type ResidenceSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`           // "apartment", "house", ...
+	Ownership     string                 `protobuf:"bytes,2,opt,name=ownership,proto3" json:"ownership,omitempty"` // "owned", "rented", ...
+	HasSteps      bool                   `protobuf:"varint,3,opt,name=has_steps,json=hasSteps,proto3" json:"has_steps,omitempty"`
+	StepHeightMm  int32                  `protobuf:"varint,4,opt,name=step_height_mm,json=stepHeightMm,proto3" json:"step_height_mm,omitempty"`
+	FloorTypes    []string               `protobuf:"bytes,5,rep,name=floor_types,json=floorTypes,proto3" json:"floor_types,omitempty"`
+	Wifi          *WifiSituation         `protobuf:"bytes,6,opt,name=wifi,proto3" json:"wifi,omitempty"` // 未指定なら判定しません
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResidenceSnapshot) Reset() {
+	*x = ResidenceSnapshot{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResidenceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResidenceSnapshot) ProtoMessage() {}
+
+func (x *ResidenceSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResidenceSnapshot.ProtoReflect.Descriptor instead.
+func (*ResidenceSnapshot) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResidenceSnapshot) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResidenceSnapshot) GetOwnership() string {
+	if x != nil {
+		return x.Ownership
+	}
+	return ""
+}
+
+func (x *ResidenceSnapshot) GetHasSteps() bool {
+	if x != nil {
+		return x.HasSteps
+	}
+	return false
+}
+
+func (x *ResidenceSnapshot) GetStepHeightMm() int32 {
+	if x != nil {
+		return x.StepHeightMm
+	}
+	return 0
+}
+
+func (x *ResidenceSnapshot) GetFloorTypes() []string {
+	if x != nil {
+		return x.FloorTypes
+	}
+	return nil
+}
+
+func (x *ResidenceSnapshot) GetWifi() *WifiSituation {
+	if x != nil {
+		return x.Wifi
+	}
+	return nil
+}
+
+type RunSimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	Budget        *BudgetConstraint      `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	Chores        []*ChoreInput          `protobuf:"bytes,3,rep,name=chores,proto3" json:"chores,omitempty"`
+	Residence     *ResidenceSnapshot     `protobuf:"bytes,4,opt,name=residence,proto3" json:"residence,omitempty"`
+	Assumptions   *RoiAssumptions        `protobuf:"bytes,5,opt,name=assumptions,proto3" json:"assumptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSimulationRequest) Reset() {
+	*x = RunSimulationRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSimulationRequest) ProtoMessage() {}
+
+func (x *RunSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSimulationRequest.ProtoReflect.Descriptor instead.
+func (*RunSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RunSimulationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RunSimulationRequest) GetBudget() *BudgetConstraint {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *RunSimulationRequest) GetChores() []*ChoreInput {
+	if x != nil {
+		return x.Chores
+	}
+	return nil
+}
+
+func (x *RunSimulationRequest) GetResidence() *ResidenceSnapshot {
+	if x != nil {
+		return x.Residence
+	}
+	return nil
+}
+
+func (x *RunSimulationRequest) GetAssumptions() *RoiAssumptions {
+	if x != nil {
+		return x.Assumptions
+	}
+	return nil
+}
+
+type RunSimulationStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*RunSimulationStreamRequest_NewScenario
+	//	*RunSimulationStreamRequest_ResumeScenarioId
+	Target        isRunSimulationStreamRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSimulationStreamRequest) Reset() {
+	*x = RunSimulationStreamRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSimulationStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSimulationStreamRequest) ProtoMessage() {}
+
+func (x *RunSimulationStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSimulationStreamRequest.ProtoReflect.Descriptor instead.
+func (*RunSimulationStreamRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RunSimulationStreamRequest) GetTarget() isRunSimulationStreamRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RunSimulationStreamRequest) GetNewScenario() *RunSimulationRequest {
+	if x != nil {
+		if x, ok := x.Target.(*RunSimulationStreamRequest_NewScenario); ok {
+			return x.NewScenario
+		}
+	}
+	return nil
+}
+
+func (x *RunSimulationStreamRequest) GetResumeScenarioId() string {
+	if x != nil {
+		if x, ok := x.Target.(*RunSimulationStreamRequest_ResumeScenarioId); ok {
+			return x.ResumeScenarioId
+		}
+	}
+	return ""
+}
+
+type isRunSimulationStreamRequest_Target interface {
+	isRunSimulationStreamRequest_Target()
+}
+
+type RunSimulationStreamRequest_NewScenario struct {
+	NewScenario *RunSimulationRequest `protobuf:"bytes,1,opt,name=new_scenario,json=newScenario,proto3,oneof"` // 新しいシナリオを開始する
+}
+
+type RunSimulationStreamRequest_ResumeScenarioId struct {
+	ResumeScenarioId string `protobuf:"bytes,2,opt,name=resume_scenario_id,json=resumeScenarioId,proto3,oneof"` // 中断したシナリオを再開する
+}
+
+func (*RunSimulationStreamRequest_NewScenario) isRunSimulationStreamRequest_Target() {}
+
+func (*RunSimulationStreamRequest_ResumeScenarioId) isRunSimulationStreamRequest_Target() {}
+
+type GetOptimizationPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptimizationPlanRequest) Reset() {
+	*x = GetOptimizationPlanRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptimizationPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptimizationPlanRequest) ProtoMessage() {}
+
+func (x *GetOptimizationPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptimizationPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetOptimizationPlanRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOptimizationPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ProposedItem: 提案する製品への参照
+type ProposedItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity          int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price             int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                                                   // 提案時点の単価
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                                  // なぜこの製品か
+	DependsOn         []string               `protobuf:"bytes,5,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`                           // 先に導入が必要な製品のID
+	ProductCategory   string                 `protobuf:"bytes,6,opt,name=product_category,json=productCategory,proto3" json:"product_category,omitempty"`         // 製品カテゴリ (例: "robot_vacuum")
+	ProductName       string                 `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`                     // 提案時点の製品名 (表示用)
+	CalibrationFactor float64                `protobuf:"fixed64,8,opt,name=calibration_factor,json=calibrationFactor,proto3" json:"calibration_factor,omitempty"` // 提案時のROI計算で削減率に掛けた較正係数 (較正なしなら1)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProposedItem) Reset() {
+	*x = ProposedItem{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposedItem) ProtoMessage() {}
+
+func (x *ProposedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposedItem.ProtoReflect.Descriptor instead.
+func (*ProposedItem) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProposedItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProposedItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProposedItem) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProposedItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProposedItem) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *ProposedItem) GetProductCategory() string {
+	if x != nil {
+		return x.ProductCategory
+	}
+	return ""
+}
+
+func (x *ProposedItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProposedItem) GetCalibrationFactor() float64 {
+	if x != nil {
+		return x.CalibrationFactor
+	}
+	return 0
+}
+
+// ProposalGroup: 課題カテゴリごとの提案グループ
+type ProposalGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`       // "cleaning", "laundry", "cooking", "security", "management", "other"
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`      // 1が最優先
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 解決方針の説明文
+	Items         []*ProposedItem        `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalGroup) Reset() {
+	*x = ProposalGroup{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalGroup) ProtoMessage() {}
+
+func (x *ProposalGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalGroup.ProtoReflect.Descriptor instead.
+func (*ProposalGroup) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProposalGroup) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProposalGroup) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ProposalGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProposalGroup) GetItems() []*ProposedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// OptimizationPlan: 提案プラン
+type OptimizationPlan struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SimulationScenarioId string                 `protobuf:"bytes,3,opt,name=simulation_scenario_id,json=simulationScenarioId,proto3" json:"simulation_scenario_id,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	Concept              string                 `protobuf:"bytes,5,opt,name=concept,proto3" json:"concept,omitempty"`
+	ProposalGroups       []*ProposalGroup       `protobuf:"bytes,6,rep,name=proposal_groups,json=proposalGroups,proto3" json:"proposal_groups,omitempty"`
+	RoiProjection        *RoiProjection         `protobuf:"bytes,7,opt,name=roi_projection,json=roiProjection,proto3" json:"roi_projection,omitempty"`
+	Roadmap              *AdoptionRoadmap       `protobuf:"bytes,8,opt,name=roadmap,proto3" json:"roadmap,omitempty"` // 予算が monthly_allowance の場合のみ
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OptimizationPlan) Reset() {
+	*x = OptimizationPlan{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimizationPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationPlan) ProtoMessage() {}
+
+func (x *OptimizationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationPlan.ProtoReflect.Descriptor instead.
+func (*OptimizationPlan) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OptimizationPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OptimizationPlan) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OptimizationPlan) GetSimulationScenarioId() string {
+	if x != nil {
+		return x.SimulationScenarioId
+	}
+	return ""
+}
+
+func (x *OptimizationPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OptimizationPlan) GetConcept() string {
+	if x != nil {
+		return x.Concept
+	}
+	return ""
+}
+
+func (x *OptimizationPlan) GetProposalGroups() []*ProposalGroup {
+	if x != nil {
+		return x.ProposalGroups
+	}
+	return nil
+}
+
+func (x *OptimizationPlan) GetRoiProjection() *RoiProjection {
+	if x != nil {
+		return x.RoiProjection
+	}
+	return nil
+}
+
+func (x *OptimizationPlan) GetRoadmap() *AdoptionRoadmap {
+	if x != nil {
+		return x.Roadmap
+	}
+	return nil
+}
+
+// RejectedCandidate: 住環境に合わず除外された製品
+type RejectedCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reasons       []string               `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedCandidate) Reset() {
+	*x = RejectedCandidate{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedCandidate) ProtoMessage() {}
+
+func (x *RejectedCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedCandidate.ProtoReflect.Descriptor instead.
+func (*RejectedCandidate) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RejectedCandidate) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RejectedCandidate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RejectedCandidate) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type ScenarioAccepted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioAccepted) Reset() {
+	*x = ScenarioAccepted{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioAccepted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioAccepted) ProtoMessage() {}
+
+func (x *ScenarioAccepted) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioAccepted.ProtoReflect.Descriptor instead.
+func (*ScenarioAccepted) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{23}
+}
+
+type CandidatesFiltered struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EligibleCount int32                  `protobuf:"varint,1,opt,name=eligible_count,json=eligibleCount,proto3" json:"eligible_count,omitempty"`
+	Rejected      []*RejectedCandidate   `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandidatesFiltered) Reset() {
+	*x = CandidatesFiltered{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandidatesFiltered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidatesFiltered) ProtoMessage() {}
+
+func (x *CandidatesFiltered) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidatesFiltered.ProtoReflect.Descriptor instead.
+func (*CandidatesFiltered) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CandidatesFiltered) GetEligibleCount() int32 {
+	if x != nil {
+		return x.EligibleCount
+	}
+	return 0
+}
+
+func (x *CandidatesFiltered) GetRejected() []*RejectedCandidate {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+type OptimizerDone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *OptimizationPlan      `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"` // 説明文とROIが未設定の下書き
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptimizerDone) Reset() {
+	*x = OptimizerDone{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimizerDone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizerDone) ProtoMessage() {}
+
+func (x *OptimizerDone) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizerDone.ProtoReflect.Descriptor instead.
+func (*OptimizerDone) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OptimizerDone) GetDraft() *OptimizationPlan {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type NarrativeToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // 提案グループのカテゴリ
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`         // 説明文の断片 (前の断片に連結して表示します)
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`        // このグループの説明文が最後まで届いたら true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NarrativeToken) Reset() {
+	*x = NarrativeToken{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NarrativeToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NarrativeToken) ProtoMessage() {}
+
+func (x *NarrativeToken) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NarrativeToken.ProtoReflect.Descriptor instead.
+func (*NarrativeToken) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NarrativeToken) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NarrativeToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NarrativeToken) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type RoiComputed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roi           *RoiProjection         `protobuf:"bytes,1,opt,name=roi,proto3" json:"roi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoiComputed) Reset() {
+	*x = RoiComputed{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiComputed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiComputed) ProtoMessage() {}
+
+func (x *RoiComputed) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiComputed.ProtoReflect.Descriptor instead.
+func (*RoiComputed) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RoiComputed) GetRoi() *RoiProjection {
+	if x != nil {
+		return x.Roi
+	}
+	return nil
+}
+
+type PlanPersisted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *OptimizationPlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPersisted) Reset() {
+	*x = PlanPersisted{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPersisted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPersisted) ProtoMessage() {}
+
+func (x *PlanPersisted) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPersisted.ProtoReflect.Descriptor instead.
+func (*PlanPersisted) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlanPersisted) GetPlan() *OptimizationPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// SimulationEvent: RunSimulationStream の進捗イベント
+type SimulationEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScenarioId string                 `protobuf:"bytes,1,opt,name=scenario_id,json=scenarioId,proto3" json:"scenario_id,omitempty"`
+	Sequence   int32                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"` // シナリオ内の通し番号 (1始まり)
+	Replayed   bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"` // 再開時に、保存済みの結果から再送したイベントなら true
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SimulationEvent_ScenarioAccepted
+	//	*SimulationEvent_CandidatesFiltered
+	//	*SimulationEvent_OptimizerDone
+	//	*SimulationEvent_NarrativeToken
+	//	*SimulationEvent_RoiComputed
+	//	*SimulationEvent_PlanPersisted
+	Event         isSimulationEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent) Reset() {
+	*x = SimulationEvent{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent) ProtoMessage() {}
+
+func (x *SimulationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent.ProtoReflect.Descriptor instead.
+func (*SimulationEvent) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SimulationEvent) GetScenarioId() string {
+	if x != nil {
+		return x.ScenarioId
+	}
+	return ""
+}
+
+func (x *SimulationEvent) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SimulationEvent) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *SimulationEvent) GetEvent() isSimulationEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetScenarioAccepted() *ScenarioAccepted {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_ScenarioAccepted); ok {
+			return x.ScenarioAccepted
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetCandidatesFiltered() *CandidatesFiltered {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_CandidatesFiltered); ok {
+			return x.CandidatesFiltered
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetOptimizerDone() *OptimizerDone {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_OptimizerDone); ok {
+			return x.OptimizerDone
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetNarrativeToken() *NarrativeToken {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_NarrativeToken); ok {
+			return x.NarrativeToken
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetRoiComputed() *RoiComputed {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_RoiComputed); ok {
+			return x.RoiComputed
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetPlanPersisted() *PlanPersisted {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_PlanPersisted); ok {
+			return x.PlanPersisted
+		}
+	}
+	return nil
+}
+
+type isSimulationEvent_Event interface {
+	isSimulationEvent_Event()
+}
+
+type SimulationEvent_ScenarioAccepted struct {
+	ScenarioAccepted *ScenarioAccepted `protobuf:"bytes,10,opt,name=scenario_accepted,json=scenarioAccepted,proto3,oneof"`
+}
+
+type SimulationEvent_CandidatesFiltered struct {
+	CandidatesFiltered *CandidatesFiltered `protobuf:"bytes,11,opt,name=candidates_filtered,json=candidatesFiltered,proto3,oneof"`
+}
+
+type SimulationEvent_OptimizerDone struct {
+	OptimizerDone *OptimizerDone `protobuf:"bytes,12,opt,name=optimizer_done,json=optimizerDone,proto3,oneof"`
+}
+
+type SimulationEvent_NarrativeToken struct {
+	NarrativeToken *NarrativeToken `protobuf:"bytes,13,opt,name=narrative_token,json=narrativeToken,proto3,oneof"`
+}
+
+type SimulationEvent_RoiComputed struct {
+	RoiComputed *RoiComputed `protobuf:"bytes,14,opt,name=roi_computed,json=roiComputed,proto3,oneof"`
+}
+
+type SimulationEvent_PlanPersisted struct {
+	PlanPersisted *PlanPersisted `protobuf:"bytes,15,opt,name=plan_persisted,json=planPersisted,proto3,oneof"`
+}
+
+func (*SimulationEvent_ScenarioAccepted) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_CandidatesFiltered) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_OptimizerDone) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_NarrativeToken) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_RoiComputed) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_PlanPersisted) isSimulationEvent_Event() {}
+
+type CompareScenariosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanIds       []string               `protobuf:"bytes,1,rep,name=plan_ids,json=planIds,proto3" json:"plan_ids,omitempty"` // 2つ以上。先頭が比較の基準
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareScenariosRequest) Reset() {
+	*x = CompareScenariosRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareScenariosRequest) ProtoMessage() {}
+
+func (x *CompareScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareScenariosRequest.ProtoReflect.Descriptor instead.
+func (*CompareScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompareScenariosRequest) GetPlanIds() []string {
+	if x != nil {
+		return x.PlanIds
+	}
+	return nil
+}
+
+// InputChange: 変わった入力条件1項目分
+type InputChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // "budget.amount", "chores.cleaning", "residence.ownership" など
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputChange) Reset() {
+	*x = InputChange{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputChange) ProtoMessage() {}
+
+func (x *InputChange) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputChange.ProtoReflect.Descriptor instead.
+func (*InputChange) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InputChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *InputChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *InputChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// ItemChange: 提案製品1つ分の変化
+type ItemChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                                        // "added", "removed", "changed"
+	Before        *ProposedItem          `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`                                    // added の場合は未設定
+	After         *ProposedItem          `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`                                      // removed の場合は未設定
+	ChangedFields []string               `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"` // changed の場合: "quantity", "price", "depends_on"
+	CausedBy      []string               `protobuf:"bytes,6,rep,name=caused_by,json=causedBy,proto3" json:"caused_by,omitempty"`                // 原因と考えられる InputChange.field、または "catalog"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemChange) Reset() {
+	*x = ItemChange{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemChange) ProtoMessage() {}
+
+func (x *ItemChange) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemChange.ProtoReflect.Descriptor instead.
+func (*ItemChange) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ItemChange) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ItemChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ItemChange) GetBefore() *ProposedItem {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ItemChange) GetAfter() *ProposedItem {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ItemChange) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *ItemChange) GetCausedBy() []string {
+	if x != nil {
+		return x.CausedBy
+	}
+	return nil
+}
+
+// GroupDiff: 提案グループごとの差分
+type GroupDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Added         []*ItemChange          `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []*ItemChange          `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	Changed       []*ItemChange          `protobuf:"bytes,4,rep,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupDiff) Reset() {
+	*x = GroupDiff{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupDiff) ProtoMessage() {}
+
+func (x *GroupDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupDiff.ProtoReflect.Descriptor instead.
+func (*GroupDiff) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GroupDiff) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GroupDiff) GetAdded() []*ItemChange {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *GroupDiff) GetRemoved() []*ItemChange {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *GroupDiff) GetChanged() []*ItemChange {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+// RoiDelta: ROI指標の差 (比較先 - 基準)
+type RoiDelta struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	RoiScore                 int32                  `protobuf:"varint,1,opt,name=roi_score,json=roiScore,proto3" json:"roi_score,omitempty"`
+	EstimatedTimeSavedYearly float64                `protobuf:"fixed64,2,opt,name=estimated_time_saved_yearly,json=estimatedTimeSavedYearly,proto3" json:"estimated_time_saved_yearly,omitempty"`
+	NetBenefitYearly         int32                  `protobuf:"varint,3,opt,name=net_benefit_yearly,json=netBenefitYearly,proto3" json:"net_benefit_yearly,omitempty"`
+	NpvFiveYears             int32                  `protobuf:"varint,4,opt,name=npv_five_years,json=npvFiveYears,proto3" json:"npv_five_years,omitempty"`
+	PaybackMonths            float64                `protobuf:"fixed64,5,opt,name=payback_months,json=paybackMonths,proto3" json:"payback_months,omitempty"` // どちらかが回収不能な場合は 0
+	PaybackReachableBefore   bool                   `protobuf:"varint,6,opt,name=payback_reachable_before,json=paybackReachableBefore,proto3" json:"payback_reachable_before,omitempty"`
+	PaybackReachableAfter    bool                   `protobuf:"varint,7,opt,name=payback_reachable_after,json=paybackReachableAfter,proto3" json:"payback_reachable_after,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RoiDelta) Reset() {
+	*x = RoiDelta{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoiDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoiDelta) ProtoMessage() {}
+
+func (x *RoiDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoiDelta.ProtoReflect.Descriptor instead.
+func (*RoiDelta) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RoiDelta) GetRoiScore() int32 {
+	if x != nil {
+		return x.RoiScore
+	}
+	return 0
+}
+
+func (x *RoiDelta) GetEstimatedTimeSavedYearly() float64 {
+	if x != nil {
+		return x.EstimatedTimeSavedYearly
+	}
+	return 0
+}
+
+func (x *RoiDelta) GetNetBenefitYearly() int32 {
+	if x != nil {
+		return x.NetBenefitYearly
+	}
+	return 0
+}
+
+func (x *RoiDelta) GetNpvFiveYears() int32 {
+	if x != nil {
+		return x.NpvFiveYears
+	}
+	return 0
+}
+
+func (x *RoiDelta) GetPaybackMonths() float64 {
+	if x != nil {
+		return x.PaybackMonths
+	}
+	return 0
+}
+
+func (x *RoiDelta) GetPaybackReachableBefore() bool {
+	if x != nil {
+		return x.PaybackReachableBefore
+	}
+	return false
+}
+
+func (x *RoiDelta) GetPaybackReachableAfter() bool {
+	if x != nil {
+		return x.PaybackReachableAfter
+	}
+	return false
+}
+
+// PlanDiff: 基準プランと比較先プランの差分
+type PlanDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasePlanId    string                 `protobuf:"bytes,1,opt,name=base_plan_id,json=basePlanId,proto3" json:"base_plan_id,omitempty"`
+	TargetPlanId  string                 `protobuf:"bytes,2,opt,name=target_plan_id,json=targetPlanId,proto3" json:"target_plan_id,omitempty"`
+	Groups        []*GroupDiff           `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	CostDelta     int64                  `protobuf:"varint,4,opt,name=cost_delta,json=costDelta,proto3" json:"cost_delta,omitempty"` // 合計金額の差 (比較先 - 基準)
+	RoiDelta      *RoiDelta              `protobuf:"bytes,5,opt,name=roi_delta,json=roiDelta,proto3" json:"roi_delta,omitempty"`
+	InputChanges  []*InputChange         `protobuf:"bytes,6,rep,name=input_changes,json=inputChanges,proto3" json:"input_changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanDiff) Reset() {
+	*x = PlanDiff{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanDiff) ProtoMessage() {}
+
+func (x *PlanDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanDiff.ProtoReflect.Descriptor instead.
+func (*PlanDiff) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PlanDiff) GetBasePlanId() string {
+	if x != nil {
+		return x.BasePlanId
+	}
+	return ""
+}
+
+func (x *PlanDiff) GetTargetPlanId() string {
+	if x != nil {
+		return x.TargetPlanId
+	}
+	return ""
+}
+
+func (x *PlanDiff) GetGroups() []*GroupDiff {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *PlanDiff) GetCostDelta() int64 {
+	if x != nil {
+		return x.CostDelta
+	}
+	return 0
+}
+
+func (x *PlanDiff) GetRoiDelta() *RoiDelta {
+	if x != nil {
+		return x.RoiDelta
+	}
+	return nil
+}
+
+func (x *PlanDiff) GetInputChanges() []*InputChange {
+	if x != nil {
+		return x.InputChanges
+	}
+	return nil
+}
+
+type CompareScenariosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diffs         []*PlanDiff            `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"` // plan_ids[1:] の順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareScenariosResponse) Reset() {
+	*x = CompareScenariosResponse{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareScenariosResponse) ProtoMessage() {}
+
+func (x *CompareScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareScenariosResponse.ProtoReflect.Descriptor instead.
+func (*CompareScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CompareScenariosResponse) GetDiffs() []*PlanDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type SweepBudgetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*SweepBudgetRequest_ScenarioId
+	//	*SweepBudgetRequest_Conditions
+	Source        isSweepBudgetRequest_Source `protobuf_oneof:"source"`
+	BudgetType    string                      `protobuf:"bytes,3,opt,name=budget_type,json=budgetType,proto3" json:"budget_type,omitempty"` // "total_initial" または "monthly_allowance"
+	MinAmount     int32                       `protobuf:"varint,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount     int32                       `protobuf:"varint,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	Step          int32                       `protobuf:"varint,6,opt,name=step,proto3" json:"step,omitempty"` // 評価する予算額の刻み (評価点は最大200)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SweepBudgetRequest) Reset() {
+	*x = SweepBudgetRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SweepBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepBudgetRequest) ProtoMessage() {}
+
+func (x *SweepBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SweepBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SweepBudgetRequest) GetSource() isSweepBudgetRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SweepBudgetRequest) GetScenarioId() string {
+	if x != nil {
+		if x, ok := x.Source.(*SweepBudgetRequest_ScenarioId); ok {
+			return x.ScenarioId
+		}
+	}
+	return ""
+}
+
+func (x *SweepBudgetRequest) GetConditions() *RunSimulationRequest {
+	if x != nil {
+		if x, ok := x.Source.(*SweepBudgetRequest_Conditions); ok {
+			return x.Conditions
+		}
+	}
+	return nil
+}
+
+func (x *SweepBudgetRequest) GetBudgetType() string {
+	if x != nil {
+		return x.BudgetType
+	}
+	return ""
+}
+
+func (x *SweepBudgetRequest) GetMinAmount() int32 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *SweepBudgetRequest) GetMaxAmount() int32 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *SweepBudgetRequest) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+type isSweepBudgetRequest_Source interface {
+	isSweepBudgetRequest_Source()
+}
+
+type SweepBudgetRequest_ScenarioId struct {
+	ScenarioId string `protobuf:"bytes,1,opt,name=scenario_id,json=scenarioId,proto3,oneof"` // 既存シナリオの家事・住環境・候補製品を使う (ログインした所有者のみ)
+}
+
+type SweepBudgetRequest_Conditions struct {
+	Conditions *RunSimulationRequest `protobuf:"bytes,2,opt,name=conditions,proto3,oneof"` // 条件を直接指定する (budget は無視します)
+}
+
+func (*SweepBudgetRequest_ScenarioId) isSweepBudgetRequest_Source() {}
+
+func (*SweepBudgetRequest_Conditions) isSweepBudgetRequest_Source() {}
+
+// BudgetBreakpoint: 効率的フロンティア上の1点
+type BudgetBreakpoint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MinBudget         int32                  `protobuf:"varint,1,opt,name=min_budget,json=minBudget,proto3" json:"min_budget,omitempty"` // この製品構成が選ばれる最小の予算
+	TotalCost         int64                  `protobuf:"varint,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	PainRelief        float64                `protobuf:"fixed64,3,opt,name=pain_relief,json=painRelief,proto3" json:"pain_relief,omitempty"`                        // 苦痛度 × 週あたり分 × 削減率 の合計
+	PainReliefPercent float64                `protobuf:"fixed64,4,opt,name=pain_relief_percent,json=painReliefPercent,proto3" json:"pain_relief_percent,omitempty"` // 全ての家事が100%削減された場合を100とした割合
+	ProductIds        []string               `protobuf:"bytes,5,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Added             []string               `protobuf:"bytes,6,rep,name=added,proto3" json:"added,omitempty"`     // 1つ前のブレークポイントから増えた製品
+	Removed           []string               `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"` // 1つ前のブレークポイントから外れた製品
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BudgetBreakpoint) Reset() {
+	*x = BudgetBreakpoint{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetBreakpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetBreakpoint) ProtoMessage() {}
+
+func (x *BudgetBreakpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetBreakpoint.ProtoReflect.Descriptor instead.
+func (*BudgetBreakpoint) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BudgetBreakpoint) GetMinBudget() int32 {
+	if x != nil {
+		return x.MinBudget
+	}
+	return 0
+}
+
+func (x *BudgetBreakpoint) GetTotalCost() int64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *BudgetBreakpoint) GetPainRelief() float64 {
+	if x != nil {
+		return x.PainRelief
+	}
+	return 0
+}
+
+func (x *BudgetBreakpoint) GetPainReliefPercent() float64 {
+	if x != nil {
+		return x.PainReliefPercent
+	}
+	return 0
+}
+
+func (x *BudgetBreakpoint) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *BudgetBreakpoint) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *BudgetBreakpoint) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type SweepBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BudgetType    string                 `protobuf:"bytes,1,opt,name=budget_type,json=budgetType,proto3" json:"budget_type,omitempty"`
+	Evaluated     int32                  `protobuf:"varint,2,opt,name=evaluated,proto3" json:"evaluated,omitempty"`    // 評価した予算額の数
+	Breakpoints   []*BudgetBreakpoint    `protobuf:"bytes,3,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"` // コストの小さい順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SweepBudgetResponse) Reset() {
+	*x = SweepBudgetResponse{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SweepBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepBudgetResponse) ProtoMessage() {}
+
+func (x *SweepBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SweepBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SweepBudgetResponse) GetBudgetType() string {
+	if x != nil {
+		return x.BudgetType
+	}
+	return ""
+}
+
+func (x *SweepBudgetResponse) GetEvaluated() int32 {
+	if x != nil {
+		return x.Evaluated
+	}
+	return 0
+}
+
+func (x *SweepBudgetResponse) GetBreakpoints() []*BudgetBreakpoint {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+type GetLlmUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 省略可。利用者はアクセストークンから決め、別の利用者を指定すると PermissionDenied
+	ScenarioId    string                 `protobuf:"bytes,2,opt,name=scenario_id,json=scenarioId,proto3" json:"scenario_id,omitempty"` // 任意
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLlmUsageRequest) Reset() {
+	*x = GetLlmUsageRequest{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLlmUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLlmUsageRequest) ProtoMessage() {}
+
+func (x *GetLlmUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLlmUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetLlmUsageRequest) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetLlmUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLlmUsageRequest) GetScenarioId() string {
+	if x != nil {
+		return x.ScenarioId
+	}
+	return ""
+}
+
+// LlmUsageRecord: LLM呼び出し1回分の記録
+type LlmUsageRecord struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Model            string                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	PromptVersion    string                 `protobuf:"bytes,2,opt,name=prompt_version,json=promptVersion,proto3" json:"prompt_version,omitempty"`
+	PromptTokens     int32                  `protobuf:"varint,3,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int32                  `protobuf:"varint,4,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	EstimatedCostYen float64                `protobuf:"fixed64,5,opt,name=estimated_cost_yen,json=estimatedCostYen,proto3" json:"estimated_cost_yen,omitempty"`
+	Cached           bool                   `protobuf:"varint,6,opt,name=cached,proto3" json:"cached,omitempty"`                       // キャッシュから返した場合は true (費用0)
+	CreatedAt        string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LlmUsageRecord) Reset() {
+	*x = LlmUsageRecord{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LlmUsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LlmUsageRecord) ProtoMessage() {}
+
+func (x *LlmUsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LlmUsageRecord.ProtoReflect.Descriptor instead.
+func (*LlmUsageRecord) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LlmUsageRecord) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *LlmUsageRecord) GetPromptVersion() string {
+	if x != nil {
+		return x.PromptVersion
+	}
+	return ""
+}
+
+func (x *LlmUsageRecord) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *LlmUsageRecord) GetCompletionTokens() int32 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *LlmUsageRecord) GetEstimatedCostYen() float64 {
+	if x != nil {
+		return x.EstimatedCostYen
+	}
+	return 0
+}
+
+func (x *LlmUsageRecord) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *LlmUsageRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetLlmUsageResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Day               string                 `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"` // 集計対象の日 (日本時間, YYYY-MM-DD)
+	Calls             int32                  `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	CachedResponses   int32                  `protobuf:"varint,4,opt,name=cached_responses,json=cachedResponses,proto3" json:"cached_responses,omitempty"`
+	PromptTokens      int32                  `protobuf:"varint,5,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens  int32                  `protobuf:"varint,6,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	EstimatedCostYen  float64                `protobuf:"fixed64,7,opt,name=estimated_cost_yen,json=estimatedCostYen,proto3" json:"estimated_cost_yen,omitempty"`
+	DailyTokenQuota   int32                  `protobuf:"varint,8,opt,name=daily_token_quota,json=dailyTokenQuota,proto3" json:"daily_token_quota,omitempty"`          // 0なら無制限
+	DailyCostQuotaYen float64                `protobuf:"fixed64,9,opt,name=daily_cost_quota_yen,json=dailyCostQuotaYen,proto3" json:"daily_cost_quota_yen,omitempty"` // 0なら無制限
+	ScenarioRecords   []*LlmUsageRecord      `protobuf:"bytes,10,rep,name=scenario_records,json=scenarioRecords,proto3" json:"scenario_records,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetLlmUsageResponse) Reset() {
+	*x = GetLlmUsageResponse{}
+	mi := &file_simulation_v1_simulation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLlmUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLlmUsageResponse) ProtoMessage() {}
+
+func (x *GetLlmUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_simulation_v1_simulation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLlmUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetLlmUsageResponse) Descriptor() ([]byte, []int) {
+	return file_simulation_v1_simulation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLlmUsageResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLlmUsageResponse) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *GetLlmUsageResponse) GetCalls() int32 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetCachedResponses() int32 {
+	if x != nil {
+		return x.CachedResponses
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetCompletionTokens() int32 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetEstimatedCostYen() float64 {
+	if x != nil {
+		return x.EstimatedCostYen
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetDailyTokenQuota() int32 {
+	if x != nil {
+		return x.DailyTokenQuota
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetDailyCostQuotaYen() float64 {
+	if x != nil {
+		return x.DailyCostQuotaYen
+	}
+	return 0
+}
+
+func (x *GetLlmUsageResponse) GetScenarioRecords() []*LlmUsageRecord {
+	if x != nil {
+		return x.ScenarioRecords
+	}
+	return nil
+}
+
+var File_simulation_v1_simulation_proto protoreflect.FileDescriptor
+
+const file_simulation_v1_simulation_proto_rawDesc = "" +
+	"\n" +
+	"\x1esimulation/v1/simulation.proto\x12\rsimulation.v1\"\xc6\x01\n" +
+	"\n" +
+	"ChoreInput\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12.\n" +
+	"\x13minutes_per_session\x18\x02 \x01(\x05R\x11minutesPerSession\x12,\n" +
+	"\x12frequency_per_week\x18\x03 \x01(\x01R\x10frequencyPerWeek\x12\x1d\n" +
+	"\n" +
+	"pain_level\x18\x04 \x01(\x05R\tpainLevel\x12\x1f\n" +
+	"\vpain_reason\x18\x05 \x01(\tR\n" +
+	"painReason\"?\n" +
+	"\rTimeReduction\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x01R\x04rate\"\xdf\x02\n" +
+	"\n" +
+	"RoiProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12E\n" +
+	"\x0ftime_reductions\x18\x04 \x03(\v2\x1c.simulation.v1.TimeReductionR\x0etimeReductions\x12A\n" +
+	"\x1dmaintenance_minutes_per_month\x18\x05 \x01(\x05R\x1amaintenanceMinutesPerMonth\x12\x1f\n" +
+	"\vpower_watts\x18\x06 \x01(\x01R\n" +
+	"powerWatts\x129\n" +
+	"\x19consumable_cost_per_month\x18\a \x01(\x05R\x16consumableCostPerMonth\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\"\x98\x01\n" +
+	"\x0eRoiAssumptions\x12\x1f\n" +
+	"\vhourly_wage\x18\x01 \x01(\x05R\n" +
+	"hourlyWage\x12)\n" +
+	"\x10electricity_rate\x18\x02 \x01(\x01R\x0felectricityRate\x12(\n" +
+	"\rdiscount_rate\x18\x03 \x01(\x01H\x00R\fdiscountRate\x88\x01\x01B\x10\n" +
+	"\x0e_discount_rate\"\xc0\x01\n" +
+	"\x13CalculateRoiRequest\x121\n" +
+	"\x06chores\x18\x01 \x03(\v2\x19.simulation.v1.ChoreInputR\x06chores\x125\n" +
+	"\bproducts\x18\x02 \x03(\v2\x19.simulation.v1.RoiProductR\bproducts\x12?\n" +
+	"\vassumptions\x18\x03 \x01(\v2\x1d.simulation.v1.RoiAssumptionsR\vassumptions\"\xe1\x01\n" +
+	"\vChoreSaving\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x125\n" +
+	"\x17minutes_per_week_before\x18\x02 \x01(\x05R\x14minutesPerWeekBefore\x12%\n" +
+	"\x0ereduction_rate\x18\x03 \x01(\x01R\rreductionRate\x12,\n" +
+	"\x12hours_saved_yearly\x18\x04 \x01(\x01R\x10hoursSavedYearly\x12*\n" +
+	"\x11time_value_yearly\x18\x05 \x01(\x05R\x0ftimeValueYearly\"\x87\x01\n" +
+	"\x0eScoreComponent\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x01R\x06points\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x04 \x01(\x01R\tmaxPoints\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"\xfb\x04\n" +
+	"\rRoiProjection\x12,\n" +
+	"\x12total_initial_cost\x18\x01 \x01(\x05R\x10totalInitialCost\x12=\n" +
+	"\x1bestimated_time_saved_yearly\x18\x02 \x01(\x01R\x18estimatedTimeSavedYearly\x12\x1b\n" +
+	"\troi_score\x18\x03 \x01(\x05R\broiScore\x12#\n" +
+	"\rmental_impact\x18\x04 \x01(\tR\fmentalImpact\x12.\n" +
+	"\x13mental_impact_score\x18\x05 \x01(\x05R\x11mentalImpactScore\x12*\n" +
+	"\x11time_value_yearly\x18\x06 \x01(\x05R\x0ftimeValueYearly\x12.\n" +
+	"\x13running_cost_yearly\x18\a \x01(\x05R\x11runningCostYearly\x12,\n" +
+	"\x12net_benefit_yearly\x18\b \x01(\x05R\x10netBenefitYearly\x12+\n" +
+	"\x11payback_reachable\x18\t \x01(\bR\x10paybackReachable\x12%\n" +
+	"\x0epayback_months\x18\n" +
+	" \x01(\x01R\rpaybackMonths\x12$\n" +
+	"\x0enpv_five_years\x18\v \x01(\x05R\fnpvFiveYears\x12?\n" +
+	"\rchore_savings\x18\f \x03(\v2\x1a.simulation.v1.ChoreSavingR\fchoreSavings\x12F\n" +
+	"\x0fscore_breakdown\x18\r \x03(\v2\x1d.simulation.v1.ScoreComponentR\x0escoreBreakdown\">\n" +
+	"\x10BudgetConstraint\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x05R\x06amount\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"a\n" +
+	"\vRoadmapItem\x123\n" +
+	"\aproduct\x18\x01 \x01(\v2\x19.simulation.v1.RoiProductR\aproduct\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x02 \x03(\tR\tdependsOn\"\xf3\x01\n" +
+	"\x12PlanRoadmapRequest\x127\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1f.simulation.v1.BudgetConstraintR\x06budget\x121\n" +
+	"\x06chores\x18\x02 \x03(\v2\x19.simulation.v1.ChoreInputR\x06chores\x120\n" +
+	"\x05items\x18\x03 \x03(\v2\x1a.simulation.v1.RoadmapItemR\x05items\x12?\n" +
+	"\vassumptions\x18\x04 \x01(\v2\x1d.simulation.v1.RoiAssumptionsR\vassumptions\"\x80\x02\n" +
+	"\fRoadmapMonth\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\tR\n" +
+	"productIds\x12\x14\n" +
+	"\x05spend\x18\x03 \x01(\x05R\x05spend\x12)\n" +
+	"\x10cumulative_spend\x18\x04 \x01(\x05R\x0fcumulativeSpend\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\x05 \x01(\x05R\tcarryOver\x12.\n" +
+	"\x03roi\x18\x06 \x01(\v2\x1c.simulation.v1.RoiProjectionR\x03roi\x12)\n" +
+	"\x10cumulative_value\x18\a \x01(\x05R\x0fcumulativeValue\"H\n" +
+	"\x0fUnscheduledItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xc1\x01\n" +
+	"\x0fAdoptionRoadmap\x127\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1f.simulation.v1.BudgetConstraintR\x06budget\x123\n" +
+	"\x06months\x18\x02 \x03(\v2\x1b.simulation.v1.RoadmapMonthR\x06months\x12@\n" +
+	"\vunscheduled\x18\x03 \x03(\v2\x1e.simulation.v1.UnscheduledItemR\vunscheduled\"C\n" +
+	"\rWifiSituation\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05bands\x18\x02 \x03(\tR\x05bands\"\xdb\x01\n" +
+	"\x11ResidenceSnapshot\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
+	"\townership\x18\x02 \x01(\tR\townership\x12\x1b\n" +
+	"\thas_steps\x18\x03 \x01(\bR\bhasSteps\x12$\n" +
+	"\x0estep_height_mm\x18\x04 \x01(\x05R\fstepHeightMm\x12\x1f\n" +
+	"\vfloor_types\x18\x05 \x03(\tR\n" +
+	"floorTypes\x120\n" +
+	"\x04wifi\x18\x06 \x01(\v2\x1c.simulation.v1.WifiSituationR\x04wifi\"\x9c\x02\n" +
+	"\x14RunSimulationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x06budget\x18\x02 \x01(\v2\x1f.simulation.v1.BudgetConstraintR\x06budget\x121\n" +
+	"\x06chores\x18\x03 \x03(\v2\x19.simulation.v1.ChoreInputR\x06chores\x12>\n" +
+	"\tresidence\x18\x04 \x01(\v2 .simulation.v1.ResidenceSnapshotR\tresidence\x12?\n" +
+	"\vassumptions\x18\x05 \x01(\v2\x1d.simulation.v1.RoiAssumptionsR\vassumptions\"\xa0\x01\n" +
+	"\x1aRunSimulationStreamRequest\x12H\n" +
+	"\fnew_scenario\x18\x01 \x01(\v2#.simulation.v1.RunSimulationRequestH\x00R\vnewScenario\x12.\n" +
+	"\x12resume_scenario_id\x18\x02 \x01(\tH\x00R\x10resumeScenarioIdB\b\n" +
+	"\x06target\",\n" +
+	"\x1aGetOptimizationPlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x02\n" +
+	"\fProposedItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x05 \x03(\tR\tdependsOn\x12)\n" +
+	"\x10product_category\x18\x06 \x01(\tR\x0fproductCategory\x12!\n" +
+	"\fproduct_name\x18\a \x01(\tR\vproductName\x12-\n" +
+	"\x12calibration_factor\x18\b \x01(\x01R\x11calibrationFactor\"\x9c\x01\n" +
+	"\rProposalGroup\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\x05items\x18\x04 \x03(\v2\x1b.simulation.v1.ProposedItemR\x05items\"\xf0\x02\n" +
+	"\x10OptimizationPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
+	"\x16simulation_scenario_id\x18\x03 \x01(\tR\x14simulationScenarioId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\aconcept\x18\x05 \x01(\tR\aconcept\x12E\n" +
+	"\x0fproposal_groups\x18\x06 \x03(\v2\x1c.simulation.v1.ProposalGroupR\x0eproposalGroups\x12C\n" +
+	"\x0eroi_projection\x18\a \x01(\v2\x1c.simulation.v1.RoiProjectionR\rroiProjection\x128\n" +
+	"\aroadmap\x18\b \x01(\v2\x1e.simulation.v1.AdoptionRoadmapR\aroadmap\"`\n" +
+	"\x11RejectedCandidate\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\areasons\x18\x03 \x03(\tR\areasons\"\x12\n" +
+	"\x10ScenarioAccepted\"y\n" +
+	"\x12CandidatesFiltered\x12%\n" +
+	"\x0eeligible_count\x18\x01 \x01(\x05R\religibleCount\x12<\n" +
+	"\brejected\x18\x02 \x03(\v2 .simulation.v1.RejectedCandidateR\brejected\"F\n" +
+	"\rOptimizerDone\x125\n" +
+	"\x05draft\x18\x01 \x01(\v2\x1f.simulation.v1.OptimizationPlanR\x05draft\"T\n" +
+	"\x0eNarrativeToken\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\"=\n" +
+	"\vRoiComputed\x12.\n" +
+	"\x03roi\x18\x01 \x01(\v2\x1c.simulation.v1.RoiProjectionR\x03roi\"D\n" +
+	"\rPlanPersisted\x123\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1f.simulation.v1.OptimizationPlanR\x04plan\"\xb2\x04\n" +
+	"\x0fSimulationEvent\x12\x1f\n" +
+	"\vscenario_id\x18\x01 \x01(\tR\n" +
+	"scenarioId\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x05R\bsequence\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\x12N\n" +
+	"\x11scenario_accepted\x18\n" +
+	" \x01(\v2\x1f.simulation.v1.ScenarioAcceptedH\x00R\x10scenarioAccepted\x12T\n" +
+	"\x13candidates_filtered\x18\v \x01(\v2!.simulation.v1.CandidatesFilteredH\x00R\x12candidatesFiltered\x12E\n" +
+	"\x0eoptimizer_done\x18\f \x01(\v2\x1c.simulation.v1.OptimizerDoneH\x00R\roptimizerDone\x12H\n" +
+	"\x0fnarrative_token\x18\r \x01(\v2\x1d.simulation.v1.NarrativeTokenH\x00R\x0enarrativeToken\x12?\n" +
+	"\froi_computed\x18\x0e \x01(\v2\x1a.simulation.v1.RoiComputedH\x00R\vroiComputed\x12E\n" +
+	"\x0eplan_persisted\x18\x0f \x01(\v2\x1c.simulation.v1.PlanPersistedH\x00R\rplanPersistedB\a\n" +
+	"\x05event\"4\n" +
+	"\x17CompareScenariosRequest\x12\x19\n" +
+	"\bplan_ids\x18\x01 \x03(\tR\aplanIds\"Q\n" +
+	"\vInputChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xeb\x01\n" +
+	"\n" +
+	"ItemChange\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x123\n" +
+	"\x06before\x18\x03 \x01(\v2\x1b.simulation.v1.ProposedItemR\x06before\x121\n" +
+	"\x05after\x18\x04 \x01(\v2\x1b.simulation.v1.ProposedItemR\x05after\x12%\n" +
+	"\x0echanged_fields\x18\x05 \x03(\tR\rchangedFields\x12\x1b\n" +
+	"\tcaused_by\x18\x06 \x03(\tR\bcausedBy\"\xc2\x01\n" +
+	"\tGroupDiff\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12/\n" +
+	"\x05added\x18\x02 \x03(\v2\x19.simulation.v1.ItemChangeR\x05added\x123\n" +
+	"\aremoved\x18\x03 \x03(\v2\x19.simulation.v1.ItemChangeR\aremoved\x123\n" +
+	"\achanged\x18\x04 \x03(\v2\x19.simulation.v1.ItemChangeR\achanged\"\xd3\x02\n" +
+	"\bRoiDelta\x12\x1b\n" +
+	"\troi_score\x18\x01 \x01(\x05R\broiScore\x12=\n" +
+	"\x1bestimated_time_saved_yearly\x18\x02 \x01(\x01R\x18estimatedTimeSavedYearly\x12,\n" +
+	"\x12net_benefit_yearly\x18\x03 \x01(\x05R\x10netBenefitYearly\x12$\n" +
+	"\x0enpv_five_years\x18\x04 \x01(\x05R\fnpvFiveYears\x12%\n" +
+	"\x0epayback_months\x18\x05 \x01(\x01R\rpaybackMonths\x128\n" +
+	"\x18payback_reachable_before\x18\x06 \x01(\bR\x16paybackReachableBefore\x126\n" +
+	"\x17payback_reachable_after\x18\a \x01(\bR\x15paybackReachableAfter\"\x9a\x02\n" +
+	"\bPlanDiff\x12 \n" +
+	"\fbase_plan_id\x18\x01 \x01(\tR\n" +
+	"basePlanId\x12$\n" +
+	"\x0etarget_plan_id\x18\x02 \x01(\tR\ftargetPlanId\x120\n" +
+	"\x06groups\x18\x03 \x03(\v2\x18.simulation.v1.GroupDiffR\x06groups\x12\x1d\n" +
+	"\n" +
+	"cost_delta\x18\x04 \x01(\x03R\tcostDelta\x124\n" +
+	"\troi_delta\x18\x05 \x01(\v2\x17.simulation.v1.RoiDeltaR\broiDelta\x12?\n" +
+	"\rinput_changes\x18\x06 \x03(\v2\x1a.simulation.v1.InputChangeR\finputChanges\"I\n" +
+	"\x18CompareScenariosResponse\x12-\n" +
+	"\x05diffs\x18\x01 \x03(\v2\x17.simulation.v1.PlanDiffR\x05diffs\"\xfb\x01\n" +
+	"\x12SweepBudgetRequest\x12!\n" +
+	"\vscenario_id\x18\x01 \x01(\tH\x00R\n" +
+	"scenarioId\x12E\n" +
+	"\n" +
+	"conditions\x18\x02 \x01(\v2#.simulation.v1.RunSimulationRequestH\x00R\n" +
+	"conditions\x12\x1f\n" +
+	"\vbudget_type\x18\x03 \x01(\tR\n" +
+	"budgetType\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\x05R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x05 \x01(\x05R\tmaxAmount\x12\x12\n" +
+	"\x04step\x18\x06 \x01(\x05R\x04stepB\b\n" +
+	"\x06source\"\xf2\x01\n" +
+	"\x10BudgetBreakpoint\x12\x1d\n" +
+	"\n" +
+	"min_budget\x18\x01 \x01(\x05R\tminBudget\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x02 \x01(\x03R\ttotalCost\x12\x1f\n" +
+	"\vpain_relief\x18\x03 \x01(\x01R\n" +
+	"painRelief\x12.\n" +
+	"\x13pain_relief_percent\x18\x04 \x01(\x01R\x11painReliefPercent\x12\x1f\n" +
+	"\vproduct_ids\x18\x05 \x03(\tR\n" +
+	"productIds\x12\x14\n" +
+	"\x05added\x18\x06 \x03(\tR\x05added\x12\x18\n" +
+	"\aremoved\x18\a \x03(\tR\aremoved\"\x97\x01\n" +
+	"\x13SweepBudgetResponse\x12\x1f\n" +
+	"\vbudget_type\x18\x01 \x01(\tR\n" +
+	"budgetType\x12\x1c\n" +
+	"\tevaluated\x18\x02 \x01(\x05R\tevaluated\x12A\n" +
+	"\vbreakpoints\x18\x03 \x03(\v2\x1f.simulation.v1.BudgetBreakpointR\vbreakpoints\"N\n" +
+	"\x12GetLlmUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vscenario_id\x18\x02 \x01(\tR\n" +
+	"scenarioId\"\x84\x02\n" +
+	"\x0eLlmUsageRecord\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12%\n" +
+	"\x0eprompt_version\x18\x02 \x01(\tR\rpromptVersion\x12#\n" +
+	"\rprompt_tokens\x18\x03 \x01(\x05R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x04 \x01(\x05R\x10completionTokens\x12,\n" +
+	"\x12estimated_cost_yen\x18\x05 \x01(\x01R\x10estimatedCostYen\x12\x16\n" +
+	"\x06cached\x18\x06 \x01(\bR\x06cached\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xa8\x03\n" +
+	"\x13GetLlmUsageResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\tR\x03day\x12\x14\n" +
+	"\x05calls\x18\x03 \x01(\x05R\x05calls\x12)\n" +
+	"\x10cached_responses\x18\x04 \x01(\x05R\x0fcachedResponses\x12#\n" +
+	"\rprompt_tokens\x18\x05 \x01(\x05R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x06 \x01(\x05R\x10completionTokens\x12,\n" +
+	"\x12estimated_cost_yen\x18\a \x01(\x01R\x10estimatedCostYen\x12*\n" +
+	"\x11daily_token_quota\x18\b \x01(\x05R\x0fdailyTokenQuota\x12/\n" +
+	"\x14daily_cost_quota_yen\x18\t \x01(\x01R\x11dailyCostQuotaYen\x12H\n" +
+	"\x10scenario_records\x18\n" +
+	" \x03(\v2\x1d.simulation.v1.LlmUsageRecordR\x0fscenarioRecords2\xe6\x05\n" +
+	"\x11SimulationService\x12P\n" +
+	"\fCalculateRoi\x12\".simulation.v1.CalculateRoiRequest\x1a\x1c.simulation.v1.RoiProjection\x12P\n" +
+	"\vPlanRoadmap\x12!.simulation.v1.PlanRoadmapRequest\x1a\x1e.simulation.v1.AdoptionRoadmap\x12U\n" +
+	"\rRunSimulation\x12#.simulation.v1.RunSimulationRequest\x1a\x1f.simulation.v1.OptimizationPlan\x12b\n" +
+	"\x13RunSimulationStream\x12).simulation.v1.RunSimulationStreamRequest\x1a\x1e.simulation.v1.SimulationEvent0\x01\x12a\n" +
+	"\x13GetOptimizationPlan\x12).simulation.v1.GetOptimizationPlanRequest\x1a\x1f.simulation.v1.OptimizationPlan\x12c\n" +
+	"\x10CompareScenarios\x12&.simulation.v1.CompareScenariosRequest\x1a'.simulation.v1.CompareScenariosResponse\x12T\n" +
+	"\vSweepBudget\x12!.simulation.v1.SweepBudgetRequest\x1a\".simulation.v1.SweepBudgetResponse\x12T\n" +
+	"\vGetLlmUsage\x12!.simulation.v1.GetLlmUsageRequest\x1a\".simulation.v1.GetLlmUsageResponseBCZAgithub.com/kinoshitatakumi/opti/gen/go/simulation/v1;simulationv1b\x06proto3"
+
+var (
+	file_simulation_v1_simulation_proto_rawDescOnce sync.Once
+	file_simulation_v1_simulation_proto_rawDescData []byte
+)
+
+func file_simulation_v1_simulation_proto_rawDescGZIP() []byte {
+	file_simulation_v1_simulation_proto_rawDescOnce.Do(func() {
+		file_simulation_v1_simulation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_simulation_v1_simulation_proto_rawDesc), len(file_simulation_v1_simulation_proto_rawDesc)))
+	})
+	return file_simulation_v1_simulation_proto_rawDescData
+}
+
+var file_simulation_v1_simulation_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_simulation_v1_simulation_proto_goTypes = []any{
+	(*ChoreInput)(nil),                 // 0: simulation.v1.ChoreInput
+	(*TimeReduction)(nil),              // 1: simulation.v1.TimeReduction
+	(*RoiProduct)(nil),                 // 2: simulation.v1.RoiProduct
+	(*RoiAssumptions)(nil),             // 3: simulation.v1.RoiAssumptions
+	(*CalculateRoiRequest)(nil),        // 4: simulation.v1.CalculateRoiRequest
+	(*ChoreSaving)(nil),                // 5: simulation.v1.ChoreSaving
+	(*ScoreComponent)(nil),             // 6: simulation.v1.ScoreComponent
+	(*RoiProjection)(nil),              // 7: simulation.v1.RoiProjection
+	(*BudgetConstraint)(nil),           // 8: simulation.v1.BudgetConstraint
+	(*RoadmapItem)(nil),                // 9: simulation.v1.RoadmapItem
+	(*PlanRoadmapRequest)(nil),         // 10: simulation.v1.PlanRoadmapRequest
+	(*RoadmapMonth)(nil),               // 11: simulation.v1.RoadmapMonth
+	(*UnscheduledItem)(nil),            // 12: simulation.v1.UnscheduledItem
+	(*AdoptionRoadmap)(nil),            // 13: simulation.v1.AdoptionRoadmap
+	(*WifiSituation)(nil),              // 14: simulation.v1.WifiSituation
+	(*ResidenceSnapshot)(nil),          // 15: simulation.v1.ResidenceSnapshot
+	(*RunSimulationRequest)(nil),       // 16: simulation.v1.RunSimulationRequest
+	(*RunSimulationStreamRequest)(nil), // 17: simulation.v1.RunSimulationStreamRequest
+	(*GetOptimizationPlanRequest)(nil), // 18: simulation.v1.GetOptimizationPlanRequest
+	(*ProposedItem)(nil),               // 19: simulation.v1.ProposedItem
+	(*ProposalGroup)(nil),              // 20: simulation.v1.ProposalGroup
+	(*OptimizationPlan)(nil),           // 21: simulation.v1.OptimizationPlan
+	(*RejectedCandidate)(nil),          // 22: simulation.v1.RejectedCandidate
+	(*ScenarioAccepted)(nil),           // 23: simulation.v1.ScenarioAccepted
+	(*CandidatesFiltered)(nil),         // 24: simulation.v1.CandidatesFiltered
+	(*OptimizerDone)(nil),              // 25: simulation.v1.OptimizerDone
+	(*NarrativeToken)(nil),             // 26: simulation.v1.NarrativeToken
+	(*RoiComputed)(nil),                // 27: simulation.v1.RoiComputed
+	(*PlanPersisted)(nil),              // 28: simulation.v1.PlanPersisted
+	(*SimulationEvent)(nil),            // 29: simulation.v1.SimulationEvent
+	(*CompareScenariosRequest)(nil),    // 30: simulation.v1.CompareScenariosRequest
+	(*InputChange)(nil),                // 31: simulation.v1.InputChange
+	(*ItemChange)(nil),                 // 32: simulation.v1.ItemChange
+	(*GroupDiff)(nil),                  // 33: simulation.v1.GroupDiff
+	(*RoiDelta)(nil),                   // 34: simulation.v1.RoiDelta
+	(*PlanDiff)(nil),                   // 35: simulation.v1.PlanDiff
+	(*CompareScenariosResponse)(nil),   // 36: simulation.v1.CompareScenariosResponse
+	(*SweepBudgetRequest)(nil),         // 37: simulation.v1.SweepBudgetRequest
+	(*BudgetBreakpoint)(nil),           // 38: simulation.v1.BudgetBreakpoint
+	(*SweepBudgetResponse)(nil),        // 39: simulation.v1.SweepBudgetResponse
+	(*GetLlmUsageRequest)(nil),         // 40: simulation.v1.GetLlmUsageRequest
+	(*LlmUsageRecord)(nil),             // 41: simulation.v1.LlmUsageRecord
+	(*GetLlmUsageResponse)(nil),        // 42: simulation.v1.GetLlmUsageResponse
+}
+var file_simulation_v1_simulation_proto_depIdxs = []int32{
+	1,  // 0: simulation.v1.RoiProduct.time_reductions:type_name -> simulation.v1.TimeReduction
+	0,  // 1: simulation.v1.CalculateRoiRequest.chores:type_name -> simulation.v1.ChoreInput
+	2,  // 2: simulation.v1.CalculateRoiRequest.products:type_name -> simulation.v1.RoiProduct
+	3,  // 3: simulation.v1.CalculateRoiRequest.assumptions:type_name -> simulation.v1.RoiAssumptions
+	5,  // 4: simulation.v1.RoiProjection.chore_savings:type_name -> simulation.v1.ChoreSaving
+	6,  // 5: simulation.v1.RoiProjection.score_breakdown:type_name -> simulation.v1.ScoreComponent
+	2,  // 6: simulation.v1.RoadmapItem.product:type_name -> simulation.v1.RoiProduct
+	8,  // 7: simulation.v1.PlanRoadmapRequest.budget:type_name -> simulation.v1.BudgetConstraint
+	0,  // 8: simulation.v1.PlanRoadmapRequest.chores:type_name -> simulation.v1.ChoreInput
+	9,  // 9: simulation.v1.PlanRoadmapRequest.items:type_name -> simulation.v1.RoadmapItem
+	3,  // 10: simulation.v1.PlanRoadmapRequest.assumptions:type_name -> simulation.v1.RoiAssumptions
+	7,  // 11: simulation.v1.RoadmapMonth.roi:type_name -> simulation.v1.RoiProjection
+	8,  // 12: simulation.v1.AdoptionRoadmap.budget:type_name -> simulation.v1.BudgetConstraint
+	11, // 13: simulation.v1.AdoptionRoadmap.months:type_name -> simulation.v1.RoadmapMonth
+	12, // 14: simulation.v1.AdoptionRoadmap.unscheduled:type_name -> simulation.v1.UnscheduledItem
+	14, // 15: simulation.v1.ResidenceSnapshot.wifi:type_name -> simulation.v1.WifiSituation
+	8,  // 16: simulation.v1.RunSimulationRequest.budget:type_name -> simulation.v1.BudgetConstraint
+	0,  // 17: simulation.v1.RunSimulationRequest.chores:type_name -> simulation.v1.ChoreInput
+	15, // 18: simulation.v1.RunSimulationRequest.residence:type_name -> simulation.v1.ResidenceSnapshot
+	3,  // 19: simulation.v1.RunSimulationRequest.assumptions:type_name -> simulation.v1.RoiAssumptions
+	16, // 20: simulation.v1.RunSimulationStreamRequest.new_scenario:type_name -> simulation.v1.RunSimulationRequest
+	19, // 21: simulation.v1.ProposalGroup.items:type_name -> simulation.v1.ProposedItem
+	20, // 22: simulation.v1.OptimizationPlan.proposal_groups:type_name -> simulation.v1.ProposalGroup
+	7,  // 23: simulation.v1.OptimizationPlan.roi_projection:type_name -> simulation.v1.RoiProjection
+	13, // 24: simulation.v1.OptimizationPlan.roadmap:type_name -> simulation.v1.AdoptionRoadmap
+	22, // 25: simulation.v1.CandidatesFiltered.rejected:type_name -> simulation.v1.RejectedCandidate
+	21, // 26: simulation.v1.OptimizerDone.draft:type_name -> simulation.v1.OptimizationPlan
+	7,  // 27: simulation.v1.RoiComputed.roi:type_name -> simulation.v1.RoiProjection
+	21, // 28: simulation.v1.PlanPersisted.plan:type_name -> simulation.v1.OptimizationPlan
+	23, // 29: simulation.v1.SimulationEvent.scenario_accepted:type_name -> simulation.v1.ScenarioAccepted
+	24, // 30: simulation.v1.SimulationEvent.candidates_filtered:type_name -> simulation.v1.CandidatesFiltered
+	25, // 31: simulation.v1.SimulationEvent.optimizer_done:type_name -> simulation.v1.OptimizerDone
+	26, // 32: simulation.v1.SimulationEvent.narrative_token:type_name -> simulation.v1.NarrativeToken
+	27, // 33: simulation.v1.SimulationEvent.roi_computed:type_name -> simulation.v1.RoiComputed
+	28, // 34: simulation.v1.SimulationEvent.plan_persisted:type_name -> simulation.v1.PlanPersisted
+	19, // 35: simulation.v1.ItemChange.before:type_name -> simulation.v1.ProposedItem
+	19, // 36: simulation.v1.ItemChange.after:type_name -> simulation.v1.ProposedItem
+	32, // 37: simulation.v1.GroupDiff.added:type_name -> simulation.v1.ItemChange
+	32, // 38: simulation.v1.GroupDiff.removed:type_name -> simulation.v1.ItemChange
+	32, // 39: simulation.v1.GroupDiff.changed:type_name -> simulation.v1.ItemChange
+	33, // 40: simulation.v1.PlanDiff.groups:type_name -> simulation.v1.GroupDiff
+	34, // 41: simulation.v1.PlanDiff.roi_delta:type_name -> simulation.v1.RoiDelta
+	31, // 42: simulation.v1.PlanDiff.input_changes:type_name -> simulation.v1.InputChange
+	35, // 43: simulation.v1.CompareScenariosResponse.diffs:type_name -> simulation.v1.PlanDiff
+	16, // 44: simulation.v1.SweepBudgetRequest.conditions:type_name -> simulation.v1.RunSimulationRequest
+	38, // 45: simulation.v1.SweepBudgetResponse.breakpoints:type_name -> simulation.v1.BudgetBreakpoint
+	41, // 46: simulation.v1.GetLlmUsageResponse.scenario_records:type_name -> simulation.v1.LlmUsageRecord
+	4,  // 47: simulation.v1.SimulationService.CalculateRoi:input_type -> simulation.v1.CalculateRoiRequest
+	10, // 48: simulation.v1.SimulationService.PlanRoadmap:input_type -> simulation.v1.PlanRoadmapRequest
+	16, // 49: simulation.v1.SimulationService.RunSimulation:input_type -> simulation.v1.RunSimulationRequest
+	17, // 50: simulation.v1.SimulationService.RunSimulationStream:input_type -> simulation.v1.RunSimulationStreamRequest
+	18, // 51: simulation.v1.SimulationService.GetOptimizationPlan:input_type -> simulation.v1.GetOptimizationPlanRequest
+	30, // 52: simulation.v1.SimulationService.CompareScenarios:input_type -> simulation.v1.CompareScenariosRequest
+	37, // 53: simulation.v1.SimulationService.SweepBudget:input_type -> simulation.v1.SweepBudgetRequest
+	40, // 54: simulation.v1.SimulationService.GetLlmUsage:input_type -> simulation.v1.GetLlmUsageRequest
+	7,  // 55: simulation.v1.SimulationService.CalculateRoi:output_type -> simulation.v1.RoiProjection
+	13, // 56: simulation.v1.SimulationService.PlanRoadmap:output_type -> simulation.v1.AdoptionRoadmap
+	21, // 57: simulation.v1.SimulationService.RunSimulation:output_type -> simulation.v1.OptimizationPlan
+	29, // 58: simulation.v1.SimulationService.RunSimulationStream:output_type -> simulation.v1.SimulationEvent
+	21, // 59: simulation.v1.SimulationService.GetOptimizationPlan:output_type -> simulation.v1.OptimizationPlan
+	36, // 60: simulation.v1.SimulationService.CompareScenarios:output_type -> simulation.v1.CompareScenariosResponse
+	39, // 61: simulation.v1.SimulationService.SweepBudget:output_type -> simulation.v1.SweepBudgetResponse
+	42, // 62: simulation.v1.SimulationService.GetLlmUsage:output_type -> simulation.v1.GetLlmUsageResponse
+	55, // [55:63] is the sub-list for method output_type
+	47, // [47:55] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_simulation_v1_simulation_proto_init() }
+func file_simulation_v1_simulation_proto_init() {
+	if File_simulation_v1_simulation_proto != nil {
+		return
+	}
+	file_simulation_v1_simulation_proto_msgTypes[3].OneofWrappers = []any{}
+	file_simulation_v1_simulation_proto_msgTypes[17].OneofWrappers = []any{
+		(*RunSimulationStreamRequest_NewScenario)(nil),
+		(*RunSimulationStreamRequest_ResumeScenarioId)(nil),
+	}
+	file_simulation_v1_simulation_proto_msgTypes[29].OneofWrappers = []any{
+		(*SimulationEvent_ScenarioAccepted)(nil),
+		(*SimulationEvent_CandidatesFiltered)(nil),
+		(*SimulationEvent_OptimizerDone)(nil),
+		(*SimulationEvent_NarrativeToken)(nil),
+		(*SimulationEvent_RoiComputed)(nil),
+		(*SimulationEvent_PlanPersisted)(nil),
+	}
+	file_simulation_v1_simulation_proto_msgTypes[37].OneofWrappers = []any{
+		(*SweepBudgetRequest_ScenarioId)(nil),
+		(*SweepBudgetRequest_Conditions)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_simulation_v1_simulation_proto_rawDesc), len(file_simulation_v1_simulation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_simulation_v1_simulation_proto_goTypes,
+		DependencyIndexes: file_simulation_v1_simulation_proto_depIdxs,
+		MessageInfos:      file_simulation_v1_simulation_proto_msgTypes,
+	}.Build()
+	File_simulation_v1_simulation_proto = out.File
+	file_simulation_v1_simulation_proto_goTypes = nil
+	file_simulation_v1_simulation_proto_depIdxs = nil
+}
@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: simulation/v1/simulation.proto
+
+// パッケージ名はディレクトリ構成と一致させます
+package simulationv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/kinoshitatakumi/opti/gen/go/simulation/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SimulationServiceName is the fully-qualified name of the SimulationService service.
+	SimulationServiceName = "simulation.v1.SimulationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SimulationServiceCalculateRoiProcedure is the fully-qualified name of the SimulationService's
+	// CalculateRoi RPC.
+	SimulationServiceCalculateRoiProcedure = "/simulation.v1.SimulationService/CalculateRoi"
+	// SimulationServicePlanRoadmapProcedure is the fully-qualified name of the SimulationService's
+	// PlanRoadmap RPC.
+	SimulationServicePlanRoadmapProcedure = "/simulation.v1.SimulationService/PlanRoadmap"
+	// SimulationServiceRunSimulationProcedure is the fully-qualified name of the SimulationService's
+	// RunSimulation RPC.
+	SimulationServiceRunSimulationProcedure = "/simulation.v1.SimulationService/RunSimulation"
+	// SimulationServiceRunSimulationStreamProcedure is the fully-qualified name of the
+	// SimulationService's RunSimulationStream RPC.
+	SimulationServiceRunSimulationStreamProcedure = "/simulation.v1.SimulationService/RunSimulationStream"
+	// SimulationServiceGetOptimizationPlanProcedure is the fully-qualified name of the
+	// SimulationService's GetOptimizationPlan RPC.
+	SimulationServiceGetOptimizationPlanProcedure = "/simulation.v1.SimulationService/GetOptimizationPlan"
+	// SimulationServiceCompareScenariosProcedure is the fully-qualified name of the SimulationService's
+	// CompareScenarios RPC.
+	SimulationServiceCompareScenariosProcedure = "/simulation.v1.SimulationService/CompareScenarios"
+	// SimulationServiceSweepBudgetProcedure is the fully-qualified name of the SimulationService's
+	// SweepBudget RPC.
+	SimulationServiceSweepBudgetProcedure = "/simulation.v1.SimulationService/SweepBudget"
+	// SimulationServiceGetLlmUsageProcedure is the fully-qualified name of the SimulationService's
+	// GetLlmUsage RPC.
+	SimulationServiceGetLlmUsageProcedure = "/simulation.v1.SimulationService/GetLlmUsage"
+)
+
+// SimulationServiceClient is a client for the simulation.v1.SimulationService service.
+type SimulationServiceClient interface {
+	// CalculateRoi: 家事の入力と導入製品からROI（投資対効果）を計算します。
+	// 保存は行わない純粋な計算APIなので、フロントエンドのスライダー操作のたびに呼び出せます。
+	CalculateRoi(context.Context, *connect.Request[v1.CalculateRoiRequest]) (*connect.Response[v1.RoiProjection], error)
+	// PlanRoadmap: 選んだ製品を月々の予算に合わせて「今月はこれ、来月はこれ」と並べます。
+	// ハブなどの依存関係と、1円あたりのペイン解消量による優先度を考慮します。
+	PlanRoadmap(context.Context, *connect.Request[v1.PlanRoadmapRequest]) (*connect.Response[v1.AdoptionRoadmap], error)
+	// RunSimulation: 診断入力から提案プランを生成し、保存したプランを返します。
+	// LLMの利用上限を利用者ごとに数えるため、ログインが必要です (シナリオの所有者はアクセストークンの利用者)。
+	RunSimulation(context.Context, *connect.Request[v1.RunSimulationRequest]) (*connect.Response[v1.OptimizationPlan], error)
+	// RunSimulationStream: RunSimulation と同じ処理を行い、進捗をイベントとして順に返します。
+	// LLMの説明文はトークン単位で届くので、生成中から画面に表示できます。
+	// 途中で切断された場合は resume_scenario_id を指定して呼び直すと、続きから再開します。
+	// 新規作成・再開ともログインが必要で、再開できるのはシナリオを作った本人 (Authorization ヘッダーのアクセストークンの利用者) だけです。
+	RunSimulationStream(context.Context, *connect.Request[v1.RunSimulationStreamRequest]) (*connect.ServerStreamForClient[v1.SimulationEvent], error)
+	// GetOptimizationPlan: 保存済みの提案プランを取得します。
+	// ログインが必要で、取得できるのはアクセストークンの利用者のプランだけです (他の利用者のプランは NotFound)。
+	GetOptimizationPlan(context.Context, *connect.Request[v1.GetOptimizationPlanRequest]) (*connect.Response[v1.OptimizationPlan], error)
+	// CompareScenarios: 2つ以上の提案プランを比較します (UC-02)。
+	// 先頭のプランを基準に、残りのプランそれぞれとの差分と、その原因となった入力条件の変更を返します。
+	// ログインが必要で、比べられるのはアクセストークンの利用者のプランだけです (他の利用者のプランは NotFound)。
+	CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error)
+	// SweepBudget: 予算を範囲内で変えながら最適化を繰り返し、ペイン解消量とコストの効率的フロンティアを返します。
+	// 「あと2万円あれば何が買えるか」を、各ブレークポイントで増える製品とともに示します。
+	// scenario_id を指定する場合はログインが必要で、使えるのはアクセストークンの利用者のシナリオだけです (他の利用者のシナリオは NotFound)。
+	SweepBudget(context.Context, *connect.Request[v1.SweepBudgetRequest]) (*connect.Response[v1.SweepBudgetResponse], error)
+	// GetLlmUsage: ユーザーの今日のLLM利用状況と上限を返します。
+	// scenario_id を指定すると、そのシナリオでのLLM呼び出しの記録も返します。
+	// ログインが必要で、返すのはアクセストークンの利用者の利用状況と、その利用者のシナリオの記録だけです。
+	// 上限に達したユーザーの RunSimulation は ResourceExhausted になります (キャッシュ済みの再実行は除く)。
+	GetLlmUsage(context.Context, *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error)
+}
+
+// NewSimulationServiceClient constructs a client for the simulation.v1.SimulationService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSimulationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SimulationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	simulationServiceMethods := v1.File_simulation_v1_simulation_proto.Services().ByName("SimulationService").Methods()
+	return &simulationServiceClient{
+		calculateRoi: connect.NewClient[v1.CalculateRoiRequest, v1.RoiProjection](
+			httpClient,
+			baseURL+SimulationServiceCalculateRoiProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("CalculateRoi")),
+			connect.WithClientOptions(opts...),
+		),
+		planRoadmap: connect.NewClient[v1.PlanRoadmapRequest, v1.AdoptionRoadmap](
+			httpClient,
+			baseURL+SimulationServicePlanRoadmapProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("PlanRoadmap")),
+			connect.WithClientOptions(opts...),
+		),
+		runSimulation: connect.NewClient[v1.RunSimulationRequest, v1.OptimizationPlan](
+			httpClient,
+			baseURL+SimulationServiceRunSimulationProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("RunSimulation")),
+			connect.WithClientOptions(opts...),
+		),
+		runSimulationStream: connect.NewClient[v1.RunSimulationStreamRequest, v1.SimulationEvent](
+			httpClient,
+			baseURL+SimulationServiceRunSimulationStreamProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("RunSimulationStream")),
+			connect.WithClientOptions(opts...),
+		),
+		getOptimizationPlan: connect.NewClient[v1.GetOptimizationPlanRequest, v1.OptimizationPlan](
+			httpClient,
+			baseURL+SimulationServiceGetOptimizationPlanProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("GetOptimizationPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		compareScenarios: connect.NewClient[v1.CompareScenariosRequest, v1.CompareScenariosResponse](
+			httpClient,
+			baseURL+SimulationServiceCompareScenariosProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("CompareScenarios")),
+			connect.WithClientOptions(opts...),
+		),
+		sweepBudget: connect.NewClient[v1.SweepBudgetRequest, v1.SweepBudgetResponse](
+			httpClient,
+			baseURL+SimulationServiceSweepBudgetProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("SweepBudget")),
+			connect.WithClientOptions(opts...),
+		),
+		getLlmUsage: connect.NewClient[v1.GetLlmUsageRequest, v1.GetLlmUsageResponse](
+			httpClient,
+			baseURL+SimulationServiceGetLlmUsageProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("GetLlmUsage")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// simulationServiceClient implements SimulationServiceClient.
+type simulationServiceClient struct {
+	calculateRoi        *connect.Client[v1.CalculateRoiRequest, v1.RoiProjection]
+	planRoadmap         *connect.Client[v1.PlanRoadmapRequest, v1.AdoptionRoadmap]
+	runSimulation       *connect.Client[v1.RunSimulationRequest, v1.OptimizationPlan]
+	runSimulationStream *connect.Client[v1.RunSimulationStreamRequest, v1.SimulationEvent]
+	getOptimizationPlan *connect.Client[v1.GetOptimizationPlanRequest, v1.OptimizationPlan]
+	compareScenarios    *connect.Client[v1.CompareScenariosRequest, v1.CompareScenariosResponse]
+	sweepBudget         *connect.Client[v1.SweepBudgetRequest, v1.SweepBudgetResponse]
+	getLlmUsage         *connect.Client[v1.GetLlmUsageRequest, v1.GetLlmUsageResponse]
+}
+
+// CalculateRoi calls simulation.v1.SimulationService.CalculateRoi.
+func (c *simulationServiceClient) CalculateRoi(ctx context.Context, req *connect.Request[v1.CalculateRoiRequest]) (*connect.Response[v1.RoiProjection], error) {
+	return c.calculateRoi.CallUnary(ctx, req)
+}
+
+// PlanRoadmap calls simulation.v1.SimulationService.PlanRoadmap.
+func (c *simulationServiceClient) PlanRoadmap(ctx context.Context, req *connect.Request[v1.PlanRoadmapRequest]) (*connect.Response[v1.AdoptionRoadmap], error) {
+	return c.planRoadmap.CallUnary(ctx, req)
+}
+
+// RunSimulation calls simulation.v1.SimulationService.RunSimulation.
+func (c *simulationServiceClient) RunSimulation(ctx context.Context, req *connect.Request[v1.RunSimulationRequest]) (*connect.Response[v1.OptimizationPlan], error) {
+	return c.runSimulation.CallUnary(ctx, req)
+}
+
+// RunSimulationStream calls simulation.v1.SimulationService.RunSimulationStream.
+func (c *simulationServiceClient) RunSimulationStream(ctx context.Context, req *connect.Request[v1.RunSimulationStreamRequest]) (*connect.ServerStreamForClient[v1.SimulationEvent], error) {
+	return c.runSimulationStream.CallServerStream(ctx, req)
+}
+
+// GetOptimizationPlan calls simulation.v1.SimulationService.GetOptimizationPlan.
+func (c *simulationServiceClient) GetOptimizationPlan(ctx context.Context, req *connect.Request[v1.GetOptimizationPlanRequest]) (*connect.Response[v1.OptimizationPlan], error) {
+	return c.getOptimizationPlan.CallUnary(ctx, req)
+}
+
+// CompareScenarios calls simulation.v1.SimulationService.CompareScenarios.
+func (c *simulationServiceClient) CompareScenarios(ctx context.Context, req *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error) {
+	return c.compareScenarios.CallUnary(ctx, req)
+}
+
+// SweepBudget calls simulation.v1.SimulationService.SweepBudget.
+func (c *simulationServiceClient) SweepBudget(ctx context.Context, req *connect.Request[v1.SweepBudgetRequest]) (*connect.Response[v1.SweepBudgetResponse], error) {
+	return c.sweepBudget.CallUnary(ctx, req)
+}
+
+// GetLlmUsage calls simulation.v1.SimulationService.GetLlmUsage.
+func (c *simulationServiceClient) GetLlmUsage(ctx context.Context, req *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error) {
+	return c.getLlmUsage.CallUnary(ctx, req)
+}
+
+// SimulationServiceHandler is an implementation of the simulation.v1.SimulationService service.
+type SimulationServiceHandler interface {
+	// CalculateRoi: 家事の入力と導入製品からROI（投資対効果）を計算します。
+	// 保存は行わない純粋な計算APIなので、フロントエンドのスライダー操作のたびに呼び出せます。
+	CalculateRoi(context.Context, *connect.Request[v1.CalculateRoiRequest]) (*connect.Response[v1.RoiProjection], error)
+	// PlanRoadmap: 選んだ製品を月々の予算に合わせて「今月はこれ、来月はこれ」と並べます。
+	// ハブなどの依存関係と、1円あたりのペイン解消量による優先度を考慮します。
+	PlanRoadmap(context.Context, *connect.Request[v1.PlanRoadmapRequest]) (*connect.Response[v1.AdoptionRoadmap], error)
+	// RunSimulation: 診断入力から提案プランを生成し、保存したプランを返します。
+	// LLMの利用上限を利用者ごとに数えるため、ログインが必要です (シナリオの所有者はアクセストークンの利用者)。
+	RunSimulation(context.Context, *connect.Request[v1.RunSimulationRequest]) (*connect.Response[v1.OptimizationPlan], error)
+	// RunSimulationStream: RunSimulation と同じ処理を行い、進捗をイベントとして順に返します。
+	// LLMの説明文はトークン単位で届くので、生成中から画面に表示できます。
+	// 途中で切断された場合は resume_scenario_id を指定して呼び直すと、続きから再開します。
+	// 新規作成・再開ともログインが必要で、再開できるのはシナリオを作った本人 (Authorization ヘッダーのアクセストークンの利用者) だけです。
+	RunSimulationStream(context.Context, *connect.Request[v1.RunSimulationStreamRequest], *connect.ServerStream[v1.SimulationEvent]) error
+	// GetOptimizationPlan: 保存済みの提案プランを取得します。
+	// ログインが必要で、取得できるのはアクセストークンの利用者のプランだけです (他の利用者のプランは NotFound)。
+	GetOptimizationPlan(context.Context, *connect.Request[v1.GetOptimizationPlanRequest]) (*connect.Response[v1.OptimizationPlan], error)
+	// CompareScenarios: 2つ以上の提案プランを比較します (UC-02)。
+	// 先頭のプランを基準に、残りのプランそれぞれとの差分と、その原因となった入力条件の変更を返します。
+	// ログインが必要で、比べられるのはアクセストークンの利用者のプランだけです (他の利用者のプランは NotFound)。
+	CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error)
+	// SweepBudget: 予算を範囲内で変えながら最適化を繰り返し、ペイン解消量とコストの効率的フロンティアを返します。
+	// 「あと2万円あれば何が買えるか」を、各ブレークポイントで増える製品とともに示します。
+	// scenario_id を指定する場合はログインが必要で、使えるのはアクセストークンの利用者のシナリオだけです (他の利用者のシナリオは NotFound)。
+	SweepBudget(context.Context, *connect.Request[v1.SweepBudgetRequest]) (*connect.Response[v1.SweepBudgetResponse], error)
+	// GetLlmUsage: ユーザーの今日のLLM利用状況と上限を返します。
+	// scenario_id を指定すると、そのシナリオでのLLM呼び出しの記録も返します。
+	// ログインが必要で、返すのはアクセストークンの利用者の利用状況と、その利用者のシナリオの記録だけです。
+	// 上限に達したユーザーの RunSimulation は ResourceExhausted になります (キャッシュ済みの再実行は除く)。
+	GetLlmUsage(context.Context, *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error)
+}
+
+// NewSimulationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSimulationServiceHandler(svc SimulationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	simulationServiceMethods := v1.File_simulation_v1_simulation_proto.Services().ByName("SimulationService").Methods()
+	simulationServiceCalculateRoiHandler := connect.NewUnaryHandler(
+		SimulationServiceCalculateRoiProcedure,
+		svc.CalculateRoi,
+		connect.WithSchema(simulationServiceMethods.ByName("CalculateRoi")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServicePlanRoadmapHandler := connect.NewUnaryHandler(
+		SimulationServicePlanRoadmapProcedure,
+		svc.PlanRoadmap,
+		connect.WithSchema(simulationServiceMethods.ByName("PlanRoadmap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceRunSimulationHandler := connect.NewUnaryHandler(
+		SimulationServiceRunSimulationProcedure,
+		svc.RunSimulation,
+		connect.WithSchema(simulationServiceMethods.ByName("RunSimulation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceRunSimulationStreamHandler := connect.NewServerStreamHandler(
+		SimulationServiceRunSimulationStreamProcedure,
+		svc.RunSimulationStream,
+		connect.WithSchema(simulationServiceMethods.ByName("RunSimulationStream")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceGetOptimizationPlanHandler := connect.NewUnaryHandler(
+		SimulationServiceGetOptimizationPlanProcedure,
+		svc.GetOptimizationPlan,
+		connect.WithSchema(simulationServiceMethods.ByName("GetOptimizationPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceCompareScenariosHandler := connect.NewUnaryHandler(
+		SimulationServiceCompareScenariosProcedure,
+		svc.CompareScenarios,
+		connect.WithSchema(simulationServiceMethods.ByName("CompareScenarios")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceSweepBudgetHandler := connect.NewUnaryHandler(
+		SimulationServiceSweepBudgetProcedure,
+		svc.SweepBudget,
+		connect.WithSchema(simulationServiceMethods.ByName("SweepBudget")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceGetLlmUsageHandler := connect.NewUnaryHandler(
+		SimulationServiceGetLlmUsageProcedure,
+		svc.GetLlmUsage,
+		connect.WithSchema(simulationServiceMethods.ByName("GetLlmUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/simulation.v1.SimulationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SimulationServiceCalculateRoiProcedure:
+			simulationServiceCalculateRoiHandler.ServeHTTP(w, r)
+		case SimulationServicePlanRoadmapProcedure:
+			simulationServicePlanRoadmapHandler.ServeHTTP(w, r)
+		case SimulationServiceRunSimulationProcedure:
+			simulationServiceRunSimulationHandler.ServeHTTP(w, r)
+		case SimulationServiceRunSimulationStreamProcedure:
+			simulationServiceRunSimulationStreamHandler.ServeHTTP(w, r)
+		case SimulationServiceGetOptimizationPlanProcedure:
+			simulationServiceGetOptimizationPlanHandler.ServeHTTP(w, r)
+		case SimulationServiceCompareScenariosProcedure:
+			simulationServiceCompareScenariosHandler.ServeHTTP(w, r)
+		case SimulationServiceSweepBudgetProcedure:
+			simulationServiceSweepBudgetHandler.ServeHTTP(w, r)
+		case SimulationServiceGetLlmUsageProcedure:
+			simulationServiceGetLlmUsageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSimulationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSimulationServiceHandler struct{}
+
+func (UnimplementedSimulationServiceHandler) CalculateRoi(context.Context, *connect.Request[v1.CalculateRoiRequest]) (*connect.Response[v1.RoiProjection], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.CalculateRoi is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) PlanRoadmap(context.Context, *connect.Request[v1.PlanRoadmapRequest]) (*connect.Response[v1.AdoptionRoadmap], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.PlanRoadmap is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) RunSimulation(context.Context, *connect.Request[v1.RunSimulationRequest]) (*connect.Response[v1.OptimizationPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.RunSimulation is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) RunSimulationStream(context.Context, *connect.Request[v1.RunSimulationStreamRequest], *connect.ServerStream[v1.SimulationEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.RunSimulationStream is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) GetOptimizationPlan(context.Context, *connect.Request[v1.GetOptimizationPlanRequest]) (*connect.Response[v1.OptimizationPlan], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.GetOptimizationPlan is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.CompareScenarios is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) SweepBudget(context.Context, *connect.Request[v1.SweepBudgetRequest]) (*connect.Response[v1.SweepBudgetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.SweepBudget is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) GetLlmUsage(context.Context, *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("simulation.v1.SimulationService.GetLlmUsage is not implemented"))
+}
@@ -429,7 +429,9 @@ type ResidenceInfo struct {
 	// 間取り (例: "3LDK")
 	Layout string `protobuf:"bytes,3,opt,name=layout,proto3" json:"layout,omitempty"`
 	// 所有形態 (例: "owned", "rented")
-	Ownership     string `protobuf:"bytes,4,opt,name=ownership,proto3" json:"ownership,omitempty"`
+	Ownership string `protobuf:"bytes,4,opt,name=ownership,proto3" json:"ownership,omitempty"`
+	// 物理的制約 (製品の設置可否の判定に使う)
+	Constraints   *ResidenceConstraints `protobuf:"bytes,5,opt,name=constraints,proto3" json:"constraints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -492,6 +494,95 @@ func (x *ResidenceInfo) GetOwnership() string {
 	return ""
 }
 
+func (x *ResidenceInfo) GetConstraints() *ResidenceConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+// ResidenceConstraints: 住居の物理的制約
+type ResidenceConstraints struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 室内に段差があるか
+	HasSteps bool `protobuf:"varint,1,opt,name=has_steps,json=hasSteps,proto3" json:"has_steps,omitempty"`
+	// 最大の段差の高さ (mm)。0なら不明
+	StepHeightMm int32 `protobuf:"varint,2,opt,name=step_height_mm,json=stepHeightMm,proto3" json:"step_height_mm,omitempty"`
+	// 床材 (例: "flooring", "tatami", "carpet")
+	FloorTypes []string `protobuf:"bytes,3,rep,name=floor_types,json=floorTypes,proto3" json:"floor_types,omitempty"`
+	// Wi-Fiがあるか
+	HasWifi bool `protobuf:"varint,4,opt,name=has_wifi,json=hasWifi,proto3" json:"has_wifi,omitempty"`
+	// 利用できるWi-Fiの帯域 (例: "wifi_2_4ghz", "wifi_5ghz")。空なら両方
+	WifiBands     []string `protobuf:"bytes,5,rep,name=wifi_bands,json=wifiBands,proto3" json:"wifi_bands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResidenceConstraints) Reset() {
+	*x = ResidenceConstraints{}
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResidenceConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResidenceConstraints) ProtoMessage() {}
+
+func (x *ResidenceConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResidenceConstraints.ProtoReflect.Descriptor instead.
+func (*ResidenceConstraints) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResidenceConstraints) GetHasSteps() bool {
+	if x != nil {
+		return x.HasSteps
+	}
+	return false
+}
+
+func (x *ResidenceConstraints) GetStepHeightMm() int32 {
+	if x != nil {
+		return x.StepHeightMm
+	}
+	return 0
+}
+
+func (x *ResidenceConstraints) GetFloorTypes() []string {
+	if x != nil {
+		return x.FloorTypes
+	}
+	return nil
+}
+
+func (x *ResidenceConstraints) GetHasWifi() bool {
+	if x != nil {
+		return x.HasWifi
+	}
+	return false
+}
+
+func (x *ResidenceConstraints) GetWifiBands() []string {
+	if x != nil {
+		return x.WifiBands
+	}
+	return nil
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
@@ -521,12 +612,21 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\vUserContext\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
-	"\tresidence\x18\x03 \x01(\v2\x16.user.v1.ResidenceInfoR\tresidence\"k\n" +
+	"\tresidence\x18\x03 \x01(\v2\x16.user.v1.ResidenceInfoR\tresidence\"\xac\x01\n" +
 	"\rResidenceInfo\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
 	"\x03age\x18\x02 \x01(\x05R\x03age\x12\x16\n" +
 	"\x06layout\x18\x03 \x01(\tR\x06layout\x12\x1c\n" +
-	"\townership\x18\x04 \x01(\tR\townership2}\n" +
+	"\townership\x18\x04 \x01(\tR\townership\x12?\n" +
+	"\vconstraints\x18\x05 \x01(\v2\x1d.user.v1.ResidenceConstraintsR\vconstraints\"\xb4\x01\n" +
+	"\x14ResidenceConstraints\x12\x1b\n" +
+	"\thas_steps\x18\x01 \x01(\bR\bhasSteps\x12$\n" +
+	"\x0estep_height_mm\x18\x02 \x01(\x05R\fstepHeightMm\x12\x1f\n" +
+	"\vfloor_types\x18\x03 \x03(\tR\n" +
+	"floorTypes\x12\x19\n" +
+	"\bhas_wifi\x18\x04 \x01(\bR\ahasWifi\x12\x1d\n" +
+	"\n" +
+	"wifi_bands\x18\x05 \x03(\tR\twifiBands2}\n" +
 	"\vAuthService\x127\n" +
 	"\x06Signup\x12\x16.user.v1.SignupRequest\x1a\x15.user.v1.AuthResponse\x125\n" +
 	"\x05Login\x12\x15.user.v1.LoginRequest\x1a\x15.user.v1.AuthResponse2\xa3\x01\n" +
@@ -546,7 +646,7 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_user_v1_user_proto_goTypes = []any{
 	(*SignupRequest)(nil),            // 0: user.v1.SignupRequest
 	(*LoginRequest)(nil),             // 1: user.v1.LoginRequest
@@ -556,24 +656,26 @@ var file_user_v1_user_proto_goTypes = []any{
 	(*UpdateUserContextRequest)(nil), // 5: user.v1.UpdateUserContextRequest
 	(*UserContext)(nil),              // 6: user.v1.UserContext
 	(*ResidenceInfo)(nil),            // 7: user.v1.ResidenceInfo
+	(*ResidenceConstraints)(nil),     // 8: user.v1.ResidenceConstraints
 }
 var file_user_v1_user_proto_depIdxs = []int32{
 	3, // 0: user.v1.AuthResponse.user:type_name -> user.v1.User
 	6, // 1: user.v1.UpdateUserContextRequest.context:type_name -> user.v1.UserContext
 	7, // 2: user.v1.UserContext.residence:type_name -> user.v1.ResidenceInfo
-	0, // 3: user.v1.AuthService.Signup:input_type -> user.v1.SignupRequest
-	1, // 4: user.v1.AuthService.Login:input_type -> user.v1.LoginRequest
-	4, // 5: user.v1.UserService.GetUserContext:input_type -> user.v1.GetUserContextRequest
-	5, // 6: user.v1.UserService.UpdateUserContext:input_type -> user.v1.UpdateUserContextRequest
-	2, // 7: user.v1.AuthService.Signup:output_type -> user.v1.AuthResponse
-	2, // 8: user.v1.AuthService.Login:output_type -> user.v1.AuthResponse
-	6, // 9: user.v1.UserService.GetUserContext:output_type -> user.v1.UserContext
-	6, // 10: user.v1.UserService.UpdateUserContext:output_type -> user.v1.UserContext
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 3: user.v1.ResidenceInfo.constraints:type_name -> user.v1.ResidenceConstraints
+	0, // 4: user.v1.AuthService.Signup:input_type -> user.v1.SignupRequest
+	1, // 5: user.v1.AuthService.Login:input_type -> user.v1.LoginRequest
+	4, // 6: user.v1.UserService.GetUserContext:input_type -> user.v1.GetUserContextRequest
+	5, // 7: user.v1.UserService.UpdateUserContext:input_type -> user.v1.UpdateUserContextRequest
+	2, // 8: user.v1.AuthService.Signup:output_type -> user.v1.AuthResponse
+	2, // 9: user.v1.AuthService.Login:output_type -> user.v1.AuthResponse
+	6, // 10: user.v1.UserService.GetUserContext:output_type -> user.v1.UserContext
+	6, // 11: user.v1.UserService.UpdateUserContext:output_type -> user.v1.UserContext
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -587,7 +689,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
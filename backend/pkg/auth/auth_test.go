@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testKey = []byte(strings.Repeat("k", 32))
+
+func TestTokenSigner(t *testing.T) {
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	signer, err := NewTokenSigner(testKey, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := signer.Issue("user-1", now)
+	if got, err := signer.Verify(token, now.Add(30*time.Minute)); err != nil || got != "user-1" {
+		t.Errorf("Verify = %q, %v", got, err)
+	}
+
+	other, _ := NewTokenSigner([]byte(strings.Repeat("x", 32)), time.Hour)
+	payload, _, _ := strings.Cut(token, ".")
+	forged := other.Issue("user-2", now)
+	_, forgedMAC, _ := strings.Cut(forged, ".")
+	for name, tc := range map[string]struct {
+		token string
+		at    time.Time
+	}{
+		"expired":      {token, now.Add(2 * time.Hour)},
+		"future":       {token, now.Add(-time.Hour)},
+		"other key":    {forged, now},
+		"swapped mac":  {payload + "." + forgedMAC, now},
+		"no signature": {payload, now},
+		"not base64":   {"!!!.!!!", now},
+		"empty":        {"", now},
+		"empty user":   {signer.Issue("", now), now},
+	} {
+		if _, err := signer.Verify(tc.token, tc.at); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: err = %v, want ErrInvalidToken", name, err)
+		}
+	}
+	if _, err := NewTokenSigner([]byte("short"), time.Hour); err == nil {
+		t.Error("short key was accepted")
+	}
+}
+
+func TestServerInterceptor(t *testing.T) {
+	const procedure = "/test.v1.WhoAmIService/WhoAmI"
+	signer, _ := NewTokenSigner(testKey, time.Hour)
+	handle := func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+		id, err := RequireUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(wrapperspb.String(id)), nil
+	}
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure, handle, connect.WithInterceptors(NewServerInterceptor(signer))))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](srv.Client(), srv.URL+procedure)
+
+	call := func(authorization string) (string, error) {
+		req := connect.NewRequest(wrapperspb.String(""))
+		if authorization != "" {
+			req.Header().Set("Authorization", authorization)
+		}
+		res, err := client.CallUnary(context.Background(), req)
+		if err != nil {
+			return "", err
+		}
+		return res.Msg.GetValue(), nil
+	}
+
+	if got, err := call("Bearer " + signer.Issue("user-1", time.Now())); err != nil || got != "user-1" {
+		t.Errorf("valid token: %q, %v", got, err)
+	}
+	for name, authorization := range map[string]string{
+		"no header":     "",
+		"invalid token": "Bearer abc.def",
+		"not bearer":    "Basic dXNlcjpwYXNz",
+	} {
+		if _, err := call(authorization); connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Errorf("%s: err = %v, want unauthenticated", name, err)
+		}
+	}
+}
+
+func TestForwardingInterceptor(t *testing.T) {
+	const procedure = "/test.v1.WhoAmIService/WhoAmI"
+	signer, _ := NewTokenSigner(testKey, time.Hour)
+	serve := func(handle func(context.Context, *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error)) *httptest.Server {
+		mux := http.NewServeMux()
+		mux.Handle(procedure, connect.NewUnaryHandler(procedure, handle, connect.WithInterceptors(NewServerInterceptor(signer))))
+		return httptest.NewServer(mux)
+	}
+
+	// 呼び出し先: ログイン中の利用者を返す
+	backend := serve(func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+		id, err := RequireUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(wrapperspb.String(id)), nil
+	})
+	defer backend.Close()
+	forwarding := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](backend.Client(), backend.URL+procedure, connect.WithInterceptors(NewForwardingInterceptor()))
+
+	// 呼び出し元: 受け付けたリクエストのまま呼び出し先を呼ぶ
+	frontend := serve(func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+		return forwarding.CallUnary(ctx, connect.NewRequest(req.Msg))
+	})
+	defer frontend.Close()
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](frontend.Client(), frontend.URL+procedure)
+
+	req := connect.NewRequest(wrapperspb.String(""))
+	req.Header().Set("Authorization", "Bearer "+signer.Issue("user-1", time.Now()))
+	if res, err := client.CallUnary(context.Background(), req); err != nil || res.Msg.GetValue() != "user-1" {
+		t.Errorf("forwarded call: %v, %v", res, err)
+	}
+	if _, err := client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(""))); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("anonymous call: err = %v, want unauthenticated", err)
+	}
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+)
+
+type userIDKey struct{}
+
+type accessTokenKey struct{}
+
+// WithUserID: 認証済みの利用者IDを ctx に入れます (インターセプターとテストで使います)。
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID: 認証済みの利用者IDを返します。未ログインのリクエストでは ok が false になります。
+func UserID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(userIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// RequireUserID: 認証済みの利用者IDを返します。未ログインなら Unauthenticated のエラーを返します。
+func RequireUserID(ctx context.Context) (string, error) {
+	id, ok := UserID(ctx)
+	if !ok {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("login required"))
+	}
+	return id, nil
+}
+
+// withAccessToken: 検証済みのアクセストークンを ctx に入れます (他サービスの呼び出しで引き継ぐため)。
+func withAccessToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, accessTokenKey{}, token)
+}
+
+// accessToken: ctx に入っている検証済みのアクセストークンを返します。
+func accessToken(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(accessTokenKey{}).(string)
+	return token, ok && token != ""
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// forwardingInterceptor: Connect クライアント用のインターセプター
+type forwardingInterceptor struct{}
+
+// NewForwardingInterceptor: 受け付けたリクエストのアクセストークンを、他サービスの呼び出しにも付けるインターセプターを作ります。
+//
+//	client := projectv1connect.NewProjectServiceClient(http.DefaultClient, baseURL,
+//		connect.WithInterceptors(auth.NewForwardingInterceptor()))
+//
+// 呼び出し先でも同じ利用者として扱われるので、「その利用者のデータか」の確認は呼び出し先に任せられます。
+// 未ログインのリクエストから呼び出した場合は、トークンを付けずに呼び出します。
+func NewForwardingInterceptor() connect.Interceptor {
+	return forwardingInterceptor{}
+}
+
+// WrapUnary: 単発の呼び出しにトークンを付けます。
+func (forwardingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			setAuthorization(ctx, req.Header())
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient: ストリームを開くときにトークンを付けます。
+func (forwardingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		setAuthorization(ctx, conn.RequestHeader())
+		return conn
+	}
+}
+
+// WrapStreamingHandler: サーバー側には何もしません。
+func (forwardingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// setAuthorization: 呼び出し側で Authorization を指定していなければ、ctx のトークンを付けます。
+func setAuthorization(ctx context.Context, header http.Header) {
+	token, ok := accessToken(ctx)
+	if !ok || header.Get("Authorization") != "" {
+		return
+	}
+	header.Set("Authorization", "Bearer "+token)
+}
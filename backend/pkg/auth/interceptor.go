@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// serverInterceptor: Connect サーバー用のインターセプター
+type serverInterceptor struct {
+	signer *TokenSigner
+	now    func() time.Time
+}
+
+// NewServerInterceptor: Authorization ヘッダーのアクセストークンを検証し、利用者IDを ctx に入れるインターセプターを作ります。
+//
+//	path, handler := catalogv1connect.NewProductServiceHandler(h,
+//		connect.WithInterceptors(auth.NewServerInterceptor(signer)))
+//
+// ヘッダーが無いリクエストは未ログインとしてそのまま通し、ログインが必要かどうかはハンドラが決めます (RequireUserID)。
+// トークンが不正・期限切れなら Unauthenticated で拒否します。
+func NewServerInterceptor(signer *TokenSigner) connect.Interceptor {
+	return &serverInterceptor{signer: signer, now: time.Now}
+}
+
+// WrapUnary: 単発の呼び出しのトークンを検証します。
+func (i *serverInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient: クライアント側には何もしません。
+func (i *serverInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler: ストリームを開くときのトークンを検証します。
+func (i *serverInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *serverInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	value := header.Get("Authorization")
+	if value == "" {
+		return ctx, nil
+	}
+	token, ok := strings.CutPrefix(value, "Bearer ")
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrInvalidToken)
+	}
+	token = strings.TrimSpace(token)
+	userID, err := i.signer.Verify(token, i.now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return withAccessToken(WithUserID(ctx, userID), token), nil
+}
@@ -0,0 +1,132 @@
+// Package eligibility: 製品の設置要件と住環境を照らし合わせる適合ルールです。
+// Catalog Service の EligibilityMatcher と、Simulation Service の評価用カタログが同じ基準で判定できるよう共有します。
+// 判定できる材料がない場合 (住環境の入力が空など) は却下しません。
+package eligibility
+
+// Code: 製品が住居に適合しない理由の種類
+type Code string
+
+const (
+	DrillingInRental       Code = "drilling_in_rental"
+	ElectricalWorkInRental Code = "electrical_work_in_rental"
+	Steps                  Code = "steps"
+	FloorType              Code = "floor_type"
+	ResidenceType          Code = "residence_type"
+	WiFi                   Code = "wifi"
+)
+
+// 通信規格の値 (Catalog Service の Protocol と同じ)
+const (
+	ProtocolWiFi24GHz = "wifi_2_4ghz"
+	ProtocolWiFi5GHz  = "wifi_5ghz"
+)
+
+// nonWiFiTransports: Wi-Fi の代わりに使える通信経路。これらを持つ製品は Wi-Fi 環境を問いません。
+var nonWiFiTransports = []string{"thread", "zigbee", "bluetooth"}
+
+// Requirements: 製品の設置要件と、通信規格
+type Requirements struct {
+	RequiresDrilling        bool
+	RequiresElectricalWork  bool
+	StepSensitive           bool
+	ClimbableStepMM         int
+	SupportedFloorTypes     []string // 空なら床材を問わない
+	SupportedResidenceTypes []string // 空なら住居の種類を問わない
+	Protocols               []string // 対応している通信規格 ("wifi_2_4ghz", "thread" など)
+}
+
+// WiFiEnvironment: 住居のWi-Fi環境
+type WiFiEnvironment struct {
+	Available bool
+	Bands     []string // 利用できる帯域。空なら両方使えるとみなす
+}
+
+// Residence: 判定に使う住環境
+type Residence struct {
+	Type         string
+	Ownership    string // "owned" / "rented" / "other"
+	HasSteps     bool
+	StepHeightMM int // 0なら不明
+	FloorTypes   []string
+	WiFi         *WiFiEnvironment // nilなら不明として判定しない
+}
+
+// WiFiIssue: Wi-Fi 環境との相性の問題
+type WiFiIssue int
+
+const (
+	WiFiOK           WiFiIssue = iota // 問題なし (Wi-Fi を使わない製品も含む)
+	WiFiMissing                       // Wi-Fi が必要だが住居に Wi-Fi がない
+	WiFiBandMismatch                  // 対応帯域が住居の Wi-Fi と合わない
+)
+
+// Check: 当てはまる却下理由を、ルールの順 (穴あけ・電気工事・段差・床材・住居タイプ・Wi-Fi) で返します。
+func Check(req Requirements, r Residence) []Code {
+	var codes []Code
+	rented := r.Ownership == "rented"
+	if req.RequiresDrilling && rented {
+		codes = append(codes, DrillingInRental)
+	}
+	if req.RequiresElectricalWork && rented {
+		codes = append(codes, ElectricalWorkInRental)
+	}
+	// 段差の高さが分かっていて、乗り越えられる高さ以内なら問題なし
+	if req.StepSensitive && r.HasSteps && (r.StepHeightMM == 0 || req.ClimbableStepMM < r.StepHeightMM) {
+		codes = append(codes, Steps)
+	}
+	if len(req.SupportedFloorTypes) > 0 && len(r.FloorTypes) > 0 && !containsAny(req.SupportedFloorTypes, r.FloorTypes) {
+		codes = append(codes, FloorType)
+	}
+	if len(req.SupportedResidenceTypes) > 0 && r.Type != "" && !contains(req.SupportedResidenceTypes, r.Type) {
+		codes = append(codes, ResidenceType)
+	}
+	if r.WiFi != nil && CheckWiFi(req.Protocols, *r.WiFi) != WiFiOK {
+		codes = append(codes, WiFi)
+	}
+	return codes
+}
+
+// CheckWiFi: Wi-Fi でしか繋がらない製品について、住居の Wi-Fi 環境と合うかを判定します。
+// Thread / Zigbee / Bluetooth など別の経路を持つ製品は対象外です。
+func CheckWiFi(protocols []string, w WiFiEnvironment) WiFiIssue {
+	bands := WiFiBands(protocols)
+	if len(bands) == 0 || containsAny(protocols, nonWiFiTransports) {
+		return WiFiOK
+	}
+	if !w.Available {
+		return WiFiMissing
+	}
+	if len(w.Bands) == 0 || containsAny(w.Bands, bands) {
+		return WiFiOK
+	}
+	return WiFiBandMismatch
+}
+
+// WiFiBands: 通信規格のうち Wi-Fi の帯域だけを返します。
+func WiFiBands(protocols []string) []string {
+	var bands []string
+	for _, p := range protocols {
+		if p == ProtocolWiFi24GHz || p == ProtocolWiFi5GHz {
+			bands = append(bands, p)
+		}
+	}
+	return bands
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(list, values []string) bool {
+	for _, v := range values {
+		if contains(list, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package eligibility
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	vacuum := Requirements{StepSensitive: true, ClimbableStepMM: 20, SupportedFloorTypes: []string{"flooring"}, Protocols: []string{ProtocolWiFi24GHz}}
+	tests := []struct {
+		name string
+		req  Requirements
+		r    Residence
+		want []Code
+	}{
+		{name: "unknown residence", req: vacuum, r: Residence{}, want: nil},
+		{name: "drilling in rental", req: Requirements{RequiresDrilling: true, RequiresElectricalWork: true}, r: Residence{Ownership: "rented"}, want: []Code{DrillingInRental, ElectricalWorkInRental}},
+		{name: "drilling in owned house", req: Requirements{RequiresDrilling: true}, r: Residence{Ownership: "owned"}, want: nil},
+		{name: "low step", req: vacuum, r: Residence{HasSteps: true, StepHeightMM: 15}, want: nil},
+		{name: "high step", req: vacuum, r: Residence{HasSteps: true, StepHeightMM: 30}, want: []Code{Steps}},
+		{name: "unknown step height", req: vacuum, r: Residence{HasSteps: true}, want: []Code{Steps}},
+		{name: "partly supported floors", req: vacuum, r: Residence{FloorTypes: []string{"tatami", "flooring"}}, want: nil},
+		{name: "unsupported floors", req: vacuum, r: Residence{FloorTypes: []string{"tatami"}}, want: []Code{FloorType}},
+		{name: "residence type", req: Requirements{SupportedResidenceTypes: []string{"house"}}, r: Residence{Type: "apartment"}, want: []Code{ResidenceType}},
+		{name: "no wifi", req: vacuum, r: Residence{WiFi: &WiFiEnvironment{}}, want: []Code{WiFi}},
+		{name: "5GHz only", req: vacuum, r: Residence{WiFi: &WiFiEnvironment{Available: true, Bands: []string{ProtocolWiFi5GHz}}}, want: []Code{WiFi}},
+		{name: "wifi with any band", req: vacuum, r: Residence{WiFi: &WiFiEnvironment{Available: true}}, want: nil},
+		{name: "other transport", req: Requirements{Protocols: []string{ProtocolWiFi24GHz, "thread"}}, r: Residence{WiFi: &WiFiEnvironment{}}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Check(tt.req, tt.r); !slices.Equal(got, tt.want) {
+				t.Errorf("Check = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
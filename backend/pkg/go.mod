@@ -1,3 +1,7 @@
 module github.com/kinoshitatakumi/opti/pkg
 
 go 1.24.1
+
+require connectrpc.com/connect v1.19.1
+
+require google.golang.org/protobuf v1.36.11
@@ -0,0 +1,88 @@
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen: サーキットブレーカーが遮断中のため、呼び出さずに失敗させたことを表します。
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// breakerState: サーキットブレーカーの状態
+type breakerState int
+
+const (
+	stateClosed   breakerState = iota // 通常どおり通す
+	stateOpen                         // 遮断中 (呼び出さずに失敗させる)
+	stateHalfOpen                     // 試しに1回だけ通し、結果で閉じるか再び遮断するかを決める
+)
+
+// CircuitBreaker: 呼び出し先ごとのサーキットブレーカー
+type CircuitBreaker struct {
+	policy BreakerPolicy
+	now    func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int       // 連続失敗回数
+	openedAt time.Time // 遮断した時刻
+	probing  bool      // half-open で試しの呼び出しが実行中
+}
+
+// NewCircuitBreaker: サーキットブレーカーの作成
+func NewCircuitBreaker(policy BreakerPolicy) *CircuitBreaker {
+	return &CircuitBreaker{policy: policy, now: time.Now}
+}
+
+// Allow: 呼び出してよいかを判定します。遮断中なら ErrCircuitOpen を返します。
+// 通した場合は、呼び出し後に必ず Record で結果を報告してください。
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.policy.OpenTimeout {
+			return ErrCircuitOpen
+		}
+		b.state = stateHalfOpen
+		b.probing = true
+		return nil
+	case stateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// Rejecting: 遮断中 (呼び出しを止めている最中) かを、状態を変えずに返します。
+func (b *CircuitBreaker) Rejecting() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case stateOpen:
+		return b.now().Sub(b.openedAt) < b.policy.OpenTimeout
+	case stateHalfOpen:
+		return b.probing
+	}
+	return false
+}
+
+// Record: 呼び出し結果を報告します。failure は「呼び出し先の障害による失敗」の場合だけ true にします。
+func (b *CircuitBreaker) Record(failure bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failure {
+		b.state = stateClosed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.policy.FailureThreshold {
+		b.state = stateOpen
+		b.openedAt = b.now()
+	}
+}
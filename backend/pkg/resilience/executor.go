@@ -0,0 +1,257 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// permanentError: リトライしてはいけないエラーの目印
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent: err を「リトライしないエラー」として包みます。
+// 例えばストリーミングで既に一部を返してしまった後の失敗など、やり直すと結果が重複する場合に使います。
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Executor: プロシージャごとの設定とサーキットブレーカーを持ち、呼び出しに耐障害性の仕組みを適用します。
+// サーキットブレーカーの状態を共有するため、呼び出し先ごとに1つ作って使い回してください。
+type Executor struct {
+	config Config
+	sleep  func(ctx context.Context, d time.Duration) error
+
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewExecutor: Executor の作成
+func NewExecutor(config Config) *Executor {
+	return &Executor{
+		config:   config,
+		sleep:    sleepContext,
+		breakers: make(map[string]*CircuitBreaker),
+	}
+}
+
+// breaker: プロシージャのサーキットブレーカーを返します (設定がなければ nil)。
+func (e *Executor) breaker(procedure string, policy *BreakerPolicy) *CircuitBreaker {
+	if policy == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b, ok := e.breakers[procedure]
+	if !ok {
+		b = NewCircuitBreaker(*policy)
+		e.breakers[procedure] = b
+	}
+	return b
+}
+
+// Call: fn を procedure の設定に従って呼び出します。
+// fn は試行ごとに呼ばれ、渡された ctx には1回分の制限時間が設定されています。
+func Call[T any](ctx context.Context, e *Executor, procedure string, fn func(ctx context.Context) (T, error)) (T, error) {
+	return call(ctx, e, procedure, e.config.For(procedure), fn)
+}
+
+func call[T any](ctx context.Context, e *Executor, procedure string, policy Policy, fn func(ctx context.Context) (T, error)) (T, error) {
+	breaker := e.breaker(procedure, policy.Breaker)
+	attempts := max(policy.Retry.MaxAttempts, 1)
+
+	var zero T
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := e.sleep(ctx, backoff(policy.Retry, attempt)); err != nil {
+				return zero, lastErr
+			}
+		}
+
+		// 1. サーキットブレーカー: 遮断中ならリトライせずに失敗
+		if breaker != nil {
+			if err := breaker.Allow(); err != nil {
+				return zero, connect.NewError(connect.CodeUnavailable, err)
+			}
+		}
+
+		// 2. 1回分の試行 (ヘッジ設定があれば並行して送る)
+		res, err := hedged(ctx, policy, fn)
+		if breaker != nil {
+			breaker.Record(err != nil && containsCode(policy.Breaker.FailureCodes, codeOf(err)))
+		}
+		if err == nil {
+			return res, nil
+		}
+		lastErr = err
+
+		// 3. 再試行してよいエラーか
+		var perm *permanentError
+		if errors.As(err, &perm) {
+			return zero, perm.err
+		}
+		if ctx.Err() != nil || !containsCode(policy.Retry.RetryableCodes, codeOf(err)) {
+			return zero, err
+		}
+	}
+	return zero, lastErr
+}
+
+// hedged: 1回分の試行を行います。ヘッジ設定があれば、遅いときに同じ呼び出しを追加で送り、最初の成功を返します。
+func hedged[T any](ctx context.Context, policy Policy, fn func(ctx context.Context) (T, error)) (T, error) {
+	if policy.Hedge == nil || policy.Hedge.MaxHedges <= 0 {
+		return once(ctx, policy.Timeout, fn)
+	}
+	res, cancel, err := hedge(ctx, *policy.Hedge, func(ctx context.Context) (T, error) {
+		return once(ctx, policy.Timeout, fn)
+	}, func(_ T, err error) bool { return err == nil }, nil)
+	cancel()
+	return res, err
+}
+
+// hedge: fn を送り、Delay ごと (または失敗したとき) に追加で送って、succeeded を満たす最初の結果を返します。
+// すべて失敗した場合は最後の結果を返します。返さなかった結果は discard に渡し、その呼び出しは中断します。
+// 戻り値の CancelFunc は返した結果の呼び出しのもので、結果を使い終わったら呼んでください。
+func hedge[T any](
+	ctx context.Context,
+	policy HedgePolicy,
+	fn func(ctx context.Context) (T, error),
+	succeeded func(T, error) bool,
+	discard func(T),
+) (T, context.CancelFunc, error) {
+	type result struct {
+		index int
+		res   T
+		err   error
+	}
+	total := policy.MaxHedges + 1
+	results := make(chan result, total)
+	var cancels []context.CancelFunc
+	launch := func() {
+		callCtx, cancel := context.WithCancel(ctx)
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			res, err := fn(callCtx)
+			results <- result{index, res, err}
+		}()
+	}
+	drop := func(r result) {
+		if discard != nil {
+			discard(r.res)
+		}
+		cancels[r.index]()
+	}
+
+	launch()
+	timer := time.NewTimer(policy.Delay)
+	defer timer.Stop()
+	var last result
+	received, won := 0, false
+	for !won && received < len(cancels) {
+		select {
+		case r := <-results:
+			if received > 0 {
+				drop(last)
+			}
+			received++
+			last = r
+			won = succeeded(r.res, r.err)
+			// 失敗した場合は、待たずに次のヘッジを送る
+			if !won && received == len(cancels) && len(cancels) < total {
+				launch()
+			}
+		case <-timer.C:
+			if len(cancels) < total {
+				launch()
+				timer.Reset(policy.Delay)
+			}
+		}
+	}
+
+	// 実行中の残りの呼び出しを中断し、結果が返ってきたら捨てる
+	for i, cancel := range cancels {
+		if i != last.index {
+			cancel()
+		}
+	}
+	if pending := len(cancels) - received; pending > 0 {
+		go func() {
+			for range pending {
+				drop(<-results)
+			}
+		}()
+	}
+	return last.res, cancels[last.index], last.err
+}
+
+// once: 制限時間付きで fn を1回呼びます。
+func once[T any](ctx context.Context, timeout time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return fn(ctx)
+}
+
+// backoff: attempt 回目のリトライ前の待ち時間です。
+// 上限 min(MaxBackoff, InitialBackoff × Multiplier^(attempt-1)) の範囲でランダムに決めます (フルジッター)。
+// 同時に失敗した複数のクライアントが、同じタイミングで再試行しないようにするためです。
+func backoff(p RetryPolicy, attempt int) time.Duration {
+	if p.InitialBackoff <= 0 {
+		return 0
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	ceiling := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && ceiling > float64(p.MaxBackoff) {
+		ceiling = float64(p.MaxBackoff)
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// codeOf: エラーを Connect のコードに分類します。
+// Connect 以外のエラーでも、タイムアウトやキャンセルは対応するコードとして扱います。
+func codeOf(err error) connect.Code {
+	var perm *permanentError
+	if errors.As(err, &perm) {
+		err = perm.err
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.CodeDeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return connect.CodeCanceled
+	}
+	return connect.CodeOf(err)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
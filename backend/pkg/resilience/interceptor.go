@@ -0,0 +1,122 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"connectrpc.com/connect"
+)
+
+// clientInterceptor: Connect クライアント用のインターセプター
+type clientInterceptor struct {
+	executor *Executor
+}
+
+// NewClientInterceptor: Connect クライアントの呼び出しに Executor の設定を適用するインターセプターを作ります。
+//
+//	client := catalogv1connect.NewProductServiceClient(http.DefaultClient, url,
+//		connect.WithInterceptors(resilience.NewClientInterceptor(executor)))
+//
+// 設定はプロシージャ名 (例: "/catalog.v1.ProductService/GetProduct") ごとに引かれます。
+// リトライは単発 (unary) の呼び出しにだけ適用し、ストリーミングはサーキットブレーカーの判定だけ行います。
+// Connect のリクエストは並行して送れないため、ヘッジは NewTransport で HTTP の層で行います。
+func NewClientInterceptor(executor *Executor) connect.Interceptor {
+	return &clientInterceptor{executor: executor}
+}
+
+// WrapUnary: 単発の呼び出しを Call で包みます。
+func (i *clientInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		policy := i.executor.config.For(req.Spec().Procedure)
+		policy.Hedge = nil
+		return call(ctx, i.executor, req.Spec().Procedure, policy, func(ctx context.Context) (connect.AnyResponse, error) {
+			return next(ctx, req)
+		})
+	}
+}
+
+// WrapStreamingClient: 遮断中のプロシージャへのストリームを開かずに失敗させます。
+// 通したストリームは、最後の受信結果 (正常終了なら io.EOF) をサーキットブレーカーに記録します。
+// ストリームは途中まで送受信した後にやり直せないため、リトライはしません。
+func (i *clientInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		policy := i.executor.config.For(spec.Procedure)
+		b := i.executor.breaker(spec.Procedure, policy.Breaker)
+		if b == nil {
+			return next(ctx, spec)
+		}
+		if err := b.Allow(); err != nil {
+			return &failedStream{spec: spec, err: connect.NewError(connect.CodeUnavailable, err)}
+		}
+		return &recordedStream{StreamingClientConn: next(ctx, spec), breaker: b, failureCodes: policy.Breaker.FailureCodes}
+	}
+}
+
+// WrapStreamingHandler: サーバー側には何もしません。
+func (i *clientInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// failedStream: 送受信のたびに同じエラーを返すストリーム
+// 呼び出し先へは接続しないので、ヘッダーなどは空のものを返します。
+type failedStream struct {
+	spec            connect.Spec
+	err             error
+	requestHeader   http.Header
+	responseHeader  http.Header
+	responseTrailer http.Header
+}
+
+func (s *failedStream) Spec() connect.Spec           { return s.spec }
+func (s *failedStream) Peer() connect.Peer           { return connect.Peer{} }
+func (s *failedStream) Send(any) error               { return s.err }
+func (s *failedStream) Receive(any) error            { return s.err }
+func (s *failedStream) CloseRequest() error          { return nil }
+func (s *failedStream) CloseResponse() error         { return nil }
+func (s *failedStream) RequestHeader() http.Header   { return lazyHeader(&s.requestHeader) }
+func (s *failedStream) ResponseHeader() http.Header  { return lazyHeader(&s.responseHeader) }
+func (s *failedStream) ResponseTrailer() http.Header { return lazyHeader(&s.responseTrailer) }
+
+func lazyHeader(h *http.Header) http.Header {
+	if *h == nil {
+		*h = make(http.Header)
+	}
+	return *h
+}
+
+// recordedStream: ストリームの結果をサーキットブレーカーに1回だけ記録します。
+// 受信が io.EOF で終われば成功、それ以外のエラーは FailureCodes に含まれるコードなら失敗とします。
+// 最後まで受信せずに閉じた場合は、呼び出し先の障害ではないので成功として記録します。
+type recordedStream struct {
+	connect.StreamingClientConn
+	breaker      *CircuitBreaker
+	failureCodes []connect.Code
+	once         sync.Once
+}
+
+func (s *recordedStream) Receive(msg any) error {
+	err := s.StreamingClientConn.Receive(msg)
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF):
+		s.record(false)
+	default:
+		s.record(containsCode(s.failureCodes, codeOf(err)))
+	}
+	return err
+}
+
+func (s *recordedStream) CloseResponse() error {
+	s.record(false)
+	return s.StreamingClientConn.CloseResponse()
+}
+
+func (s *recordedStream) record(failure bool) {
+	s.once.Do(func() { s.breaker.Record(failure) })
+}
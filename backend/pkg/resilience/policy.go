@@ -0,0 +1,107 @@
+// Package resilience: 他サービスや外部API (LLMなど) を呼び出す際の耐障害性の仕組みをまとめたパッケージです。
+//
+//   - リトライ: 再試行して良いエラーコードのときだけ、ジッター付き指数バックオフで再試行します
+//   - タイムアウト: 1回の呼び出し (試行) ごとに制限時間を設けます
+//   - サーキットブレーカー: 失敗が続いた呼び出し先への呼び出しを一時的に止め、すぐにエラーを返します
+//   - ヘッジリクエスト: 読み取り系の呼び出しが遅いとき、同じリクエストを並行して送り、早く返った方を使います
+//
+// 設定はプロシージャ (例: "/catalog.v1.ProductService/ListEligibleProducts") ごとに変えられます。
+// Connect のクライアントには NewClientInterceptor を、それ以外の呼び出しには Call を使います。
+package resilience
+
+import (
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// DefaultRetryableCodes: 既定で再試行するエラーコードです。
+// 一時的な障害を表すコードだけを対象にし、入力の不正 (InvalidArgument など) は再試行しません。
+var DefaultRetryableCodes = []connect.Code{
+	connect.CodeUnavailable,
+	connect.CodeDeadlineExceeded,
+	connect.CodeResourceExhausted,
+	connect.CodeAborted,
+}
+
+// DefaultFailureCodes: サーキットブレーカーが「呼び出し先の障害」として数えるエラーコードです。
+var DefaultFailureCodes = []connect.Code{
+	connect.CodeUnavailable,
+	connect.CodeDeadlineExceeded,
+	connect.CodeInternal,
+	connect.CodeUnknown,
+}
+
+// RetryPolicy: リトライの設定
+type RetryPolicy struct {
+	MaxAttempts    int           // 最初の1回を含む試行回数 (1以下ならリトライしない)
+	InitialBackoff time.Duration // 1回目のリトライ前の待ち時間の上限
+	MaxBackoff     time.Duration // 待ち時間の上限
+	Multiplier     float64       // リトライごとに待ち時間の上限を何倍にするか
+	RetryableCodes []connect.Code
+}
+
+// BreakerPolicy: サーキットブレーカーの設定
+type BreakerPolicy struct {
+	FailureThreshold int           // 連続で何回失敗したら遮断するか
+	OpenTimeout      time.Duration // 遮断してから試しに1回通すまでの時間
+	FailureCodes     []connect.Code
+}
+
+// HedgePolicy: ヘッジリクエストの設定 (副作用のない読み取り系の呼び出しにだけ使ってください)
+type HedgePolicy struct {
+	Delay     time.Duration // 最初のリクエストからこの時間が経っても返らなければ、追加で送る
+	MaxHedges int           // 追加で送る最大数
+}
+
+// Policy: 1つのプロシージャに適用する設定一式
+// 各項目はゼロ値なら無効です (Retry.MaxAttempts が0ならリトライしない、Breaker が nil なら遮断しない、など)。
+type Policy struct {
+	Timeout time.Duration // 1回の試行あたりの制限時間
+	Retry   RetryPolicy
+	Breaker *BreakerPolicy
+	Hedge   *HedgePolicy
+}
+
+// DefaultPolicy: 一般的なサービス間呼び出し向けの設定です。
+func DefaultPolicy() Policy {
+	return Policy{
+		Timeout: 5 * time.Second,
+		Retry: RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     2 * time.Second,
+			Multiplier:     2,
+			RetryableCodes: DefaultRetryableCodes,
+		},
+		Breaker: &BreakerPolicy{
+			FailureThreshold: 5,
+			OpenTimeout:      10 * time.Second,
+			FailureCodes:     DefaultFailureCodes,
+		},
+	}
+}
+
+// Config: プロシージャごとの設定
+// Procedures にないプロシージャには Default を使います。
+type Config struct {
+	Default    Policy
+	Procedures map[string]Policy
+}
+
+// For: プロシージャに適用する設定を返します。
+func (c Config) For(procedure string) Policy {
+	if p, ok := c.Procedures[procedure]; ok {
+		return p
+	}
+	return c.Default
+}
+
+func containsCode(codes []connect.Code, code connect.Code) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
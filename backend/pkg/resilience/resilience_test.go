@@ -0,0 +1,365 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testProcedure  = "/test.v1.EchoService/Echo"
+	otherProcedure = "/test.v1.EchoService/Other"
+)
+
+// faultServer: 呼び出しごとに fault で決めた障害を起こす Connect サーバー
+type faultServer struct {
+	calls atomic.Int32
+	fault func(call int32) (delay time.Duration, err error)
+}
+
+func newFaultServer(t *testing.T, fault func(call int32) (time.Duration, error)) (*faultServer, *httptest.Server) {
+	t.Helper()
+	fs := &faultServer{fault: fault}
+	handle := func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+		delay, err := fs.fault(fs.calls.Add(1))
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(wrapperspb.String(req.Msg.GetValue())), nil
+	}
+	mux := http.NewServeMux()
+	mux.Handle(testProcedure, connect.NewUnaryHandler(testProcedure, handle))
+	mux.Handle(otherProcedure, connect.NewUnaryHandler(otherProcedure, handle))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return fs, srv
+}
+
+func newTestClient(srv *httptest.Server, procedure string, executor *Executor) *connect.Client[wrapperspb.StringValue, wrapperspb.StringValue] {
+	httpClient := &http.Client{Transport: NewTransport(executor, srv.Client().Transport)}
+	return connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		httpClient, srv.URL+procedure,
+		connect.WithInterceptors(NewClientInterceptor(executor)),
+	)
+}
+
+func fastRetry(attempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    attempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+		RetryableCodes: DefaultRetryableCodes,
+	}
+}
+
+func echo(ctx context.Context, client *connect.Client[wrapperspb.StringValue, wrapperspb.StringValue]) error {
+	_, err := client.CallUnary(ctx, connect.NewRequest(wrapperspb.String("ping")))
+	return err
+}
+
+func TestRetryRecoversFromTransientFailures(t *testing.T) {
+	fs, srv := newFaultServer(t, func(call int32) (time.Duration, error) {
+		if call < 3 {
+			return 0, connect.NewError(connect.CodeUnavailable, errors.New("overloaded"))
+		}
+		return 0, nil
+	})
+	client := newTestClient(srv, testProcedure, NewExecutor(Config{Default: Policy{Retry: fastRetry(3)}}))
+
+	if err := echo(context.Background(), client); err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if got := fs.calls.Load(); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestRetrySkipsNonRetryableCodes(t *testing.T) {
+	fs, srv := newFaultServer(t, func(int32) (time.Duration, error) {
+		return 0, connect.NewError(connect.CodeInvalidArgument, errors.New("bad request"))
+	})
+	client := newTestClient(srv, testProcedure, NewExecutor(Config{Default: Policy{Retry: fastRetry(5)}}))
+
+	err := echo(context.Background(), client)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if got := fs.calls.Load(); got != 1 {
+		t.Fatalf("expected a single call, got %d", got)
+	}
+}
+
+func TestPerCallTimeoutIsRetried(t *testing.T) {
+	fs, srv := newFaultServer(t, func(call int32) (time.Duration, error) {
+		if call == 1 {
+			return time.Second, nil
+		}
+		return 0, nil
+	})
+	client := newTestClient(srv, testProcedure, NewExecutor(Config{Default: Policy{
+		Timeout: 50 * time.Millisecond,
+		Retry:   fastRetry(2),
+	}}))
+
+	start := time.Now()
+	if err := echo(context.Background(), client); err != nil {
+		t.Fatalf("expected success on second attempt, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("timeout did not cut the slow call: took %v", elapsed)
+	}
+	if got := fs.calls.Load(); got != 2 {
+		t.Fatalf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestCircuitBreakerOpensAndRecovers(t *testing.T) {
+	var healthy atomic.Bool
+	fs, srv := newFaultServer(t, func(int32) (time.Duration, error) {
+		if healthy.Load() {
+			return 0, nil
+		}
+		return 0, connect.NewError(connect.CodeUnavailable, errors.New("down"))
+	})
+	executor := NewExecutor(Config{Default: Policy{
+		Retry: fastRetry(1),
+		Breaker: &BreakerPolicy{
+			FailureThreshold: 3,
+			OpenTimeout:      50 * time.Millisecond,
+			FailureCodes:     DefaultFailureCodes,
+		},
+	}})
+	client := newTestClient(srv, testProcedure, executor)
+
+	for range 3 {
+		if err := echo(context.Background(), client); connect.CodeOf(err) != connect.CodeUnavailable {
+			t.Fatalf("expected Unavailable, got %v", err)
+		}
+	}
+	// 遮断中はサーバーまで届かない
+	err := echo(context.Background(), client)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if got := fs.calls.Load(); got != 3 {
+		t.Fatalf("expected open circuit to skip the server, got %d calls", got)
+	}
+
+	// OpenTimeout 後の試しの呼び出しが成功すれば閉じる
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	for range 2 {
+		if err := echo(context.Background(), client); err != nil {
+			t.Fatalf("expected recovery, got %v", err)
+		}
+	}
+	if got := fs.calls.Load(); got != 5 {
+		t.Fatalf("expected 5 calls, got %d", got)
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	fs, srv := newFaultServer(t, func(int32) (time.Duration, error) {
+		return 0, connect.NewError(connect.CodeNotFound, errors.New("missing"))
+	})
+	client := newTestClient(srv, testProcedure, NewExecutor(Config{Default: Policy{
+		Breaker: &BreakerPolicy{FailureThreshold: 1, OpenTimeout: time.Minute, FailureCodes: DefaultFailureCodes},
+	}}))
+
+	for range 3 {
+		if err := echo(context.Background(), client); connect.CodeOf(err) != connect.CodeNotFound {
+			t.Fatalf("expected NotFound, got %v", err)
+		}
+	}
+	if got := fs.calls.Load(); got != 3 {
+		t.Fatalf("expected every call to reach the server, got %d", got)
+	}
+}
+
+func TestHedgedReadReturnsFastestResponse(t *testing.T) {
+	fs, srv := newFaultServer(t, func(call int32) (time.Duration, error) {
+		if call == 1 {
+			return time.Second, nil
+		}
+		return 0, nil
+	})
+	client := newTestClient(srv, testProcedure, NewExecutor(Config{Default: Policy{
+		Hedge: &HedgePolicy{Delay: 20 * time.Millisecond, MaxHedges: 1},
+	}}))
+
+	start := time.Now()
+	if err := echo(context.Background(), client); err != nil {
+		t.Fatalf("expected hedged success, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("hedge did not win over the slow call: took %v", elapsed)
+	}
+	if got := fs.calls.Load(); got != 2 {
+		t.Fatalf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestCallHedgesPlainFunctions(t *testing.T) {
+	var calls atomic.Int32
+	executor := NewExecutor(Config{Default: Policy{
+		Hedge: &HedgePolicy{Delay: 20 * time.Millisecond, MaxHedges: 2},
+	}})
+
+	start := time.Now()
+	got, err := Call(context.Background(), executor, "read", func(ctx context.Context) (int32, error) {
+		n := calls.Add(1)
+		if n == 1 {
+			<-ctx.Done() // 最初の呼び出しは、中断されるまで返らない
+			return 0, ctx.Err()
+		}
+		return n, nil
+	})
+	if err != nil || got != 2 {
+		t.Fatalf("expected the hedge to win, got %d, %v", got, err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("hedge did not win over the slow call: took %v", elapsed)
+	}
+}
+
+func TestPolicyIsSelectedPerProcedure(t *testing.T) {
+	fs, srv := newFaultServer(t, func(int32) (time.Duration, error) {
+		return 0, connect.NewError(connect.CodeUnavailable, errors.New("down"))
+	})
+	executor := NewExecutor(Config{
+		Default:    Policy{Retry: fastRetry(3)},
+		Procedures: map[string]Policy{otherProcedure: {Retry: fastRetry(1)}},
+	})
+
+	_ = echo(context.Background(), newTestClient(srv, otherProcedure, executor))
+	if got := fs.calls.Load(); got != 1 {
+		t.Fatalf("expected override without retries, got %d calls", got)
+	}
+	_ = echo(context.Background(), newTestClient(srv, testProcedure, executor))
+	if got := fs.calls.Load(); got != 4 {
+		t.Fatalf("expected default policy with 3 attempts, got %d calls", got-1)
+	}
+}
+
+func TestCallWrapsPlainHTTP(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	executor := NewExecutor(Config{Default: Policy{Retry: fastRetry(3)}})
+
+	status, err := Call(context.Background(), executor, "http.get", func(ctx context.Context) (int, error) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		resp, err := srv.Client().Do(req)
+		if err != nil {
+			return 0, connect.NewError(connect.CodeUnavailable, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusServiceUnavailable {
+			return 0, connect.NewError(connect.CodeUnavailable, errors.New(resp.Status))
+		}
+		return resp.StatusCode, nil
+	})
+	if err != nil || status != http.StatusOK {
+		t.Fatalf("expected 200 after retry, got %d, %v", status, err)
+	}
+
+	calls.Store(0)
+	_, err = Call(context.Background(), executor, "http.get", func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		return 0, Permanent(connect.NewError(connect.CodeUnavailable, errors.New("partial response")))
+	})
+	if connect.CodeOf(err) != connect.CodeUnavailable || calls.Load() != 1 {
+		t.Fatalf("expected permanent error without retry, got %v after %d calls", err, calls.Load())
+	}
+}
+
+const streamProcedure = "/test.v1.EchoService/EchoStream"
+
+// newStreamServer: ストリームごとに fail で決めたエラーを返すサーバーストリーミングのサーバー
+func newStreamServer(t *testing.T, fail func(call int32) error) (*atomic.Int32, *httptest.Server) {
+	t.Helper()
+	var calls atomic.Int32
+	handle := func(ctx context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+		if err := stream.Send(wrapperspb.String(req.Msg.GetValue())); err != nil {
+			return err
+		}
+		return fail(calls.Add(1))
+	}
+	mux := http.NewServeMux()
+	mux.Handle(streamProcedure, connect.NewServerStreamHandler(streamProcedure, handle))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return &calls, srv
+}
+
+func echoStream(ctx context.Context, client *connect.Client[wrapperspb.StringValue, wrapperspb.StringValue]) error {
+	stream, err := client.CallServerStream(ctx, connect.NewRequest(wrapperspb.String("ping")))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	for stream.Receive() {
+	}
+	return stream.Err()
+}
+
+func TestCircuitBreakerRecordsStreams(t *testing.T) {
+	var healthy atomic.Bool
+	calls, srv := newStreamServer(t, func(int32) error {
+		if healthy.Load() {
+			return nil
+		}
+		return connect.NewError(connect.CodeUnavailable, errors.New("down"))
+	})
+	executor := NewExecutor(Config{Default: Policy{
+		Breaker: &BreakerPolicy{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond, FailureCodes: DefaultFailureCodes},
+	}})
+	client := newTestClient(srv, streamProcedure, executor)
+
+	// ストリームの途中で返ったエラーも失敗として数える
+	for range 2 {
+		if err := echoStream(context.Background(), client); connect.CodeOf(err) != connect.CodeUnavailable {
+			t.Fatalf("expected Unavailable, got %v", err)
+		}
+	}
+	// 遮断中はストリームを開かない
+	if err := echoStream(context.Background(), client); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected open circuit to skip the server, got %d calls", got)
+	}
+
+	// OpenTimeout 後の試しのストリームが最後まで成功すれば閉じる
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	for range 2 {
+		if err := echoStream(context.Background(), client); err != nil {
+			t.Fatalf("expected recovery, got %v", err)
+		}
+	}
+	if got := calls.Load(); got != 4 {
+		t.Fatalf("expected 4 calls, got %d", got)
+	}
+}
@@ -0,0 +1,86 @@
+package resilience
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+// hedgingTransport: ヘッジリクエストを HTTP の層で行う RoundTripper
+type hedgingTransport struct {
+	executor *Executor
+	base     http.RoundTripper
+}
+
+// NewTransport: Executor のヘッジ設定を適用する http.RoundTripper を作ります。
+// 設定は URL のパス (Connect ではプロシージャ名と同じ) ごとに引かれ、ヘッジ設定がなければ base にそのまま渡します。
+// base が nil なら http.DefaultTransport を使います。
+//
+//	httpClient := &http.Client{Transport: resilience.NewTransport(executor, nil)}
+//	client := catalogv1connect.NewProductServiceClient(httpClient, url,
+//		connect.WithInterceptors(resilience.NewClientInterceptor(executor)))
+func NewTransport(executor *Executor, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &hedgingTransport{executor: executor, base: base}
+}
+
+// RoundTrip: ヘッジ設定のあるパスなら、同じリクエストを複製して並行に送ります。
+// サーバーエラー (5xx) 以外のレスポンスが最初に返ったものを採用します。
+func (t *hedgingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	policy := t.executor.config.For(req.URL.Path)
+	if policy.Hedge == nil || policy.Hedge.MaxHedges <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	// 1. 複製して送れるように、ボディを読み込んでおく (単発の呼び出しなので小さい)
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. ヘッジして送る
+	resp, cancel, err := hedge(req.Context(), *policy.Hedge, func(ctx context.Context) (*http.Response, error) {
+		clone := req.Clone(ctx)
+		if body != nil {
+			clone.Body = io.NopCloser(bytes.NewReader(body))
+			clone.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+		return t.base.RoundTrip(clone)
+	}, func(resp *http.Response, err error) bool {
+		return err == nil && resp.StatusCode < http.StatusInternalServerError
+	}, func(resp *http.Response) {
+		if resp != nil {
+			resp.Body.Close()
+		}
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// 3. 採用したレスポンスのボディを読み終えるまで、その呼び出しを中断しない
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose: Close したときに呼び出しの context も解放するボディ
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,202 @@
+// catalogctl: 製品カタログを CSV / NDJSON で一括登録・出力するコマンドです。
+//
+//	go run ./cmd/catalogctl import -file products.csv -dry-run
+//	go run ./cmd/catalogctl export -format ndjson -o products.ndjson
+//
+// 接続先は -addr (未指定なら CATALOG_SERVICE_URL、それも無ければ http://localhost:8080) です。
+// import で1行でも失敗した場合は終了コード1で終わります。
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/kinoshitatakumi/opti/gen/go/catalog/v1"
+	"github.com/kinoshitatakumi/opti/gen/go/catalog/v1/catalogv1connect"
+	"golang.org/x/net/http2"
+)
+
+// chunkSize: ImportProducts で1メッセージに詰める大きさ
+const chunkSize = 32 * 1024
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		usage()
+	}
+	switch os.Args[1] {
+	case "import":
+		os.Exit(runImport(os.Args[2:]))
+	case "export":
+		os.Exit(runExport(os.Args[2:]))
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: catalogctl import|export [flags]")
+	os.Exit(2)
+}
+
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	addr := fs.String("addr", defaultAddr(), "Catalog Service のURL")
+	file := fs.String("file", "-", "読み込むファイル (- なら標準入力)")
+	format := fs.String("format", "", "csv / ndjson (未指定ならファイルの拡張子から判断)")
+	dryRun := fs.Bool("dry-run", false, "保存せず、件数とエラーだけを確認する")
+	_ = fs.Parse(args)
+
+	// 1. 入力ファイルと形式の決定
+	if *format == "" {
+		*format = formatFromExt(*file)
+	}
+	if *format == "" {
+		log.Fatalf("cannot infer the format of %s, specify -format csv or -format ndjson", *file)
+	}
+	var in io.Reader = os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Fatalf("failed to open %s: %v", *file, err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	// 2. 最初に形式などの設定を送り、続けてファイルの中身を chunk に分けて送る
+	stream := newClient(*addr).ImportProducts(context.Background())
+	if err := stream.Send(&catalogv1.ImportProductsRequest{
+		Payload: &catalogv1.ImportProductsRequest_Options{Options: &catalogv1.ImportOptions{Format: *format, DryRun: *dryRun}},
+	}); err != nil && !errors.Is(err, io.EOF) {
+		log.Fatalf("failed to send options: %v", err)
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&catalogv1.ImportProductsRequest{
+				Payload: &catalogv1.ImportProductsRequest_Chunk{Chunk: append([]byte(nil), buf[:n]...)},
+			})
+			// サーバーが途中で失敗した場合は io.EOF になるので、理由は CloseAndReceive で受け取ります
+			if errors.Is(sendErr, io.EOF) {
+				break
+			}
+			if sendErr != nil {
+				log.Fatalf("failed to send chunk: %v", sendErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("failed to read %s: %v", *file, err)
+		}
+	}
+	res, err := stream.CloseAndReceive()
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+
+	// 3. 結果の表示
+	report := res.Msg
+	for _, e := range report.Errors {
+		fmt.Fprintf(os.Stderr, "line %d (sku %q): %s\n", e.Line, e.Sku, e.Message)
+	}
+	if extra := int(report.Failed) - len(report.Errors); extra > 0 {
+		fmt.Fprintf(os.Stderr, "... and %d more errors\n", extra)
+	}
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run, nothing saved)"
+	}
+	fmt.Printf("created %d, updated %d, unchanged %d, failed %d%s\n", report.Created, report.Updated, report.Unchanged, report.Failed, mode)
+	if report.Failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	addr := fs.String("addr", defaultAddr(), "Catalog Service のURL")
+	format := fs.String("format", "", "csv / ndjson (未指定なら -o の拡張子から判断し、それも無ければ csv)")
+	out := fs.String("o", "", "出力先 (空なら標準出力)")
+	_ = fs.Parse(args)
+
+	if *format == "" {
+		*format = formatFromExt(*out)
+	}
+	if *format == "" {
+		*format = "csv"
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("failed to create %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	stream, err := newClient(*addr).ExportProducts(context.Background(), connect.NewRequest(&catalogv1.ExportProductsRequest{Format: *format}))
+	if err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+	defer stream.Close()
+	for stream.Receive() {
+		if _, err := w.Write(stream.Msg().Chunk); err != nil {
+			log.Fatalf("failed to write: %v", err)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+	return 0
+}
+
+func defaultAddr() string {
+	if u := os.Getenv("CATALOG_SERVICE_URL"); u != "" {
+		return u
+	}
+	return "http://localhost:8080"
+}
+
+// formatFromExt: 拡張子から形式を判断します。判断できなければ空文字を返します。
+func formatFromExt(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	}
+	return ""
+}
+
+// newClient: クライアントの作成
+// クライアントストリーミングは HTTP/2 が必要なため、http:// の接続先には h2c (暗号化なしHTTP/2) で接続します。
+func newClient(addr string) catalogv1connect.ProductServiceClient {
+	httpClient := http.DefaultClient
+	if strings.HasPrefix(addr, "http://") {
+		httpClient = &http.Client{Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		}}
+	}
+	return catalogv1connect.NewProductServiceClient(httpClient, addr)
+}
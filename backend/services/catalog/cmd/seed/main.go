@@ -0,0 +1,87 @@
+// seed: 開発用のフィクスチャ (YAML / JSON) を製品リポジトリに読み込むコマンドです。
+//
+//	go run ./cmd/seed -fixtures fixtures/products -firestore-project my-project
+//	go run ./cmd/seed -fixtures fixtures/products -dry-run
+//
+// 製品はSKUで作成・更新するので、何度実行しても同じ状態になります。
+// 不正な製品が1つでもあれば何も保存せず、終了コード1で終わります。
+// -dry-run ではリポジトリに接続せず、フィクスチャが業務ルールを満たしているかだけを確かめます。
+// 起動中のメモリ版サーバーに読み込む場合は、サーバーを -seed 付きで起動してください。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/bulk"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
+)
+
+func main() {
+	fixtures := flag.String("fixtures", "fixtures/products", "フィクスチャのファイルまたはディレクトリ")
+	project := flag.String("firestore-project", os.Getenv("GOOGLE_CLOUD_PROJECT"), "読み込み先の Firestore のプロジェクトID")
+	dryRun := flag.Bool("dry-run", false, "保存せず、検証と件数の確認だけを行う")
+	flag.Parse()
+	ctx := context.Background()
+
+	// 1. 読み込み先のリポジトリ
+	var repo repository.ProductRepository
+	var history repository.PriceHistoryRepository
+	var drops repository.PriceDropRepository
+	switch {
+	case *dryRun:
+		repo = db.NewMemoryProductRepository()
+		history = db.NewMemoryPriceHistoryRepository()
+		drops = db.NewMemoryPriceDropRepository()
+	case *project != "":
+		client, err := db.NewFirestoreClient(ctx, *project)
+		if err != nil {
+			log.Fatalf("failed to connect to firestore: %v", err)
+		}
+		defer client.Close()
+		repo = db.NewFirestoreProductRepository(client)
+		history = db.NewFirestorePriceHistoryRepository(client)
+		drops = db.NewFirestorePriceDropRepository(client)
+	default:
+		log.Fatal("specify -firestore-project (or GOOGLE_CLOUD_PROJECT), or -dry-run to only validate the fixtures")
+	}
+	prices := usecase.NewPriceUsecase(history, drops, service.NewPriceDropDetector(service.DefaultPriceDropWindow))
+	u := usecase.NewProductUsecase(repo, prices)
+
+	// 2. フィクスチャの読み込み
+	rows, err := bulk.OpenFixtures(*fixtures)
+	if err != nil {
+		log.Fatalf("failed to open fixtures: %v", err)
+	}
+	var report *model.ImportReport
+	if *dryRun {
+		report, err = u.ImportProducts(ctx, rows, true)
+	} else {
+		report, err = u.SeedProducts(ctx, rows)
+	}
+
+	// 3. 結果の表示
+	if report != nil {
+		for _, e := range report.Errors {
+			fmt.Fprintf(os.Stderr, "%s:%d (sku %q): %s\n", e.Source, e.Line, e.SKU, e.Message)
+		}
+	}
+	if err != nil {
+		log.Fatalf("failed to seed products: %v", err)
+	}
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run, nothing saved)"
+	}
+	fmt.Printf("created %d, updated %d, unchanged %d, failed %d%s\n", report.Created, report.Updated, report.Unchanged, report.Failed, mode)
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
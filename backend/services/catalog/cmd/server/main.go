@@ -1,46 +1,258 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"flag"
 	"log"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"cloud.google.com/go/storage"
+	"connectrpc.com/connect"
 	"github.com/kinoshitatakumi/opti/gen/go/catalog/v1/catalogv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/blob"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/imaging"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/linkcheck"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/merchant"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/project"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/search"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/bulk"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/grpc"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/media"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/redirect"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
 func main() {
+	// 起動時に開発用の製品データを読み込む場合は -seed fixtures/products のように指定します
+	seedPath := flag.String("seed", "", "起動時に読み込むフィクスチャ (ファイルまたはディレクトリ)")
+	flag.Parse()
+
 	// 1. Dependency Injection (依存性の注入)
 	// ここでアプリケーションの全ての部品を生成し、組み立てます。
 
-	// (a) Repository: データの保存場所を作成（今回はメモリ）
-	// 将来ここを `db.NewPostgresProductRepository()` に変えるだけでDB切り替えが完了します。
-	repo := db.NewMemoryProductRepository()
+	// (a) Repository: データの保存場所を作成
+	// FIRESTORE_PROJECT を指定すれば Firestore に、未指定ならメモリに保存します (開発用)。
+	// 検索インデックスは、リポジトリへの保存のたびに更新されるようデコレーターで包みます。
+	store := openStore(context.Background(), os.Getenv("FIRESTORE_PROJECT"))
+	index := search.NewIndex()
+	repo, err := search.NewIndexedProductRepository(context.Background(), store.products, index)
+	if err != nil {
+		log.Fatalf("failed to build search index: %v", err)
+	}
+	// Firestore には cmd/seed や別のインスタンスも書き込むので、その変更もインデックスに反映します
+	if store.changes != nil {
+		go search.Follow(context.Background(), store.changes, index)
+	}
 
 	// (b) Usecase: ビジネスロジックを作成
 	// 作成したリポジトリを渡すことで、Useaseは保存場所を知らずに使えます。
-	u := usecase.NewProductUsecase(repo)
+	// 価格は変更のたびに履歴に残し、直近の最安値を下回ったら値下がりとして記録します
+	prices := usecase.NewPriceUsecase(store.history, store.drops, service.NewPriceDropDetector(service.DefaultPriceDropWindow))
+	u := usecase.NewProductUsecase(repo, prices)
+	compatibility := usecase.NewCompatibilityUsecase(repo, service.NewCompatibilityChecker())
+	eligibility := usecase.NewEligibilityUsecase(repo, service.NewEligibilityMatcher())
+	searchUsecase := usecase.NewSearchUsecase(index)
+	if *seedPath != "" {
+		seed(u, *seedPath)
+	}
+
+	// 販売サイトからの価格・在庫状況の定期取得 (PRICE_SOURCES が未設定なら取得しない)
+	// 例: PRICE_SOURCES="rakuten=rakuten.co.jp@3s;yodobashi=yodobashi.com" PRICE_REFRESH_INTERVAL=6h
+	if spec := os.Getenv("PRICE_SOURCES"); spec != "" {
+		sources, intervals, err := merchant.ParseSources(spec, nil)
+		if err != nil {
+			log.Fatalf("invalid PRICE_SOURCES: %v", err)
+		}
+		interval := 6 * time.Hour
+		if v := os.Getenv("PRICE_REFRESH_INTERVAL"); v != "" {
+			if interval, err = time.ParseDuration(v); err != nil || interval <= 0 {
+				log.Fatalf("invalid PRICE_REFRESH_INTERVAL %q", v)
+			}
+		}
+		refresher := usecase.NewPriceRefresher(repo, prices, sources, intervals)
+		go refresher.Run(context.Background(), interval)
+		log.Printf("Refreshing prices from %d merchants every %s", len(sources), interval)
+	}
+
+	// 購入リンクの定期確認 (LINK_CHECK_INTERVAL が未設定なら確認しない)。問題は ListProductIssues で見られます
+	issueRepo := store.issues
+	if v := os.Getenv("LINK_CHECK_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			log.Fatalf("invalid LINK_CHECK_INTERVAL %q", v)
+		}
+		checker := usecase.NewLinkHealthChecker(repo, issueRepo, linkcheck.NewChecker(nil, linkcheck.DefaultTimeout), usecase.DefaultHostInterval)
+		go checker.Run(context.Background(), interval)
+		log.Printf("Checking purchase links every %s", interval)
+	}
+
+	// 購入リンク (/r/{token}) はトークンに署名して、クリックの記録先を偽れないようにします
+	// 採用計画は Project Service で利用者のものか確かめてから入れます (PROJECT_SERVICE_URL が未設定なら入れない)
+	var plans repository.PlanDirectory
+	if projectURL := os.Getenv("PROJECT_SERVICE_URL"); projectURL != "" {
+		plans = project.NewResilientPlanClient(projectURL)
+	} else {
+		log.Println("PROJECT_SERVICE_URL is not set; purchase links will not record adoption plans")
+	}
+	baseURL := envOr("PUBLIC_BASE_URL", "http://localhost:8080")
+	affiliate := usecase.NewAffiliateUsecase(repo, store.clicks, plans, clickTokenSigner(), baseURL)
+
+	// 製品画像のサムネイルは、内容のハッシュをキーにして保存します
+	images := usecase.NewImageUsecase(repo, imageStore(baseURL), imaging.NewProcessor(), imaging.NewFetcher(nil, imaging.DefaultFetchTimeout))
 
 	// (c) Handler: 外部との窓口を作成
 	// 作成したUseaseを渡すことで、リクエストをロジックに流せるようにします。
-	handler := grpc.NewProductHandler(u)
+	handler := grpc.NewProductHandler(u, compatibility, eligibility, searchUsecase, prices, affiliate, usecase.NewProductIssueUsecase(issueRepo), images)
 
 	// 2. サーバーのルーティング設定
 	mux := http.NewServeMux()
 	// Connectが生成したコードを使って、「このパスに来たら、このハンドラを呼ぶ」という紐付けを行います。
-	path, connectHandler := catalogv1connect.NewProductServiceHandler(handler)
+	// アクセストークンがあれば検証し、ログイン中の利用者をハンドラーに渡します
+	path, connectHandler := catalogv1connect.NewProductServiceHandler(handler, connect.WithInterceptors(authInterceptor()))
 	mux.Handle(path, connectHandler)
+	// 購入リンクはブラウザから開かれるので、Connect とは別に素の HTTP で受け付けます
+	redirect.NewHandler(affiliate).Register(mux)
+	// ローカルに保存したサムネイルも、<img> から読めるよう素の HTTP で配信します
+	media.NewHandler(images).Register(mux)
 
 	// 3. サーバー起動
 	log.Println("Starting catalog service on :8080")
 	// h2c: HTTP/2 Cleartext (暗号化なしHTTP/2)
 	// gRPCは通常HTTP/2が必要ですが、開発中はTLS(SSL)設定が面倒なので、
 	// 平文でHTTP/2を喋れる `h2c` を使って起動します。
-	err := http.ListenAndServe(":8080", h2c.NewHandler(mux, &http2.Server{}))
+	err = http.ListenAndServe(":8080", h2c.NewHandler(mux, &http2.Server{}))
 	if err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// stores: 保存先ごとのリポジトリ
+type stores struct {
+	products repository.ProductRepository
+	changes  repository.ProductChangeFeed // 他のプロセスからの書き込みも通知する (メモリでは nil)
+	history  repository.PriceHistoryRepository
+	drops    repository.PriceDropRepository
+	clicks   repository.ClickRepository
+	issues   repository.ProductIssueRepository
+}
+
+// openStore: project が空ならメモリの、そうでなければ Firestore のリポジトリを作ります。
+func openStore(ctx context.Context, project string) stores {
+	if project == "" {
+		return stores{
+			products: db.NewMemoryProductRepository(),
+			history:  db.NewMemoryPriceHistoryRepository(),
+			drops:    db.NewMemoryPriceDropRepository(),
+			clicks:   db.NewMemoryClickRepository(),
+			issues:   db.NewMemoryProductIssueRepository(),
+		}
+	}
+	client, err := db.NewFirestoreClient(ctx, project)
+	if err != nil {
+		log.Fatalf("failed to connect to firestore: %v", err)
+	}
+	log.Printf("Using firestore project %s", project)
+	return stores{
+		products: db.NewFirestoreProductRepository(client),
+		changes:  db.NewFirestoreProductChangeFeed(client),
+		history:  db.NewFirestorePriceHistoryRepository(client),
+		drops:    db.NewFirestorePriceDropRepository(client),
+		clicks:   db.NewFirestoreClickRepository(client),
+		issues:   db.NewFirestoreProductIssueRepository(client),
+	}
+}
+
+// seed: フィクスチャを読み込みます。不正な製品があれば、内容を表示して起動を中止します。
+func seed(u *usecase.ProductUsecase, path string) {
+	rows, err := bulk.OpenFixtures(path)
+	if err != nil {
+		log.Fatalf("failed to open fixtures: %v", err)
+	}
+	report, err := u.SeedProducts(context.Background(), rows)
+	if err != nil {
+		if report != nil {
+			for _, e := range report.Errors {
+				log.Printf("%s:%d (sku %q): %s", e.Source, e.Line, e.SKU, e.Message)
+			}
+		}
+		log.Fatalf("failed to seed products: %v", err)
+	}
+	log.Printf("Seeded products from %s: created %d, updated %d, unchanged %d", path, report.Created, report.Updated, report.Unchanged)
+}
+
+// clickTokenSigner: 購入リンクの署名鍵は CLICK_TOKEN_KEY (32バイト以上) で指定します。
+// 鍵を入れ替えるときは、古い鍵を CLICK_TOKEN_OLD_KEYS (カンマ区切り) に残すと発行済みのリンクも使えます。
+// 未設定なら起動のたびに鍵を作るので、再起動すると発行済みのリンクは使えなくなります (開発用)。
+func clickTokenSigner() *service.ClickTokenSigner {
+	key := []byte(os.Getenv("CLICK_TOKEN_KEY"))
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("failed to generate click token key: %v", err)
+		}
+		log.Println("CLICK_TOKEN_KEY is not set; purchase links will not survive a restart")
+	}
+	var oldKeys [][]byte
+	for _, k := range strings.Split(os.Getenv("CLICK_TOKEN_OLD_KEYS"), ",") {
+		if k != "" {
+			oldKeys = append(oldKeys, []byte(k))
+		}
+	}
+	signer, err := service.NewClickTokenSigner(key, service.DefaultClickTokenMaxAge, oldKeys...)
+	if err != nil {
+		log.Fatalf("invalid CLICK_TOKEN_KEY: %v", err)
+	}
+	return signer
+}
+
+// authInterceptor: アクセストークンの署名鍵は AUTH_TOKEN_KEY (32バイト以上、全サービス共通) で指定します。
+// 未設定なら起動のたびに鍵を作るのでどのトークンも受け付けず、購入リンクのクリックは匿名で記録されます (開発用)。
+func authInterceptor() connect.Interceptor {
+	key := []byte(os.Getenv("AUTH_TOKEN_KEY"))
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("failed to generate auth token key: %v", err)
+		}
+		log.Println("AUTH_TOKEN_KEY is not set; requests that need a logged-in user will be rejected")
+	}
+	signer, err := auth.NewTokenSigner(key, auth.DefaultTokenMaxAge)
+	if err != nil {
+		log.Fatalf("invalid AUTH_TOKEN_KEY: %v", err)
+	}
+	return auth.NewServerInterceptor(signer)
+}
+
+// imageStore: IMAGE_BUCKET を指定すれば Cloud Storage のバケットに保存し、バケット (または IMAGE_BASE_URL の CDN) から配信します。
+// 未指定なら IMAGE_DIR (既定は data/images) に保存し、このサービスの /images/ から配信します (開発用)。
+func imageStore(baseURL string) repository.BlobStore {
+	if bucket := os.Getenv("IMAGE_BUCKET"); bucket != "" {
+		client, err := storage.NewClient(context.Background())
+		if err != nil {
+			log.Fatalf("failed to create cloud storage client: %v", err)
+		}
+		return blob.NewGCSStore(client, bucket, os.Getenv("IMAGE_BASE_URL"))
+	}
+	store, err := blob.NewLocalStore(envOr("IMAGE_DIR", "data/images"), strings.TrimRight(baseURL, "/")+strings.TrimRight(usecase.ImagePath, "/"))
+	if err != nil {
+		log.Fatalf("failed to open image directory: %v", err)
+	}
+	return store
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
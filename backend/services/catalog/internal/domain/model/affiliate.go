@@ -0,0 +1,223 @@
+package model
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// アフィリエイトのテンプレートで使える置き換え文字列 (MerchantOffer.AffiliateTemplate)
+const (
+	AffiliateURL        = "{url}"         // 商品ページのURL
+	AffiliateURLEncoded = "{url_encoded}" // 商品ページのURL (クエリパラメータとして埋め込む場合)
+	AffiliateProductID  = "{product_id}"  // 製品ID (アフィリエイト側のレポートで製品を区別する場合)
+)
+
+// MerchantOffer: 製品を販売している販売サイトと、その商品ページ
+type MerchantOffer struct {
+	Merchant string // 販売サイトの名前 (製品の中で一意。例: "rakuten")
+	URL      string // 商品ページのURL
+	// AffiliateTemplate: アフィリエイトリンクの作り方。空ならURLをそのまま使います。
+	// 例: "{url}?tag=opti-22"、"https://ck.example.com/referral?sid=1&vc_url={url_encoded}"
+	AffiliateTemplate string
+}
+
+// Validate: 販売サイトの名前と、商品ページ・アフィリエイトリンクが http(s) のURLであることをチェックします。
+func (o MerchantOffer) Validate() error {
+	if strings.TrimSpace(o.Merchant) == "" {
+		return fmt.Errorf("%w: offer merchant is required", ErrInvalidProduct)
+	}
+	if !isHTTPURL(o.URL) {
+		return fmt.Errorf("%w: offer %q has an invalid url %q", ErrInvalidProduct, o.Merchant, o.URL)
+	}
+	if o.AffiliateTemplate == "" {
+		return nil
+	}
+	if !strings.Contains(o.AffiliateTemplate, AffiliateURL) && !strings.Contains(o.AffiliateTemplate, AffiliateURLEncoded) {
+		return fmt.Errorf("%w: affiliate template of offer %q must contain %s or %s", ErrInvalidProduct, o.Merchant, AffiliateURL, AffiliateURLEncoded)
+	}
+	if !isHTTPURL(o.TaggedURL("x")) {
+		return fmt.Errorf("%w: affiliate template of offer %q does not produce an http(s) url", ErrInvalidProduct, o.Merchant)
+	}
+	return nil
+}
+
+// TaggedURL: アフィリエイトのテンプレートに商品ページのURLを埋め込んだリンクを返します。
+func (o MerchantOffer) TaggedURL(productID ProductID) string {
+	if o.AffiliateTemplate == "" {
+		return o.URL
+	}
+	return strings.NewReplacer(
+		AffiliateURLEncoded, url.QueryEscape(o.URL),
+		AffiliateURL, o.URL,
+		AffiliateProductID, url.QueryEscape(productID.String()),
+	).Replace(o.AffiliateTemplate)
+}
+
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validateOffers: 販売サイトの名前が製品の中で重複していないかもチェックします (クリックの集計の単位のため)。
+func validateOffers(offers []MerchantOffer) error {
+	seen := make(map[string]bool, len(offers))
+	for _, o := range offers {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+		if seen[o.Merchant] {
+			return fmt.Errorf("%w: duplicate offer for merchant %q", ErrInvalidProduct, o.Merchant)
+		}
+		seen[o.Merchant] = true
+	}
+	return nil
+}
+
+// DirectMerchant: 販売サイトを登録していない製品で、購入リンク (PurchaseLink) を使うときの販売サイトの名前
+const DirectMerchant = "direct"
+
+// PurchaseOffers: 購入リンクを作る販売サイトの一覧です。
+// 販売サイトを登録していない製品は、これまでの購入リンク (PurchaseLink) をアフィリエイトなしの販売サイトとして扱います。
+func (p *Product) PurchaseOffers() []MerchantOffer {
+	if len(p.Offers) > 0 {
+		return p.Offers
+	}
+	if isHTTPURL(p.PurchaseLink) {
+		return []MerchantOffer{{Merchant: DirectMerchant, URL: p.PurchaseLink}}
+	}
+	return nil
+}
+
+// Offer: 販売サイトの名前から商品ページを探します。
+func (p *Product) Offer(merchant string) (MerchantOffer, bool) {
+	for _, o := range p.PurchaseOffers() {
+		if o.Merchant == merchant {
+			return o, true
+		}
+	}
+	return MerchantOffer{}, false
+}
+
+// ClickToken: 購入リンク (/r/{token}) に署名して埋め込む内容
+// 遷移先のURLではなく製品と販売サイトを埋め込み、遷移先はカタログに登録された商品ページから決めます。
+// そのため、署名が正しくても登録されていないサイトへは遷移しません (オープンリダイレクトの防止)。
+type ClickToken struct {
+	ProductID ProductID
+	Merchant  string
+	UserID    string // 空なら未ログイン
+	PlanID    string // 空なら採用計画の外 (カタログ画面など) からのクリック
+	IssuedAt  time.Time
+}
+
+// PurchaseLink: 利用者に見せる購入リンク
+type PurchaseLink struct {
+	Merchant    string
+	RedirectURL string // クリックを記録してから商品ページへ遷移するリンク (/r/{token})
+	TaggedURL   string // 遷移先のアフィリエイトリンク (表示用。クリックは記録されない)
+}
+
+// ClickEvent: 購入リンクのクリック
+type ClickEvent struct {
+	ID        string
+	ProductID ProductID
+	Merchant  string
+	UserID    string
+	PlanID    string
+	ClickedAt time.Time
+}
+
+// ClickFilter: クリックの集計の条件。ゼロ値の項目は絞り込まない
+type ClickFilter struct {
+	Since      time.Time // この日時以降 (含む)
+	Until      time.Time // この日時より前 (含まない)
+	ProductIDs []ProductID
+	PlanIDs    []string
+}
+
+// Matches: クリックが条件に当てはまるかどうか
+func (f ClickFilter) Matches(e *ClickEvent) bool {
+	if !f.Since.IsZero() && e.ClickedAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.ClickedAt.Before(f.Until) {
+		return false
+	}
+	if len(f.ProductIDs) > 0 && !contains(f.ProductIDs, e.ProductID) {
+		return false
+	}
+	if len(f.PlanIDs) > 0 && !contains(f.PlanIDs, e.PlanID) {
+		return false
+	}
+	return true
+}
+
+// ClickCount: ある製品 (または採用計画) のクリック数
+type ClickCount struct {
+	Key         string         // 製品ID または 採用計画ID
+	Clicks      int            // クリック数
+	UniqueUsers int            // クリックした利用者の数 (未ログインのクリックは数えない)
+	ByMerchant  map[string]int // 販売サイトごとのクリック数
+}
+
+// ClickReport: クリックの集計
+type ClickReport struct {
+	Total     int
+	ByProduct []ClickCount // クリック数の多い順
+	ByPlan    []ClickCount // クリック数の多い順 (採用計画の外からのクリックは含まない)
+}
+
+// CountClicks: クリックを製品ごと・採用計画ごとに集計します。
+func CountClicks(events []*ClickEvent) *ClickReport {
+	products := newClickCounter()
+	plans := newClickCounter()
+	for _, e := range events {
+		products.add(e.ProductID.String(), e)
+		if e.PlanID != "" {
+			plans.add(e.PlanID, e)
+		}
+	}
+	return &ClickReport{Total: len(events), ByProduct: products.result(), ByPlan: plans.result()}
+}
+
+type clickCounter struct {
+	counts map[string]*ClickCount
+	users  map[string]map[string]bool
+}
+
+func newClickCounter() *clickCounter {
+	return &clickCounter{counts: make(map[string]*ClickCount), users: make(map[string]map[string]bool)}
+}
+
+func (c *clickCounter) add(key string, e *ClickEvent) {
+	count, ok := c.counts[key]
+	if !ok {
+		count = &ClickCount{Key: key, ByMerchant: make(map[string]int)}
+		c.counts[key] = count
+		c.users[key] = make(map[string]bool)
+	}
+	count.Clicks++
+	count.ByMerchant[e.Merchant]++
+	if e.UserID != "" && !c.users[key][e.UserID] {
+		c.users[key][e.UserID] = true
+		count.UniqueUsers++
+	}
+}
+
+// result: クリック数の多い順 (同数ならキーの順) に並べます。
+func (c *clickCounter) result() []ClickCount {
+	list := make([]ClickCount, 0, len(c.counts))
+	for _, count := range c.counts {
+		list = append(list, *count)
+	}
+	slices.SortFunc(list, func(a, b ClickCount) int {
+		if a.Clicks != b.Clicks {
+			return b.Clicks - a.Clicks
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return list
+}
@@ -0,0 +1,67 @@
+package model
+
+import "fmt"
+
+// ChoreCategory: 製品が効果を持つ家事カテゴリを表す型
+// Simulation Service の ChoreInput.category と同じ値を使います。
+type ChoreCategory string
+
+const (
+	ChoreCleaning ChoreCategory = "cleaning"
+	ChoreLaundry  ChoreCategory = "laundry"
+	ChoreCooking  ChoreCategory = "cooking"
+	ChoreSecurity ChoreCategory = "security"
+	ChoreOther    ChoreCategory = "other"
+)
+
+// IsValid: 定義済みのカテゴリかどうかを判定します。
+func (c ChoreCategory) IsValid() bool {
+	switch c {
+	case ChoreCleaning, ChoreLaundry, ChoreCooking, ChoreSecurity, ChoreOther:
+		return true
+	}
+	return false
+}
+
+// ChoreEffect: ある家事カテゴリの時間をどれだけ削減できるか
+type ChoreEffect struct {
+	Category             ChoreCategory // 対象の家事カテゴリ
+	TimeReductionPercent int           // 推定時間削減率 (0〜100%)
+}
+
+// AutomationEffect: 製品の自動化効果とランニングコスト (Value Object)
+// ROI計算や提案ロジックが「この製品で家事がどれだけ減るか」を知るための構造化データです。
+// StrongPoints / WeakPoints のような自由記述ではなく、計算に使える数値で持ちます。
+type AutomationEffect struct {
+	ChoreEffects               []ChoreEffect // 効果のある家事カテゴリと削減率
+	MaintenanceMinutesPerMonth int           // 定期メンテナンスにかかる時間 (分/月)
+	PowerWatts                 float64       // 平均消費電力 (W)
+	ConsumableCostPerMonth     int32         // 消耗品コスト (円/月)
+}
+
+// Validate: 効果データの不変条件をチェックします。
+func (e AutomationEffect) Validate() error {
+	seen := make(map[ChoreCategory]bool, len(e.ChoreEffects))
+	for _, c := range e.ChoreEffects {
+		if !c.Category.IsValid() {
+			return fmt.Errorf("%w: unknown chore category %q", ErrInvalidProduct, c.Category)
+		}
+		if seen[c.Category] {
+			return fmt.Errorf("%w: duplicated chore category %q", ErrInvalidProduct, c.Category)
+		}
+		seen[c.Category] = true
+		if c.TimeReductionPercent < 0 || c.TimeReductionPercent > 100 {
+			return fmt.Errorf("%w: time reduction must be between 0 and 100: %d", ErrInvalidProduct, c.TimeReductionPercent)
+		}
+	}
+	if e.MaintenanceMinutesPerMonth < 0 {
+		return fmt.Errorf("%w: maintenance minutes cannot be negative: %d", ErrInvalidProduct, e.MaintenanceMinutesPerMonth)
+	}
+	if e.PowerWatts < 0 {
+		return fmt.Errorf("%w: power consumption cannot be negative: %v", ErrInvalidProduct, e.PowerWatts)
+	}
+	if e.ConsumableCostPerMonth < 0 {
+		return fmt.Errorf("%w: consumable cost cannot be negative: %d", ErrInvalidProduct, e.ConsumableCostPerMonth)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// StockStatus: 販売サイトでの在庫状況
+type StockStatus string
+
+const (
+	StockUnknown      StockStatus = ""             // まだ取得していない、または販売サイトが在庫状況を載せていない
+	StockInStock      StockStatus = "in_stock"     // 在庫あり
+	StockOutOfStock   StockStatus = "out_of_stock" // 在庫切れ (再入荷の可能性あり)
+	StockPreorder     StockStatus = "preorder"     // 予約受付中
+	StockDiscontinued StockStatus = "discontinued" // 販売終了・商品ページが削除された
+)
+
+// Purchasable: 今すぐ購入できるかどうか
+func (s StockStatus) Purchasable() bool {
+	return s == StockInStock
+}
+
+// Availability: 製品の在庫状況と、それを確認した販売サイト・日時
+// 在庫状況は価格の取得 (PriceRefresher) だけが更新し、管理画面・一括登録での更新では前の値を引き継ぎます。
+type Availability struct {
+	Status    StockStatus
+	Merchant  string    // 確認した販売サイト
+	CheckedAt time.Time // 最後に確認した日時
+}
+
+// PriceQuote: 販売サイトから取得した価格と在庫状況
+type PriceQuote struct {
+	Amount    int32 // 価格 (円)。在庫状況だけで価格が載っていない場合は0
+	HasPrice  bool
+	Status    StockStatus
+	FetchedAt time.Time
+}
+
+// PriceRefreshReport: 価格の取得をひととおり行った結果
+type PriceRefreshReport struct {
+	Checked      int               // 価格を取得できた製品の数
+	PriceChanged int               // 価格が変わった製品の数
+	Drops        []*PriceDropEvent // 直近の最安値を下回った製品
+	Failed       int               // 取得に失敗した製品の数 (前回の価格・在庫状況のまま)
+	Skipped      int               // 購入リンクに対応する販売サイトがない製品の数
+}
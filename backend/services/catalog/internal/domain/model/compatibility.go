@@ -0,0 +1,58 @@
+package model
+
+// WiFiEnvironment: 住居のWi-Fi環境 (Value Object)
+type WiFiEnvironment struct {
+	Available bool       // Wi-Fiがあるかどうか
+	Bands     []Protocol // 利用できる帯域 (wifi_2_4ghz / wifi_5ghz)。空なら両方使えるとみなします
+}
+
+// HasBand: 指定した帯域が使えるかを判定します。
+func (w WiFiEnvironment) HasBand(band Protocol) bool {
+	if !w.Available {
+		return false
+	}
+	if len(w.Bands) == 0 {
+		return true
+	}
+	return containsProtocol(w.Bands, band)
+}
+
+// CompatibilityIssueKind: 互換性の問題の種類
+type CompatibilityIssueKind string
+
+const (
+	IssueNoWiFi           CompatibilityIssueKind = "no_wifi"            // Wi-Fiが必要だが住居にWi-Fiがない
+	IssueWiFiBandMismatch CompatibilityIssueKind = "wifi_band_mismatch" // 対応帯域が住居のWi-Fiと合わない
+	IssueMissingBridge    CompatibilityIssueKind = "missing_bridge"     // 必要なハブ・ブリッジが構成に含まれていない
+)
+
+// CompatibilityIssue: 製品1つに対する互換性の問題
+type CompatibilityIssue struct {
+	ProductID ProductID
+	Kind      CompatibilityIssueKind
+	Protocols []Protocol // 問題に関係する規格 (不足しているブリッジの規格など)
+	Message   string     // ユーザー向けの説明文
+}
+
+// ManagementProposalGroup: ハブなどの基盤製品を提案する際のグループ名です。
+// docs/domain_modeling.md の ProposalGroup.category = "management" に対応します。
+const ManagementProposalGroup = "management"
+
+// HubProposal: 不足しているブリッジを補うためのハブの提案
+type HubProposal struct {
+	ProductID     ProductID
+	ProposalGroup string     // 常に "management"
+	Covers        []Protocol // このハブで解消できる規格
+	Reason        string
+}
+
+// CompatibilityReport: 互換性チェックの結果
+type CompatibilityReport struct {
+	Issues       []CompatibilityIssue
+	ProposedHubs []HubProposal
+}
+
+// Compatible: 問題が1つもなければ true を返します。
+func (r *CompatibilityReport) Compatible() bool {
+	return len(r.Issues) == 0
+}
@@ -0,0 +1,88 @@
+package model
+
+import "fmt"
+
+// Protocol: 製品が対応する通信規格・スマートホームエコシステムを表す型
+type Protocol string
+
+const (
+	ProtocolMatter     Protocol = "matter"
+	ProtocolThread     Protocol = "thread"
+	ProtocolZigbee     Protocol = "zigbee"
+	ProtocolWiFi24GHz  Protocol = "wifi_2_4ghz"
+	ProtocolWiFi5GHz   Protocol = "wifi_5ghz"
+	ProtocolBluetooth  Protocol = "bluetooth"
+	ProtocolHomeKit    Protocol = "homekit"
+	ProtocolAlexa      Protocol = "alexa"
+	ProtocolGoogleHome Protocol = "google_home"
+)
+
+// IsValid: 定義済みの規格かどうかを判定します。
+func (p Protocol) IsValid() bool {
+	switch p {
+	case ProtocolMatter, ProtocolThread, ProtocolZigbee, ProtocolWiFi24GHz, ProtocolWiFi5GHz,
+		ProtocolBluetooth, ProtocolHomeKit, ProtocolAlexa, ProtocolGoogleHome:
+		return true
+	}
+	return false
+}
+
+// IsWiFi: Wi-Fi (2.4GHz / 5GHz) の規格かどうかを判定します。
+func (p Protocol) IsWiFi() bool {
+	return p == ProtocolWiFi24GHz || p == ProtocolWiFi5GHz
+}
+
+// Connectivity: 製品の接続性情報 (Value Object)
+// 「どの規格で繋がるか」「動かすためにどんなハブが必要か」「ハブとして何を仲介できるか」を表します。
+type Connectivity struct {
+	Protocols            []Protocol // 対応している通信規格・エコシステム
+	RequiredHubProtocols []Protocol // 動作に必要なハブの規格 (いずれか1つを仲介できるハブが必要。空ならハブ不要)
+	BridgedProtocols     []Protocol // ハブ・ブリッジとして仲介できる規格 (ハブ製品のみ)
+}
+
+// Supports: 指定した規格に対応しているかを判定します。
+func (c Connectivity) Supports(p Protocol) bool {
+	return containsProtocol(c.Protocols, p)
+}
+
+// Bridges: 指定した規格を仲介できるかを判定します。
+func (c Connectivity) Bridges(p Protocol) bool {
+	return containsProtocol(c.BridgedProtocols, p)
+}
+
+// RequiresHub: 動作にハブが必要かどうかを返します。
+func (c Connectivity) RequiresHub() bool {
+	return len(c.RequiredHubProtocols) > 0
+}
+
+// WiFiBands: 対応しているWi-Fiの帯域を返します。
+func (c Connectivity) WiFiBands() []Protocol {
+	var bands []Protocol
+	for _, p := range c.Protocols {
+		if p.IsWiFi() {
+			bands = append(bands, p)
+		}
+	}
+	return bands
+}
+
+// Validate: 接続性情報の不変条件をチェックします。
+func (c Connectivity) Validate() error {
+	for _, list := range [][]Protocol{c.Protocols, c.RequiredHubProtocols, c.BridgedProtocols} {
+		for _, p := range list {
+			if !p.IsValid() {
+				return fmt.Errorf("%w: unknown protocol %q", ErrInvalidProduct, p)
+			}
+		}
+	}
+	return nil
+}
+
+func containsProtocol(list []Protocol, p Protocol) bool {
+	for _, v := range list {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
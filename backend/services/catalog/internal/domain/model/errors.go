@@ -0,0 +1,26 @@
+package model
+
+import "errors"
+
+// ドメイン層で発生するエラーの定義です。
+// Handler層では errors.Is でこれらを判定し、適切なRPCステータスコードに変換します。
+var (
+	// ErrProductNotFound: 指定された製品が存在しない
+	ErrProductNotFound = errors.New("product not found")
+	// ErrInvalidProduct: 製品データが業務ルールを満たしていない
+	ErrInvalidProduct = errors.New("invalid product")
+	// ErrInvalidSearchQuery: 検索条件が不正 (価格帯の上下が逆など)
+	ErrInvalidSearchQuery = errors.New("invalid search query")
+	// ErrTooManyProducts: 一度に取得できる製品数の上限を超えた
+	ErrTooManyProducts = errors.New("too many products requested")
+	// ErrListingNotFound: 販売サイトに商品ページがない (削除された)
+	ErrListingNotFound = errors.New("listing not found")
+	// ErrInvalidClickToken: 購入リンクのトークンが改ざんされている、または期限切れ
+	ErrInvalidClickToken = errors.New("invalid click token")
+	// ErrInvalidImage: 画像を取り込めない (対応していない形式、大きすぎる・小さすぎる、取得できないなど)
+	ErrInvalidImage = errors.New("invalid image")
+	// ErrPlanNotOwned: 採用計画が無い、またはログイン中の利用者のものではない
+	ErrPlanNotOwned = errors.New("plan does not belong to the user")
+	// ErrBlobNotFound: 保存先に指定されたファイルが無い
+	ErrBlobNotFound = errors.New("blob not found")
+)
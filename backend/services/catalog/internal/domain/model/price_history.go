@@ -0,0 +1,108 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// 価格の変更元 (PricePoint.Source)
+const (
+	PriceSourceAdmin  = "admin"  // 管理画面での作成・更新
+	PriceSourceImport = "import" // 一括登録・フィクスチャ
+)
+
+// MerchantPriceSource: 販売サイトから取得した価格の変更元 (例: "merchant:rakuten")
+func MerchantPriceSource(merchant string) string {
+	return "merchant:" + merchant
+}
+
+// PricePoint: ある時点で設定された価格
+// 価格が変わったときだけ記録するので、次の PricePoint までの間はこの価格だったことを表します。
+type PricePoint struct {
+	ProductID  ProductID
+	Amount     int32     // 価格 (円)
+	Source     string    // 変更元 (PriceSourceAdmin など)
+	RecordedAt time.Time // 変更された日時
+}
+
+// PriceHistory: 製品の価格の履歴 (古い順)
+type PriceHistory struct {
+	ProductID ProductID
+	Points    []PricePoint
+}
+
+// Latest: 最新の価格を返します。履歴が空なら false を返します。
+func (h PriceHistory) Latest() (PricePoint, bool) {
+	if len(h.Points) == 0 {
+		return PricePoint{}, false
+	}
+	return h.Points[len(h.Points)-1], true
+}
+
+// TrailingMin: at の直前 window の期間中についていた価格の最安値を返します。
+// 期間の開始時点で有効だった価格 (期間より前の最後の変更) も含めます。
+// 例えば半年間ずっと同じ価格だった場合、その価格が直近90日の最安値になります。
+// at より前の履歴がなければ false を返します。
+func (h PriceHistory) TrailingMin(at time.Time, window time.Duration) (int32, bool) {
+	start := at.Add(-window)
+	var min int32
+	found := false
+	for i, pt := range h.Points {
+		if !pt.RecordedAt.Before(at) {
+			break
+		}
+		// 期間の開始前の変更は、次の変更が期間の開始以前なら期間中には有効でない
+		if pt.RecordedAt.Before(start) && i+1 < len(h.Points) && !h.Points[i+1].RecordedAt.After(start) {
+			continue
+		}
+		if !found || pt.Amount < min {
+			min, found = pt.Amount, true
+		}
+	}
+	return min, found
+}
+
+// Since: since 以降の変更と、その時点で有効だった価格 (since より前の最後の変更) を返します。
+func (h PriceHistory) Since(since time.Time) PriceHistory {
+	if since.IsZero() {
+		return h
+	}
+	for i, pt := range h.Points {
+		if pt.RecordedAt.After(since) {
+			if i > 0 {
+				i--
+			}
+			return PriceHistory{ProductID: h.ProductID, Points: h.Points[i:]}
+		}
+	}
+	if len(h.Points) == 0 {
+		return h
+	}
+	return PriceHistory{ProductID: h.ProductID, Points: h.Points[len(h.Points)-1:]}
+}
+
+// PriceDropEvent: 製品の価格が直近の最安値を下回ったことを表すイベント
+// 採用計画で購入前 (pending) の製品について、ユーザーに知らせるために使います。
+type PriceDropEvent struct {
+	ID            string
+	ProductID     ProductID
+	ProductName   string
+	Price         int32         // 新しい価格
+	PreviousPrice int32         // 直前の価格
+	TrailingMin   int32         // 直近 Window の最安値 (新しい価格はこれを下回った)
+	Window        time.Duration // 最安値を求めた期間
+	Source        string        // 価格の変更元
+	DetectedAt    time.Time
+}
+
+// DropPercent: 直近の最安値からの値下がり率 (%)
+func (e PriceDropEvent) DropPercent() float64 {
+	if e.TrailingMin == 0 {
+		return 0
+	}
+	return float64(e.TrailingMin-e.Price) / float64(e.TrailingMin) * 100
+}
+
+func (e PriceDropEvent) String() string {
+	return fmt.Sprintf("%s (%s): %d -> %d yen, %.1f%% below the %d-day minimum", e.ProductName, e.ProductID, e.PreviousPrice, e.Price, e.DropPercent(), int(e.Window/(24*time.Hour)))
+}
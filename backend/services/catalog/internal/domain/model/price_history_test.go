@@ -0,0 +1,110 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var day0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func day(n int) time.Time {
+	return day0.AddDate(0, 0, n)
+}
+
+// history: 日付と価格の組を古い順に並べた履歴
+func history(points ...PricePoint) PriceHistory {
+	return PriceHistory{ProductID: "p-1", Points: points}
+}
+
+func point(d int, amount int32) PricePoint {
+	return PricePoint{ProductID: "p-1", Amount: amount, Source: PriceSourceAdmin, RecordedAt: day(d)}
+}
+
+func TestPriceHistoryTrailingMin(t *testing.T) {
+	const window = 30 * 24 * time.Hour
+	tests := []struct {
+		name    string
+		history PriceHistory
+		at      time.Time
+		want    int32
+		found   bool
+	}{
+		{name: "empty", history: history(), at: day(100), found: false},
+		{name: "only changes at or after at", history: history(point(100, 1000)), at: day(100), found: false},
+		{name: "price unchanged since long before the window", history: history(point(0, 1000)), at: day(100), want: 1000, found: true},
+		{
+			name:    "price in effect at the window start counts",
+			history: history(point(0, 800), point(80, 1000)),
+			at:      day(100),
+			want:    800,
+			found:   true,
+		},
+		{
+			name:    "price replaced before the window start does not count",
+			history: history(point(0, 800), point(60, 1000), point(90, 1200)),
+			at:      day(100),
+			want:    1000,
+			found:   true,
+		},
+		{
+			name:    "price replaced exactly at the window start does not count",
+			history: history(point(0, 800), point(70, 1000)),
+			at:      day(100),
+			want:    1000,
+			found:   true,
+		},
+		{
+			name:    "minimum inside the window",
+			history: history(point(0, 1000), point(80, 700), point(90, 1000)),
+			at:      day(100),
+			want:    700,
+			found:   true,
+		},
+		{
+			name:    "changes at or after at are ignored",
+			history: history(point(0, 1000), point(100, 500), point(110, 400)),
+			at:      day(100),
+			want:    1000,
+			found:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.history.TrailingMin(tt.at, window)
+			if got != tt.want || found != tt.found {
+				t.Errorf("TrailingMin = %d, %v, want %d, %v", got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestPriceHistorySince(t *testing.T) {
+	h := history(point(0, 1000), point(10, 900), point(20, 800))
+	tests := []struct {
+		name  string
+		since time.Time
+		want  []int32
+	}{
+		{name: "zero time returns everything", since: time.Time{}, want: []int32{1000, 900, 800}},
+		{name: "before the first change", since: day(-1), want: []int32{1000, 900, 800}},
+		{name: "includes the price in effect at since", since: day(15), want: []int32{900, 800}},
+		{name: "change exactly at since is the price in effect", since: day(10), want: []int32{900, 800}},
+		{name: "after the last change keeps the current price", since: day(30), want: []int32{800}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int32
+			for _, pt := range h.Since(tt.since).Points {
+				got = append(got, pt.Amount)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Since = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := history().Since(day(0)); len(got.Points) != 0 {
+		t.Errorf("empty history Since = %+v", got)
+	}
+}
@@ -1,22 +1,73 @@
 package model
 
-import "github.com/kinoshitatakumi/opti/pkg/domain/value"
+import (
+	"fmt"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+)
 
 // Product: ドメインモデルとしての製品定義です。
 // Protoファイル(通信用)とは異なり、Goのプログラム内でビジネスロジックを扱うための純粋な構造体です。
 // 特定のライブラリ（DBタグやJSONタグなど）に依存させないことで、技術的な変更に強くしています。
 type Product struct {
-	ID                     ProductID              // システム内で一意なID (Value Object)
-	Name                   string                 // 製品名
-	Description            string                 // 製品の詳細説明
-	Price                  value.Price            // 価格 (Value Object)
-	Manufacturer           string                 // 製造メーカー名
-	PurchaseLink           string                 // 購入サイトへのURL
-	ImageURL               string                 // 製品画像のURL
-	WeakPoints             []string               // 導入時のデメリット・注意点 (AIによる分析用)
-	StrongPoints           []string               // 導入時のメリット・アピールポイント
-	InstallationDifficulty InstallationDifficulty // 設置難易度
-	Category               ProductCategory        // 製品カテゴリ
+	ID                       ProductID                // システム内で一意なID (Value Object)
+	SKU                      string                   // 管理用の外部SKU (一括登録で既存製品を特定するキー。空なら未設定)
+	Name                     string                   // 製品名
+	Description              string                   // 製品の詳細説明
+	Price                    value.Price              // 価格 (Value Object)
+	Manufacturer             string                   // 製造メーカー名
+	PurchaseLink             string                   // 購入サイトへのURL
+	ImageURL                 string                   // 製品画像のURL (画像を取り込んだ製品では Image のサムネイル)
+	WeakPoints               []string                 // 導入時のデメリット・注意点 (AIによる分析用)
+	StrongPoints             []string                 // 導入時のメリット・アピールポイント
+	InstallationDifficulty   InstallationDifficulty   // 設置難易度
+	Category                 ProductCategory          // 製品カテゴリ
+	AutomationEffect         AutomationEffect         // 家事の自動化効果 (ROI計算・提案用)
+	Connectivity             Connectivity             // 対応規格とハブ要件 (互換性チェック用)
+	InstallationRequirements InstallationRequirements // 設置要件 (住環境との適合判定用)
+	Availability             Availability             // 販売サイトでの在庫状況 (価格の取得で更新)
+	Offers                   []MerchantOffer          // 販売サイトごとの商品ページとアフィリエイトリンクの作り方
+	Image                    *ProductImage            // 取り込んだ製品画像 (取り込んでいなければ nil)
+}
+
+// Validate: 製品データが業務ルールを満たしているかチェックします。
+// 作成・更新のたびにユースケースから呼び出されます。
+func (p *Product) Validate() error {
+	if err := p.AutomationEffect.Validate(); err != nil {
+		return err
+	}
+	if err := p.Connectivity.Validate(); err != nil {
+		return err
+	}
+	if err := validateOffers(p.Offers); err != nil {
+		return err
+	}
+	return p.InstallationRequirements.Validate()
+}
+
+// ValidateImport: 一括登録の行に対するチェックです。
+// 表計算ソフトで作ったデータは入力ミスが多いため、Validate に加えて
+// SKU・製品名の入力と、カテゴリ・設置難易度が定義済みの値であることも確かめます。
+func (p *Product) ValidateImport() error {
+	if p.SKU == "" {
+		return fmt.Errorf("%w: sku is required", ErrInvalidProduct)
+	}
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidProduct)
+	}
+	if !p.Category.IsValid() {
+		return fmt.Errorf("%w: unknown category %q", ErrInvalidProduct, p.Category)
+	}
+	if p.InstallationDifficulty != "" && !p.InstallationDifficulty.IsValid() {
+		return fmt.Errorf("%w: unknown installation difficulty %q", ErrInvalidProduct, p.InstallationDifficulty)
+	}
+	return p.Validate()
+}
+
+// ProductBatch: まとめて取得した製品
+type ProductBatch struct {
+	Products   []*Product  // 見つかった製品 (リクエストの順序)
+	MissingIDs []ProductID // 見つからなかった製品のID (リクエストの順序)
 }
 
 // InstallationDifficulty: 設置難易度を表す型
@@ -28,6 +79,15 @@ const (
 	DifficultyHigh   InstallationDifficulty = "high"
 )
 
+// IsValid: 定義済みの設置難易度かどうかを判定します。
+func (d InstallationDifficulty) IsValid() bool {
+	switch d {
+	case DifficultyLow, DifficultyMedium, DifficultyHigh:
+		return true
+	}
+	return false
+}
+
 // ProductCategory: 製品カテゴリを表す型
 type ProductCategory string
 
@@ -40,3 +100,18 @@ const (
 	CategoryHub         ProductCategory = "hub"
 	CategoryOther       ProductCategory = "other"
 )
+
+// IsValid: 定義済みのカテゴリかどうかを判定します。
+func (c ProductCategory) IsValid() bool {
+	switch c {
+	case CategoryRobotVacuum, CategorySmartLock, CategoryDishWasher, CategoryLighting, CategorySensor, CategoryHub, CategoryOther:
+		return true
+	}
+	return false
+}
+
+// ProductChanges: 保存先で起きた製品の変更 (ProductChangeFeed が通知します)
+type ProductChanges struct {
+	Saved   []*Product  // 追加・更新された製品
+	Removed []ProductID // 削除された製品
+}
@@ -0,0 +1,183 @@
+package model
+
+import "sort"
+
+// FacetName: 件数を集計する項目
+type FacetName string
+
+const (
+	FacetCategory               FacetName = "category"
+	FacetManufacturer           FacetName = "manufacturer"
+	FacetInstallationDifficulty FacetName = "installation_difficulty"
+	FacetPriceBucket            FacetName = "price_bucket"
+	FacetProtocol               FacetName = "protocol"
+)
+
+// facetOrder: レスポンスに並べるファセットの順序
+var facetOrder = []FacetName{FacetCategory, FacetManufacturer, FacetInstallationDifficulty, FacetPriceBucket, FacetProtocol}
+
+// PriceBucket: 価格帯ファセットの区切り
+type PriceBucket struct {
+	Key      string
+	MinPrice int32 // この金額以上
+	MaxPrice int32 // この金額以下。0なら上限なし
+}
+
+// PriceBuckets: 価格帯の区切り (安い順)
+var PriceBuckets = []PriceBucket{
+	{Key: "under_5000", MinPrice: 0, MaxPrice: 4999},
+	{Key: "5000_9999", MinPrice: 5000, MaxPrice: 9999},
+	{Key: "10000_29999", MinPrice: 10000, MaxPrice: 29999},
+	{Key: "30000_49999", MinPrice: 30000, MaxPrice: 49999},
+	{Key: "50000_99999", MinPrice: 50000, MaxPrice: 99999},
+	{Key: "100000_plus", MinPrice: 100000},
+}
+
+// priceBucketOf: 価格が含まれる価格帯の位置を返します。
+func priceBucketOf(price int32) int {
+	for i := len(PriceBuckets) - 1; i > 0; i-- {
+		if price >= PriceBuckets[i].MinPrice {
+			return i
+		}
+	}
+	return 0
+}
+
+// FacetValue: ファセットの値ごとの件数
+type FacetValue struct {
+	Value    string
+	Count    int
+	Selected bool         // 絞り込み条件で指定されている値か
+	Bucket   *PriceBucket // 価格帯ファセットの場合のみ
+}
+
+// Facet: 1つの項目の集計結果
+type Facet struct {
+	Name   FacetName
+	Values []FacetValue
+}
+
+// ProductFacets: 絞り込み条件に対するファセットの集計結果
+// 各ファセットの件数は、その項目自身の条件だけを外して数えます。
+// (カテゴリを1つ選んでいても、他のカテゴリを選んだ場合の件数が分かるようにするため)
+type ProductFacets struct {
+	Total  int // すべての条件を満たす製品の数
+	Facets []Facet
+}
+
+// FacetCounter: 製品を1件ずつ受け取ってファセットの件数を集計します。
+// リポジトリの実装ごとに製品の読み出し方は違っても、集計の規則はここにまとめます。
+type FacetCounter struct {
+	filter ProductFilter
+	total  int
+	counts map[FacetName]map[string]int
+	prices []int
+}
+
+// NewFacetCounter: 集計の開始
+func NewFacetCounter(filter ProductFilter) *FacetCounter {
+	c := &FacetCounter{filter: filter}
+	c.Reset()
+	return c
+}
+
+// Reset: 集計をやり直します (トランザクションの再試行時などに使います)。
+func (c *FacetCounter) Reset() {
+	c.total = 0
+	c.counts = make(map[FacetName]map[string]int, len(facetOrder))
+	for _, name := range facetOrder {
+		c.counts[name] = make(map[string]int)
+	}
+	c.prices = make([]int, len(PriceBuckets))
+}
+
+// Add: 製品を集計に加えます。
+// すべての条件を満たす製品は全ファセットに、1つだけ満たさない製品はその項目のファセットにだけ数えます。
+func (c *FacetCounter) Add(p *Product) {
+	failedFacet, failed := c.filter.failures(p)
+	if failed > 1 {
+		return
+	}
+	if failed == 0 {
+		c.total++
+	}
+	counts := func(name FacetName) bool {
+		return failed == 0 || failedFacet == name
+	}
+	if counts(FacetCategory) && p.Category != "" {
+		c.counts[FacetCategory][string(p.Category)]++
+	}
+	if counts(FacetManufacturer) && p.Manufacturer != "" {
+		c.counts[FacetManufacturer][p.Manufacturer]++
+	}
+	if counts(FacetInstallationDifficulty) && p.InstallationDifficulty != "" {
+		c.counts[FacetInstallationDifficulty][string(p.InstallationDifficulty)]++
+	}
+	if counts(FacetPriceBucket) {
+		c.prices[priceBucketOf(p.Price.Amount())]++
+	}
+	if counts(FacetProtocol) {
+		for i, proto := range p.Connectivity.Protocols {
+			// 同じ規格が重複して登録されていても1件と数えます
+			if !containsProtocol(p.Connectivity.Protocols[:i], proto) {
+				c.counts[FacetProtocol][string(proto)]++
+			}
+		}
+	}
+}
+
+// Result: 集計結果を返します。
+// 値は件数の多い順に並べ、条件で指定された値は0件でも含めます。価格帯は安い順にすべて並べます。
+func (c *FacetCounter) Result() *ProductFacets {
+	selected := map[FacetName][]string{
+		FacetCategory:               toStrings(c.filter.Categories),
+		FacetManufacturer:           c.filter.Manufacturers,
+		FacetInstallationDifficulty: toStrings(c.filter.Difficulties),
+		FacetProtocol:               toStrings(c.filter.Protocols),
+	}
+	result := &ProductFacets{Total: c.total}
+	for _, name := range facetOrder {
+		if name == FacetPriceBucket {
+			result.Facets = append(result.Facets, c.priceFacet())
+			continue
+		}
+		facet := Facet{Name: name}
+		counts := c.counts[name]
+		for _, v := range selected[name] {
+			if _, ok := counts[v]; !ok {
+				counts[v] = 0
+			}
+		}
+		for v, n := range counts {
+			facet.Values = append(facet.Values, FacetValue{Value: v, Count: n, Selected: contains(selected[name], v)})
+		}
+		sort.Slice(facet.Values, func(i, j int) bool {
+			if facet.Values[i].Count != facet.Values[j].Count {
+				return facet.Values[i].Count > facet.Values[j].Count
+			}
+			return facet.Values[i].Value < facet.Values[j].Value
+		})
+		result.Facets = append(result.Facets, facet)
+	}
+	return result
+}
+
+// priceFacet: 価格帯ファセット。価格の条件と重なる価格帯を「指定されている値」とします。
+func (c *FacetCounter) priceFacet() Facet {
+	facet := Facet{Name: FacetPriceBucket}
+	priceFiltered := c.filter.MinPrice > 0 || c.filter.MaxPrice > 0
+	for i := range PriceBuckets {
+		b := PriceBuckets[i]
+		overlaps := (b.MaxPrice == 0 || b.MaxPrice >= c.filter.MinPrice) && (c.filter.MaxPrice == 0 || b.MinPrice <= c.filter.MaxPrice)
+		facet.Values = append(facet.Values, FacetValue{Value: b.Key, Count: c.prices[i], Selected: priceFiltered && overlaps, Bucket: &b})
+	}
+	return facet
+}
+
+func toStrings[T ~string](list []T) []string {
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = string(v)
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package model
+
+import "fmt"
+
+// ProductFilter: 製品一覧の絞り込み条件 (Value Object)
+// 同じ項目の中で複数指定した値は「いずれか」、項目どうしは「すべて」を満たす製品に絞り込みます。
+type ProductFilter struct {
+	Categories    []ProductCategory
+	Manufacturers []string
+	MinPrice      int32 // 下限 (円)。0なら下限なし
+	MaxPrice      int32 // 上限 (円)。0なら上限なし
+	Difficulties  []InstallationDifficulty
+	Protocols     []Protocol // いずれかの規格に対応している製品
+}
+
+// Validate: 条件の矛盾 (価格帯の上下が逆など) をチェックします。
+func (f ProductFilter) Validate() error {
+	if f.MinPrice < 0 || f.MaxPrice < 0 {
+		return fmt.Errorf("%w: price range must not be negative", ErrInvalidSearchQuery)
+	}
+	if f.MaxPrice > 0 && f.MinPrice > f.MaxPrice {
+		return fmt.Errorf("%w: min price %d exceeds max price %d", ErrInvalidSearchQuery, f.MinPrice, f.MaxPrice)
+	}
+	return nil
+}
+
+// Matches: 製品が絞り込み条件をすべて満たすかを判定します。
+func (f ProductFilter) Matches(p *Product) bool {
+	_, failed := f.failures(p)
+	return failed == 0
+}
+
+// failures: 満たさなかった条件の数と、最初に満たさなかった条件のファセットを返します。
+// ファセットの件数計算で「その項目の条件だけを外した場合」を1回の走査で求めるために使います。
+func (f ProductFilter) failures(p *Product) (FacetName, int) {
+	var first FacetName
+	failed := 0
+	fail := func(name FacetName) {
+		if failed == 0 {
+			first = name
+		}
+		failed++
+	}
+	if len(f.Categories) > 0 && !contains(f.Categories, p.Category) {
+		fail(FacetCategory)
+	}
+	if len(f.Manufacturers) > 0 && !contains(f.Manufacturers, p.Manufacturer) {
+		fail(FacetManufacturer)
+	}
+	if len(f.Difficulties) > 0 && !contains(f.Difficulties, p.InstallationDifficulty) {
+		fail(FacetInstallationDifficulty)
+	}
+	if price := p.Price.Amount(); price < f.MinPrice || (f.MaxPrice > 0 && price > f.MaxPrice) {
+		fail(FacetPriceBucket)
+	}
+	if len(f.Protocols) > 0 && !supportsAny(p.Connectivity, f.Protocols) {
+		fail(FacetProtocol)
+	}
+	return first, failed
+}
+
+func supportsAny(c Connectivity, protocols []Protocol) bool {
+	for _, p := range protocols {
+		if c.Supports(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains[T comparable](list []T, v T) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"time"
+)
+
+// 取り込める画像の制限
+const (
+	MaxImageBytes  = 10 << 20   // 元画像の大きさの上限 (10MB)
+	MaxImagePixels = 40_000_000 // 元画像の画素数の上限 (展開したときのメモリを抑えるため)
+	MinImageEdge   = 100        // 元画像の短い辺の下限 (これより小さいと一覧でもぼやける)
+)
+
+// ImageFormat: サムネイルの形式
+type ImageFormat string
+
+const (
+	ImageFormatJPEG ImageFormat = "jpeg"
+	ImageFormatWebP ImageFormat = "webp" // 可逆圧縮 (VP8L)
+)
+
+// Extension: 保存するファイルの拡張子
+func (f ImageFormat) Extension() string {
+	if f == ImageFormatWebP {
+		return ".webp"
+	}
+	return ".jpg"
+}
+
+// ContentType: 配信するときの Content-Type
+func (f ImageFormat) ContentType() string {
+	if f == ImageFormatWebP {
+		return "image/webp"
+	}
+	return "image/jpeg"
+}
+
+// ImageVariantSpec: サムネイルの大きさ (長い辺をこの大きさに縮めます。元画像より大きくはしません)
+type ImageVariantSpec struct {
+	Name    string
+	MaxEdge int
+}
+
+// ImageVariantSpecs: 作るサムネイルの大きさ。形式ごと (JPEG / WebP) にすべての大きさを作ります
+var ImageVariantSpecs = []ImageVariantSpec{
+	{Name: "small", MaxEdge: 160},  // 一覧・採用計画のカード
+	{Name: "medium", MaxEdge: 480}, // 製品詳細
+	{Name: "large", MaxEdge: 1200}, // 拡大表示
+}
+
+// PrimaryImageVariant: Product.ImageURL に入れるサムネイル (JPEG)。どのクライアントでも表示できるものにします
+const PrimaryImageVariant = "large"
+
+// ProductImage: 取り込んだ製品画像
+// 元画像は保存せず、EXIF などのメタデータを取り除いて作り直したサムネイルだけを保存します。
+type ProductImage struct {
+	SourceHash string         // 元画像の SHA-256 (同じ画像を取り込み直したときに作り直さないため)
+	SourceURL  string         // 取得元のURL (アップロードなら空)
+	MIMEType   string         // 元画像の形式 (例: "image/png")
+	Width      int            // 向きを直した後の元画像の幅
+	Height     int            // 向きを直した後の元画像の高さ
+	Variants   []ImageVariant // 大きさ・形式ごとのサムネイル
+	IngestedAt time.Time
+}
+
+// Variant: 大きさと形式からサムネイルを探します。
+func (i *ProductImage) Variant(name string, format ImageFormat) (ImageVariant, bool) {
+	for _, v := range i.Variants {
+		if v.Name == name && v.Format == format {
+			return v, true
+		}
+	}
+	return ImageVariant{}, false
+}
+
+// ImageVariant: 保存したサムネイル
+type ImageVariant struct {
+	Name   string // ImageVariantSpec.Name
+	Format ImageFormat
+	Width  int
+	Height int
+	Bytes  int
+	Key    string // BlobStore のキー (内容の SHA-256 と拡張子)
+	URL    string // 配信するURL。内容が変われば URL も変わるので、長期間キャッシュできます
+}
+
+// ProcessedImage: 元画像を確かめて作ったサムネイル (まだ保存していないもの)
+type ProcessedImage struct {
+	SourceHash string
+	MIMEType   string
+	Width      int
+	Height     int
+	Variants   []EncodedImage
+}
+
+// EncodedImage: 1つのサムネイルの中身
+type EncodedImage struct {
+	Name   string
+	Format ImageFormat
+	Width  int
+	Height int
+	Data   []byte
+}
+
+// Key: 内容から決まる BlobStore のキー (例: "3a7bd3e2…9f.webp")
+func (e EncodedImage) Key() string {
+	return ContentHash(e.Data) + e.Format.Extension()
+}
+
+// ContentHash: 内容の SHA-256 (16進数)
+func ContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+var imageKeyPattern = regexp.MustCompile(`^[0-9a-f]{64}\.(jpg|webp)$`)
+
+// IsImageKey: サムネイルのキーの形をしているかどうか (配信のときに任意のパスを読ませないため)
+func IsImageKey(key string) bool {
+	return imageKeyPattern.MatchString(key)
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ProductRow: 一括登録ファイルの1行を読み取った結果
+// 行の形式が不正な場合 (数値でない価格など) は Product の代わりに Err が入ります。
+type ProductRow struct {
+	Source  string // 読み込んだファイル名 (複数のファイルをまとめて読む場合のみ)
+	Line    int    // ファイル上の行番号 (1始まり)
+	SKU     string
+	Product *Product
+	Err     error
+}
+
+// Location: エラーメッセージ用の行の位置 ("products.yaml:12" または "line 12")
+func (r ProductRow) Location() string {
+	if r.Source != "" {
+		return fmt.Sprintf("%s:%d", r.Source, r.Line)
+	}
+	return fmt.Sprintf("line %d", r.Line)
+}
+
+// ImportAction: 一括登録で行ごとに行った操作
+type ImportAction string
+
+const (
+	ImportCreated   ImportAction = "created"
+	ImportUpdated   ImportAction = "updated"
+	ImportUnchanged ImportAction = "unchanged" // 既存の製品と内容が同じため保存しなかった
+	ImportFailed    ImportAction = "failed"
+)
+
+// MaxImportErrors: 一括登録の結果に含める行エラーの上限 (件数の集計は全行について行います)
+const MaxImportErrors = 1000
+
+// ImportRowError: 取り込めなかった行とその理由
+type ImportRowError struct {
+	Source  string
+	Line    int
+	SKU     string
+	Message string
+}
+
+// ImportReport: 一括登録の結果
+// 取り込みは行ごとに行い、エラーのあった行だけを飛ばします (ファイル全体は取り消しません)。
+// DryRun の場合は保存せずに、保存した場合の件数とエラーだけを返します。
+type ImportReport struct {
+	DryRun    bool
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+	Errors    []ImportRowError
+}
+
+// Record: 行の結果を集計に加えます。
+func (r *ImportReport) Record(row ProductRow, action ImportAction, err error) {
+	switch action {
+	case ImportCreated:
+		r.Created++
+	case ImportUpdated:
+		r.Updated++
+	case ImportUnchanged:
+		r.Unchanged++
+	case ImportFailed:
+		r.Failed++
+		if len(r.Errors) < MaxImportErrors {
+			r.Errors = append(r.Errors, ImportRowError{Source: row.Source, Line: row.Line, SKU: row.SKU, Message: err.Error()})
+		}
+	}
+}
+
+// SameImportableFields: 一括登録ファイルの列にある項目がすべて同じかを判定します。
+// 在庫状況・販売サイト・取り込んだ画像はファイルにないため比べません。
+// リストは nil と空を同じとみなします (保存先から読み戻すと、空のリストが nil になることがあるため)。
+func (p *Product) SameImportableFields(other *Product) bool {
+	a, b := p.AutomationEffect, other.AutomationEffect
+	c, d := p.Connectivity, other.Connectivity
+	r, s := p.InstallationRequirements, other.InstallationRequirements
+	return p.SKU == other.SKU &&
+		p.Name == other.Name &&
+		p.Description == other.Description &&
+		p.Price.Amount() == other.Price.Amount() &&
+		p.Manufacturer == other.Manufacturer &&
+		p.PurchaseLink == other.PurchaseLink &&
+		p.ImageURL == other.ImageURL &&
+		slices.Equal(p.WeakPoints, other.WeakPoints) &&
+		slices.Equal(p.StrongPoints, other.StrongPoints) &&
+		p.InstallationDifficulty == other.InstallationDifficulty &&
+		p.Category == other.Category &&
+		slices.Equal(a.ChoreEffects, b.ChoreEffects) &&
+		a.MaintenanceMinutesPerMonth == b.MaintenanceMinutesPerMonth &&
+		a.PowerWatts == b.PowerWatts &&
+		a.ConsumableCostPerMonth == b.ConsumableCostPerMonth &&
+		slices.Equal(c.Protocols, d.Protocols) &&
+		slices.Equal(c.RequiredHubProtocols, d.RequiredHubProtocols) &&
+		slices.Equal(c.BridgedProtocols, d.BridgedProtocols) &&
+		r.RequiresDrilling == s.RequiresDrilling &&
+		r.RequiresElectricalWork == s.RequiresElectricalWork &&
+		r.StepSensitive == s.StepSensitive &&
+		r.ClimbableStepMM == s.ClimbableStepMM &&
+		slices.Equal(r.SupportedFloorTypes, s.SupportedFloorTypes) &&
+		slices.Equal(r.SupportedResidenceTypes, s.SupportedResidenceTypes)
+}
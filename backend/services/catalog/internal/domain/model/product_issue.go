@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+// LinkStatus: 購入リンクを確認した結果
+type LinkStatus string
+
+const (
+	LinkOK          LinkStatus = "ok"
+	LinkNotFound    LinkStatus = "not_found"    // 404 / 410 (商品ページが削除された)
+	LinkSoftMissing LinkStatus = "soft_404"     // 200 だが「ページが見つかりません」「販売終了」の内容、またはトップページへの転送
+	LinkOutOfStock  LinkStatus = "out_of_stock" // 200 だが「在庫切れ」の内容
+	LinkUnreachable LinkStatus = "unreachable"  // タイムアウト・接続失敗・5xx・転送の繰り返し
+)
+
+// IsValid: 定義済みの結果かどうかを判定します。
+func (s LinkStatus) IsValid() bool {
+	switch s {
+	case LinkOK, LinkNotFound, LinkSoftMissing, LinkOutOfStock, LinkUnreachable:
+		return true
+	}
+	return false
+}
+
+// NeedsAttention: 管理者が確認すべき結果かどうか
+func (s LinkStatus) NeedsAttention() bool {
+	return s != LinkOK
+}
+
+// LinkCheckResult: 1つのリンクを確認した結果
+type LinkCheckResult struct {
+	Status     LinkStatus
+	HTTPStatus int    // 最後に受け取ったレスポンスのステータスコード (受け取れなかったら0)
+	FinalURL   string // 転送をたどった後のURL
+	Detail     string // 判定の理由 (例: "page contains \"販売終了\"")
+}
+
+// ProductIssue: 製品の購入リンクの問題 (管理者が確認すべきもの)
+type ProductIssue struct {
+	ProductID     ProductID
+	ProductName   string
+	Merchant      string // リンクの販売サイト (MerchantOffer.Merchant。購入リンクなら DirectMerchant)
+	URL           string
+	Status        LinkStatus
+	HTTPStatus    int
+	FinalURL      string
+	Detail        string
+	FirstSeenAt   time.Time // 問題を最初に見つけた日時 (解消するまで引き継ぐ)
+	LastCheckedAt time.Time
+}
+
+// ProductIssueFilter: 問題の一覧の絞り込み条件。空なら全件
+type ProductIssueFilter struct {
+	Statuses []LinkStatus
+}
+
+// Matches: 問題が条件に当てはまるかどうか
+func (f ProductIssueFilter) Matches(i ProductIssue) bool {
+	return len(f.Statuses) == 0 || contains(f.Statuses, i.Status)
+}
+
+// LinkCheckReport: 購入リンクをひととおり確認した結果
+type LinkCheckReport struct {
+	Checked  int // 確認したリンクの数
+	Issues   int // 問題のあったリンクの数
+	ByStatus map[LinkStatus]int
+}
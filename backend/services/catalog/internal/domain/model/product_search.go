@@ -0,0 +1,66 @@
+package model
+
+import "fmt"
+
+// 検索結果の件数の既定値と上限です。
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// SearchField: 全文検索の対象になる製品の項目
+type SearchField string
+
+const (
+	SearchFieldName         SearchField = "name"
+	SearchFieldDescription  SearchField = "description"
+	SearchFieldManufacturer SearchField = "manufacturer"
+	SearchFieldStrongPoints SearchField = "strong_points"
+	SearchFieldWeakPoints   SearchField = "weak_points"
+)
+
+// ProductSearchQuery: 製品検索の条件 (Value Object)
+// Text が空の場合は、絞り込み条件だけで製品名順に返します。
+type ProductSearchQuery struct {
+	Text   string
+	Filter ProductFilter
+	Limit  int
+	Offset int
+}
+
+// Normalize: 件数の既定値を補い、条件の矛盾をチェックします。
+func (q ProductSearchQuery) Normalize() (ProductSearchQuery, error) {
+	if err := q.Filter.Validate(); err != nil {
+		return q, err
+	}
+	if q.Offset < 0 {
+		return q, fmt.Errorf("%w: offset must not be negative", ErrInvalidSearchQuery)
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultSearchLimit
+	}
+	if q.Limit > MaxSearchLimit {
+		q.Limit = MaxSearchLimit
+	}
+	return q, nil
+}
+
+// SearchHighlight: 検索語に一致した箇所を含む抜粋
+// Fragments は HTML エスケープ済みで、一致箇所を <mark></mark> で囲んでいます。
+type SearchHighlight struct {
+	Field     SearchField
+	Fragments []string
+}
+
+// ProductSearchHit: 検索にヒットした製品
+type ProductSearchHit struct {
+	Product    *Product
+	Score      float64 // 関連度 (大きいほど関連が強い)
+	Highlights []SearchHighlight
+}
+
+// ProductSearchResult: 検索結果 (Offset/Limit で切り出した1ページ分)
+type ProductSearchResult struct {
+	Hits  []ProductSearchHit
+	Total int // 条件に一致した全件数
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/eligibility"
+)
+
+// FloorType: 床材を表す型
+type FloorType string
+
+const (
+	FloorFlooring FloorType = "flooring" // フローリング
+	FloorTatami   FloorType = "tatami"   // 畳
+	FloorCarpet   FloorType = "carpet"   // カーペット
+)
+
+// IsValid: 定義済みの床材かどうかを判定します。
+func (f FloorType) IsValid() bool {
+	switch f {
+	case FloorFlooring, FloorTatami, FloorCarpet:
+		return true
+	}
+	return false
+}
+
+// ResidenceType: 住居の種類 (User Service の ResidenceInfo.type と同じ値)
+type ResidenceType string
+
+const (
+	ResidenceApartment ResidenceType = "apartment"
+	ResidenceHouse     ResidenceType = "house"
+	ResidenceTownhouse ResidenceType = "townhouse"
+	ResidenceOther     ResidenceType = "other"
+)
+
+// IsValid: 定義済みの住居の種類かどうかを判定します。
+func (t ResidenceType) IsValid() bool {
+	switch t {
+	case ResidenceApartment, ResidenceHouse, ResidenceTownhouse, ResidenceOther:
+		return true
+	}
+	return false
+}
+
+// Ownership: 所有形態 (User Service の ResidenceInfo.ownership と同じ値)
+type Ownership string
+
+const (
+	OwnershipOwned  Ownership = "owned"
+	OwnershipRented Ownership = "rented"
+	OwnershipOther  Ownership = "other"
+)
+
+// ResidenceProfile: 製品の適合判定に使う住環境 (Value Object)
+// User Service の ResidenceInfo のうち、カタログ側で判定に必要な項目だけを受け取ります。
+type ResidenceProfile struct {
+	Type         ResidenceType
+	Ownership    Ownership
+	HasSteps     bool             // 室内に段差があるか
+	StepHeightMM int              // 最大の段差の高さ (mm)。0なら不明
+	FloorTypes   []FloorType      // 家の中の床材
+	WiFi         *WiFiEnvironment // Wi-Fi環境。nilなら不明として判定しません
+}
+
+// InstallationRequirements: 製品の設置要件 (Value Object)
+// 「どんな住居なら設置できるか」を宣言的に記述します。判定は EligibilityMatcher が行います。
+type InstallationRequirements struct {
+	RequiresDrilling        bool            // 壁やドアへの穴あけが必要 (賃貸では原則不可)
+	RequiresElectricalWork  bool            // 電気工事士による配線工事が必要 (賃貸では原則不可)
+	StepSensitive           bool            // 段差の影響を受ける (ロボット掃除機など)
+	ClimbableStepMM         int             // 乗り越えられる段差の高さ (mm)
+	SupportedFloorTypes     []FloorType     // 対応する床材 (空なら床材を問わない)
+	SupportedResidenceTypes []ResidenceType // 設置できる住居の種類 (空なら問わない)
+}
+
+// Validate: 設置要件の不変条件をチェックします。
+func (r InstallationRequirements) Validate() error {
+	if r.ClimbableStepMM < 0 {
+		return fmt.Errorf("%w: climbable step height cannot be negative: %d", ErrInvalidProduct, r.ClimbableStepMM)
+	}
+	for _, f := range r.SupportedFloorTypes {
+		if !f.IsValid() {
+			return fmt.Errorf("%w: unknown floor type %q", ErrInvalidProduct, f)
+		}
+	}
+	for _, t := range r.SupportedResidenceTypes {
+		if !t.IsValid() {
+			return fmt.Errorf("%w: unknown residence type %q", ErrInvalidProduct, t)
+		}
+	}
+	return nil
+}
+
+// RejectionCode: 製品が住居に適合しない理由の種類
+type RejectionCode string
+
+// 値は判定ルール (pkg/domain/eligibility) の Code と同じです。
+const (
+	RejectDrillingInRental       = RejectionCode(eligibility.DrillingInRental)
+	RejectElectricalWorkInRental = RejectionCode(eligibility.ElectricalWorkInRental)
+	RejectSteps                  = RejectionCode(eligibility.Steps)
+	RejectFloorType              = RejectionCode(eligibility.FloorType)
+	RejectResidenceType          = RejectionCode(eligibility.ResidenceType)
+	RejectWiFi                   = RejectionCode(eligibility.WiFi)
+)
+
+// Rejection: 製品を提案しない理由。ユーザーにそのまま説明できる文章を持ちます。
+type Rejection struct {
+	Code    RejectionCode
+	Message string
+}
+
+// Eligibility: 1製品分の適合判定結果
+type Eligibility struct {
+	Product    *Product
+	Rejections []Rejection
+	Notes      []string // 提案はできるが伝えておくべき注意点 (一部の床材に非対応など)
+}
+
+// Eligible: 却下理由がなければ true を返します。
+func (e Eligibility) Eligible() bool {
+	return len(e.Rejections) == 0
+}
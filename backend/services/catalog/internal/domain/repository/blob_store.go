@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// BlobStore: 製品画像のサムネイルなどのファイルの保存先 (ローカルのディレクトリや Cloud Storage)
+// キーは内容から決まる (model.ContentHash) ので、同じキーのファイルを別の内容で上書きすることはありません。
+type BlobStore interface {
+	// Put: ファイルを保存します。同じキーのファイルがすでにあれば何もしません。
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Get: ファイルの中身と Content-Type を返します。無ければ model.ErrBlobNotFound を返します。
+	Get(ctx context.Context, key string) ([]byte, string, error)
+	// URL: ファイルを配信するURL
+	URL(key string) string
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// ClickRepository: 購入リンクのクリックの保存先
+type ClickRepository interface {
+	Save(ctx context.Context, e *model.ClickEvent) error
+	// List: 条件に当てはまるクリックを古い順で返します。
+	List(ctx context.Context, filter model.ClickFilter) ([]*model.ClickEvent, error)
+}
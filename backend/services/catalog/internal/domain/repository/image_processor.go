@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// ImageProcessor: 画像を確かめてサムネイルを作ります。
+type ImageProcessor interface {
+	// Process: 形式・大きさを確かめ、メタデータを取り除いたサムネイルを model.ImageVariantSpecs の大きさで作ります。
+	// 取り込めない画像なら model.ErrInvalidImage を返します。
+	Process(data []byte) (*model.ProcessedImage, error)
+}
+
+// ImageFetcher: URL から元画像を取得します。
+type ImageFetcher interface {
+	// Fetch: 取得できない、または model.MaxImageBytes を超える場合は model.ErrInvalidImage を返します。
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
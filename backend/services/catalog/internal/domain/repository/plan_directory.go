@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// PlanDirectory: 採用計画の所有者を調べます。
+// 購入リンクに採用計画を埋め込む前に、その計画がログイン中の利用者のものかを確かめるのに使います。
+type PlanDirectory interface {
+	// OwnerOf: 採用計画の利用者IDを返します。計画が無ければ model.ErrPlanNotOwned を返します。
+	OwnerOf(ctx context.Context, planID string) (string, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// PriceHistoryRepository: 製品の価格の履歴の保存先
+type PriceHistoryRepository interface {
+	// Append: 価格の変更を1件追加します。
+	Append(ctx context.Context, point model.PricePoint) error
+	// Get: 製品の価格の履歴を古い順で返します。履歴がなければ空の履歴を返します。
+	Get(ctx context.Context, id model.ProductID) (model.PriceHistory, error)
+}
+
+// PriceDropRepository: 検出した値下がりイベントの保存先
+type PriceDropRepository interface {
+	Save(ctx context.Context, e *model.PriceDropEvent) error
+	// List: since より後に検出したイベントを古い順で返します。productIDs が空でなければ、その製品のイベントだけを返します。
+	List(ctx context.Context, since time.Time, productIDs []model.ProductID) ([]*model.PriceDropEvent, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// PriceSource: 販売サイトから製品の価格と在庫状況を取得します。
+// 販売サイトごとに1つ実装し、PriceRefresher に登録します。
+type PriceSource interface {
+	// Merchant: 販売サイトの名前 (レート制限の単位と、価格の変更元の記録に使います)
+	Merchant() string
+	// Supports: 購入リンクがこの販売サイトのものかどうか
+	Supports(link string) bool
+	// Fetch: 購入リンクの商品の価格と在庫状況を取得します。
+	// 商品ページが無くなっている場合は model.ErrListingNotFound を返します。
+	Fetch(ctx context.Context, link string) (model.PriceQuote, error)
+}
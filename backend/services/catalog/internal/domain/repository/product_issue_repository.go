@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// ProductIssueRepository: 購入リンクの確認で見つかった問題の保存先
+type ProductIssueRepository interface {
+	// Replace: 製品の問題をまとめて置き換えます。issues が空なら製品の問題をすべて消します。
+	Replace(ctx context.Context, id model.ProductID, issues []model.ProductIssue) error
+	// Get: 製品の問題を返します。
+	Get(ctx context.Context, id model.ProductID) ([]model.ProductIssue, error)
+	// List: 問題のある製品すべての問題を、製品IDの順で返します。
+	List(ctx context.Context) ([]model.ProductIssue, error)
+}
+
+// LinkChecker: 購入リンクが生きているかを確認します。
+type LinkChecker interface {
+	// Check: リンクを確認します。確認できなかった場合 (タイムアウトなど) も model.LinkUnreachable として結果を返し、
+	// error は ctx がキャンセルされた場合にだけ返します。
+	Check(ctx context.Context, link string) (model.LinkCheckResult, error)
+}
@@ -10,5 +10,18 @@ type ProductRepository interface {
 	Save(ctx context.Context, product *model.Product) error
 	List(ctx context.Context) ([]*model.Product, error)
 	GetByID(ctx context.Context, id model.ProductID) (*model.Product, error)
+	// GetByIDs: 複数の製品をまとめて取得します。戻り値は ids と同じ順序で、存在しない製品の位置は nil です。
+	GetByIDs(ctx context.Context, ids []model.ProductID) ([]*model.Product, error)
+	// GetBySKU: 外部SKUで製品を取得します。存在しない場合は (nil, nil) を返します。
+	GetBySKU(ctx context.Context, sku string) (*model.Product, error)
+	// CountFacets: 絞り込み条件に対するファセットの件数を、ある時点の一貫した状態で集計します。
+	CountFacets(ctx context.Context, filter model.ProductFilter) (*model.ProductFacets, error)
 	// Delete can be added later
 }
+
+// ProductChangeFeed: 保存先への製品の変更を、書き込んだプロセスを問わず通知します。
+// 検索インデックスのように、プロセスごとに持つ複製を保存先と揃えるのに使います。
+type ProductChangeFeed interface {
+	// Watch: ctx が終わるか購読が切れるまで、変更があるたびに fn を呼びます。
+	Watch(ctx context.Context, fn func(model.ProductChanges)) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// ProductSearcher: 製品の全文検索を担当します。
+// 検索の仕組み (転置インデックス、外部の検索エンジンなど) はインフラ層で実装します。
+type ProductSearcher interface {
+	Search(ctx context.Context, query model.ProductSearchQuery) (*model.ProductSearchResult, error)
+}
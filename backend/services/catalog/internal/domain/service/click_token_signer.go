@@ -0,0 +1,117 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// DefaultClickTokenMaxAge: 購入リンクの有効期限の標準値
+// 採用計画の画面を開いたまま購入を検討することもあるため、長めにとります。
+const DefaultClickTokenMaxAge = 30 * 24 * time.Hour
+
+// ClickTokenSigner: 購入リンク (/r/{token}) のトークンを HMAC-SHA256 で署名・検証するドメインサービスです。
+// トークンは "内容.署名" を URL で使える base64 にしたものです。
+// 署名により、発行後のトークンの書き換え (利用者・採用計画の差し替えや、任意の製品・販売サイトへのリンクの作成) を防ぎます。
+// 利用者と採用計画が正しいかは、発行する側 (AffiliateUsecase) がアクセストークンと計画の所有者で確かめます。
+type ClickTokenSigner struct {
+	key     []byte
+	oldKeys [][]byte // 鍵を入れ替えた後も、発行済みのリンクを検証できるようにする
+	maxAge  time.Duration
+}
+
+// NewClickTokenSigner: key で署名し、key と oldKeys で検証します。
+// maxAge が0以下なら DefaultClickTokenMaxAge を使います。
+func NewClickTokenSigner(key []byte, maxAge time.Duration, oldKeys ...[]byte) (*ClickTokenSigner, error) {
+	if len(key) < 32 {
+		return nil, errors.New("click token key must be at least 32 bytes")
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultClickTokenMaxAge
+	}
+	return &ClickTokenSigner{key: key, oldKeys: oldKeys, maxAge: maxAge}, nil
+}
+
+// clickPayload: トークンに埋め込む内容 (URLを短くするため、キーを1文字にします)
+type clickPayload struct {
+	ProductID string `json:"p"`
+	Merchant  string `json:"m"`
+	UserID    string `json:"u,omitempty"`
+	PlanID    string `json:"l,omitempty"`
+	IssuedAt  int64  `json:"t"`
+}
+
+var tokenEncoding = base64.RawURLEncoding
+
+// Sign: トークンを作成します。
+func (s *ClickTokenSigner) Sign(t model.ClickToken) (string, error) {
+	payload, err := json.Marshal(clickPayload{
+		ProductID: t.ProductID.String(),
+		Merchant:  t.Merchant,
+		UserID:    t.UserID,
+		PlanID:    t.PlanID,
+		IssuedAt:  t.IssuedAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	encoded := tokenEncoding.EncodeToString(payload)
+	return encoded + "." + tokenEncoding.EncodeToString(mac(s.key, encoded)), nil
+}
+
+// Verify: トークンの署名と有効期限を確かめ、内容を返します。
+// 不正なトークンはすべて model.ErrInvalidClickToken を返します (どこが不正かは利用者に見せません)。
+func (s *ClickTokenSigner) Verify(token string, now time.Time) (model.ClickToken, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return model.ClickToken{}, fmt.Errorf("%w: malformed token", model.ErrInvalidClickToken)
+	}
+	sig, err := tokenEncoding.DecodeString(signature)
+	if err != nil || !s.validSignature(encoded, sig) {
+		return model.ClickToken{}, fmt.Errorf("%w: bad signature", model.ErrInvalidClickToken)
+	}
+	payload, err := tokenEncoding.DecodeString(encoded)
+	if err != nil {
+		return model.ClickToken{}, fmt.Errorf("%w: malformed payload", model.ErrInvalidClickToken)
+	}
+	var p clickPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return model.ClickToken{}, fmt.Errorf("%w: malformed payload", model.ErrInvalidClickToken)
+	}
+	issuedAt := time.Unix(p.IssuedAt, 0)
+	if now.Sub(issuedAt) > s.maxAge {
+		return model.ClickToken{}, fmt.Errorf("%w: expired", model.ErrInvalidClickToken)
+	}
+	return model.ClickToken{
+		ProductID: model.ProductID(p.ProductID),
+		Merchant:  p.Merchant,
+		UserID:    p.UserID,
+		PlanID:    p.PlanID,
+		IssuedAt:  issuedAt,
+	}, nil
+}
+
+func (s *ClickTokenSigner) validSignature(encoded string, sig []byte) bool {
+	if hmac.Equal(sig, mac(s.key, encoded)) {
+		return true
+	}
+	for _, key := range s.oldKeys {
+		if hmac.Equal(sig, mac(key, encoded)) {
+			return true
+		}
+	}
+	return false
+}
+
+func mac(key []byte, encoded string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(encoded))
+	return h.Sum(nil)
+}
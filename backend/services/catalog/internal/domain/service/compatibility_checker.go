@@ -0,0 +1,206 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/eligibility"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// CompatibilityChecker: 製品の組み合わせと住居のWi-Fi環境から、互換性の問題を洗い出すドメインサービスです。
+// 不足しているブリッジがあれば、カタログ内のハブ製品から補うものを「基盤・管理 (management)」グループとして提案します。
+type CompatibilityChecker struct{}
+
+// NewCompatibilityChecker: チェッカーの作成
+func NewCompatibilityChecker() *CompatibilityChecker {
+	return &CompatibilityChecker{}
+}
+
+// Check: 互換性チェックを実行します。
+// products は導入を検討している製品の組み合わせ、hubCandidates は提案に使えるハブ製品の一覧です。
+// wifi が nil なら住居のWi-Fi環境は不明として、Wi-Fiの判定をしません (ListEligibleProducts と同じ扱い)。
+func (c *CompatibilityChecker) Check(products []*model.Product, wifi *model.WiFiEnvironment, hubCandidates []*model.Product) *model.CompatibilityReport {
+	report := &model.CompatibilityReport{}
+
+	// 1. Wi-Fi環境との相性
+	if wifi != nil {
+		for _, p := range products {
+			if issue, ok := wifiIssue(p, *wifi); ok {
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+	}
+
+	// 2. 必要なハブ・ブリッジが構成内にあるか
+	var unmet []*model.Product
+	for _, p := range products {
+		if !p.Connectivity.RequiresHub() || bridgedBy(p, products) {
+			continue
+		}
+		unmet = append(unmet, p)
+		report.Issues = append(report.Issues, model.CompatibilityIssue{
+			ProductID: p.ID,
+			Kind:      model.IssueMissingBridge,
+			Protocols: p.Connectivity.RequiredHubProtocols,
+			Message:   fmt.Sprintf("%sを使うには%sに対応したハブが必要です", p.Name, joinProtocols(p.Connectivity.RequiredHubProtocols)),
+		})
+	}
+
+	// 3. 不足分を補うハブを提案
+	report.ProposedHubs = c.proposeHubs(unmet, products, wifi, hubCandidates)
+	return report
+}
+
+// proposeHubs: 不足しているブリッジをできるだけ少ないハブで補う組み合わせを選びます。
+// 「最も多くの製品を救えるハブ」から順に選ぶ貪欲法で、同数なら安い方を優先します。
+func (c *CompatibilityChecker) proposeHubs(unmet, selected []*model.Product, wifi *model.WiFiEnvironment, candidates []*model.Product) []model.HubProposal {
+	inSet := make(map[model.ProductID]bool, len(selected))
+	for _, p := range selected {
+		inSet[p.ID] = true
+	}
+	var usable []*model.Product
+	for _, h := range candidates {
+		if inSet[h.ID] || len(h.Connectivity.BridgedProtocols) == 0 {
+			continue
+		}
+		// ハブ自体が住居のWi-Fiで動かなければ提案しない
+		if wifi != nil {
+			if _, bad := wifiIssue(h, *wifi); bad {
+				continue
+			}
+		}
+		usable = append(usable, h)
+	}
+	sort.SliceStable(usable, func(i, j int) bool {
+		if usable[i].Price.Amount() != usable[j].Price.Amount() {
+			return usable[i].Price.Amount() < usable[j].Price.Amount()
+		}
+		return usable[i].ID < usable[j].ID
+	})
+
+	var proposals []model.HubProposal
+	remaining := unmet
+	for len(remaining) > 0 {
+		var best *model.Product
+		var bestCovered []*model.Product
+		for _, h := range usable {
+			var covered []*model.Product
+			for _, p := range remaining {
+				if canBridge(h, p) {
+					covered = append(covered, p)
+				}
+			}
+			if len(covered) > len(bestCovered) {
+				best, bestCovered = h, covered
+			}
+		}
+		if best == nil {
+			break
+		}
+
+		var names []string
+		var covers []model.Protocol
+		for _, p := range bestCovered {
+			names = append(names, p.Name)
+			for _, proto := range p.Connectivity.RequiredHubProtocols {
+				if best.Connectivity.Bridges(proto) && !containsProtocol(covers, proto) {
+					covers = append(covers, proto)
+				}
+			}
+		}
+		proposals = append(proposals, model.HubProposal{
+			ProductID:     best.ID,
+			ProposalGroup: model.ManagementProposalGroup,
+			Covers:        covers,
+			Reason:        fmt.Sprintf("%sを動かすために必要な%sのブリッジです", strings.Join(names, "、"), joinProtocols(covers)),
+		})
+
+		var next []*model.Product
+		for _, p := range remaining {
+			if !canBridge(best, p) {
+				next = append(next, p)
+			}
+		}
+		remaining = next
+	}
+	return proposals
+}
+
+// wifiIssue: 製品がWi-Fiでしか繋がらない場合に、住居のWi-Fi環境と合うかを判定します。
+// Thread / Zigbee / Bluetooth など別の経路を持つ製品は対象外です (判定は pkg/domain/eligibility と共通)。
+func wifiIssue(p *model.Product, wifi model.WiFiEnvironment) (model.CompatibilityIssue, bool) {
+	bands := p.Connectivity.WiFiBands()
+	switch eligibility.CheckWiFi(requirementsOf(p).Protocols, *wifiOf(wifi)) {
+	case eligibility.WiFiMissing:
+		return model.CompatibilityIssue{
+			ProductID: p.ID,
+			Kind:      model.IssueNoWiFi,
+			Protocols: bands,
+			Message:   fmt.Sprintf("%sはWi-Fi環境が必要です", p.Name),
+		}, true
+	case eligibility.WiFiBandMismatch:
+		return model.CompatibilityIssue{
+			ProductID: p.ID,
+			Kind:      model.IssueWiFiBandMismatch,
+			Protocols: bands,
+			Message:   fmt.Sprintf("%sは%sにのみ対応しており、ご自宅のWi-Fiでは接続できません", p.Name, joinProtocols(bands)),
+		}, true
+	}
+	return model.CompatibilityIssue{}, false
+}
+
+// bridgedBy: 構成内の他の製品が、p の必要とするハブの役割を果たせるかを判定します。
+func bridgedBy(p *model.Product, products []*model.Product) bool {
+	for _, h := range products {
+		if h.ID != p.ID && canBridge(h, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// canBridge: ハブ h が製品 p の要求する規格のいずれかを仲介できるかを判定します。
+func canBridge(h, p *model.Product) bool {
+	for _, proto := range p.Connectivity.RequiredHubProtocols {
+		if h.Connectivity.Bridges(proto) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsProtocol(list []model.Protocol, p model.Protocol) bool {
+	for _, v := range list {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+// protocolLabels: ユーザー向けの説明文で使う規格の表示名です。
+var protocolLabels = map[model.Protocol]string{
+	model.ProtocolMatter:     "Matter",
+	model.ProtocolThread:     "Thread",
+	model.ProtocolZigbee:     "Zigbee",
+	model.ProtocolWiFi24GHz:  "Wi-Fi (2.4GHz)",
+	model.ProtocolWiFi5GHz:   "Wi-Fi (5GHz)",
+	model.ProtocolBluetooth:  "Bluetooth",
+	model.ProtocolHomeKit:    "HomeKit",
+	model.ProtocolAlexa:      "Alexa",
+	model.ProtocolGoogleHome: "Google Home",
+}
+
+func joinProtocols(list []model.Protocol) string {
+	labels := make([]string, 0, len(list))
+	for _, p := range list {
+		if l, ok := protocolLabels[p]; ok {
+			labels = append(labels, l)
+		} else {
+			labels = append(labels, string(p))
+		}
+	}
+	return strings.Join(labels, "/")
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+func TestCompatibilityCheckerWiFi(t *testing.T) {
+	plug := &model.Product{ID: "plug", Name: "スマートプラグ", Connectivity: model.Connectivity{Protocols: []model.Protocol{model.ProtocolWiFi24GHz}}}
+	tests := []struct {
+		name string
+		wifi *model.WiFiEnvironment
+		want []model.CompatibilityIssueKind
+	}{
+		{name: "unknown", wifi: nil, want: nil},
+		{name: "no wifi", wifi: &model.WiFiEnvironment{Available: false}, want: []model.CompatibilityIssueKind{model.IssueNoWiFi}},
+		{name: "5GHz only", wifi: &model.WiFiEnvironment{Available: true, Bands: []model.Protocol{model.ProtocolWiFi5GHz}}, want: []model.CompatibilityIssueKind{model.IssueWiFiBandMismatch}},
+		{name: "2.4GHz", wifi: &model.WiFiEnvironment{Available: true, Bands: []model.Protocol{model.ProtocolWiFi24GHz}}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewCompatibilityChecker().Check([]*model.Product{plug}, tt.wifi, nil)
+			var got []model.CompatibilityIssueKind
+			for _, i := range report.Issues {
+				got = append(got, i.Kind)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("issues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
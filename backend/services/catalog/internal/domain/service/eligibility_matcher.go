@@ -0,0 +1,158 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/eligibility"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// EligibilityMatcher: 製品の設置要件を住環境と照らし合わせ、提案してよいかを判定するドメインサービスです。
+// 判定は Simulation Service の評価用カタログと共有しているルール (pkg/domain/eligibility) で行い、
+// ここでは当てはまった却下理由ごとに、ユーザーに説明する文章を組み立てます。
+type EligibilityMatcher struct{}
+
+// NewEligibilityMatcher: 標準のルールセットでマッチャーを作成します。
+func NewEligibilityMatcher() *EligibilityMatcher {
+	return &EligibilityMatcher{}
+}
+
+// Match: 1製品分の判定を行います。
+func (m *EligibilityMatcher) Match(p *model.Product, r model.ResidenceProfile) model.Eligibility {
+	e := model.Eligibility{Product: p}
+	for _, code := range eligibility.Check(requirementsOf(p), residenceOf(r)) {
+		e.Rejections = append(e.Rejections, model.Rejection{Code: model.RejectionCode(code), Message: rejectionMessage(code, p, r)})
+	}
+	if note := partialFloorNote(p, r); note != "" {
+		e.Notes = append(e.Notes, note)
+	}
+	return e
+}
+
+// MatchAll: 複数製品をまとめて判定します。
+func (m *EligibilityMatcher) MatchAll(products []*model.Product, r model.ResidenceProfile) []model.Eligibility {
+	results := make([]model.Eligibility, 0, len(products))
+	for _, p := range products {
+		results = append(results, m.Match(p, r))
+	}
+	return results
+}
+
+// rejectionMessage: 却下理由をユーザーに説明する文章です。
+func rejectionMessage(code eligibility.Code, p *model.Product, r model.ResidenceProfile) string {
+	switch code {
+	case eligibility.DrillingInRental:
+		return fmt.Sprintf("%sは設置に穴あけが必要なため、原状回復が必要な賃貸住宅には提案できません", p.Name)
+	case eligibility.ElectricalWorkInRental:
+		return fmt.Sprintf("%sは電気工事が必要なため、賃貸住宅には提案できません", p.Name)
+	case eligibility.Steps:
+		if r.StepHeightMM > 0 {
+			return fmt.Sprintf("%sが乗り越えられる段差は%dmmまでで、ご自宅の段差 (%dmm) を越えられません", p.Name, p.InstallationRequirements.ClimbableStepMM, r.StepHeightMM)
+		}
+		return fmt.Sprintf("%sはご自宅の段差を乗り越えられません", p.Name)
+	case eligibility.FloorType:
+		return fmt.Sprintf("%sはご自宅の床材 (%s) に対応していません", p.Name, joinFloors(r.FloorTypes))
+	case eligibility.ResidenceType:
+		return fmt.Sprintf("%sはこの住居タイプには設置できません", p.Name)
+	case eligibility.WiFi:
+		// 互換性チェックと同じ文章を使います
+		if issue, ok := wifiIssue(p, *r.WiFi); ok {
+			return issue.Message
+		}
+	}
+	return fmt.Sprintf("%sはご自宅の環境に設置できません", p.Name)
+}
+
+// requirementsOf: 製品の設置要件を、共有ルールの入力に変換します。
+func requirementsOf(p *model.Product) eligibility.Requirements {
+	req := p.InstallationRequirements
+	out := eligibility.Requirements{
+		RequiresDrilling:       req.RequiresDrilling,
+		RequiresElectricalWork: req.RequiresElectricalWork,
+		StepSensitive:          req.StepSensitive,
+		ClimbableStepMM:        req.ClimbableStepMM,
+	}
+	for _, f := range req.SupportedFloorTypes {
+		out.SupportedFloorTypes = append(out.SupportedFloorTypes, string(f))
+	}
+	for _, t := range req.SupportedResidenceTypes {
+		out.SupportedResidenceTypes = append(out.SupportedResidenceTypes, string(t))
+	}
+	for _, proto := range p.Connectivity.Protocols {
+		out.Protocols = append(out.Protocols, string(proto))
+	}
+	return out
+}
+
+// residenceOf: 住環境を、共有ルールの入力に変換します。
+func residenceOf(r model.ResidenceProfile) eligibility.Residence {
+	out := eligibility.Residence{
+		Type:         string(r.Type),
+		Ownership:    string(r.Ownership),
+		HasSteps:     r.HasSteps,
+		StepHeightMM: r.StepHeightMM,
+	}
+	for _, f := range r.FloorTypes {
+		out.FloorTypes = append(out.FloorTypes, string(f))
+	}
+	if r.WiFi != nil {
+		out.WiFi = wifiOf(*r.WiFi)
+	}
+	return out
+}
+
+func wifiOf(w model.WiFiEnvironment) *eligibility.WiFiEnvironment {
+	out := &eligibility.WiFiEnvironment{Available: w.Available}
+	for _, b := range w.Bands {
+		out.Bands = append(out.Bands, string(b))
+	}
+	return out
+}
+
+func partialFloorNote(p *model.Product, r model.ResidenceProfile) string {
+	supported := p.InstallationRequirements.SupportedFloorTypes
+	if len(supported) == 0 {
+		return ""
+	}
+	var unsupported []model.FloorType
+	matched := false
+	for _, f := range r.FloorTypes {
+		if containsFloor(supported, f) {
+			matched = true
+		} else {
+			unsupported = append(unsupported, f)
+		}
+	}
+	if !matched || len(unsupported) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%sの部屋では%sを使えません", joinFloors(unsupported), p.Name)
+}
+
+func containsFloor(list []model.FloorType, f model.FloorType) bool {
+	for _, v := range list {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
+
+var floorLabels = map[model.FloorType]string{
+	model.FloorFlooring: "フローリング",
+	model.FloorTatami:   "畳",
+	model.FloorCarpet:   "カーペット",
+}
+
+func joinFloors(list []model.FloorType) string {
+	labels := make([]string, 0, len(list))
+	for _, f := range list {
+		if l, ok := floorLabels[f]; ok {
+			labels = append(labels, l)
+		} else {
+			labels = append(labels, string(f))
+		}
+	}
+	return strings.Join(labels, "・")
+}
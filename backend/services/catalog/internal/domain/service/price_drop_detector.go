@@ -0,0 +1,46 @@
+package service
+
+import (
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// DefaultPriceDropWindow: 値下がりの判定で最安値を求める期間の標準値
+// ロボット掃除機などはセールで週単位に価格が動くため、一時的な値戻りを挟んでも
+// 「最近でいちばん安い」と言える期間として90日を使います。
+const DefaultPriceDropWindow = 90 * 24 * time.Hour
+
+// PriceDropDetector: 新しい価格が直近の最安値を下回ったかを判定するドメインサービスです。
+// 最安値と同じ価格に戻っただけでは値下がりとみなしません (セールの繰り返しで何度も通知しないため)。
+type PriceDropDetector struct {
+	window time.Duration
+}
+
+// NewPriceDropDetector: window が0以下なら DefaultPriceDropWindow を使います。
+func NewPriceDropDetector(window time.Duration) *PriceDropDetector {
+	if window <= 0 {
+		window = DefaultPriceDropWindow
+	}
+	return &PriceDropDetector{window: window}
+}
+
+// Detect: 追加前の履歴 history と新しい価格 next から値下がりを判定します。
+// 初めての価格 (比べる履歴がない) は値下がりとみなしません。
+func (d *PriceDropDetector) Detect(p *model.Product, history model.PriceHistory, next model.PricePoint) (*model.PriceDropEvent, bool) {
+	min, ok := history.TrailingMin(next.RecordedAt, d.window)
+	if !ok || next.Amount >= min {
+		return nil, false
+	}
+	latest, _ := history.Latest()
+	return &model.PriceDropEvent{
+		ProductID:     p.ID,
+		ProductName:   p.Name,
+		Price:         next.Amount,
+		PreviousPrice: latest.Amount,
+		TrailingMin:   min,
+		Window:        d.window,
+		Source:        next.Source,
+		DetectedAt:    next.RecordedAt,
+	}, true
+}
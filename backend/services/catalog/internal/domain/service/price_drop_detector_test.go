@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+func TestPriceDropDetectorDetect(t *testing.T) {
+	product := &model.Product{ID: "p-1", Name: "ロボット掃除機"}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int, amount int32) model.PricePoint {
+		return model.PricePoint{ProductID: product.ID, Amount: amount, Source: model.PriceSourceAdmin, RecordedAt: start.AddDate(0, 0, days)}
+	}
+	tests := []struct {
+		name        string
+		history     []model.PricePoint
+		next        model.PricePoint
+		drop        bool
+		previous    int32
+		trailingMin int32
+	}{
+		{name: "first price", history: nil, next: at(0, 20000), drop: false},
+		{name: "price rise", history: []model.PricePoint{at(0, 20000)}, next: at(10, 22000), drop: false},
+		{
+			name:        "below the trailing minimum",
+			history:     []model.PricePoint{at(0, 20000)},
+			next:        at(10, 18000),
+			drop:        true,
+			previous:    20000,
+			trailingMin: 20000,
+		},
+		{
+			name:    "return to the previous minimum after a sale ends",
+			history: []model.PricePoint{at(0, 20000), at(10, 15000), at(20, 20000)},
+			next:    at(30, 15000),
+			drop:    false,
+		},
+		{
+			name:    "below the current price but above a recent sale",
+			history: []model.PricePoint{at(0, 20000), at(10, 15000), at(20, 20000)},
+			next:    at(30, 18000),
+			drop:    false,
+		},
+		{
+			name:        "sale older than the window is forgotten",
+			history:     []model.PricePoint{at(0, 15000), at(10, 20000)},
+			next:        at(120, 18000),
+			drop:        true,
+			previous:    20000,
+			trailingMin: 20000,
+		},
+		{
+			name:    "sale price still in effect at the window start is remembered",
+			history: []model.PricePoint{at(0, 15000), at(100, 20000)},
+			next:    at(120, 16000),
+			drop:    false,
+		},
+	}
+	d := NewPriceDropDetector(0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, drop := d.Detect(product, model.PriceHistory{ProductID: product.ID, Points: tt.history}, tt.next)
+			if drop != tt.drop {
+				t.Fatalf("drop = %v, want %v", drop, tt.drop)
+			}
+			if !drop {
+				if event != nil {
+					t.Errorf("event = %+v, want nil", event)
+				}
+				return
+			}
+			if event.Price != tt.next.Amount || event.PreviousPrice != tt.previous || event.TrailingMin != tt.trailingMin {
+				t.Errorf("event = %+v, want price %d previous %d min %d", event, tt.next.Amount, tt.previous, tt.trailingMin)
+			}
+			if event.Window != DefaultPriceDropWindow || !event.DetectedAt.Equal(tt.next.RecordedAt) || event.ProductName != product.Name {
+				t.Errorf("event = %+v", event)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// immutableCacheControl: キーは内容から決まり、同じキーの中身は変わらないので、ずっとキャッシュさせます。
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
+// GCSStore: Cloud Storage のバケットに保存する BlobStore です。
+// ファイルはバケット (または前に置いた CDN) から直接配信します。
+type GCSStore struct {
+	bucket  *storage.BucketHandle
+	baseURL string
+}
+
+// NewGCSStore: baseURL が空なら、バケットの公開URL (https://storage.googleapis.com/{bucket}) を使います。
+// バケットは公開読み取りにするか、CDN を置いて baseURL にそのURLを指定してください。
+func NewGCSStore(client *storage.Client, bucket, baseURL string) repository.BlobStore {
+	if baseURL == "" {
+		baseURL = "https://storage.googleapis.com/" + bucket
+	}
+	return &GCSStore{bucket: client.Bucket(bucket), baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// Put: オブジェクトが無いときだけ書き込みます (同じキーがあれば、中身も同じなので何もしません)。
+func (s *GCSStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	w := s.bucket.Object(key).If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	w.ContentType = contentType
+	w.CacheControl = immutableCacheControl
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to upload %s to cloud storage: %w", key, err)
+	}
+	if err := w.Close(); err != nil {
+		if alreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to upload %s to cloud storage: %w", key, err)
+	}
+	return nil
+}
+
+func (s *GCSStore) Get(ctx context.Context, key string) ([]byte, string, error) {
+	r, err := s.bucket.Object(key).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, "", model.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s from cloud storage: %w", key, err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s from cloud storage: %w", key, err)
+	}
+	return data, r.Attrs.ContentType, nil
+}
+
+func (s *GCSStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// alreadyExists: DoesNotExist の条件に合わなかった (オブジェクトがすでにある) かどうか。
+// JSON API では 412、gRPC API では FailedPrecondition になります。
+func alreadyExists(err error) bool {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusPreconditionFailed
+	}
+	return status.Code(err) == codes.FailedPrecondition
+}
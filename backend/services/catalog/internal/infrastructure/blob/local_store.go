@@ -0,0 +1,90 @@
+// Package blob: 製品画像のサムネイルなどのファイルの保存先 (BlobStore) の実装です。
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// LocalStore: ローカルのディレクトリに保存する BlobStore です (開発用)。
+// ファイルはカタログサービス自身が配信します (interface/media)。
+type LocalStore struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStore: dir が無ければ作ります。baseURL は配信するURLのキーより前の部分です (例: "http://localhost:8080/images")。
+func NewLocalStore(dir, baseURL string) (repository.BlobStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &LocalStore{dir: dir, baseURL: strings.TrimRight(baseURL, "/")}, nil
+}
+
+// Put: 一時ファイルに書いてから名前を変えるので、書きかけのファイルを配信することはありません。
+func (s *LocalStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to save blob %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save blob %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save blob %s: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save blob %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get: Content-Type はキーの拡張子から決めます。
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, "", model.ErrBlobNotFound
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, "", model.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read blob %s: %w", key, err)
+	}
+	contentType := mime.TypeByExtension(filepath.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return data, contentType, nil
+}
+
+func (s *LocalStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// path: キーをファイルのパスにします。ディレクトリの外を指すキー ("../" など) は受け付けません。
+func (s *LocalStore) path(key string) (string, error) {
+	if !filepath.IsLocal(key) || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
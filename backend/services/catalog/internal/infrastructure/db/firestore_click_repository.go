@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+const clickCollection = "clicks"
+
+// FirestoreClickRepository: 購入リンクのクリックを Firestore に保存します。
+type FirestoreClickRepository struct {
+	client *FirestoreClient
+}
+
+// NewFirestoreClickRepository: リポジトリの作成
+func NewFirestoreClickRepository(client *FirestoreClient) repository.ClickRepository {
+	return &FirestoreClickRepository{client: client}
+}
+
+func (r *FirestoreClickRepository) Save(ctx context.Context, e *model.ClickEvent) error {
+	if _, err := r.client.Client.Collection(clickCollection).Doc(e.ID).Set(ctx, e); err != nil {
+		return fmt.Errorf("failed to save click to firestore: %w", err)
+	}
+	return nil
+}
+
+// List: 期間はクエリで絞り込み、製品・採用計画の絞り込みは "in" クエリの上限 (30件) があるため取得後に行います。
+func (r *FirestoreClickRepository) List(ctx context.Context, filter model.ClickFilter) ([]*model.ClickEvent, error) {
+	q := r.client.Client.Collection(clickCollection).Query
+	if !filter.Since.IsZero() {
+		q = q.Where("ClickedAt", ">=", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		q = q.Where("ClickedAt", "<", filter.Until)
+	}
+	iter := q.OrderBy("ClickedAt", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var list []*model.ClickEvent
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return list, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list clicks from firestore: %w", err)
+		}
+		var e model.ClickEvent
+		if err := doc.DataTo(&e); err != nil {
+			return nil, err
+		}
+		if filter.Matches(&e) {
+			list = append(list, &e)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+const (
+	priceHistoryCollection = "price_history"
+	priceDropCollection    = "price_drops"
+)
+
+// FirestorePriceHistoryRepository: 価格の履歴を Firestore に保存します。
+// 製品ごとのサブコレクションにせず1つのコレクションに入れ、ProductID と RecordedAt で検索します。
+type FirestorePriceHistoryRepository struct {
+	client *FirestoreClient
+}
+
+// NewFirestorePriceHistoryRepository: リポジトリの作成
+func NewFirestorePriceHistoryRepository(client *FirestoreClient) repository.PriceHistoryRepository {
+	return &FirestorePriceHistoryRepository{client: client}
+}
+
+func (r *FirestorePriceHistoryRepository) Append(ctx context.Context, point model.PricePoint) error {
+	if _, _, err := r.client.Client.Collection(priceHistoryCollection).Add(ctx, point); err != nil {
+		return fmt.Errorf("failed to save price history to firestore: %w", err)
+	}
+	return nil
+}
+
+func (r *FirestorePriceHistoryRepository) Get(ctx context.Context, id model.ProductID) (model.PriceHistory, error) {
+	history := model.PriceHistory{ProductID: id}
+	iter := r.client.Client.Collection(priceHistoryCollection).
+		Where("ProductID", "==", id).
+		OrderBy("RecordedAt", firestore.Asc).
+		Documents(ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return history, nil
+		}
+		if err != nil {
+			return history, fmt.Errorf("failed to get price history from firestore: %w", err)
+		}
+		var pt model.PricePoint
+		if err := doc.DataTo(&pt); err != nil {
+			return history, err
+		}
+		history.Points = append(history.Points, pt)
+	}
+}
+
+// FirestorePriceDropRepository: 値下がりイベントを Firestore に保存します。
+type FirestorePriceDropRepository struct {
+	client *FirestoreClient
+}
+
+// NewFirestorePriceDropRepository: リポジトリの作成
+func NewFirestorePriceDropRepository(client *FirestoreClient) repository.PriceDropRepository {
+	return &FirestorePriceDropRepository{client: client}
+}
+
+func (r *FirestorePriceDropRepository) Save(ctx context.Context, e *model.PriceDropEvent) error {
+	if _, err := r.client.Client.Collection(priceDropCollection).Doc(e.ID).Set(ctx, e); err != nil {
+		return fmt.Errorf("failed to save price drop to firestore: %w", err)
+	}
+	return nil
+}
+
+// List: 製品の絞り込みは "in" クエリの上限 (30件) があるため、取得後に行います。
+func (r *FirestorePriceDropRepository) List(ctx context.Context, since time.Time, productIDs []model.ProductID) ([]*model.PriceDropEvent, error) {
+	wanted := make(map[model.ProductID]bool, len(productIDs))
+	for _, id := range productIDs {
+		wanted[id] = true
+	}
+	iter := r.client.Client.Collection(priceDropCollection).
+		Where("DetectedAt", ">", since).
+		OrderBy("DetectedAt", firestore.Asc).
+		Documents(ctx)
+	defer iter.Stop()
+	var list []*model.PriceDropEvent
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return list, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list price drops from firestore: %w", err)
+		}
+		var e model.PriceDropEvent
+		if err := doc.DataTo(&e); err != nil {
+			return nil, err
+		}
+		if len(wanted) > 0 && !wanted[e.ProductID] {
+			continue
+		}
+		list = append(list, &e)
+	}
+}
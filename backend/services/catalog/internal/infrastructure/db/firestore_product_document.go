@@ -0,0 +1,100 @@
+package db
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// productDocument: products コレクションに保存するドキュメントの形です。
+// model.Product をそのまま Set すると、非公開フィールドしか持たない value.Price が {} で保存され、
+// 読み戻すと価格が0になってしまいます。保存する項目をここで公開フィールドとして定め、タグで名前を固定します。
+type productDocument struct {
+	ID                       string                         `firestore:"id"`
+	SKU                      string                         `firestore:"sku"`
+	Name                     string                         `firestore:"name"`
+	Description              string                         `firestore:"description"`
+	Price                    int32                          `firestore:"price"` // 円
+	Manufacturer             string                         `firestore:"manufacturer"`
+	PurchaseLink             string                         `firestore:"purchase_link"`
+	ImageURL                 string                         `firestore:"image_url"`
+	WeakPoints               []string                       `firestore:"weak_points"`
+	StrongPoints             []string                       `firestore:"strong_points"`
+	InstallationDifficulty   model.InstallationDifficulty   `firestore:"installation_difficulty"`
+	Category                 model.ProductCategory          `firestore:"category"`
+	AutomationEffect         model.AutomationEffect         `firestore:"automation_effect"`
+	Connectivity             model.Connectivity             `firestore:"connectivity"`
+	InstallationRequirements model.InstallationRequirements `firestore:"installation_requirements"`
+	Availability             model.Availability             `firestore:"availability"`
+	Offers                   []model.MerchantOffer          `firestore:"offers"`
+	Image                    *model.ProductImage            `firestore:"image"`
+}
+
+// skuField: GetBySKU で検索に使うフィールド名 (productDocument.SKU のタグと同じ)
+const skuField = "sku"
+
+// toProductDocument: 製品を保存用のドキュメントに変換します。
+func toProductDocument(p *model.Product) productDocument {
+	return productDocument{
+		ID:                       p.ID.String(),
+		SKU:                      p.SKU,
+		Name:                     p.Name,
+		Description:              p.Description,
+		Price:                    p.Price.Amount(),
+		Manufacturer:             p.Manufacturer,
+		PurchaseLink:             p.PurchaseLink,
+		ImageURL:                 p.ImageURL,
+		WeakPoints:               p.WeakPoints,
+		StrongPoints:             p.StrongPoints,
+		InstallationDifficulty:   p.InstallationDifficulty,
+		Category:                 p.Category,
+		AutomationEffect:         p.AutomationEffect,
+		Connectivity:             p.Connectivity,
+		InstallationRequirements: p.InstallationRequirements,
+		Availability:             p.Availability,
+		Offers:                   p.Offers,
+		Image:                    p.Image,
+	}
+}
+
+// toProduct: 読み込んだドキュメントを製品に戻します。価格は value.NewPrice を通して不変条件をチェックします。
+func (d productDocument) toProduct() (*model.Product, error) {
+	price, err := value.NewPrice(d.Price)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price in product %s: %w", d.ID, err)
+	}
+	return &model.Product{
+		ID:                       model.ProductID(d.ID),
+		SKU:                      d.SKU,
+		Name:                     d.Name,
+		Description:              d.Description,
+		Price:                    price,
+		Manufacturer:             d.Manufacturer,
+		PurchaseLink:             d.PurchaseLink,
+		ImageURL:                 d.ImageURL,
+		WeakPoints:               d.WeakPoints,
+		StrongPoints:             d.StrongPoints,
+		InstallationDifficulty:   d.InstallationDifficulty,
+		Category:                 d.Category,
+		AutomationEffect:         d.AutomationEffect,
+		Connectivity:             d.Connectivity,
+		InstallationRequirements: d.InstallationRequirements,
+		Availability:             d.Availability,
+		Offers:                   d.Offers,
+		Image:                    d.Image,
+	}, nil
+}
+
+// decodeProduct: Firestore のスナップショットを製品に変換します。
+// ID はドキュメントIDを正とします。
+func decodeProduct(doc *firestore.DocumentSnapshot) (*model.Product, error) {
+	var d productDocument
+	if err := doc.DataTo(&d); err != nil {
+		return nil, fmt.Errorf("failed to decode product %s: %w", doc.Ref.ID, err)
+	}
+	d.ID = doc.Ref.ID
+	return d.toProduct()
+}
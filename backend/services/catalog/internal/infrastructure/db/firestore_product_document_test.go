@@ -0,0 +1,81 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+func TestProductDocumentRoundTrip(t *testing.T) {
+	price, _ := value.NewPrice(29800)
+	want := &model.Product{
+		ID:                     "p-1",
+		SKU:                    "SKU-1",
+		Name:                   "ロボット掃除機",
+		Description:            "段差に強いモデル",
+		Price:                  price,
+		Manufacturer:           "maker",
+		PurchaseLink:           "https://example.com/p-1",
+		ImageURL:               "https://cdn.example.com/p-1.webp",
+		WeakPoints:             []string{"音が大きい"},
+		StrongPoints:           []string{"吸引力"},
+		InstallationDifficulty: model.DifficultyLow,
+		Category:               model.CategoryRobotVacuum,
+		AutomationEffect: model.AutomationEffect{
+			ChoreEffects:               []model.ChoreEffect{{Category: model.ChoreCleaning, TimeReductionPercent: 70}},
+			MaintenanceMinutesPerMonth: 30,
+			PowerWatts:                 25,
+			ConsumableCostPerMonth:     300,
+		},
+		Connectivity:             model.Connectivity{Protocols: []model.Protocol{model.ProtocolWiFi24GHz}},
+		InstallationRequirements: model.InstallationRequirements{StepSensitive: true, ClimbableStepMM: 20},
+		Availability:             model.Availability{Status: model.StockInStock, Merchant: "rakuten", CheckedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Offers:                   []model.MerchantOffer{{Merchant: "rakuten", URL: "https://example.com/p-1"}},
+		Image:                    &model.ProductImage{SourceHash: "abc", MIMEType: "image/png", Width: 800, Height: 600},
+	}
+
+	got, err := toProductDocument(want).toProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Price.Amount() != 29800 {
+		t.Errorf("price = %d, want 29800", got.Price.Amount())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", got, want)
+	}
+}
+
+func TestProductDocumentRejectsNegativePrice(t *testing.T) {
+	if _, err := (productDocument{ID: "p-1", Price: -1}).toProduct(); err == nil {
+		t.Fatal("expected an error for a negative price")
+	}
+}
+
+// Firestore のエンコーダーは非公開フィールドを黙って読み飛ばすので、
+// ドキュメントから辿れる構造体に非公開フィールドがないことを確かめます (time.Time は Firestore が直接扱います)。
+func TestProductDocumentHasOnlyExportedFields(t *testing.T) {
+	seen := map[reflect.Type]bool{}
+	var walk func(typ reflect.Type, path string)
+	walk = func(typ reflect.Type, path string) {
+		for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct || typ == reflect.TypeOf(time.Time{}) || seen[typ] {
+			return
+		}
+		seen[typ] = true
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if !f.IsExported() {
+				t.Errorf("%s.%s is unexported and would not be stored", path, f.Name)
+				continue
+			}
+			walk(f.Type, path+"."+f.Name)
+		}
+	}
+	walk(reflect.TypeOf(productDocument{}), "productDocument")
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+const productIssueCollection = "product_issues"
+
+// productIssueDoc: 製品ごとに1つのドキュメントにまとめて保存します (ドキュメントIDは製品ID)。
+type productIssueDoc struct {
+	ProductID model.ProductID
+	Issues    []model.ProductIssue
+}
+
+// FirestoreProductIssueRepository: 購入リンクの問題を Firestore に保存します。
+type FirestoreProductIssueRepository struct {
+	client *FirestoreClient
+}
+
+// NewFirestoreProductIssueRepository: リポジトリの作成
+func NewFirestoreProductIssueRepository(client *FirestoreClient) repository.ProductIssueRepository {
+	return &FirestoreProductIssueRepository{client: client}
+}
+
+func (r *FirestoreProductIssueRepository) Replace(ctx context.Context, id model.ProductID, issues []model.ProductIssue) error {
+	ref := r.client.Client.Collection(productIssueCollection).Doc(id.String())
+	var err error
+	if len(issues) == 0 {
+		_, err = ref.Delete(ctx)
+	} else {
+		_, err = ref.Set(ctx, productIssueDoc{ProductID: id, Issues: issues})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save product issues to firestore: %w", err)
+	}
+	return nil
+}
+
+func (r *FirestoreProductIssueRepository) Get(ctx context.Context, id model.ProductID) ([]model.ProductIssue, error) {
+	doc, err := r.client.Client.Collection(productIssueCollection).Doc(id.String()).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product issues from firestore: %w", err)
+	}
+	var d productIssueDoc
+	if err := doc.DataTo(&d); err != nil {
+		return nil, err
+	}
+	return d.Issues, nil
+}
+
+func (r *FirestoreProductIssueRepository) List(ctx context.Context) ([]model.ProductIssue, error) {
+	iter := r.client.Client.Collection(productIssueCollection).OrderBy(firestore.DocumentID, firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+	var list []model.ProductIssue
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return list, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list product issues from firestore: %w", err)
+		}
+		var d productIssueDoc
+		if err := doc.DataTo(&d); err != nil {
+			return nil, err
+		}
+		list = append(list, d.Issues...)
+	}
+}
@@ -2,8 +2,14 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
 )
@@ -22,32 +28,154 @@ func NewFirestoreProductRepository(client *FirestoreClient) repository.ProductRe
 const collectionName = "products"
 
 func (r *FirestoreProductRepository) Save(ctx context.Context, p *model.Product) error {
-	// Firestore独自の保存処理
-	// map[string]interface{} への変換が必要な場合もありますが、
-	// firestoreタグをつければ直接保存も可能です。
-	_, err := r.client.Client.Collection(collectionName).Doc(p.ID.String()).Set(ctx, p)
+	// ドメインモデルにはタグを付けないので、保存用のドキュメントに変換してから保存します。
+	_, err := r.client.Client.Collection(collectionName).Doc(p.ID.String()).Set(ctx, toProductDocument(p))
 	if err != nil {
 		return fmt.Errorf("failed to save product to firestore: %w", err)
 	}
 	return nil
 }
 
-// List: 仮実装
+// List: 全製品をドキュメントIDの順で取得します。
 func (r *FirestoreProductRepository) List(ctx context.Context) ([]*model.Product, error) {
-	// 実装イメージ:
-	// docs, err := r.client.Client.Collection(collectionName).Documents(ctx).GetAll()
-	return nil, fmt.Errorf("not implemented yet")
+	iter := r.client.Client.Collection(collectionName).Documents(ctx)
+	defer iter.Stop()
+	var list []*model.Product
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return list, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products from firestore: %w", err)
+		}
+		p, err := decodeProduct(doc)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
 }
 
-// GetByID: 仮実装
+// GetByID: IDを指定して製品を取得します。見つからなかったら nil を返します (メモリの実装と同じ)。
 func (r *FirestoreProductRepository) GetByID(ctx context.Context, id model.ProductID) (*model.Product, error) {
 	doc, err := r.client.Client.Collection(collectionName).Doc(id.String()).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get product from firestore: %w", err)
 	}
-	var p model.Product
-	if err := doc.DataTo(&p); err != nil {
-		return nil, err
+	return decodeProduct(doc)
+}
+
+// GetByIDs: GetAll で複数の製品を1回の往復でまとめて取得します。
+// GetAll は存在しないドキュメントもエラーにせず返すので、その位置は nil にします。
+func (r *FirestoreProductRepository) GetByIDs(ctx context.Context, ids []model.ProductID) ([]*model.Product, error) {
+	refs := make([]*firestore.DocumentRef, len(ids))
+	for i, id := range ids {
+		refs[i] = r.client.Client.Collection(collectionName).Doc(id.String())
+	}
+	docs, err := r.client.Client.GetAll(ctx, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products from firestore: %w", err)
+	}
+	list := make([]*model.Product, len(ids))
+	for i, doc := range docs {
+		if !doc.Exists() {
+			continue
+		}
+		p, err := decodeProduct(doc)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = p
+	}
+	return list, nil
+}
+
+// GetBySKU: 外部SKUで製品を検索します。見つからなかったら nil を返します。
+func (r *FirestoreProductRepository) GetBySKU(ctx context.Context, sku string) (*model.Product, error) {
+	iter := r.client.Client.Collection(collectionName).Where(skuField, "==", sku).Limit(1).Documents(ctx)
+	defer iter.Stop()
+	doc, err := iter.Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find product by sku: %w", err)
+	}
+	return decodeProduct(doc)
+}
+
+// CountFacets: ファセットの件数を集計します。
+// 読み取り専用トランザクションの中で全件を読むので、集計中に書き込みがあっても同じ時点の状態で数えます。
+// トランザクションが再試行された場合に二重に数えないよう、毎回集計をやり直します。
+func (r *FirestoreProductRepository) CountFacets(ctx context.Context, filter model.ProductFilter) (*model.ProductFacets, error) {
+	counter := model.NewFacetCounter(filter)
+	err := r.client.Client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		counter.Reset()
+		iter := tx.Documents(r.client.Client.Collection(collectionName))
+		defer iter.Stop()
+		for {
+			doc, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			p, err := decodeProduct(doc)
+			if err != nil {
+				return err
+			}
+			counter.Add(p)
+		}
+	}, firestore.ReadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count product facets in firestore: %w", err)
+	}
+	return counter.Result(), nil
+}
+
+// FirestoreProductChangeFeed: products コレクションのスナップショットを購読する ProductChangeFeed の実装です。
+// 別のインスタンスや cmd/seed からの書き込みも通知されます。
+type FirestoreProductChangeFeed struct {
+	client *FirestoreClient
+}
+
+// NewFirestoreProductChangeFeed: コンストラクタ
+func NewFirestoreProductChangeFeed(client *FirestoreClient) repository.ProductChangeFeed {
+	return &FirestoreProductChangeFeed{client: client}
+}
+
+// Watch: スナップショットの変更 (追加・更新・削除) ごとに fn を呼びます。
+// 最初のスナップショットでは、既存の全製品が追加として通知されます。
+func (f *FirestoreProductChangeFeed) Watch(ctx context.Context, fn func(model.ProductChanges)) error {
+	iter := f.client.Client.Collection(collectionName).Snapshots(ctx)
+	defer iter.Stop()
+	for {
+		snap, err := iter.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to watch products in firestore: %w", err)
+		}
+		var changes model.ProductChanges
+		for _, c := range snap.Changes {
+			if c.Kind == firestore.DocumentRemoved {
+				changes.Removed = append(changes.Removed, model.ProductID(c.Doc.Ref.ID))
+				continue
+			}
+			p, err := decodeProduct(c.Doc)
+			if err != nil {
+				return err
+			}
+			changes.Saved = append(changes.Saved, p)
+		}
+		if len(changes.Saved) > 0 || len(changes.Removed) > 0 {
+			fn(changes)
+		}
 	}
-	return &p, nil
 }
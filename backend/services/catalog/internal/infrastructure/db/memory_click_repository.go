@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// MemoryClickRepository: 購入リンクのクリックをメモリ上に保存します。
+type MemoryClickRepository struct {
+	mu     sync.RWMutex
+	events []*model.ClickEvent // クリックされた順
+}
+
+// NewMemoryClickRepository: リポジトリの作成
+func NewMemoryClickRepository() repository.ClickRepository {
+	return &MemoryClickRepository{}
+}
+
+func (r *MemoryClickRepository) Save(ctx context.Context, e *model.ClickEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *MemoryClickRepository) List(ctx context.Context, filter model.ClickFilter) ([]*model.ClickEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var list []*model.ClickEvent
+	for _, e := range r.events {
+		if filter.Matches(e) {
+			list = append(list, e)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// MemoryPriceHistoryRepository: 価格の履歴をメモリ上に保存します。
+type MemoryPriceHistoryRepository struct {
+	mu     sync.RWMutex
+	points map[model.ProductID][]model.PricePoint // 製品ごとの履歴 (古い順)
+}
+
+// NewMemoryPriceHistoryRepository: リポジトリの作成
+func NewMemoryPriceHistoryRepository() repository.PriceHistoryRepository {
+	return &MemoryPriceHistoryRepository{points: make(map[model.ProductID][]model.PricePoint)}
+}
+
+// Append: 履歴の順序を保つため、日時の順になる位置に挿入します (通常は末尾への追加です)。
+func (r *MemoryPriceHistoryRepository) Append(ctx context.Context, point model.PricePoint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := r.points[point.ProductID]
+	i := len(list)
+	for i > 0 && list[i-1].RecordedAt.After(point.RecordedAt) {
+		i--
+	}
+	r.points[point.ProductID] = slices.Insert(list, i, point)
+	return nil
+}
+
+func (r *MemoryPriceHistoryRepository) Get(ctx context.Context, id model.ProductID) (model.PriceHistory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return model.PriceHistory{ProductID: id, Points: slices.Clone(r.points[id])}, nil
+}
+
+// MemoryPriceDropRepository: 値下がりイベントをメモリ上に保存します。
+type MemoryPriceDropRepository struct {
+	mu     sync.RWMutex
+	events []*model.PriceDropEvent // 検出した順
+}
+
+// NewMemoryPriceDropRepository: リポジトリの作成
+func NewMemoryPriceDropRepository() repository.PriceDropRepository {
+	return &MemoryPriceDropRepository{}
+}
+
+func (r *MemoryPriceDropRepository) Save(ctx context.Context, e *model.PriceDropEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *MemoryPriceDropRepository) List(ctx context.Context, since time.Time, productIDs []model.ProductID) ([]*model.PriceDropEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var list []*model.PriceDropEvent
+	for _, e := range r.events {
+		if !e.DetectedAt.After(since) {
+			continue
+		}
+		if len(productIDs) > 0 && !slices.Contains(productIDs, e.ProductID) {
+			continue
+		}
+		list = append(list, e)
+	}
+	return list, nil
+}
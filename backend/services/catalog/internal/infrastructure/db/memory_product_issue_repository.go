@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// MemoryProductIssueRepository: 購入リンクの問題をメモリ上に保存します。
+type MemoryProductIssueRepository struct {
+	mu     sync.RWMutex
+	issues map[model.ProductID][]model.ProductIssue
+}
+
+// NewMemoryProductIssueRepository: リポジトリの作成
+func NewMemoryProductIssueRepository() repository.ProductIssueRepository {
+	return &MemoryProductIssueRepository{issues: make(map[model.ProductID][]model.ProductIssue)}
+}
+
+func (r *MemoryProductIssueRepository) Replace(ctx context.Context, id model.ProductID, issues []model.ProductIssue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(issues) == 0 {
+		delete(r.issues, id)
+		return nil
+	}
+	r.issues[id] = slices.Clone(issues)
+	return nil
+}
+
+func (r *MemoryProductIssueRepository) Get(ctx context.Context, id model.ProductID) ([]model.ProductIssue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.issues[id]), nil
+}
+
+func (r *MemoryProductIssueRepository) List(ctx context.Context) ([]model.ProductIssue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]model.ProductID, 0, len(r.issues))
+	for id := range r.issues {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	var list []model.ProductIssue
+	for _, id := range ids {
+		list = append(list, r.issues[id]...)
+	}
+	return list, nil
+}
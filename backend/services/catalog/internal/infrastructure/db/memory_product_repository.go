@@ -14,6 +14,7 @@ import (
 type MemoryProductRepository struct {
 	mu       sync.RWMutex                       // 排他制御用のロック（同時に書き込みが来た時に壊れないようにする）
 	products map[model.ProductID]*model.Product // 実際のデータ保存場所（IDをキーにしたマップ）
+	skus     map[string]model.ProductID         // 外部SKUからIDを引くための索引
 }
 
 // NewMemoryProductRepository: リポジトリの作成（初期化）を行います。
@@ -22,6 +23,7 @@ type MemoryProductRepository struct {
 func NewMemoryProductRepository() repository.ProductRepository {
 	return &MemoryProductRepository{
 		products: make(map[model.ProductID]*model.Product),
+		skus:     make(map[string]model.ProductID),
 	}
 }
 
@@ -29,7 +31,14 @@ func NewMemoryProductRepository() repository.ProductRepository {
 func (r *MemoryProductRepository) Save(ctx context.Context, p *model.Product) error {
 	r.mu.Lock()         // 書き込みロックを取得（他の人は読めない・書けない）
 	defer r.mu.Unlock() // 関数が終わったら必ずアンロック
+	// SKUが変わった場合は古いSKUの索引を外します
+	if old, ok := r.products[p.ID]; ok && old.SKU != "" && r.skus[old.SKU] == p.ID {
+		delete(r.skus, old.SKU)
+	}
 	r.products[p.ID] = p
+	if p.SKU != "" {
+		r.skus[p.SKU] = p.ID
+	}
 	return nil
 }
 
@@ -57,3 +66,36 @@ func (r *MemoryProductRepository) GetByID(ctx context.Context, id model.ProductI
 	}
 	return nil, nil // 見つからなかったら nil を返す (エラーではない)
 }
+
+// GetByIDs: 複数の商品をまとめて取得します。見つからなかった位置は nil のままにします。
+func (r *MemoryProductRepository) GetByIDs(ctx context.Context, ids []model.ProductID) ([]*model.Product, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]*model.Product, len(ids))
+	for i, id := range ids {
+		list[i] = r.products[id]
+	}
+	return list, nil
+}
+
+// GetBySKU: 外部SKUで商品を取得します。見つからなかったら nil を返します。
+func (r *MemoryProductRepository) GetBySKU(ctx context.Context, sku string) (*model.Product, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if id, ok := r.skus[sku]; ok {
+		return r.products[id], nil
+	}
+	return nil, nil
+}
+
+// CountFacets: ファセットの件数を集計します。
+// 読み取りロックを持ったまま全件を数えるので、同時に書き込みがあっても集計途中の状態が混ざりません。
+func (r *MemoryProductRepository) CountFacets(ctx context.Context, filter model.ProductFilter) (*model.ProductFacets, error) {
+	counter := model.NewFacetCounter(filter)
+	r.mu.RLock()
+	for _, p := range r.products {
+		counter.Add(p)
+	}
+	r.mu.RUnlock()
+	return counter.Result(), nil
+}
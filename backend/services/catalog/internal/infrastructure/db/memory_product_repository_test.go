@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+const benchmarkCatalogSize = 50_000
+
+var (
+	testCategories   = []model.ProductCategory{model.CategoryRobotVacuum, model.CategorySmartLock, model.CategoryDishWasher, model.CategoryLighting, model.CategorySensor, model.CategoryHub}
+	testDifficulties = []model.InstallationDifficulty{model.DifficultyLow, model.DifficultyMedium, model.DifficultyHigh}
+	testProtocols    = []model.Protocol{model.ProtocolMatter, model.ProtocolThread, model.ProtocolZigbee, model.ProtocolWiFi24GHz, model.ProtocolBluetooth}
+)
+
+// testProduct: i 番目の製品を決定的に作ります (カテゴリ・メーカー・価格などが適度にばらけるようにします)。
+func testProduct(i int) *model.Product {
+	price, _ := value.NewPrice(int32(1000 + (i*7919)%150000))
+	return &model.Product{
+		ID:                     model.ProductID(fmt.Sprintf("p-%06d", i)),
+		Name:                   fmt.Sprintf("製品 %d", i),
+		Manufacturer:           fmt.Sprintf("maker-%02d", i%40),
+		Price:                  price,
+		Category:               testCategories[i%len(testCategories)],
+		InstallationDifficulty: testDifficulties[i%len(testDifficulties)],
+		Connectivity: model.Connectivity{Protocols: []model.Protocol{
+			testProtocols[i%len(testProtocols)],
+			testProtocols[(i/len(testProtocols))%len(testProtocols)],
+		}},
+	}
+}
+
+func newTestCatalog(tb testing.TB, n int) repository.ProductRepository {
+	tb.Helper()
+	repo := NewMemoryProductRepository()
+	for i := 0; i < n; i++ {
+		if err := repo.Save(context.Background(), testProduct(i)); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return repo
+}
+
+func facetTotal(f *model.ProductFacets, name model.FacetName) int {
+	total := 0
+	for _, facet := range f.Facets {
+		if facet.Name == name {
+			for _, v := range facet.Values {
+				total += v.Count
+			}
+		}
+	}
+	return total
+}
+
+func TestMemoryCountFacets(t *testing.T) {
+	repo := newTestCatalog(t, 600)
+	filter := model.ProductFilter{Categories: []model.ProductCategory{model.CategoryHub}, Difficulties: []model.InstallationDifficulty{model.DifficultyLow}}
+	facets, err := repo.CountFacets(context.Background(), filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// hub は i%6 == 5 なので難易度は必ず high (i%3 == 2)。両方の条件を満たす製品はありません
+	if facets.Total != 0 {
+		t.Fatalf("total = %d, want 0", facets.Total)
+	}
+	// カテゴリのファセットはカテゴリの条件を外して数える: low (i%3==0) は i%6 ∈ {0,3} の2カテゴリ × 100件
+	if got := facetTotal(facets, model.FacetCategory); got != 200 {
+		t.Errorf("category facet total = %d, want 200", got)
+	}
+	// 難易度のファセットは難易度の条件を外して数える: hub 100件はすべて high
+	if got := facetTotal(facets, model.FacetInstallationDifficulty); got != 100 {
+		t.Errorf("difficulty facet total = %d, want 100", got)
+	}
+	for _, facet := range facets.Facets {
+		if facet.Name != model.FacetCategory {
+			continue
+		}
+		for _, v := range facet.Values {
+			if v.Value == string(model.CategoryHub) && (!v.Selected || v.Count != 0) {
+				t.Errorf("selected hub value = %+v, want selected with 0 count", v)
+			}
+		}
+	}
+}
+
+// TestMemoryCountFacetsUnderConcurrentWrites: 書き込みと同時に集計しても、ある時点の一貫した件数になることを確かめます。
+// 各書き込み担当は、スマートロックの製品1件をロボット掃除機に変えてから、別のロボット掃除機1件をスマートロックに戻す、を繰り返します。
+// どの時点でもロボット掃除機の件数は半数から「半数 + 書き込み担当の数」の間に収まるので、
+// 集計途中の変更が混ざると (ある製品は変更前、別の製品は変更後の状態で数えると) この範囲を外れます。
+func TestMemoryCountFacetsUnderConcurrentWrites(t *testing.T) {
+	const (
+		size    = 2000
+		writers = 2
+	)
+	ctx := context.Background()
+	repo := NewMemoryProductRepository()
+	save := func(i int, c model.ProductCategory) error {
+		p := testProduct(i)
+		p.Category = c
+		return repo.Save(ctx, p)
+	}
+	vacuums := make([][]int, writers)
+	locks := make([][]int, writers)
+	for i := 0; i < size; i++ {
+		c := model.CategorySmartLock
+		if i%(2*writers) < writers {
+			c = model.CategoryRobotVacuum
+			vacuums[i%writers] = append(vacuums[i%writers], i)
+		} else {
+			locks[i%writers] = append(locks[i%writers], i)
+		}
+		if err := save(i, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var stop atomic.Bool
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(vacuums, locks []int) {
+			defer wg.Done()
+			for !stop.Load() {
+				i := locks[0]
+				if err := save(i, model.CategoryRobotVacuum); err != nil {
+					t.Error(err)
+					return
+				}
+				locks, vacuums = locks[1:], append(vacuums, i)
+				j := vacuums[0]
+				if err := save(j, model.CategorySmartLock); err != nil {
+					t.Error(err)
+					return
+				}
+				vacuums, locks = vacuums[1:], append(locks, j)
+			}
+		}(vacuums[w], locks[w])
+	}
+
+	filter := model.ProductFilter{Categories: []model.ProductCategory{model.CategoryRobotVacuum}}
+	for i := 0; i < 500; i++ {
+		facets, err := repo.CountFacets(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if facets.Total < size/2 || facets.Total > size/2+writers {
+			t.Fatalf("total = %d, want between %d and %d", facets.Total, size/2, size/2+writers)
+		}
+		// カテゴリのファセットはカテゴリの条件を外して数えるので、全製品の合計になる
+		if got := facetTotal(facets, model.FacetCategory); got != size {
+			t.Fatalf("category facet total = %d, want %d", got, size)
+		}
+		if got := facetTotal(facets, model.FacetPriceBucket); got != facets.Total {
+			t.Fatalf("price facet total = %d, total = %d", got, facets.Total)
+		}
+	}
+	stop.Store(true)
+	wg.Wait()
+}
+
+func BenchmarkMemoryCountFacets50k(b *testing.B) {
+	repo := newTestCatalog(b, benchmarkCatalogSize)
+	ctx := context.Background()
+	filters := map[string]model.ProductFilter{
+		"NoFilter": {},
+		"Filtered": {
+			Categories: []model.ProductCategory{model.CategoryRobotVacuum, model.CategoryHub},
+			MinPrice:   10000,
+			MaxPrice:   80000,
+			Protocols:  []model.Protocol{model.ProtocolMatter},
+		},
+	}
+	for name, filter := range filters {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.CountFacets(ctx, filter); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkMemoryCountFacets50kParallelWrites: 集計と書き込み (10回に1回) が並行する場合の性能
+func BenchmarkMemoryCountFacets50kParallelWrites(b *testing.B) {
+	repo := newTestCatalog(b, benchmarkCatalogSize)
+	ctx := context.Background()
+	var seq atomic.Int64
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			n := int(seq.Add(1))
+			if n%10 == 0 {
+				if err := repo.Save(ctx, testProduct(n%benchmarkCatalogSize)); err != nil {
+					b.Fatal(err)
+				}
+				continue
+			}
+			if _, err := repo.CountFacets(ctx, model.ProductFilter{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// DefaultFetchTimeout: 1つの画像の取得にかける時間の上限
+const DefaultFetchTimeout = 15 * time.Second
+
+// maxRedirects: 元画像の取得でたどる転送の上限
+const maxRedirects = 5
+
+const userAgent = "opti-image-fetcher/1.0"
+
+// errFetchFailed: 呼び出し元に返す、取得に失敗したときのメッセージ
+// 接続先や応答の詳細を返すと内部のホストを探るのに使えるので、詳細はログにだけ残します。
+var errFetchFailed = fmt.Errorf("%w: the source url could not be fetched as an image", model.ErrInvalidImage)
+
+// errBlockedAddress: 内部のアドレスへの接続を断ったときのエラー
+var errBlockedAddress = errors.New("connection to a non-public address is not allowed")
+
+// Fetcher: HTTP で元画像を取得する ImageFetcher です。
+// URL は API の呼び出し元が指定するので、サービスの内部 (ループバック、プライベートアドレス、
+// 169.254.169.254 のメタデータサーバーなど) には接続しません (SSRF 対策)。
+type Fetcher struct {
+	httpClient *http.Client
+	timeout    time.Duration
+}
+
+// NewFetcher: httpClient が nil なら、公開アドレスにだけ接続するクライアント (NewPublicHTTPClient) を使います。
+// timeout が0以下なら DefaultFetchTimeout を使います。
+func NewFetcher(httpClient *http.Client, timeout time.Duration) repository.ImageFetcher {
+	if httpClient == nil {
+		httpClient = NewPublicHTTPClient()
+	}
+	if timeout <= 0 {
+		timeout = DefaultFetchTimeout
+	}
+	return &Fetcher{httpClient: httpClient, timeout: timeout}
+}
+
+// NewPublicHTTPClient: 公開アドレスにだけ接続する HTTP クライアントを作ります。
+// 接続先は名前解決した後のアドレスで確かめるので (net.Dialer の Control)、転送先や DNS の書き換えでも内部には届きません。
+// プロキシを経由すると接続先を確かめられないため、環境変数のプロキシ設定は使いません。
+func NewPublicHTTPClient() *http.Client {
+	return newGuardedClient(func(ap netip.AddrPort) bool { return isPublicAddr(ap.Addr().Unmap()) })
+}
+
+// newGuardedClient: allow が許可した接続先にだけ接続するクライアントを作ります。
+func newGuardedClient(allow func(netip.AddrPort) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil || !allow(ap) {
+				return errBlockedAddress
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+	}
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to a non-http url: %s", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}
+
+// isPublicAddr: インターネット上の公開アドレスかどうか
+func isPublicAddr(addr netip.Addr) bool {
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// nonPublicPrefixes: netip.Addr の判定に含まれない、公開されていないアドレスの範囲
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "このネットワーク"
+	netip.MustParsePrefix("100.64.0.0/10"),  // キャリアグレードNAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF プロトコル割り当て
+	netip.MustParsePrefix("198.18.0.0/15"),  // ベンチマーク用
+	netip.MustParsePrefix("240.0.0.0/4"),    // 予約済み
+	netip.MustParsePrefix("64:ff9b:1::/48"), // ローカルの NAT64
+	netip.MustParsePrefix("2001:db8::/32"),  // ドキュメント用
+}
+
+// Fetch: 画像を取得します。
+// 上限を超える画像は、Content-Length を見て読む前に、Content-Length が無ければ上限まで読んだところで断ります。
+// 呼び出し元がキャンセルした場合は画像の問題ではないので、ErrInvalidImage ではなく ctx のエラーを返します。
+func (f *Fetcher) Fetch(parent context.Context, sourceURL string) ([]byte, error) {
+	u, err := url.Parse(sourceURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: source url must be an http(s) url", model.ErrInvalidImage)
+	}
+	ctx, cancel := context.WithTimeout(parent, f.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: source url must be an http(s) url", model.ErrInvalidImage)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "image/*")
+	res, err := f.httpClient.Do(req)
+	if err != nil {
+		if parent.Err() != nil {
+			return nil, parent.Err()
+		}
+		log.Printf("failed to fetch image %s: %v", sourceURL, err)
+		return nil, errFetchFailed
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		log.Printf("failed to fetch image %s: %s", sourceURL, res.Status)
+		return nil, errFetchFailed
+	}
+	if res.ContentLength > model.MaxImageBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", model.ErrInvalidImage, model.MaxImageBytes)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, model.MaxImageBytes+1))
+	if err != nil {
+		if parent.Err() != nil {
+			return nil, parent.Err()
+		}
+		log.Printf("failed to read image %s: %v", sourceURL, err)
+		return nil, errFetchFailed
+	}
+	if len(data) > model.MaxImageBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", model.ErrInvalidImage, model.MaxImageBytes)
+	}
+	return data, nil
+}
@@ -0,0 +1,89 @@
+package imaging
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+func TestFetcherRejectsNonPublicAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("internal"))
+	}))
+	defer srv.Close()
+
+	// 既定のクライアントは、ループバック (内部のサービス) に接続しない
+	fetcher := NewFetcher(nil, time.Second)
+	for _, u := range []string{srv.URL, "http://169.254.169.254/computeMetadata/v1/", "http://[::1]/", "http://10.0.0.1/", "http://0.0.0.0/"} {
+		_, err := fetcher.Fetch(context.Background(), u)
+		if !errors.Is(err, model.ErrInvalidImage) {
+			t.Errorf("%s: err = %v, want ErrInvalidImage", u, err)
+			continue
+		}
+		// 応答や接続の詳細は返さない
+		if strings.Contains(err.Error(), "127.0.0.1") || strings.Contains(err.Error(), "169.254") || strings.Contains(err.Error(), "refused") {
+			t.Errorf("%s: error leaks details: %v", u, err)
+		}
+	}
+}
+
+func TestFetcherChecksRedirectTargets(t *testing.T) {
+	var internalHits int
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalHits++
+		w.Write([]byte("secret"))
+	}))
+	defer internal.Close()
+	internalAddr := netip.MustParseAddrPort(strings.TrimPrefix(internal.URL, "http://"))
+
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL+"/secret", http.StatusFound)
+	}))
+	defer public.Close()
+	publicAddr := netip.MustParseAddrPort(strings.TrimPrefix(public.URL, "http://"))
+
+	// テストではどちらのサーバーもループバックにあるので、ポートで「公開」と「内部」を区別します
+	client := newGuardedClient(func(ap netip.AddrPort) bool { return ap.Port() == publicAddr.Port() })
+	_, err := NewFetcher(client, time.Second).Fetch(context.Background(), public.URL+"/image.png")
+	if !errors.Is(err, model.ErrInvalidImage) {
+		t.Fatalf("err = %v, want ErrInvalidImage", err)
+	}
+	if internalHits != 0 || strings.Contains(err.Error(), internalAddr.String()) {
+		t.Errorf("redirect reached the internal server (%d hits): %v", internalHits, err)
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"93.184.216.34":       true,
+		"2606:2800:220:1::":   true,
+		"127.0.0.1":           false,
+		"10.1.2.3":            false,
+		"172.16.0.1":          false,
+		"192.168.1.1":         false,
+		"169.254.169.254":     false,
+		"100.64.0.1":          false,
+		"0.0.0.0":             false,
+		"::1":                 false,
+		"fe80::1":             false,
+		"fd00:ec2::254":       false,
+		"::ffff:127.0.0.1":    false,
+		"::ffff:169.254.1.1":  false,
+		"255.255.255.255":     false,
+		"224.0.0.1":           false,
+		"198.18.0.1":          false,
+		"2001:db8::1":         false,
+		"64:ff9b:1::a00:0001": false,
+	} {
+		if got := isPublicAddr(netip.MustParseAddr(addr).Unmap()); got != want {
+			t.Errorf("isPublicAddr(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Package imaging: 製品画像の確認とサムネイルの作成 (ImageProcessor)、URL からの取得 (ImageFetcher) の実装です。
+// cgo や外部コマンドに頼らず、標準ライブラリと golang.org/x/image だけで処理します。
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// jpegQuality: サムネイルの JPEG の品質
+const jpegQuality = 85
+
+// imageFormat: 取り込める元画像の形式
+type imageFormat struct {
+	decode       func(io.Reader) (image.Image, error)
+	decodeConfig func(io.Reader) (image.Config, error)
+}
+
+// formats: 中身から判定した MIME タイプごとの読み方 (GIF はアニメーションでも最初のコマだけを使います)
+var formats = map[string]imageFormat{
+	"image/jpeg": {decode: jpeg.Decode, decodeConfig: jpeg.DecodeConfig},
+	"image/png":  {decode: png.Decode, decodeConfig: png.DecodeConfig},
+	"image/gif":  {decode: gif.Decode, decodeConfig: gif.DecodeConfig},
+	"image/webp": {decode: webp.Decode, decodeConfig: webp.DecodeConfig},
+}
+
+// Processor: 元画像を確かめて、JPEG と WebP のサムネイルを作る ImageProcessor です。
+type Processor struct{}
+
+// NewProcessor: コンストラクタ
+func NewProcessor() repository.ImageProcessor {
+	return &Processor{}
+}
+
+// Process: 元画像からサムネイルを作ります。
+// 1. 中身から形式を判定し、対応している形式か確かめる (送られてきた Content-Type や拡張子は信用しない)
+// 2. 展開する前に幅・高さだけを読み、画素数の上限を確かめる (小さなファイルで巨大な画像を展開させないため)
+// 3. 展開し、大きい順に縮小する (小さいサムネイルは1つ大きいサムネイルから作ります)
+// 4. JPEG は EXIF の向き (Orientation) を反映してから、JPEG と WebP で書き出す
+//
+// 画素から書き出し直すので、EXIF (撮影場所など)・ICC プロファイルなどのメタデータはサムネイルに残りません。
+func (p *Processor) Process(data []byte) (*model.ProcessedImage, error) {
+	// 1. 形式
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty file", model.ErrInvalidImage)
+	}
+	if len(data) > model.MaxImageBytes {
+		return nil, fmt.Errorf("%w: %d bytes (max %d)", model.ErrInvalidImage, len(data), model.MaxImageBytes)
+	}
+	mimeType := http.DetectContentType(data)
+	format, ok := formats[mimeType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported type %s", model.ErrInvalidImage, mimeType)
+	}
+
+	// 2. 大きさ
+	cfg, err := format.decodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidImage, err)
+	}
+	if cfg.Width*cfg.Height > model.MaxImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels (max %d)", model.ErrInvalidImage, cfg.Width, cfg.Height, model.MaxImagePixels)
+	}
+	if min(cfg.Width, cfg.Height) < model.MinImageEdge {
+		return nil, fmt.Errorf("%w: %dx%d is too small (min %dpx)", model.ErrInvalidImage, cfg.Width, cfg.Height, model.MinImageEdge)
+	}
+
+	// 3. 縮小
+	src, err := format.decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidImage, err)
+	}
+	specs := model.ImageVariantSpecs
+	thumbs := make([]*image.RGBA, len(specs))
+	for i := len(specs) - 1; i >= 0; i-- {
+		thumbs[i] = resize(src, specs[i].MaxEdge)
+		src = thumbs[i]
+	}
+
+	// 4. 向きを直して書き出す
+	orientation := 1
+	if mimeType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+	result := &model.ProcessedImage{SourceHash: model.ContentHash(data), MIMEType: mimeType, Width: cfg.Width, Height: cfg.Height}
+	if orientation >= 5 {
+		result.Width, result.Height = cfg.Height, cfg.Width
+	}
+	for i, spec := range specs {
+		thumb := orient(thumbs[i], orientation)
+		size := thumb.Bounds().Size()
+		jpegData, err := encodeJPEG(thumb)
+		if err != nil {
+			return nil, err
+		}
+		webpData, err := encodeWebP(toNRGBA(thumb))
+		if err != nil {
+			return nil, err
+		}
+		result.Variants = append(result.Variants,
+			model.EncodedImage{Name: spec.Name, Format: model.ImageFormatJPEG, Width: size.X, Height: size.Y, Data: jpegData},
+			model.EncodedImage{Name: spec.Name, Format: model.ImageFormatWebP, Width: size.X, Height: size.Y, Data: webpData},
+		)
+	}
+	return result, nil
+}
+
+// resize: 長い辺が maxEdge になるよう縮小します。元の画像の方が小さければ、大きさはそのままです。
+func resize(src image.Image, maxEdge int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if long := max(w, h); long > maxEdge {
+		w, h = max(w*maxEdge/long, 1), max(h*maxEdge/long, 1)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	if w == b.Dx() && h == b.Dy() {
+		draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Src)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Src, nil)
+	}
+	return dst
+}
+
+// encodeJPEG: JPEG には透明度が無いので、白い背景に重ねてから書き出します。
+func encodeJPEG(img *image.RGBA) ([]byte, error) {
+	b := img.Bounds()
+	flat := image.NewRGBA(b)
+	draw.Draw(flat, b, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, b, img, b.Min, draw.Over)
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode jpeg: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// toNRGBA: WebP はアルファを乗算しない形で書くので変換します。
+func toNRGBA(img *image.RGBA) *image.NRGBA {
+	b := img.Bounds()
+	dst := image.NewNRGBA(b)
+	draw.Draw(dst, b, img, b.Min, draw.Src)
+	return dst
+}
@@ -0,0 +1,187 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"golang.org/x/image/webp"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// halves: 左半分が赤、右半分が青の画像
+func halves(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.NRGBA{255, 0, 0, 255}
+			if x >= w/2 {
+				c = color.NRGBA{0, 0, 255, 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// jpegWithOrientation: EXIF の Orientation だけを持つ APP1 を SOI の直後に入れた JPEG
+func jpegWithOrientation(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")      // ビッグエンディアン、最初の IFD は8バイト目
+	tiff = append(tiff, 0, 1)                         // エントリは1つ
+	tiff = append(tiff, 0x01, 0x12, 0, 3, 0, 0, 0, 1) // Orientation, SHORT, 1個
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0) // 値の残りと、次の IFD (無し)
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	data := buf.Bytes()
+	out := append([]byte{}, data[:2]...)
+	out = append(out, 0xff, 0xe1)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(segment)+2))
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+// hugePNGHeader: 展開すると巨大になる PNG の先頭 (IHDR まで)
+func hugePNGHeader(w, h uint32) []byte {
+	ihdr := []byte("IHDR")
+	ihdr = binary.BigEndian.AppendUint32(ihdr, w)
+	ihdr = binary.BigEndian.AppendUint32(ihdr, h)
+	ihdr = append(ihdr, 8, 6, 0, 0, 0) // 8bit RGBA
+	out := []byte("\x89PNG\r\n\x1a\n")
+	out = binary.BigEndian.AppendUint32(out, uint32(len(ihdr)-4))
+	out = append(out, ihdr...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(ihdr))
+}
+
+func TestProcessorRejectsInvalidImages(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "not an image", data: []byte("<html><body>not found</body></html>")},
+		{name: "svg", data: []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="200" height="200"></svg>`)},
+		{name: "too small", data: encodePNG(t, halves(300, 50))},
+		{name: "too many pixels", data: hugePNGHeader(20000, 20000)},
+		{name: "broken", data: hugePNGHeader(300, 300)},
+		{name: "too large file", data: append(encodePNG(t, halves(200, 200)), make([]byte, model.MaxImageBytes)...)},
+	}
+	p := NewProcessor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := p.Process(tt.data); !errors.Is(err, model.ErrInvalidImage) {
+				t.Errorf("err = %v, want ErrInvalidImage", err)
+			}
+		})
+	}
+}
+
+func TestProcessorCreatesVariants(t *testing.T) {
+	// 半透明の PNG (600x300)
+	src := halves(600, 300)
+	src.SetNRGBA(0, 0, color.NRGBA{0, 255, 0, 128})
+	data := encodePNG(t, src)
+
+	got, err := NewProcessor().Process(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.MIMEType != "image/png" || got.Width != 600 || got.Height != 300 || got.SourceHash != model.ContentHash(data) {
+		t.Errorf("got %s %dx%d hash %s", got.MIMEType, got.Width, got.Height, got.SourceHash)
+	}
+
+	want := map[string]image.Point{"small": {160, 80}, "medium": {480, 240}, "large": {600, 300}} // 元画像より大きくはしない
+	if len(got.Variants) != 2*len(want) {
+		t.Fatalf("got %d variants", len(got.Variants))
+	}
+	for _, v := range got.Variants {
+		var decoded image.Image
+		switch v.Format {
+		case model.ImageFormatJPEG:
+			decoded, err = jpeg.Decode(bytes.NewReader(v.Data))
+		case model.ImageFormatWebP:
+			decoded, err = webp.Decode(bytes.NewReader(v.Data))
+		}
+		if err != nil {
+			t.Fatalf("%s %s: %v", v.Name, v.Format, err)
+		}
+		size := decoded.Bounds().Size()
+		if size != want[v.Name] || size != (image.Point{v.Width, v.Height}) {
+			t.Errorf("%s %s: decoded %v, reported %dx%d, want %v", v.Name, v.Format, size, v.Width, v.Height, want[v.Name])
+		}
+	}
+
+	// WebP は透明度を残し、JPEG は白い背景に重ねる
+	large, _ := findVariant(got, "large", model.ImageFormatWebP)
+	img, _ := webp.Decode(bytes.NewReader(large.Data))
+	if _, _, _, a := img.At(0, 0).RGBA(); a>>8 != 128 {
+		t.Errorf("webp alpha = %d, want 128", a>>8)
+	}
+}
+
+func TestProcessorAppliesOrientationAndStripsExif(t *testing.T) {
+	// 横長 (左が赤・右が青) に撮って、「時計回りに90度回して表示する」と EXIF に書いた写真
+	data := jpegWithOrientation(t, halves(400, 200), 6)
+	if jpegOrientation(data) != 6 {
+		t.Fatal("test image has no orientation")
+	}
+
+	got, err := NewProcessor().Process(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Width != 200 || got.Height != 400 {
+		t.Errorf("size = %dx%d, want 200x400", got.Width, got.Height)
+	}
+	for _, v := range got.Variants {
+		if bytes.Contains(v.Data, []byte("Exif")) {
+			t.Errorf("%s %s still contains EXIF", v.Name, v.Format)
+		}
+	}
+
+	small, _ := findVariant(got, "small", model.ImageFormatJPEG)
+	img, err := jpeg.Decode(bytes.NewReader(small.Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := img.Bounds().Size(); size != (image.Point{80, 160}) {
+		t.Fatalf("small = %v, want 80x160", size)
+	}
+	// 回した後は上が赤、下が青
+	if r, _, b, _ := img.At(40, 20).RGBA(); r < b {
+		t.Errorf("top is not red: r=%d b=%d", r>>8, b>>8)
+	}
+	if r, _, b, _ := img.At(40, 140).RGBA(); b < r {
+		t.Errorf("bottom is not blue: r=%d b=%d", r>>8, b>>8)
+	}
+}
+
+func findVariant(p *model.ProcessedImage, name string, format model.ImageFormat) (model.EncodedImage, bool) {
+	for _, v := range p.Variants {
+		if v.Name == name && v.Format == format {
+			return v, true
+		}
+	}
+	return model.EncodedImage{}, false
+}
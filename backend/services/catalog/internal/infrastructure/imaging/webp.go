@@ -0,0 +1,453 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"math/bits"
+	"slices"
+)
+
+// WebP (可逆圧縮 VP8L) のエンコーダです。
+// 標準ライブラリと golang.org/x/image には WebP のデコーダしか無いため、仕様
+// (https://developers.google.com/speed/webp/docs/webp_lossless_bitstream_specification) のうち
+// 次の部分だけを使って書き出します。圧縮率より、実装の小ささと確実にデコードできることを優先しています。
+// - 変換: 緑の減算と、タイルごとに予測方法を選ぶ予測変換
+// - 画素: Huffman 符号のリテラルだけ (LZ77 の後方参照とカラーキャッシュは使わない)
+
+const (
+	vp8lSignature   = 0x2f
+	vp8lMaxEdge     = 1 << 14
+	predictorBits   = 5 // 予測方法を選ぶタイルの大きさ (32x32)
+	maxCodeLength   = 15
+	maxCodeLenCodes = 7 // 符号長を表す符号の長さの上限
+
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+)
+
+// predictorModes: タイルごとに試す予測方法 (右上の画素を使うものは、行の端の扱いが複雑なので使いません)
+var predictorModes = []byte{
+	1,  // L
+	2,  // T
+	7,  // Average2(L, T)
+	12, // ClampAddSubtractFull(L, T, TL)
+}
+
+// codeLengthCodeOrder: 符号長を表す符号の長さを書く順序 (仕様で決まっています)
+var codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// encodeWebP: 画像を WebP (VP8L) のファイルにします。
+func encodeWebP(img *image.NRGBA) ([]byte, error) {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxEdge || height > vp8lMaxEdge {
+		return nil, fmt.Errorf("webp: invalid image size %dx%d", width, height)
+	}
+	pix := make([]byte, 4*width*height)
+	for y := 0; y < height; y++ {
+		copy(pix[4*width*y:4*width*(y+1)], img.Pix[img.PixOffset(b.Min.X, b.Min.Y+y):])
+	}
+
+	var w bitWriter
+	w.write(vp8lSignature, 8)
+	w.write(uint32(width-1), 14)
+	w.write(uint32(height-1), 14)
+	if img.Opaque() {
+		w.write(0, 1)
+	} else {
+		w.write(1, 1)
+	}
+	w.write(0, 3) // バージョン
+
+	// 変換は書いた順に行い、デコーダは逆の順に戻します
+	w.write(1, 1)
+	w.write(transformSubtractGreen, 2)
+	subtractGreen(pix)
+
+	modes, residuals := predict(pix, width, height)
+	w.write(1, 1)
+	w.write(transformPredictor, 2)
+	w.write(predictorBits-2, 3)
+	writeEntropyImage(&w, modes, false)
+
+	w.write(0, 1) // 変換の終わり
+	writeEntropyImage(&w, residuals, true)
+
+	data := w.bytes()
+	pad := len(data) & 1
+	out := make([]byte, 0, 20+len(data)+pad)
+	out = append(out, "RIFF"...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(12+len(data)+pad))
+	out = append(out, "WEBPVP8L"...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(data)))
+	out = append(out, data...)
+	if pad == 1 {
+		out = append(out, 0)
+	}
+	return out, nil
+}
+
+// subtractGreen: 赤と青から緑を引きます (色の相関を減らして符号を短くする)。
+func subtractGreen(pix []byte) {
+	for p := 0; p < len(pix); p += 4 {
+		pix[p+0] -= pix[p+1]
+		pix[p+2] -= pix[p+1]
+	}
+}
+
+// predict: 画素を周りの画素から予測し、予測との差 (残差) を返します。modes はタイルごとの予測方法の画像です。
+// 1. 左上の画素は不透明の黒、最初の行は左 (L)、最初の列は上 (T) から予測する (仕様で決まっています)
+// 2. 残りはタイルごとに、残差の絶対値の和が最も小さくなる予測方法を選ぶ
+func predict(pix []byte, width, height int) (modes, residuals []byte) {
+	tilesX, tilesY := nTiles(width), nTiles(height)
+	modes = make([]byte, 4*tilesX*tilesY)
+	residuals = make([]byte, len(pix))
+	at := func(x, y int) []byte { return pix[4*(width*y+x):][:4] }
+	residual := func(x, y int, pred [4]byte) {
+		p := 4 * (width*y + x)
+		for c := 0; c < 4; c++ {
+			residuals[p+c] = pix[p+c] - pred[c]
+		}
+	}
+
+	// 1. 最初の行と最初の列
+	residual(0, 0, [4]byte{0, 0, 0, 0xff})
+	for x := 1; x < width; x++ {
+		residual(x, 0, [4]byte(at(x-1, 0)))
+	}
+	for y := 1; y < height; y++ {
+		residual(0, y, [4]byte(at(0, y-1)))
+	}
+
+	// 2. タイルごとに予測方法を選ぶ
+	for ty := 0; ty < tilesY; ty++ {
+		for tx := 0; tx < tilesX; tx++ {
+			x0, x1 := max(tx<<predictorBits, 1), min((tx+1)<<predictorBits, width)
+			y0, y1 := max(ty<<predictorBits, 1), min((ty+1)<<predictorBits, height)
+			best, bestCost := predictorModes[0], -1
+			for _, mode := range predictorModes {
+				cost := 0
+				for y := y0; y < y1; y++ {
+					for x := x0; x < x1; x++ {
+						pred := predictPixel(mode, at(x-1, y), at(x, y-1), at(x-1, y-1))
+						for c, v := range at(x, y) {
+							cost += absResidual(v - pred[c])
+						}
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			modes[4*(tilesX*ty+tx)+1] = best // 予測方法は緑に入れます
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					residual(x, y, predictPixel(best, at(x-1, y), at(x, y-1), at(x-1, y-1)))
+				}
+			}
+		}
+	}
+	return modes, residuals
+}
+
+func nTiles(size int) int {
+	return (size + 1<<predictorBits - 1) >> predictorBits
+}
+
+func predictPixel(mode byte, l, t, tl []byte) [4]byte {
+	var pred [4]byte
+	for c := 0; c < 4; c++ {
+		switch mode {
+		case 1:
+			pred[c] = l[c]
+		case 2:
+			pred[c] = t[c]
+		case 7:
+			pred[c] = byte((int(l[c]) + int(t[c])) / 2)
+		case 12:
+			pred[c] = byte(min(max(int(l[c])+int(t[c])-int(tl[c]), 0), 255))
+		}
+	}
+	return pred
+}
+
+func absResidual(r byte) int {
+	if r >= 128 {
+		return 256 - int(r)
+	}
+	return int(r)
+}
+
+// writeEntropyImage: 画素 (RGBA の順) を Huffman 符号のリテラルで書きます。
+// topLevel はメインの画像かどうか (メインの画像にだけ、Huffman 符号を切り替えるかどうかのビットがあります)。
+func writeEntropyImage(w *bitWriter, pix []byte, topLevel bool) {
+	w.write(0, 1) // カラーキャッシュを使わない
+	if topLevel {
+		w.write(0, 1) // 画像全体で1組の Huffman 符号を使う
+	}
+
+	// 緑 (と後方参照の長さ)・赤・青・アルファ・距離の5つの符号
+	green := make([]int, 256+24)
+	red, blue, alpha := make([]int, 256), make([]int, 256), make([]int, 256)
+	for p := 0; p < len(pix); p += 4 {
+		red[pix[p+0]]++
+		green[pix[p+1]]++
+		blue[pix[p+2]]++
+		alpha[pix[p+3]]++
+	}
+	codes := [4]huffmanCode{
+		writeHuffmanCode(w, green),
+		writeHuffmanCode(w, red),
+		writeHuffmanCode(w, blue),
+		writeHuffmanCode(w, alpha),
+	}
+	writeHuffmanCode(w, make([]int, 40)) // 距離 (後方参照を使わないので記号は1つ)
+
+	for p := 0; p < len(pix); p += 4 {
+		codes[0].write(w, int(pix[p+1]))
+		codes[1].write(w, int(pix[p+0]))
+		codes[2].write(w, int(pix[p+2]))
+		codes[3].write(w, int(pix[p+3]))
+	}
+}
+
+// huffmanCode: 記号ごとの符号 (書き出す順にビットを反転したもの) と長さ
+type huffmanCode struct {
+	codes []uint32
+	bits  []uint8
+}
+
+func (c huffmanCode) write(w *bitWriter, symbol int) {
+	w.write(c.codes[symbol], uint(c.bits[symbol]))
+}
+
+// writeHuffmanCode: 記号の出現回数から Huffman 符号を作って書き、その符号を返します。
+// 記号が2つ以下なら、符号長を書かない簡単な形 (simple code) にします。
+func writeHuffmanCode(w *bitWriter, freq []int) huffmanCode {
+	var used []int
+	for s, f := range freq {
+		if f > 0 {
+			used = append(used, s)
+		}
+	}
+	c := huffmanCode{codes: make([]uint32, len(freq)), bits: make([]uint8, len(freq))}
+	if len(used) <= 2 && (len(used) == 0 || used[len(used)-1] < 256) {
+		if len(used) == 0 {
+			used = []int{0}
+		}
+		w.write(1, 1)
+		w.write(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			w.write(0, 1)
+			w.write(uint32(used[0]), 1)
+		} else {
+			w.write(1, 1)
+			w.write(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			w.write(uint32(used[1]), 8)
+			c.bits[used[0]], c.bits[used[1]] = 1, 1
+			c.codes[used[1]] = 1
+		}
+		return c
+	}
+
+	lengths := huffmanLengths(freq, maxCodeLength)
+	c.setCanonical(lengths)
+	w.write(0, 1)
+	writeCodeLengths(w, lengths)
+	return c
+}
+
+// setCanonical: 符号長から正準 Huffman 符号を作ります (デコーダと同じ作り方)。
+// 記号が1つだけの場合、デコーダは0ビットで読むので何も書きません。
+func (c *huffmanCode) setCanonical(lengths []uint8) {
+	var count [maxCodeLength + 1]uint32
+	used := 0
+	for _, l := range lengths {
+		if l > 0 {
+			count[l]++
+			used++
+		}
+	}
+	var next [maxCodeLength + 1]uint32
+	code := uint32(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		next[l] = code
+		code = (code + count[l]) << 1
+	}
+	for s, l := range lengths {
+		if l == 0 || used == 1 {
+			continue
+		}
+		// ビットは下位から書き出され、デコーダは符号の上位ビットから読むので反転します
+		c.codes[s] = bits.Reverse32(next[l]) >> (32 - uint(l))
+		c.bits[s] = l
+		next[l]++
+	}
+}
+
+// codeLengthToken: 符号長の列を書くときの記号 (0〜15 は長さそのもの、16〜18 は繰り返し)
+type codeLengthToken struct {
+	symbol    int
+	extra     uint32
+	extraBits uint
+}
+
+// writeCodeLengths: 符号長の列を、繰り返しをまとめてから Huffman 符号で書きます。
+func writeCodeLengths(w *bitWriter, lengths []uint8) {
+	tokens := codeLengthTokens(lengths)
+	freq := make([]int, len(codeLengthCodeOrder))
+	for _, t := range tokens {
+		freq[t.symbol]++
+	}
+	clLengths := huffmanLengths(freq, maxCodeLenCodes)
+	cl := huffmanCode{codes: make([]uint32, len(freq)), bits: make([]uint8, len(freq))}
+	cl.setCanonical(clLengths)
+
+	n := len(codeLengthCodeOrder)
+	for n > 4 && clLengths[codeLengthCodeOrder[n-1]] == 0 {
+		n--
+	}
+	w.write(uint32(n-4), 4)
+	for _, s := range codeLengthCodeOrder[:n] {
+		w.write(uint32(clLengths[s]), 3)
+	}
+	w.write(0, 1) // すべての記号の符号長を書く
+	for _, t := range tokens {
+		cl.write(w, t.symbol)
+		w.write(t.extra, t.extraBits)
+	}
+}
+
+func codeLengthTokens(lengths []uint8) []codeLengthToken {
+	var tokens []codeLengthToken
+	for i := 0; i < len(lengths); {
+		v := lengths[i]
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == v {
+			run++
+		}
+		i += run
+		if v == 0 {
+			// 17: 0を3〜10回、18: 0を11〜138回
+			for run >= 3 {
+				if run <= 10 {
+					tokens = append(tokens, codeLengthToken{symbol: 17, extra: uint32(run - 3), extraBits: 3})
+					run = 0
+					break
+				}
+				n := min(run, 138)
+				tokens = append(tokens, codeLengthToken{symbol: 18, extra: uint32(n - 11), extraBits: 7})
+				run -= n
+			}
+		} else {
+			// 16: 直前の長さを3〜6回
+			tokens = append(tokens, codeLengthToken{symbol: int(v)})
+			run--
+			for run >= 3 {
+				n := min(run, 6)
+				tokens = append(tokens, codeLengthToken{symbol: 16, extra: uint32(n - 3), extraBits: 2})
+				run -= n
+			}
+		}
+		for ; run > 0; run-- {
+			tokens = append(tokens, codeLengthToken{symbol: int(v)})
+		}
+	}
+	return tokens
+}
+
+// huffmanLengths: 出現回数から、maxLength ビット以下の Huffman 符号の長さを求めます。
+// 長すぎる符号ができたら、出現回数を半分にして (差を縮めて) 作り直します。
+func huffmanLengths(freq []int, maxLength int) []uint8 {
+	f := slices.Clone(freq)
+	lengths := make([]uint8, len(freq))
+	for buildHuffmanLengths(f, lengths) > maxLength {
+		for i := range f {
+			if f[i] > 0 {
+				f[i] = (f[i] + 1) / 2
+			}
+		}
+	}
+	return lengths
+}
+
+// buildHuffmanLengths: Huffman 木を作って記号ごとの深さを lengths に入れ、最も深い深さを返します。
+// 記号が1つだけなら長さ1にします。
+func buildHuffmanLengths(freq []int, lengths []uint8) int {
+	clear(lengths)
+	var symbols []int
+	for s, f := range freq {
+		if f > 0 {
+			symbols = append(symbols, s)
+		}
+	}
+	switch len(symbols) {
+	case 0:
+		return 0
+	case 1:
+		lengths[symbols[0]] = 1
+		return 1
+	}
+	slices.SortStableFunc(symbols, func(a, b int) int { return freq[a] - freq[b] })
+
+	// 葉は出現回数の少ない順、内部の節は作った順 (重みが増えていく) に並ぶので、2つの列の先頭から小さい方を取ります
+	n := len(symbols)
+	weight := make([]int, n, 2*n-1)
+	parent := make([]int, 2*n-1)
+	for i, s := range symbols {
+		weight[i] = freq[s]
+	}
+	leaf, inner := 0, n
+	pick := func() int {
+		if leaf < n && (inner >= len(weight) || weight[leaf] <= weight[inner]) {
+			leaf++
+			return leaf - 1
+		}
+		inner++
+		return inner - 1
+	}
+	for len(weight) < 2*n-1 {
+		a, b := pick(), pick()
+		weight = append(weight, weight[a]+weight[b])
+		parent[a], parent[b] = len(weight)-1, len(weight)-1
+	}
+
+	depth := make([]int, 2*n-1)
+	deepest := 0
+	for i := 2*n - 3; i >= 0; i-- {
+		depth[i] = depth[parent[i]] + 1
+		if i < n {
+			lengths[symbols[i]] = uint8(min(depth[i], 255))
+			deepest = max(deepest, depth[i])
+		}
+	}
+	return deepest
+}
+
+// bitWriter: ビットを下位から詰めて書きます (VP8L のビットの順序)。
+type bitWriter struct {
+	buf []byte
+	acc uint64
+	n   uint
+}
+
+func (w *bitWriter) write(v uint32, n uint) {
+	w.acc |= uint64(v) << w.n
+	w.n += n
+	for w.n >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.n -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.n > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.n = 0, 0
+	}
+	return w.buf
+}
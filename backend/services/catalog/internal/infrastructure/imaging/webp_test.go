@@ -0,0 +1,81 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand/v2"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebPRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	tests := []struct {
+		name string
+		w, h int
+		at   func(x, y int) color.NRGBA
+	}{
+		{name: "single pixel", w: 1, h: 1, at: func(x, y int) color.NRGBA { return color.NRGBA{10, 20, 30, 255} }},
+		{name: "flat", w: 64, h: 64, at: func(x, y int) color.NRGBA { return color.NRGBA{200, 200, 200, 255} }},
+		{name: "gradient with alpha", w: 37, h: 23, at: func(x, y int) color.NRGBA {
+			return color.NRGBA{uint8(x * 7), uint8(y * 11), uint8(x * y), uint8(255 - x*3)}
+		}},
+		{name: "two colors", w: 50, h: 3, at: func(x, y int) color.NRGBA {
+			if x%2 == 0 {
+				return color.NRGBA{255, 0, 0, 255}
+			}
+			return color.NRGBA{0, 0, 255, 255}
+		}},
+		{name: "noise", w: 70, h: 45, at: func(x, y int) color.NRGBA {
+			return color.NRGBA{uint8(rng.IntN(256)), uint8(rng.IntN(256)), uint8(rng.IntN(256)), uint8(rng.IntN(256))}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := image.NewNRGBA(image.Rect(0, 0, tt.w, tt.h))
+			for y := 0; y < tt.h; y++ {
+				for x := 0; x < tt.w; x++ {
+					img.SetNRGBA(x, y, tt.at(x, y))
+				}
+			}
+			data, err := encodeWebP(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := webp.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("failed to decode: %v", err)
+			}
+			got, ok := decoded.(*image.NRGBA)
+			if !ok || got.Bounds() != img.Bounds() {
+				t.Fatalf("decoded %T %v, want *image.NRGBA %v", decoded, decoded.Bounds(), img.Bounds())
+			}
+			if !bytes.Equal(got.Pix, img.Pix) {
+				t.Error("decoded pixels differ from the original")
+			}
+		})
+	}
+}
+
+func TestHuffmanLengthsAreLimited(t *testing.T) {
+	// フィボナッチ数列の出現回数は、そのままでは非常に深い木になります
+	freq := make([]int, 30)
+	a, b := 1, 1
+	for i := range freq {
+		freq[i] = a
+		a, b = b, a+b
+	}
+	lengths := huffmanLengths(freq, maxCodeLength)
+	kraft := 0.0
+	for s, l := range lengths {
+		if l == 0 || l > maxCodeLength {
+			t.Fatalf("length of symbol %d = %d", s, l)
+		}
+		kraft += 1 / float64(uint(1)<<l)
+	}
+	if kraft != 1 {
+		t.Errorf("code is not complete: kraft sum = %v", kraft)
+	}
+}
@@ -0,0 +1,215 @@
+// Package linkcheck: 購入リンクが生きているかを HTTP で確認する LinkChecker の実装です。
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+const (
+	// DefaultTimeout: 1つのリンクの確認にかける時間の上限 (転送を含む)
+	DefaultTimeout = 10 * time.Second
+	// maxRedirects: たどる転送の回数の上限
+	maxRedirects = 10
+	// maxBodySize: 内容を確かめるために読む大きさの上限 (「在庫切れ」などはページの前半にあることが多い)
+	maxBodySize = 512 * 1024
+	userAgent   = "opti-link-checker/1.0"
+)
+
+// notFoundPhrases: 200 を返すが、実際には商品ページが無いことを表す文言 (小文字で比べます)
+// 文言は構造化データに在庫状況が無いページでだけ、タイトル・見出し・本文の主要部分から探します。
+// 関連商品の欄などにも出てくる短い文言 (「販売終了」だけなど) は、健全なページを誤って問題にしやすいので避けます。
+var notFoundPhrases = []string{
+	"お探しのページは見つかりません",
+	"ページが見つかりません",
+	"指定されたページは存在しません",
+	"この商品は販売を終了しました",
+	"販売終了しました",
+	"販売を終了いたしました",
+	"取り扱いを終了しました",
+	"page not found",
+	"no longer available",
+	"this item is unavailable",
+}
+
+// outOfStockPhrases: 在庫切れを表す文言 (小文字で比べます)
+var outOfStockPhrases = []string{
+	"在庫切れ",
+	"品切れ",
+	"入荷待ち",
+	"入荷未定",
+	"out of stock",
+	"sold out",
+	"currently unavailable",
+}
+
+var errTooManyRedirects = errors.New("too many redirects")
+
+// Checker: HEAD で確認し、必要なら GET で内容も確かめる LinkChecker です。
+// 1. HEAD が 404 / 410 なら削除されたものとします (内容は読まない)
+// 2. HEAD に対応していないサイトや、HTML のページは GET して内容を確かめます
+// 3. 200 でも構造化データ (schema.org の availability) が販売終了・在庫切れならそれに従い、
+// 構造化データが無ければ「ページが見つかりません」「在庫切れ」などの文言で判断します。トップページへの転送も問題として扱います
+type Checker struct {
+	httpClient *http.Client
+	timeout    time.Duration
+}
+
+// NewChecker: timeout が0以下なら DefaultTimeout を使います。
+// 転送の回数の上限は httpClient のコピーに設定するので、渡したクライアントは変更しません。
+func NewChecker(httpClient *http.Client, timeout time.Duration) repository.LinkChecker {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	client := *httpClient
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return errTooManyRedirects
+		}
+		return nil
+	}
+	return &Checker{httpClient: &client, timeout: timeout}
+}
+
+func (c *Checker) Check(parent context.Context, link string) (model.LinkCheckResult, error) {
+	ctx, cancel := context.WithTimeout(parent, c.timeout)
+	defer cancel()
+	// 呼び出し元がキャンセルした場合はリンクの問題ではないので、結果にせずエラーを返します
+	parentDone := func() bool { return parent.Err() != nil }
+
+	// 1. HEAD
+	res, err := c.do(ctx, http.MethodHead, link)
+	if err != nil {
+		if parentDone() {
+			return model.LinkCheckResult{}, parent.Err()
+		}
+		if errors.Is(err, errTooManyRedirects) || errors.Is(err, context.DeadlineExceeded) {
+			return unreachable(0, link, err), nil
+		}
+		// HEAD の接続を切るサイトもあるので、GET でもう一度試します
+	} else {
+		res.Body.Close()
+		switch {
+		case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+			return notFound(res), nil
+		case isSuccess(res.StatusCode) && !isHTML(res):
+			return model.LinkCheckResult{Status: model.LinkOK, HTTPStatus: res.StatusCode, FinalURL: res.Request.URL.String()}, nil
+		}
+	}
+
+	// 2. GET
+	res, err = c.do(ctx, http.MethodGet, link)
+	if err != nil {
+		if parentDone() {
+			return model.LinkCheckResult{}, parent.Err()
+		}
+		return unreachable(0, link, err), nil
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return notFound(res), nil
+	case !isSuccess(res.StatusCode):
+		return unreachable(res.StatusCode, res.Request.URL.String(), fmt.Errorf("server returned %s", res.Status)), nil
+	}
+
+	// 3. 内容
+	result := model.LinkCheckResult{Status: model.LinkOK, HTTPStatus: res.StatusCode, FinalURL: res.Request.URL.String()}
+	if redirectedToTop(link, res.Request.URL) {
+		result.Status = model.LinkSoftMissing
+		result.Detail = "redirected to the top page"
+		return result, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxBodySize))
+	if err != nil {
+		if parentDone() {
+			return model.LinkCheckResult{}, parent.Err()
+		}
+		return unreachable(res.StatusCode, result.FinalURL, err), nil
+	}
+	page := parsePage(body)
+	// 構造化データに在庫状況があれば、販売サイト自身の表明としてそれを優先し、文言は探しません
+	switch page.availability {
+	case model.StockDiscontinued:
+		result.Status = model.LinkSoftMissing
+		result.Detail = "structured data says the item is discontinued"
+		return result, nil
+	case model.StockOutOfStock:
+		result.Status = model.LinkOutOfStock
+		result.Detail = "structured data says the item is out of stock"
+		return result, nil
+	case model.StockInStock, model.StockPreorder:
+		return result, nil
+	}
+	if phrase, ok := findPhrase(page.text, notFoundPhrases); ok {
+		result.Status = model.LinkSoftMissing
+		result.Detail = fmt.Sprintf("page contains %q", phrase)
+	} else if phrase, ok := findPhrase(page.text, outOfStockPhrases); ok {
+		result.Status = model.LinkOutOfStock
+		result.Detail = fmt.Sprintf("page contains %q", phrase)
+	}
+	return result, nil
+}
+
+func (c *Checker) do(ctx context.Context, method, link string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,*/*;q=0.8")
+	return c.httpClient.Do(req)
+}
+
+func isSuccess(code int) bool {
+	return code >= 200 && code < 300
+}
+
+func isHTML(res *http.Response) bool {
+	ct := res.Header.Get("Content-Type")
+	return ct == "" || strings.Contains(ct, "html")
+}
+
+func notFound(res *http.Response) model.LinkCheckResult {
+	return model.LinkCheckResult{
+		Status:     model.LinkNotFound,
+		HTTPStatus: res.StatusCode,
+		FinalURL:   res.Request.URL.String(),
+		Detail:     "server returned " + res.Status,
+	}
+}
+
+func unreachable(code int, finalURL string, err error) model.LinkCheckResult {
+	return model.LinkCheckResult{Status: model.LinkUnreachable, HTTPStatus: code, FinalURL: finalURL, Detail: err.Error()}
+}
+
+// redirectedToTop: 商品ページからトップページへ転送された (削除された商品ページでよくある) かどうか
+func redirectedToTop(link string, final *url.URL) bool {
+	original, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	isTop := func(u *url.URL) bool { return u.Path == "" || u.Path == "/" }
+	return !isTop(original) && isTop(final)
+}
+
+func findPhrase(text string, phrases []string) (string, bool) {
+	for _, p := range phrases {
+		if strings.Contains(text, p) {
+			return p, true
+		}
+	}
+	return "", false
+}
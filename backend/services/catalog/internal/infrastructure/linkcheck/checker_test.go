@@ -0,0 +1,154 @@
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// newTestSite: 商品ページのさまざまな状態を返すサイトです。GET の回数を gets に数えます。
+func newTestSite(t *testing.T, gets *atomic.Int32) *httptest.Server {
+	t.Helper()
+	page := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				gets.Add(1)
+			}
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprintf(w, "<html><body>%s</body></html>", body)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/", page("トップページ"))
+	mux.Handle("/items/ok", page("<h1>ロボット掃除機</h1><p>在庫あり</p>"))
+	mux.Handle("/items/soft", page("<p>申し訳ございません。お探しのページは見つかりません。</p>"))
+	mux.Handle("/items/stock", page(`<p class="stock">SOLD OUT</p>`))
+	// 関連商品の欄の「在庫切れ」は、本文 (<main>) の外なので問題にしない
+	mux.Handle("/items/related", page(`<main><h1>ロボット掃除機</h1><p>3〜5日で発送</p></main><aside><p>関連商品: 旧モデル (在庫切れ)</p></aside>`))
+	// 構造化データがあれば文言より優先する (一部の色だけ在庫切れなど)
+	mux.Handle("/items/ld-in-stock", page(`<script type="application/ld+json">{"@type":"Product","offers":{"@type":"Offer","price":39800,"priceCurrency":"JPY","availability":"https://schema.org/InStock"}}</script><main><p>ホワイトは在庫切れ</p></main>`))
+	mux.Handle("/items/ld-out-of-stock", page(`<script type="application/ld+json">{"@type":"Product","offers":{"@type":"Offer","price":39800,"availability":"https://schema.org/OutOfStock"}}</script><main><h1>ロボット掃除機</h1></main>`))
+	mux.Handle("/items/microdata-discontinued", page(`<div itemscope itemtype="https://schema.org/Product"><h1 itemprop="name">ロボット掃除機</h1><div itemprop="offers" itemscope itemtype="https://schema.org/Offer"><link itemprop="availability" href="https://schema.org/Discontinued"></div></div>`))
+	mux.HandleFunc("/items/gone", func(w http.ResponseWriter, r *http.Request) { http.NotFound(w, r) })
+	mux.HandleFunc("/items/removed", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusGone) })
+	mux.HandleFunc("/items/error", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusInternalServerError) })
+	mux.HandleFunc("/items/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/items/ok", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/items/to-top", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/", http.StatusFound) })
+	mux.HandleFunc("/items/loop", func(w http.ResponseWriter, r *http.Request) { http.Redirect(w, r, "/items/loop", http.StatusFound) })
+	mux.HandleFunc("/items/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
+	})
+	// HEAD に対応していないサイト
+	mux.HandleFunc("/items/no-head", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		page("<h1>電動歯ブラシ</h1>")(w, r)
+	})
+	mux.HandleFunc("/items/no-head-gone", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/manual.pdf", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets.Add(1)
+		}
+		w.Header().Set("Content-Type", "application/pdf")
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCheckerCheck(t *testing.T) {
+	var gets atomic.Int32
+	srv := newTestSite(t, &gets)
+	checker := NewChecker(srv.Client(), 200*time.Millisecond)
+
+	tests := []struct {
+		path       string
+		want       model.LinkStatus
+		wantHTTP   int
+		wantFinal  string // 空なら確かめない
+		wantDetail string // 空なら確かめない
+	}{
+		{path: "/items/ok", want: model.LinkOK, wantHTTP: 200},
+		{path: "/items/gone", want: model.LinkNotFound, wantHTTP: 404},
+		{path: "/items/removed", want: model.LinkNotFound, wantHTTP: 410},
+		{path: "/items/soft", want: model.LinkSoftMissing, wantHTTP: 200, wantDetail: "お探しのページは見つかりません"},
+		{path: "/items/stock", want: model.LinkOutOfStock, wantHTTP: 200, wantDetail: "sold out"},
+		{path: "/items/related", want: model.LinkOK, wantHTTP: 200},
+		{path: "/items/ld-in-stock", want: model.LinkOK, wantHTTP: 200},
+		{path: "/items/ld-out-of-stock", want: model.LinkOutOfStock, wantHTTP: 200, wantDetail: "structured data"},
+		{path: "/items/microdata-discontinued", want: model.LinkSoftMissing, wantHTTP: 200, wantDetail: "discontinued"},
+		{path: "/items/moved", want: model.LinkOK, wantHTTP: 200, wantFinal: "/items/ok"},
+		{path: "/items/to-top", want: model.LinkSoftMissing, wantHTTP: 200, wantFinal: "/", wantDetail: "top page"},
+		{path: "/items/loop", want: model.LinkUnreachable, wantDetail: "too many redirects"},
+		{path: "/items/slow", want: model.LinkUnreachable, wantDetail: "deadline exceeded"},
+		{path: "/items/error", want: model.LinkUnreachable, wantHTTP: 500},
+		{path: "/items/no-head", want: model.LinkOK, wantHTTP: 200},
+		{path: "/items/no-head-gone", want: model.LinkNotFound, wantHTTP: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := checker.Check(context.Background(), srv.URL+tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tt.want || got.HTTPStatus != tt.wantHTTP {
+				t.Errorf("got %+v, want status %q http %d", got, tt.want, tt.wantHTTP)
+			}
+			if tt.wantFinal != "" && got.FinalURL != srv.URL+tt.wantFinal {
+				t.Errorf("final url = %q, want %q", got.FinalURL, srv.URL+tt.wantFinal)
+			}
+			if !strings.Contains(got.Detail, tt.wantDetail) {
+				t.Errorf("detail = %q, want it to contain %q", got.Detail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestCheckerSkipsBodyOfNonHTML(t *testing.T) {
+	var gets atomic.Int32
+	srv := newTestSite(t, &gets)
+	checker := NewChecker(srv.Client(), time.Second)
+
+	got, err := checker.Check(context.Background(), srv.URL+"/manual.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != model.LinkOK {
+		t.Errorf("status = %q, want ok", got.Status)
+	}
+	if n := gets.Load(); n != 0 {
+		t.Errorf("sent %d GET requests, want only HEAD", n)
+	}
+}
+
+func TestCheckerReturnsErrorWhenCanceled(t *testing.T) {
+	var gets atomic.Int32
+	srv := newTestSite(t, &gets)
+	checker := NewChecker(srv.Client(), time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := checker.Check(ctx, srv.URL+"/items/ok"); err == nil {
+		t.Error("Check returned no error for a canceled context")
+	}
+}
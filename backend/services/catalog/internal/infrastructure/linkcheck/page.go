@@ -0,0 +1,110 @@
+package linkcheck
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/merchant"
+)
+
+// page: 商品ページから読み取った、商品ページの状態の手がかり
+type page struct {
+	// availability: 構造化データ (JSON-LD の Offer、または microdata の itemprop="availability") の在庫状況
+	availability model.StockStatus
+	// text: 文言を探す範囲 (<title>、<h1>、本文の主要部分) を小文字にしたもの
+	text string
+}
+
+// skippedElements: 本文の主要部分として扱わない要素 (関連商品やナビゲーションの「在庫切れ」などを拾わないため)
+var skippedElements = map[string]bool{
+	"script": true, "style": true, "noscript": true, "template": true,
+	"nav": true, "header": true, "footer": true, "aside": true,
+}
+
+// parsePage: HTML を解析します。
+// 本文は <main> (または role="main") の中だけを使い、無ければ <body> からナビゲーション・ヘッダー・フッター・サイドバーを除いたものを使います。
+func parsePage(body []byte) page {
+	var p page
+	if quote, ok, err := merchant.ReadQuote(bytes.NewReader(body)); err == nil && ok {
+		p.availability = quote.Status
+	}
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return p
+	}
+
+	var headings, mainText, bodyText strings.Builder
+	hasMain := false
+	var walk func(n *html.Node, inMain, skipped bool)
+	walk = func(n *html.Node, inMain, skipped bool) {
+		if n.Type == html.ElementNode {
+			if p.availability == model.StockUnknown && attr(n, "itemprop") == "availability" {
+				p.availability = merchant.ParseAvailability(firstNonEmpty(attr(n, "href"), attr(n, "content")))
+			}
+			switch {
+			case n.Data == "title" || n.Data == "h1":
+				headings.WriteString(textOf(n))
+				headings.WriteByte('\n')
+			case n.Data == "main" || attr(n, "role") == "main":
+				hasMain, inMain = true, true
+			case skippedElements[n.Data]:
+				skipped = true
+			}
+		}
+		if n.Type == html.TextNode {
+			if inMain && !skipped {
+				mainText.WriteString(n.Data)
+			}
+			if !skipped {
+				bodyText.WriteString(n.Data)
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, inMain, skipped)
+		}
+	}
+	walk(doc, false, false)
+
+	text := bodyText.String()
+	if hasMain {
+		text = mainText.String()
+	}
+	p.text = strings.ToLower(headings.String() + text)
+	return p
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+func textOf(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
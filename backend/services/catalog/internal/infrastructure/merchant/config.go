@@ -0,0 +1,57 @@
+package merchant
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// ParseSources: 環境変数などで指定した販売サイトの一覧から、価格の取得方法を作成します。
+// 書式は "名前=ホスト,ホスト@間隔" を ";" で区切ったものです。間隔 (同じ販売サイトへのリクエストの最小間隔) は省略できます。
+//
+//	rakuten=rakuten.co.jp@3s;yodobashi=yodobashi.com,www.yodobashi.com
+//
+// 返り値の intervals は usecase.NewPriceRefresher にそのまま渡せます (省略した販売サイトは含みません)。
+func ParseSources(spec string, httpClient *http.Client) ([]repository.PriceSource, map[string]time.Duration, error) {
+	var sources []repository.PriceSource
+	intervals := make(map[string]time.Duration)
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, rest, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, nil, fmt.Errorf("invalid price source %q: want name=host[,host...][@interval]", entry)
+		}
+		if seen[name] {
+			return nil, nil, fmt.Errorf("duplicate price source %q", name)
+		}
+		seen[name] = true
+
+		hostList, intervalText, hasInterval := strings.Cut(rest, "@")
+		if hasInterval {
+			interval, err := time.ParseDuration(strings.TrimSpace(intervalText))
+			if err != nil || interval <= 0 {
+				return nil, nil, fmt.Errorf("invalid interval for price source %q: %q", name, intervalText)
+			}
+			intervals[name] = interval
+		}
+		var hosts []string
+		for _, h := range strings.Split(hostList, ",") {
+			if h = strings.TrimSpace(h); h != "" {
+				hosts = append(hosts, h)
+			}
+		}
+		if len(hosts) == 0 {
+			return nil, nil, fmt.Errorf("price source %q has no hosts", name)
+		}
+		sources = append(sources, NewStructuredDataSource(httpClient, name, hosts...))
+	}
+	return sources, intervals, nil
+}
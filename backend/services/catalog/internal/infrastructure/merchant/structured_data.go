@@ -0,0 +1,262 @@
+// Package merchant: 販売サイトから価格と在庫状況を取得する PriceSource の実装です。
+package merchant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// maxPageSize: 読み込む商品ページの大きさの上限
+const maxPageSize = 5 * 1024 * 1024
+
+// userAgent: 販売サイトへのリクエストで名乗る名前
+const userAgent = "opti-price-refresher/1.0"
+
+// StructuredDataSource: 商品ページに埋め込まれた schema.org の構造化データ (JSON-LD の Product / Offer) から
+// 価格と在庫状況を読み取る PriceSource です。
+// 多くのECサイトは検索エンジン向けに構造化データを載せているため、販売サイトごとにページの解析を書かずに使えます。
+type StructuredDataSource struct {
+	httpClient *http.Client
+	name       string
+	hosts      []string
+}
+
+// NewStructuredDataSource: 販売サイト name の取得方法を作成します。
+// hosts には購入リンクのホスト名を指定します (サブドメインも対象になります。例: "rakuten.co.jp")。
+func NewStructuredDataSource(httpClient *http.Client, name string, hosts ...string) repository.PriceSource {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &StructuredDataSource{httpClient: httpClient, name: name, hosts: hosts}
+}
+
+func (s *StructuredDataSource) Merchant() string {
+	return s.name
+}
+
+// Supports: 購入リンクのホスト (ポート番号を含む、または含まない) が hosts のいずれかに当てはまるかを判定します。
+func (s *StructuredDataSource) Supports(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	for _, h := range s.hosts {
+		for _, host := range []string{u.Host, u.Hostname()} {
+			if host == h || strings.HasSuffix(host, "."+h) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Fetch: 商品ページを取得し、構造化データの Offer から価格と在庫状況を読み取ります。
+// 404 / 410 は商品ページが削除されたものとして model.ErrListingNotFound を返します。
+func (s *StructuredDataSource) Fetch(ctx context.Context, link string) (model.PriceQuote, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return model.PriceQuote{}, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html")
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return model.PriceQuote{}, fmt.Errorf("%s: request failed: %w", s.name, err)
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return model.PriceQuote{}, fmt.Errorf("%w: %s returned %s", model.ErrListingNotFound, s.name, res.Status)
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		return model.PriceQuote{}, fmt.Errorf("%s returned %s", s.name, res.Status)
+	}
+
+	quote, ok, err := ReadQuote(io.LimitReader(res.Body, maxPageSize))
+	if err != nil {
+		return model.PriceQuote{}, fmt.Errorf("%s: %w", s.name, err)
+	}
+	if !ok {
+		return model.PriceQuote{}, fmt.Errorf("%s: no product offer in structured data", s.name)
+	}
+	quote.FetchedAt = time.Now()
+	return quote, nil
+}
+
+// ReadQuote: 商品ページの HTML に埋め込まれた JSON-LD の Offer から、価格と在庫状況を読み取ります。
+// Product の Offer が1つも無ければ ok は false です (購入リンクの定期確認でも使います)。
+func ReadQuote(r io.Reader) (quote model.PriceQuote, ok bool, err error) {
+	offers, err := extractOffers(r)
+	if err != nil {
+		return model.PriceQuote{}, false, err
+	}
+	quote, ok = bestOffer(offers)
+	return quote, ok, nil
+}
+
+// extractOffers: HTML の <script type="application/ld+json"> から、Product に含まれる Offer を集めます。
+// 形式の壊れた JSON-LD は読み飛ばします (1ページに複数あり、関係のないものが壊れていることもあるため)。
+func extractOffers(r io.Reader) ([]map[string]any, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page: %w", err)
+	}
+	var offers []map[string]any
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "script" && isJSONLD(n) && n.FirstChild != nil {
+			var data any
+			if json.Unmarshal([]byte(n.FirstChild.Data), &data) == nil {
+				offers = append(offers, productOffers(data)...)
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return offers, nil
+}
+
+func isJSONLD(n *html.Node) bool {
+	for _, a := range n.Attr {
+		if a.Key == "type" && strings.EqualFold(strings.TrimSpace(a.Val), "application/ld+json") {
+			return true
+		}
+	}
+	return false
+}
+
+// productOffers: JSON-LD の中から Product を探し、その offers を返します。
+// トップレベルの配列や @graph の中にある Product にも対応します。
+func productOffers(v any) []map[string]any {
+	switch v := v.(type) {
+	case []any:
+		var offers []map[string]any
+		for _, item := range v {
+			offers = append(offers, productOffers(item)...)
+		}
+		return offers
+	case map[string]any:
+		if graph, ok := v["@graph"]; ok {
+			return productOffers(graph)
+		}
+		if !hasType(v, "Product") {
+			return nil
+		}
+		return offerList(v["offers"])
+	}
+	return nil
+}
+
+// offerList: offers は1件のオブジェクトの場合と配列の場合があります。
+func offerList(v any) []map[string]any {
+	switch v := v.(type) {
+	case map[string]any:
+		return []map[string]any{v}
+	case []any:
+		var list []map[string]any
+		for _, item := range v {
+			if m, ok := item.(map[string]any); ok {
+				list = append(list, m)
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+func hasType(m map[string]any, want string) bool {
+	switch t := m["@type"].(type) {
+	case string:
+		return t == want
+	case []any:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bestOffer: 円建ての Offer のうち、在庫のあるものの最安値を選びます。在庫のある Offer がなければ最初の Offer を使います。
+func bestOffer(offers []map[string]any) (model.PriceQuote, bool) {
+	var best model.PriceQuote
+	found := false
+	for _, o := range offers {
+		if cur, ok := o["priceCurrency"].(string); ok && cur != "" && !strings.EqualFold(cur, "JPY") {
+			continue
+		}
+		availability, _ := o["availability"].(string)
+		q := model.PriceQuote{Status: ParseAvailability(availability)}
+		// AggregateOffer (複数の店舗・バリエーション) は最安値を使います
+		for _, key := range []string{"price", "lowPrice"} {
+			if amount, ok := parsePrice(o[key]); ok {
+				q.Amount, q.HasPrice = amount, true
+				break
+			}
+		}
+		switch {
+		case !found:
+			best, found = q, true
+		case q.Status.Purchasable() && !best.Status.Purchasable():
+			best = q
+		case q.Status.Purchasable() == best.Status.Purchasable() && q.HasPrice && (!best.HasPrice || q.Amount < best.Amount):
+			best = q
+		}
+	}
+	return best, found
+}
+
+// parsePrice: 価格は数値のことも "39,800" のような文字列のこともあります。
+func parsePrice(v any) (int32, bool) {
+	var f float64
+	switch v := v.(type) {
+	case float64:
+		f = v
+	case string:
+		parsed, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(v), ",", ""), 64)
+		if err != nil {
+			return 0, false
+		}
+		f = parsed
+	default:
+		return 0, false
+	}
+	if f < 0 || f > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(math.Round(f)), true
+}
+
+// ParseAvailability: schema.org の ItemAvailability ("https://schema.org/InStock" など) を在庫状況に変換します。
+func ParseAvailability(s string) model.StockStatus {
+	if i := strings.LastIndex(s, "/"); i >= 0 {
+		s = s[i+1:]
+	}
+	switch s {
+	case "InStock", "InStoreOnly", "OnlineOnly", "LimitedAvailability":
+		return model.StockInStock
+	case "OutOfStock", "SoldOut", "BackOrder":
+		return model.StockOutOfStock
+	case "PreOrder", "PreSale":
+		return model.StockPreorder
+	case "Discontinued":
+		return model.StockDiscontinued
+	}
+	return model.StockUnknown
+}
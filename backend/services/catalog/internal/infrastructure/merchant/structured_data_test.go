@@ -0,0 +1,115 @@
+package merchant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+func TestExtractOffers(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       string
+		wantPrice  int32
+		wantStatus model.StockStatus
+		wantFound  bool
+	}{
+		{
+			name:       "single offer",
+			page:       `<script type="application/ld+json">{"@type":"Product","offers":{"@type":"Offer","price":39800,"priceCurrency":"JPY","availability":"https://schema.org/InStock"}}</script>`,
+			wantPrice:  39800,
+			wantStatus: model.StockInStock,
+			wantFound:  true,
+		},
+		{
+			name:       "graph with string price",
+			page:       `<script type="application/ld+json">{"@context":"https://schema.org","@graph":[{"@type":"BreadcrumbList"},{"@type":["Product","Thing"],"offers":[{"price":"12,980","availability":"http://schema.org/OutOfStock"}]}]}</script>`,
+			wantPrice:  12980,
+			wantStatus: model.StockOutOfStock,
+			wantFound:  true,
+		},
+		{
+			name:       "cheapest in-stock offer wins",
+			page:       `<script type="application/ld+json">[{"@type":"Product","offers":[{"price":9000,"availability":"OutOfStock"},{"price":11000,"availability":"InStock"},{"price":10500,"availability":"InStock"},{"price":50,"priceCurrency":"USD","availability":"InStock"}]}]</script>`,
+			wantPrice:  10500,
+			wantStatus: model.StockInStock,
+			wantFound:  true,
+		},
+		{
+			name:       "aggregate offer low price",
+			page:       `<script type="application/ld+json">{"@type":"Product","offers":{"@type":"AggregateOffer","lowPrice":"24800","highPrice":"29800"}}</script>`,
+			wantPrice:  24800,
+			wantStatus: model.StockUnknown,
+			wantFound:  true,
+		},
+		{
+			name:      "broken json-ld is skipped",
+			page:      `<script type="application/ld+json">{broken</script><script type="application/ld+json">{"@type":"Organization"}</script>`,
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offers, err := extractOffers(strings.NewReader("<html><head>" + tt.page + "</head></html>"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			quote, found := bestOffer(offers)
+			if found != tt.wantFound {
+				t.Fatalf("found = %v, want %v", found, tt.wantFound)
+			}
+			if !found {
+				return
+			}
+			if !quote.HasPrice || quote.Amount != tt.wantPrice || quote.Status != tt.wantStatus {
+				t.Errorf("quote = %+v, want price %d status %q", quote, tt.wantPrice, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestStructuredDataSourceFetchStub(t *testing.T) {
+	stub := NewStubMerchant()
+	stub.SetItem("a", StubItem{Name: "ロボット掃除機 <Pro>", Price: 49800, Status: model.StockPreorder})
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	source := NewStructuredDataSource(srv.Client(), "stub", "127.0.0.1")
+	if !source.Supports(srv.URL + "/items/a") {
+		t.Fatalf("Supports(%q) = false", srv.URL)
+	}
+	if source.Supports("https://example.com/items/a") {
+		t.Error("Supports(example.com) = true")
+	}
+
+	quote, err := source.Fetch(context.Background(), srv.URL+"/items/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote.Amount != 49800 || quote.Status != model.StockPreorder || quote.FetchedAt.IsZero() {
+		t.Errorf("quote = %+v", quote)
+	}
+
+	stub.RemoveItem("a")
+	if _, err := source.Fetch(context.Background(), srv.URL+"/items/a"); !errors.Is(err, model.ErrListingNotFound) {
+		t.Errorf("err = %v, want ErrListingNotFound", err)
+	}
+}
+
+func TestStructuredDataSourceFetchServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "busy", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	source := NewStructuredDataSource(srv.Client(), "stub", "127.0.0.1")
+	_, err := source.Fetch(context.Background(), srv.URL+"/items/a")
+	if err == nil || errors.Is(err, model.ErrListingNotFound) {
+		t.Errorf("err = %v, want a non-listing error", err)
+	}
+}
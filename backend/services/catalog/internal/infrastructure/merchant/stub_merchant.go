@@ -0,0 +1,122 @@
+package merchant
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// StubItem: 開発用の販売サイトで売っている商品
+type StubItem struct {
+	Name   string
+	Price  int32
+	Status model.StockStatus
+}
+
+// StubMerchant: 開発・テスト用の販売サイトです。本物のサイトに問い合わせずに価格の取得を試せます。
+// GET /items/{id} で、JSON-LD の構造化データを埋め込んだ商品ページを返します (StructuredDataSource で読めます)。
+// 登録されていない商品は 404 を返します。
+type StubMerchant struct {
+	mu       sync.Mutex
+	items    map[string]StubItem
+	requests []time.Time // 商品ページへのリクエストを受けた日時 (レート制限の確認用)
+	mux      *http.ServeMux
+}
+
+// NewStubMerchant: 商品のない販売サイトを作成します。
+func NewStubMerchant() *StubMerchant {
+	m := &StubMerchant{items: make(map[string]StubItem), mux: http.NewServeMux()}
+	m.mux.HandleFunc("GET /items/{id}", m.serveItem)
+	return m
+}
+
+// SetItem: 商品を登録・変更します。
+func (m *StubMerchant) SetItem(id string, item StubItem) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[id] = item
+}
+
+// RemoveItem: 商品ページを削除します。
+func (m *StubMerchant) RemoveItem(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.items, id)
+}
+
+// Requests: 商品ページへのリクエストを受けた日時を古い順に返します。
+func (m *StubMerchant) Requests() []time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]time.Time(nil), m.requests...)
+}
+
+func (m *StubMerchant) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mux.ServeHTTP(w, r)
+}
+
+var stubItemPage = template.Must(template.New("item").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<script type="application/ld+json">{{.JSONLD}}</script>
+</head>
+<body><h1>{{.Name}}</h1><p class="price">¥{{.Price}}</p></body>
+</html>
+`))
+
+func (m *StubMerchant) serveItem(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	m.requests = append(m.requests, time.Now())
+	item, ok := m.items[r.PathValue("id")]
+	m.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	offer := map[string]any{
+		"@type":         "Offer",
+		"price":         item.Price,
+		"priceCurrency": "JPY",
+	}
+	if availability := schemaAvailability(item.Status); availability != "" {
+		offer["availability"] = availability
+	}
+	jsonld, err := json.Marshal(map[string]any{
+		"@context": "https://schema.org",
+		"@type":    "Product",
+		"name":     item.Name,
+		"offers":   offer,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = stubItemPage.Execute(w, map[string]any{
+		"Name":   item.Name,
+		"Price":  item.Price,
+		"JSONLD": template.JS(jsonld),
+	})
+}
+
+// schemaAvailability: 在庫状況を schema.org の ItemAvailability に変換します (ParseAvailability の逆)。
+func schemaAvailability(s model.StockStatus) string {
+	switch s {
+	case model.StockInStock:
+		return "https://schema.org/InStock"
+	case model.StockOutOfStock:
+		return "https://schema.org/OutOfStock"
+	case model.StockPreorder:
+		return "https://schema.org/PreOrder"
+	case model.StockDiscontinued:
+		return "https://schema.org/Discontinued"
+	}
+	return ""
+}
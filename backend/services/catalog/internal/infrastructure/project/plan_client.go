@@ -0,0 +1,51 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	projectv1 "github.com/kinoshitatakumi/opti/gen/go/project/v1"
+	"github.com/kinoshitatakumi/opti/gen/go/project/v1/projectv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/pkg/resilience"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// PlanClient: Project Service の GetAdoptionProject を呼び出す PlanDirectory の実装です。
+// Project Service は所有者にしかプロジェクトを返さないので、受け付けたリクエストのアクセストークンを引き継いで呼び出します。
+type PlanClient struct {
+	client projectv1connect.ProjectServiceClient
+}
+
+// NewPlanClient: クライアントの作成
+// baseURL は Project Service のURL (例: "http://localhost:8083") です。
+func NewPlanClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) repository.PlanDirectory {
+	return &PlanClient{
+		client: projectv1connect.NewProjectServiceClient(httpClient, baseURL, opts...),
+	}
+}
+
+// NewResilientPlanClient: リトライ・タイムアウト・サーキットブレーカーを適用したクライアントを作成します。
+func NewResilientPlanClient(baseURL string) repository.PlanDirectory {
+	executor := resilience.NewExecutor(resilience.Config{Default: resilience.DefaultPolicy()})
+	return NewPlanClient(http.DefaultClient, baseURL, connect.WithInterceptors(auth.NewForwardingInterceptor(), resilience.NewClientInterceptor(executor)))
+}
+
+// OwnerOf: 採用計画の利用者IDを取得します。
+// ログイン中の利用者のものでなければ Project Service が PermissionDenied を返すので、見つからない場合と同じく model.ErrPlanNotOwned にします。
+func (c *PlanClient) OwnerOf(ctx context.Context, planID string) (string, error) {
+	res, err := c.client.GetAdoptionProject(ctx, connect.NewRequest(&projectv1.GetAdoptionProjectRequest{Id: planID}))
+	if err != nil {
+		switch connect.CodeOf(err) {
+		case connect.CodeNotFound:
+			return "", fmt.Errorf("%w: plan %s not found", model.ErrPlanNotOwned, planID)
+		case connect.CodePermissionDenied:
+			return "", fmt.Errorf("%w: plan %s belongs to another user", model.ErrPlanNotOwned, planID)
+		}
+		return "", fmt.Errorf("failed to get adoption project: %w", err)
+	}
+	return res.Msg.UserId, nil
+}
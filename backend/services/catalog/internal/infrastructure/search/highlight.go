@@ -0,0 +1,109 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// 抜粋の長さです (文字数)。
+const (
+	maxFragmentRunes     = 80 // これより短い項目は全体を返します
+	fragmentLeadingRunes = 20 // 最初の一致箇所より前に含める文字数
+	maxFragmentsPerField = 3  // 特長・注意点など複数ある項目で返す抜粋の数
+	highlightPreTag      = "<mark>"
+	highlightPostTag     = "</mark>"
+)
+
+// highlights: 製品の各項目から、検索語に一致した箇所を強調した抜粋を作ります。
+func highlights(p *model.Product, wanted map[string]bool) []model.SearchHighlight {
+	var list []model.SearchHighlight
+	for _, f := range fields {
+		var fragments []string
+		for _, text := range f.texts(p) {
+			if fragment, ok := highlightText(text, wanted); ok {
+				fragments = append(fragments, fragment)
+				if len(fragments) == maxFragmentsPerField {
+					break
+				}
+			}
+		}
+		if len(fragments) > 0 {
+			list = append(list, model.SearchHighlight{Field: f.field, Fragments: fragments})
+		}
+	}
+	return list
+}
+
+// span: 強調する範囲 (バイトオフセット)
+type span struct{ start, end int }
+
+// highlightText: 一致箇所を <mark> で囲んだ抜粋を返します。一致がなければ false を返します。
+// bigram は1文字ずつ重なるので、重なった・隣接した一致箇所は1つにまとめます。
+// 1文字の検索語にも一致させるため、インデックスと同じく unigram を含めて分割します。
+// 一致箇所以外は HTML エスケープするため、結果はそのまま HTML に埋め込めます。
+func highlightText(text string, wanted map[string]bool) (string, bool) {
+	var spans []span
+	for _, t := range tokenizeForIndex(text) {
+		if !wanted[t.term] {
+			continue
+		}
+		if n := len(spans); n > 0 && t.start <= spans[n-1].end {
+			spans[n-1].end = max(spans[n-1].end, t.end)
+			continue
+		}
+		spans = append(spans, span{t.start, t.end})
+	}
+	if len(spans) == 0 {
+		return "", false
+	}
+
+	// 長い項目は、最初の一致箇所の少し前から切り出します
+	from, to := 0, len(text)
+	if utf8.RuneCountInString(text) > maxFragmentRunes {
+		from = backRunes(text, spans[0].start, fragmentLeadingRunes)
+		to = forwardRunes(text, from, maxFragmentRunes)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, s := range spans {
+		start, end := max(s.start, from), min(s.end, to)
+		if start >= end {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:start]))
+		b.WriteString(highlightPreTag)
+		b.WriteString(html.EscapeString(text[start:end]))
+		b.WriteString(highlightPostTag)
+		pos = end
+	}
+	b.WriteString(html.EscapeString(text[pos:to]))
+	if to < len(text) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+// backRunes: offset から n 文字戻った位置を返します。
+func backRunes(text string, offset, n int) int {
+	for ; n > 0 && offset > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(text[:offset])
+		offset -= size
+	}
+	return offset
+}
+
+// forwardRunes: offset から n 文字進んだ位置を返します。
+func forwardRunes(text string, offset, n int) int {
+	for ; n > 0 && offset < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		offset += size
+	}
+	return offset
+}
@@ -0,0 +1,61 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlightText(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{"overlapping bigrams are merged", "ロボット掃除機", "ロボット", "<mark>ロボット</mark>掃除機"},
+		{"normalized match keeps original text", "ＷｉＦｉ対応", "wifi", "<mark>ＷｉＦｉ</mark>対応"},
+		{"one kanji", "自動施錠に対応", "錠", "自動施<mark>錠</mark>に対応"},
+		{"one katakana at the end of a run", "スマートロック", "ク", "スマートロッ<mark>ク</mark>"},
+		{"separate matches", "掃除と掃除", "掃除", "<mark>掃除</mark>と<mark>掃除</mark>"},
+		{"html is escaped", "<b>ハブ</b>", "ハブ", "&lt;b&gt;<mark>ハブ</mark>&lt;/b&gt;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := highlightText(tt.text, wantedTerms(tt.query))
+			if !ok {
+				t.Fatalf("no match for %q in %q", tt.query, tt.text)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlightTextNoMatch(t *testing.T) {
+	if got, ok := highlightText("スマート電球", wantedTerms("錠")); ok {
+		t.Errorf("got %q, want no match", got)
+	}
+}
+
+func TestHighlightTextCutsLongText(t *testing.T) {
+	text := strings.Repeat("あ", 50) + "自動施錠" + strings.Repeat("い", 100)
+	got, ok := highlightText(text, wantedTerms("施錠"))
+	if !ok {
+		t.Fatal("no match")
+	}
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") {
+		t.Errorf("fragment is not cut on both sides: %q", got)
+	}
+	if !strings.Contains(got, strings.Repeat("あ", fragmentLeadingRunes-2)+"自動<mark>施錠</mark>") {
+		t.Errorf("fragment does not start shortly before the match: %q", got)
+	}
+}
+
+func wantedTerms(query string) map[string]bool {
+	wanted := make(map[string]bool)
+	for _, term := range terms(query) {
+		wanted[term] = true
+	}
+	return wanted
+}
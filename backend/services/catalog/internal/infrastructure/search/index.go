@@ -0,0 +1,247 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// BM25 のパラメータです (一般的な既定値)。
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// fieldSpec: 検索対象の項目と、その項目で一致したときの重み
+type fieldSpec struct {
+	field  model.SearchField
+	weight float64
+	texts  func(p *model.Product) []string
+}
+
+// fields: 検索対象の項目。製品名やメーカー名での一致を、説明文での一致より重く扱います。
+var fields = []fieldSpec{
+	{model.SearchFieldName, 3.0, func(p *model.Product) []string { return []string{p.Name} }},
+	{model.SearchFieldManufacturer, 2.0, func(p *model.Product) []string { return []string{p.Manufacturer} }},
+	{model.SearchFieldStrongPoints, 1.2, func(p *model.Product) []string { return p.StrongPoints }},
+	{model.SearchFieldDescription, 1.0, func(p *model.Product) []string { return []string{p.Description} }},
+	{model.SearchFieldWeakPoints, 0.8, func(p *model.Product) []string { return p.WeakPoints }},
+}
+
+const numFields = 5
+
+// document: インデックスに登録した製品
+type document struct {
+	product *model.Product
+	lengths [numFields]int // 項目ごとのトークン数 (文書長の正規化に使います)
+	terms   []string       // 含まれる検索語 (削除時に転置リストから外すため)
+}
+
+// posting: ある検索語が、ある製品の各項目に何回出現したか
+type posting [numFields]int
+
+// Index: 製品の全文検索用の転置インデックスです (ProductSearcher の実装)。
+// すべてメモリ上に持ち、製品の保存のたびに IndexedProductRepository から更新されます。
+// 関連度は項目ごとに重みを付けた BM25 (BM25F) で計算します。
+type Index struct {
+	mu           sync.RWMutex
+	docs         map[model.ProductID]*document
+	postings     map[string]map[model.ProductID]*posting
+	totalLengths [numFields]int
+}
+
+// NewIndex: 空のインデックスを作成します。
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[model.ProductID]*document),
+		postings: make(map[string]map[model.ProductID]*posting),
+	}
+}
+
+var _ repository.ProductSearcher = (*Index)(nil)
+
+// Upsert: 製品を登録します。登録済みの場合は古い内容を置き換えます。
+func (ix *Index) Upsert(p *model.Product) {
+	// トークン分割はロックの外で行います
+	copied := *p
+	doc := &document{product: &copied}
+	freqs := make(map[string]*posting)
+	for fi, f := range fields {
+		for _, text := range f.texts(p) {
+			for _, t := range tokenizeForIndex(text) {
+				ps, ok := freqs[t.term]
+				if !ok {
+					ps = &posting{}
+					freqs[t.term] = ps
+					doc.terms = append(doc.terms, t.term)
+				}
+				ps[fi]++
+				if !t.unigram {
+					doc.lengths[fi]++
+				}
+			}
+		}
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(p.ID)
+	ix.docs[p.ID] = doc
+	for fi := range fields {
+		ix.totalLengths[fi] += doc.lengths[fi]
+	}
+	for term, ps := range freqs {
+		list, ok := ix.postings[term]
+		if !ok {
+			list = make(map[model.ProductID]*posting)
+			ix.postings[term] = list
+		}
+		list[p.ID] = ps
+	}
+}
+
+// Remove: 製品をインデックスから外します。
+func (ix *Index) Remove(id model.ProductID) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(id)
+}
+
+// Rebuild: インデックスを作り直します (起動時にリポジトリの全製品を読み込むために使います)。
+func (ix *Index) Rebuild(products []*model.Product) {
+	ix.mu.Lock()
+	ix.docs = make(map[model.ProductID]*document)
+	ix.postings = make(map[string]map[model.ProductID]*posting)
+	ix.totalLengths = [numFields]int{}
+	ix.mu.Unlock()
+	for _, p := range products {
+		ix.Upsert(p)
+	}
+}
+
+func (ix *Index) remove(id model.ProductID) {
+	doc, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+	for _, term := range doc.terms {
+		list := ix.postings[term]
+		delete(list, id)
+		if len(list) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+	for fi := range fields {
+		ix.totalLengths[fi] -= doc.lengths[fi]
+	}
+	delete(ix.docs, id)
+}
+
+// Search: 検索語をすべて含み、絞り込み条件を満たす製品を関連度の高い順に返します。
+// 検索語が空の場合は、絞り込み条件だけで製品名順に返します。
+func (ix *Index) Search(ctx context.Context, q model.ProductSearchQuery) (*model.ProductSearchResult, error) {
+	queryTerms := terms(q.Text)
+
+	ix.mu.RLock()
+	var hits []model.ProductSearchHit
+	if len(queryTerms) == 0 {
+		for _, doc := range ix.docs {
+			if q.Filter.Matches(doc.product) {
+				hits = append(hits, model.ProductSearchHit{Product: doc.product})
+			}
+		}
+	} else {
+		hits = ix.match(queryTerms, q)
+	}
+	ix.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].Product.Name != hits[j].Product.Name {
+			return hits[i].Product.Name < hits[j].Product.Name
+		}
+		return hits[i].Product.ID < hits[j].Product.ID
+	})
+
+	// ページの切り出し。抜粋の作成は表示するページの分だけ行います
+	result := &model.ProductSearchResult{Total: len(hits)}
+	start := min(q.Offset, len(hits))
+	end := len(hits)
+	if q.Limit > 0 {
+		end = min(start+q.Limit, len(hits))
+	}
+	result.Hits = hits[start:end]
+	if len(queryTerms) > 0 {
+		wanted := make(map[string]bool, len(queryTerms))
+		for _, t := range queryTerms {
+			wanted[t] = true
+		}
+		for i := range result.Hits {
+			result.Hits[i].Highlights = highlights(result.Hits[i].Product, wanted)
+		}
+	}
+	return result, nil
+}
+
+// match: すべての検索語を含む製品を探してスコアを付けます。呼び出し側で読み取りロックを取得してください。
+func (ix *Index) match(queryTerms []string, q model.ProductSearchQuery) []model.ProductSearchHit {
+	lists := make([]map[model.ProductID]*posting, len(queryTerms))
+	for i, term := range queryTerms {
+		list, ok := ix.postings[term]
+		if !ok {
+			return nil
+		}
+		lists[i] = list
+	}
+	// 一番短い転置リストから候補を絞り込みます
+	shortest := 0
+	for i, list := range lists {
+		if len(list) < len(lists[shortest]) {
+			shortest = i
+		}
+	}
+
+	n := float64(len(ix.docs))
+	var avgLengths [numFields]float64
+	for fi := range fields {
+		avgLengths[fi] = math.Max(float64(ix.totalLengths[fi])/n, 1)
+	}
+
+	var hits []model.ProductSearchHit
+candidates:
+	for id := range lists[shortest] {
+		for _, list := range lists {
+			if _, ok := list[id]; !ok {
+				continue candidates
+			}
+		}
+		doc := ix.docs[id]
+		if !q.Filter.Matches(doc.product) {
+			continue
+		}
+		var score float64
+		for _, list := range lists {
+			ps := list[id]
+			// 項目ごとの出現回数を、重みと文書長で正規化してから合算します (BM25F)
+			var tf float64
+			for fi, f := range fields {
+				if ps[fi] == 0 {
+					continue
+				}
+				norm := 1 - bm25B + bm25B*float64(doc.lengths[fi])/avgLengths[fi]
+				tf += f.weight * float64(ps[fi]) / norm
+			}
+			df := float64(len(list))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1)
+		}
+		hits = append(hits, model.ProductSearchHit{Product: doc.product, Score: score})
+	}
+	return hits
+}
@@ -0,0 +1,144 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+func searchIDs(t *testing.T, ix *Index, text string) []model.ProductID {
+	t.Helper()
+	res, err := ix.Search(context.Background(), model.ProductSearchQuery{Text: text})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]model.ProductID, len(res.Hits))
+	for i, h := range res.Hits {
+		ids[i] = h.Product.ID
+	}
+	return ids
+}
+
+func TestSearchMatchesOneCharacterQueries(t *testing.T) {
+	ix := NewIndex()
+	ix.Rebuild([]*model.Product{
+		{ID: "lock", Name: "スマートロック", Description: "自動施錠に対応"},
+		{ID: "vacuum", Name: "ロボット掃除機"},
+		{ID: "light", Name: "スマート電球"},
+	})
+	tests := []struct {
+		query string
+		want  []model.ProductID
+	}{
+		{"錠", []model.ProductID{"lock"}},
+		{"ク", []model.ProductID{"lock"}},
+		{"機", []model.ProductID{"vacuum"}},
+		{"球", []model.ProductID{"light"}},
+		{"ロック", []model.ProductID{"lock"}},
+		{"錠 スマート", []model.ProductID{"lock"}},
+		{"扉", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := searchIDs(t, ix, tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("hits = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("hits = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+	// "ロ" はどちらにも含まれます
+	if got := searchIDs(t, ix, "ロ"); len(got) != 2 {
+		t.Errorf("hits for ロ = %v, want lock and vacuum", got)
+	}
+}
+
+func TestSearchScoresWithBM25F(t *testing.T) {
+	tests := []struct {
+		name     string
+		products []*model.Product
+		query    string
+		want     []model.ProductID // 関連度の高い順
+	}{
+		{
+			name: "name outweighs description",
+			products: []*model.Product{
+				{ID: "desc", Name: "電球", Description: "ハブに対応"},
+				{ID: "name", Name: "ハブ", Description: "電球に対応"},
+			},
+			query: "ハブ",
+			want:  []model.ProductID{"name", "desc"},
+		},
+		{
+			name: "shorter field wins for the same term frequency",
+			products: []*model.Product{
+				{ID: "long", Name: "センサー 人感 温度 湿度 照度 防水 電池式"},
+				{ID: "short", Name: "センサー"},
+			},
+			query: "センサー",
+			want:  []model.ProductID{"short", "long"},
+		},
+		{
+			name: "all terms are required",
+			products: []*model.Product{
+				{ID: "both", Name: "スマート 照明"},
+				{ID: "one", Name: "照明"},
+			},
+			query: "スマート 照明",
+			want:  []model.ProductID{"both"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ix := NewIndex()
+			ix.Rebuild(tt.products)
+			got := searchIDs(t, ix, tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("hits = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("hits = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchIDFPrefersRareTerms(t *testing.T) {
+	ix := NewIndex()
+	ix.Rebuild([]*model.Product{
+		{ID: "a", Name: "照明", Description: "調光"},
+		{ID: "b", Name: "調光", Description: "照明"},
+		{ID: "c", Name: "照明"},
+		{ID: "d", Name: "照明"},
+	})
+	res, err := ix.Search(context.Background(), model.ProductSearchQuery{Text: "照明 調光"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "調光" は "照明" より少ない製品にしか出てこないので、製品名で "調光" に一致する b が上に来ます
+	if len(res.Hits) != 2 || res.Hits[0].Product.ID != "b" {
+		t.Fatalf("hits = %+v", res.Hits)
+	}
+	if res.Hits[0].Score <= res.Hits[1].Score {
+		t.Errorf("scores = %v, %v", res.Hits[0].Score, res.Hits[1].Score)
+	}
+}
+
+func TestUnigramsDoNotChangeDocumentLength(t *testing.T) {
+	ix := NewIndex()
+	ix.Upsert(&model.Product{ID: "p", Name: "自動施錠"})
+	if got := ix.docs["p"].lengths[0]; got != 3 {
+		t.Errorf("name length = %d, want 3 bigrams", got)
+	}
+	ix.Remove("p")
+	if len(ix.postings) != 0 || ix.totalLengths[0] != 0 {
+		t.Errorf("postings = %v, total lengths = %v after remove", ix.postings, ix.totalLengths)
+	}
+}
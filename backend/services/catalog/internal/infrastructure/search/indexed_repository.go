@@ -0,0 +1,69 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// IndexedProductRepository: 保存のたびに検索インデックスを更新する ProductRepository のデコレーターです。
+// インデックスはプロセスごとに持つため、このデコレーターで揃うのは自分のプロセスからの書き込みだけです。
+// Firestore のように複数のプロセス (cmd/seed や別のインスタンス) が書き込む保存先では、Follow で変更も購読してください。
+type IndexedProductRepository struct {
+	repository.ProductRepository
+	index *Index
+}
+
+// NewIndexedProductRepository: 既存の製品でインデックスを作り直してから、デコレーターを返します。
+func NewIndexedProductRepository(ctx context.Context, base repository.ProductRepository, index *Index) (repository.ProductRepository, error) {
+	products, err := base.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load products for search index: %w", err)
+	}
+	index.Rebuild(products)
+	return &IndexedProductRepository{ProductRepository: base, index: index}, nil
+}
+
+// Save: 保存に成功した場合だけインデックスを更新します。
+func (r *IndexedProductRepository) Save(ctx context.Context, p *model.Product) error {
+	if err := r.ProductRepository.Save(ctx, p); err != nil {
+		return err
+	}
+	r.index.Upsert(p)
+	return nil
+}
+
+// FollowRetryInterval: 変更の購読が切れたときに、つなぎ直すまでの間隔
+const FollowRetryInterval = 10 * time.Second
+
+// Follow: ctx が終わるまで保存先の変更を購読し、インデックスに反映します。
+// 購読が切れたらログに残し、FollowRetryInterval 後につなぎ直します (再購読の最初の通知で全製品が反映されます)。
+func Follow(ctx context.Context, feed repository.ProductChangeFeed, index *Index) {
+	for {
+		err := feed.Watch(ctx, func(c model.ProductChanges) {
+			for _, p := range c.Saved {
+				index.Upsert(p)
+			}
+			for _, id := range c.Removed {
+				index.Remove(id)
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("change feed closed")
+		}
+		log.Printf("product change feed stopped; retrying in %s: %v", FollowRetryInterval, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(FollowRetryInterval):
+		}
+	}
+}
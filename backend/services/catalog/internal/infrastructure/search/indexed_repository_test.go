@@ -0,0 +1,60 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// fakeFeed: 用意した変更を順に通知してから、ctx が終わるまで待つ ProductChangeFeed
+type fakeFeed struct {
+	changes []model.ProductChanges
+	done    chan struct{}
+}
+
+func (f *fakeFeed) Watch(ctx context.Context, fn func(model.ProductChanges)) error {
+	for _, c := range f.changes {
+		fn(c)
+	}
+	close(f.done)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestFollowAppliesChangesFromOtherWriters(t *testing.T) {
+	index := NewIndex()
+	index.Rebuild([]*model.Product{{ID: "old", Name: "旧型ロボット掃除機"}})
+	feed := &fakeFeed{done: make(chan struct{}), changes: []model.ProductChanges{
+		{Saved: []*model.Product{{ID: "new", Name: "新型ロボット掃除機"}}},
+		{Removed: []model.ProductID{"old"}},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		Follow(ctx, feed, index)
+		close(stopped)
+	}()
+	select {
+	case <-feed.done:
+	case <-time.After(time.Second):
+		t.Fatal("feed was not watched")
+	}
+
+	res, err := index.Search(context.Background(), model.ProductSearchQuery{Text: "ロボット掃除機", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Hits) != 1 || res.Hits[0].Product.ID != "new" {
+		t.Errorf("hits = %+v", res.Hits)
+	}
+
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Follow did not stop after ctx was cancelled")
+	}
+}
@@ -0,0 +1,135 @@
+package search
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// token: 正規化済みの検索語と、元の文字列上の位置 (バイトオフセット)
+type token struct {
+	term       string
+	start, end int
+	unigram    bool // 1文字検索のためにインデックスへ追加した漢字・かな1文字 (文書長には数えません)
+}
+
+// runeClass: トークンの区切りを決める文字の種類
+type runeClass int
+
+const (
+	classSeparator runeClass = iota // 空白・記号 (トークンに含めない)
+	classWord                       // 英数字など。空白で区切られた単語単位でトークンにします
+	classCJK                        // 漢字・ひらがな・カタカナ。2文字ずつ (bigram) のトークンにします
+)
+
+// tokenize: 文字列を検索語に分割します。
+// 日本語は単語の区切りに空白を使わないため、形態素解析の代わりに bigram を使います。
+// 辞書が不要で、新しい製品名や型番にもそのまま対応できます。
+//
+//	"ロボット掃除機 Roomba" → "ろぼ" "ぼっ" "っと" "と掃" "掃除" "除機" "roomba"
+//
+// 漢字やかなが1文字だけの場合は、その1文字をトークンにします。
+func tokenize(text string) []token {
+	return tokenizeText(text, false)
+}
+
+// tokenizeForIndex: インデックスに登録する検索語に分割します。
+// tokenize の結果に加えて、2文字以上続く漢字・かなの1文字ずつ (unigram) も返します。
+// 1文字の検索語 ("錠" や "ロ") は unigram になるため、bigram だけでは "自動施錠" や "スマートロック" に一致しません。
+// 位置の順に並べて返すので、強調表示で一致箇所をまとめるときにもそのまま使えます。
+func tokenizeForIndex(text string) []token {
+	return tokenizeText(text, true)
+}
+
+func tokenizeText(text string, unigrams bool) []token {
+	var (
+		tokens []token
+		run    []runePos
+		class  runeClass
+	)
+	flush := func() {
+		switch {
+		case len(run) == 0:
+		case class == classWord:
+			tokens = append(tokens, token{term: runesTerm(run), start: run[0].start, end: run[len(run)-1].end})
+		case len(run) == 1:
+			tokens = append(tokens, token{term: runesTerm(run), start: run[0].start, end: run[0].end})
+		default:
+			for i := range run {
+				if unigrams {
+					tokens = append(tokens, token{term: runesTerm(run[i : i+1]), start: run[i].start, end: run[i].end, unigram: true})
+				}
+				if i+1 < len(run) {
+					tokens = append(tokens, token{term: runesTerm(run[i : i+2]), start: run[i].start, end: run[i+1].end})
+				}
+			}
+		}
+		run = run[:0]
+	}
+	for i, original := range text {
+		r := normalizeRune(original)
+		c := classOf(r)
+		if c != class {
+			flush()
+			class = c
+		}
+		if c == classSeparator {
+			continue
+		}
+		run = append(run, runePos{r: r, start: i, end: i + utf8.RuneLen(original)})
+	}
+	flush()
+	return tokens
+}
+
+// terms: 重複を除いた検索語を出現順に返します。
+func terms(text string) []string {
+	seen := make(map[string]bool)
+	var list []string
+	for _, t := range tokenize(text) {
+		if !seen[t.term] {
+			seen[t.term] = true
+			list = append(list, t.term)
+		}
+	}
+	return list
+}
+
+// runePos: 正規化後の文字と、元の文字列での位置
+type runePos struct {
+	r          rune
+	start, end int
+}
+
+func runesTerm(run []runePos) string {
+	rs := make([]rune, len(run))
+	for i, p := range run {
+		rs[i] = p.r
+	}
+	return string(rs)
+}
+
+// normalizeRune: 表記ゆれを吸収するため、1文字ずつ正規化します。
+//   - 全角英数字・記号 → 半角 ("ＷｉＦｉ" と "WiFi" を同じにします)
+//   - 大文字 → 小文字
+//   - カタカナ → ひらがな ("ロボット" と "ろぼっと" を同じにします)
+func normalizeRune(r rune) rune {
+	switch {
+	case r == '　': // 全角スペース
+		return ' '
+	case r >= '！' && r <= '～':
+		r -= 0xFEE0
+	case r >= 'ァ' && r <= 'ヶ':
+		r -= 0x60
+	}
+	return unicode.ToLower(r)
+}
+
+func classOf(r rune) runeClass {
+	switch {
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana), r == 'ー', r == '々':
+		return classCJK
+	case unicode.IsLetter(r), unicode.IsDigit(r):
+		return classWord
+	}
+	return classSeparator
+}
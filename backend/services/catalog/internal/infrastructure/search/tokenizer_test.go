@@ -0,0 +1,57 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTerms(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"bigrams and words", "ロボット掃除機 Roomba", []string{"ろぼ", "ぼっ", "っと", "と掃", "掃除", "除機", "roomba"}},
+		{"full-width and upper case", "ＷｉＦｉ 対応", []string{"wifi", "対応"}},
+		{"katakana and hiragana are the same", "ロック", []string{"ろっ", "っく"}},
+		{"symbols split tokens", "Matter/Thread", []string{"matter", "thread"}},
+		{"one kanji", "錠", []string{"錠"}},
+		{"one katakana", "ロ", []string{"ろ"}},
+		{"duplicates removed", "掃除 掃除", []string{"掃除"}},
+		{"empty", " 　", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := terms(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("terms(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenizeForIndexAddsUnigrams(t *testing.T) {
+	var got []string
+	var unigrams int
+	for _, tok := range tokenizeForIndex("施錠 Lock") {
+		got = append(got, tok.term)
+		if tok.unigram {
+			unigrams++
+		}
+	}
+	want := []string{"施", "施錠", "錠", "lock"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("terms = %q, want %q", got, want)
+	}
+	if unigrams != 2 {
+		t.Errorf("unigrams = %d, want 2", unigrams)
+	}
+}
+
+func TestTokenizeKeepsOriginalOffsets(t *testing.T) {
+	text := "ＷｉＦｉ ロボ"
+	for _, tok := range tokenizeForIndex(text) {
+		if normalized := terms(text[tok.start:tok.end]); len(normalized) != 1 || normalized[0] != tok.term {
+			t.Errorf("token %q points at %q", tok.term, text[tok.start:tok.end])
+		}
+	}
+}
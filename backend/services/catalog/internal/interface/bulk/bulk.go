@@ -0,0 +1,42 @@
+package bulk
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
+)
+
+// ErrMalformedFile: ファイル全体を読み取れない (ヘッダーが不正、行が長すぎるなど)
+var ErrMalformedFile = errors.New("malformed import file")
+
+// Writer: 製品を1件ずつ書き出します。最後に Flush を呼んでください。
+type Writer interface {
+	Write(p *model.Product) error
+	Flush() error
+}
+
+// NewReader: 形式に合わせた一括登録ファイルのリーダーを作成します。
+// CSVの場合はここでヘッダーを読み、列の構成が不正ならエラーを返します。
+func NewReader(r io.Reader, format Format) (usecase.ProductRowReader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatNDJSON:
+		return newNDJSONReader(r), nil
+	}
+	return nil, fmt.Errorf("%w: unsupported format %q", ErrMalformedFile, format)
+}
+
+// NewWriter: 形式に合わせたライターを作成します。
+func NewWriter(w io.Writer, format Format) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
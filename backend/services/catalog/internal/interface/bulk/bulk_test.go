@@ -0,0 +1,166 @@
+package bulk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
+)
+
+// readAll: リーダーの行をすべて読みます。
+func readAll(t *testing.T, r usecase.ProductRowReader) []model.ProductRow {
+	t.Helper()
+	var list []model.ProductRow
+	for {
+		row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return list
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		list = append(list, row)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	input := "\xEF\xBB\xBF" + `SKU,Name,category,price,strong_points,chore_effects,protocols,requires_drilling,climbable_step_mm
+RV-001,ロボット掃除機,robot_vacuum,"29,800",静か| 薄型 |,cleaning:80|laundry:30,wifi_2_4ghz|matter,no,20
+RV-002,価格が不正,robot_vacuum,abc,,,,,
+RV-003,真偽値が不正,robot_vacuum,1000,,,,maybe,
+RV-004,列が足りない
+`
+	r, err := NewReader(strings.NewReader(input), FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+	if len(rows) != 4 {
+		t.Fatalf("rows = %d, want 4", len(rows))
+	}
+
+	first := rows[0]
+	if first.Err != nil || first.Line != 2 || first.SKU != "RV-001" {
+		t.Fatalf("first row = %+v", first)
+	}
+	p := first.Product
+	if p.Name != "ロボット掃除機" || p.Price.Amount() != 29800 || p.Category != model.CategoryRobotVacuum {
+		t.Errorf("product = %+v", p)
+	}
+	if !reflect.DeepEqual(p.StrongPoints, []string{"静か", "薄型"}) {
+		t.Errorf("strong points = %q", p.StrongPoints)
+	}
+	wantEffects := []model.ChoreEffect{{Category: model.ChoreCleaning, TimeReductionPercent: 80}, {Category: model.ChoreLaundry, TimeReductionPercent: 30}}
+	if !reflect.DeepEqual(p.AutomationEffect.ChoreEffects, wantEffects) {
+		t.Errorf("chore effects = %+v", p.AutomationEffect.ChoreEffects)
+	}
+	if len(p.Connectivity.Protocols) != 2 || p.InstallationRequirements.RequiresDrilling || p.InstallationRequirements.ClimbableStepMM != 20 {
+		t.Errorf("connectivity = %+v, requirements = %+v", p.Connectivity, p.InstallationRequirements)
+	}
+
+	// 行の問題はその行の Err で返し、続きの行は読めます
+	for _, row := range rows[1:] {
+		if row.Err == nil {
+			t.Errorf("row %d: expected an error, got %+v", row.Line, row)
+		}
+	}
+	if rows[3].SKU != "RV-004" || rows[3].Line != 5 {
+		t.Errorf("short row = %+v", rows[3])
+	}
+}
+
+func TestCSVReaderRejectsBadHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty file", ""},
+		{"unknown column", "sku,name,category,colour\n"},
+		{"duplicated column", "sku,name,category,name\n"},
+		{"missing required column", "sku,name\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewReader(strings.NewReader(tt.input), FormatCSV); !errors.Is(err, ErrMalformedFile) {
+				t.Errorf("err = %v, want ErrMalformedFile", err)
+			}
+		})
+	}
+}
+
+func TestNDJSONReader(t *testing.T) {
+	input := `{"sku":"RV-001","name":"ロボット掃除機","category":"robot_vacuum","price":29800,"protocols":["matter"]}
+
+{"sku":"RV-002","name":"キーの綴り間違い","category":"robot_vacuum","prise":1000}
+{"sku":"RV-003","name":"価格が負","category":"robot_vacuum","price":-1}
+not json
+`
+	r, err := NewReader(strings.NewReader(input), FormatNDJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+	if len(rows) != 4 {
+		t.Fatalf("rows = %d, want 4", len(rows))
+	}
+	if rows[0].Err != nil || rows[0].Line != 1 || rows[0].Product.Price.Amount() != 29800 || len(rows[0].Product.Connectivity.Protocols) != 1 {
+		t.Errorf("first row = %+v", rows[0])
+	}
+	wantLines := []int{3, 4, 5}
+	wantSKUs := []string{"RV-002", "RV-003", ""}
+	for i, row := range rows[1:] {
+		if row.Err == nil || row.Line != wantLines[i] || row.SKU != wantSKUs[i] {
+			t.Errorf("row %d = %+v, want an error at line %d for sku %q", i+2, row, wantLines[i], wantSKUs[i])
+		}
+	}
+}
+
+func TestNDJSONReaderRejectsTooLongLine(t *testing.T) {
+	input := `{"sku":"RV-001","name":"ok","category":"robot_vacuum"}` + "\n" + strings.Repeat("x", maxNDJSONLine+1)
+	r := newNDJSONReader(strings.NewReader(input))
+	if row, err := r.Next(); err != nil || row.Err != nil {
+		t.Fatalf("first row = %+v, %v", row, err)
+	}
+	if _, err := r.Next(); !errors.Is(err, ErrMalformedFile) {
+		t.Errorf("err = %v, want ErrMalformedFile", err)
+	}
+}
+
+// 出力したファイルをそのまま取り込み直せることを確かめます。
+func TestWriterOutputCanBeReadBack(t *testing.T) {
+	rows := readAll(t, newNDJSONReader(strings.NewReader(
+		`{"sku":"RV-001","name":"ロボット掃除機, 薄型","category":"robot_vacuum","price":29800,"strong_points":["静か"],"chore_effects":[{"category":"cleaning","time_reduction_percent":80}],"supported_floor_types":["flooring"],"power_watts":25.5}`,
+	)))
+	want := rows[0].Product
+	for _, format := range []Format{FormatCSV, FormatNDJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Write(want); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+			r, err := NewReader(&buf, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := readAll(t, r)
+			if len(got) != 1 || got[0].Err != nil {
+				t.Fatalf("rows = %+v", got)
+			}
+			if !got[0].Product.SameImportableFields(want) {
+				t.Errorf("read back %+v, want %+v", got[0].Product, want)
+			}
+		})
+	}
+}
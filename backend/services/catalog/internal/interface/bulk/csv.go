@@ -0,0 +1,286 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// csvColumn: CSVのセルと record の項目の読み書き
+type csvColumn struct {
+	get func(r *record) string
+	set func(r *record, cell string) error
+}
+
+// csvColumns: 列名ごとの読み書き。columns と同じ列を持ちます。
+var csvColumns = map[string]csvColumn{
+	"sku":                           textColumn(func(r *record) *string { return &r.SKU }),
+	"id":                            textColumn(func(r *record) *string { return &r.ID }),
+	"name":                          textColumn(func(r *record) *string { return &r.Name }),
+	"description":                   textColumn(func(r *record) *string { return &r.Description }),
+	"manufacturer":                  textColumn(func(r *record) *string { return &r.Manufacturer }),
+	"price":                         int32Column(func(r *record) *int32 { return &r.Price }),
+	"purchase_link":                 textColumn(func(r *record) *string { return &r.PurchaseLink }),
+	"image_url":                     textColumn(func(r *record) *string { return &r.ImageURL }),
+	"category":                      textColumn(func(r *record) *string { return &r.Category }),
+	"installation_difficulty":       textColumn(func(r *record) *string { return &r.InstallationDifficulty }),
+	"strong_points":                 listColumn(func(r *record) *[]string { return &r.StrongPoints }),
+	"weak_points":                   listColumn(func(r *record) *[]string { return &r.WeakPoints }),
+	"chore_effects":                 {get: getChoreEffects, set: setChoreEffects},
+	"maintenance_minutes_per_month": intColumn(func(r *record) *int { return &r.MaintenanceMinutes }),
+	"power_watts":                   floatColumn(func(r *record) *float64 { return &r.PowerWatts }),
+	"consumable_cost_per_month":     int32Column(func(r *record) *int32 { return &r.ConsumableCostPerMonth }),
+	"protocols":                     listColumn(func(r *record) *[]string { return &r.Protocols }),
+	"required_hub_protocols":        listColumn(func(r *record) *[]string { return &r.RequiredHubProtocols }),
+	"bridged_protocols":             listColumn(func(r *record) *[]string { return &r.BridgedProtocols }),
+	"requires_drilling":             boolColumn(func(r *record) *bool { return &r.RequiresDrilling }),
+	"requires_electrical_work":      boolColumn(func(r *record) *bool { return &r.RequiresElectricalWork }),
+	"step_sensitive":                boolColumn(func(r *record) *bool { return &r.StepSensitive }),
+	"climbable_step_mm":             intColumn(func(r *record) *int { return &r.ClimbableStepMM }),
+	"supported_floor_types":         listColumn(func(r *record) *[]string { return &r.SupportedFloorTypes }),
+	"supported_residence_types":     listColumn(func(r *record) *[]string { return &r.SupportedResidenceTypes }),
+}
+
+// requiredCSVColumns: ヘッダーに必ず含まれている必要がある列
+var requiredCSVColumns = []string{"sku", "name", "category"}
+
+// csvReader: CSVの一括登録ファイルを1行ずつ読み取ります。
+// 1行目はヘッダーで、列の順序は自由です (columns にない列があるとファイル全体をエラーにします)。
+type csvReader struct {
+	r       *csv.Reader
+	columns []csvColumn
+	skuAt   int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	// Excel で保存したCSVの先頭に付くBOMを取り除きます
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		_, _ = br.Discard(3)
+	}
+	cr := csv.NewReader(br)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: csv header row is missing", ErrMalformedFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("csv: failed to read header: %w", err)
+	}
+
+	reader := &csvReader{r: cr, skuAt: -1}
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		col, ok := csvColumns[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown csv column %q", ErrMalformedFile, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: duplicated csv column %q", ErrMalformedFile, name)
+		}
+		seen[name] = true
+		if name == "sku" {
+			reader.skuAt = i
+		}
+		reader.columns = append(reader.columns, col)
+	}
+	for _, name := range requiredCSVColumns {
+		if !seen[name] {
+			return nil, fmt.Errorf("%w: required csv column %q is missing", ErrMalformedFile, name)
+		}
+	}
+	return reader, nil
+}
+
+// Next: 次の行を読み取ります。列数の違いなど、行単位の問題は ProductRow.Err で返します。
+func (c *csvReader) Next() (model.ProductRow, error) {
+	cells, err := c.r.Read()
+	if errors.Is(err, io.EOF) {
+		return model.ProductRow{}, io.EOF
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return model.ProductRow{Line: parseErr.StartLine, SKU: c.sku(cells), Err: parseErr.Err}, nil
+	}
+	if err != nil {
+		return model.ProductRow{}, err
+	}
+
+	line, _ := c.r.FieldPos(0)
+	row := model.ProductRow{Line: line, SKU: c.sku(cells)}
+	var rec record
+	for i, cell := range cells {
+		if err := c.columns[i].set(&rec, cell); err != nil {
+			row.Err = err
+			return row, nil
+		}
+	}
+	row.Product, row.Err = rec.toProduct()
+	return row, nil
+}
+
+func (c *csvReader) sku(cells []string) string {
+	if c.skuAt < 0 || c.skuAt >= len(cells) {
+		return ""
+	}
+	return strings.TrimSpace(cells[c.skuAt])
+}
+
+// csvWriter: 製品をCSVで書き出します。ヘッダーは作成時に書きます。
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: cw}, nil
+}
+
+func (c *csvWriter) Write(p *model.Product) error {
+	rec := fromProduct(p)
+	cells := make([]string, len(columns))
+	for i, name := range columns {
+		cells[i] = csvColumns[name].get(rec)
+	}
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func textColumn(field func(r *record) *string) csvColumn {
+	return csvColumn{
+		get: func(r *record) string { return *field(r) },
+		set: func(r *record, cell string) error {
+			*field(r) = strings.TrimSpace(cell)
+			return nil
+		},
+	}
+}
+
+// listColumn: "|" 区切りのリスト。空の要素は無視します。
+func listColumn(field func(r *record) *[]string) csvColumn {
+	return csvColumn{
+		get: func(r *record) string { return strings.Join(*field(r), listSeparator) },
+		set: func(r *record, cell string) error {
+			*field(r) = splitList(cell)
+			return nil
+		},
+	}
+}
+
+func intColumn(field func(r *record) *int) csvColumn {
+	return csvColumn{
+		get: func(r *record) string { return strconv.Itoa(*field(r)) },
+		set: func(r *record, cell string) error {
+			n, err := parseInt(cell, 0)
+			*field(r) = int(n)
+			return err
+		},
+	}
+}
+
+func int32Column(field func(r *record) *int32) csvColumn {
+	return csvColumn{
+		get: func(r *record) string { return strconv.FormatInt(int64(*field(r)), 10) },
+		set: func(r *record, cell string) error {
+			n, err := parseInt(cell, 32)
+			*field(r) = int32(n)
+			return err
+		},
+	}
+}
+
+func floatColumn(field func(r *record) *float64) csvColumn {
+	return csvColumn{
+		get: func(r *record) string { return strconv.FormatFloat(*field(r), 'f', -1, 64) },
+		set: func(r *record, cell string) error {
+			cell = strings.TrimSpace(cell)
+			if cell == "" {
+				*field(r) = 0
+				return nil
+			}
+			f, err := strconv.ParseFloat(cell, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number %q", cell)
+			}
+			*field(r) = f
+			return nil
+		},
+	}
+}
+
+// boolColumn: true/false のほか、表計算ソフトでよく使う 1/0、yes/no も受け付けます。空は false です。
+func boolColumn(field func(r *record) *bool) csvColumn {
+	return csvColumn{
+		get: func(r *record) string { return strconv.FormatBool(*field(r)) },
+		set: func(r *record, cell string) error {
+			switch strings.ToLower(strings.TrimSpace(cell)) {
+			case "", "false", "0", "no":
+				*field(r) = false
+			case "true", "1", "yes":
+				*field(r) = true
+			default:
+				return fmt.Errorf("invalid boolean %q", cell)
+			}
+			return nil
+		},
+	}
+}
+
+// parseInt: 整数のセルを読み取ります。"12,800" のような桁区切りも受け付けます。空は0です。
+func parseInt(cell string, bitSize int) (int64, error) {
+	cell = strings.ReplaceAll(strings.TrimSpace(cell), ",", "")
+	if cell == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(cell, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %q", cell)
+	}
+	return n, nil
+}
+
+func splitList(cell string) []string {
+	var list []string
+	for _, v := range strings.Split(cell, listSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// getChoreEffects: "cleaning:80|laundry:30" の形式で書き出します。
+func getChoreEffects(r *record) string {
+	parts := make([]string, len(r.ChoreEffects))
+	for i, c := range r.ChoreEffects {
+		parts[i] = fmt.Sprintf("%s:%d", c.Category, c.TimeReductionPercent)
+	}
+	return strings.Join(parts, listSeparator)
+}
+
+func setChoreEffects(r *record, cell string) error {
+	r.ChoreEffects = nil
+	for _, part := range splitList(cell) {
+		category, percent, ok := strings.Cut(part, ":")
+		n, err := strconv.Atoi(strings.TrimSpace(percent))
+		if !ok || err != nil {
+			return fmt.Errorf("invalid chore effect %q (want category:percent)", part)
+		}
+		r.ChoreEffects = append(r.ChoreEffects, choreEffect{Category: strings.TrimSpace(category), TimeReductionPercent: n})
+	}
+	return nil
+}
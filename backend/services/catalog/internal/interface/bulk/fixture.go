@@ -0,0 +1,148 @@
+package bulk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
+	"gopkg.in/yaml.v3"
+)
+
+// FixtureVersion: 対応しているフィクスチャの形式のバージョン
+// 列を追加しただけでは上げず、既存のファイルの意味が変わる変更 (列の単位や値の変更など) のときに上げます。
+const FixtureVersion = 1
+
+// fixtureFile: 開発用のフィクスチャファイル (YAML / JSON) の構造です。
+// 製品の各キーは NDJSON と同じです。
+//
+//	version: 1
+//	products:
+//	  - sku: RV-001
+//	    name: ロボット掃除機
+//	    category: robot_vacuum
+type fixtureFile struct {
+	Version  int         `yaml:"version"`
+	Products []yaml.Node `yaml:"products"`
+}
+
+// OpenFixtures: path (ファイルまたはディレクトリ) のフィクスチャを読み込み、全製品を1つの ProductRowReader にまとめます。
+// ディレクトリの場合は直下の .yaml / .yml / .json をファイル名順に読みます。
+// 各行の Source にはファイル名、Line には製品の定義が始まる行番号が入ります。
+func OpenFixtures(path string) (usecase.ProductRowReader, error) {
+	files, err := fixtureFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	reader := &fixtureReader{}
+	for _, f := range files {
+		rows, err := readFixtureFile(f)
+		if err != nil {
+			return nil, err
+		}
+		reader.rows = append(reader.rows, rows...)
+	}
+	return reader, nil
+}
+
+func fixtureFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".yaml", ".yml", ".json":
+			if !e.IsDir() {
+				files = append(files, filepath.Join(path, e.Name()))
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: no fixture files in %s", ErrMalformedFile, path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// readFixtureFile: 1ファイル分の製品を読み取ります。
+// JSON は YAML として読めるので、どちらも同じ方法で読みます。
+func readFixtureFile(path string) ([]model.ProductRow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var f fixtureFile
+	if err := dec.Decode(&f); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s is empty", ErrMalformedFile, path)
+		}
+		return nil, fmt.Errorf("%w: %s: %v", ErrMalformedFile, path, err)
+	}
+	if f.Version != FixtureVersion {
+		return nil, fmt.Errorf("%w: %s: unsupported fixture version %d (want %d)", ErrMalformedFile, path, f.Version, FixtureVersion)
+	}
+
+	rows := make([]model.ProductRow, 0, len(f.Products))
+	for i := range f.Products {
+		rows = append(rows, fixtureRow(path, &f.Products[i]))
+	}
+	return rows, nil
+}
+
+// fixtureRow: 製品1件分の定義を読み取ります。未知のキーはエラーにします (キーの綴り間違いに気付けるように)。
+func fixtureRow(path string, node *yaml.Node) model.ProductRow {
+	row := model.ProductRow{Source: path, Line: node.Line}
+	if node.Kind != yaml.MappingNode {
+		row.Err = errors.New("product must be a mapping")
+		return row
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if key.Value == "sku" {
+			row.SKU = node.Content[i+1].Value
+		}
+		if !slices.Contains(columns, key.Value) {
+			row.Err = fmt.Errorf("unknown field %q on line %d", key.Value, key.Line)
+			return row
+		}
+	}
+	var rec record
+	if err := node.Decode(&rec); err != nil {
+		row.Err = fmt.Errorf("invalid value: %v", err)
+		return row
+	}
+	row.Product, row.Err = rec.toProduct()
+	return row
+}
+
+// fixtureReader: 読み込み済みの行を順に返します。
+type fixtureReader struct {
+	rows []model.ProductRow
+	next int
+}
+
+func (r *fixtureReader) Next() (model.ProductRow, error) {
+	if r.next >= len(r.rows) {
+		return model.ProductRow{}, io.EOF
+	}
+	r.next++
+	return r.rows[r.next-1], nil
+}
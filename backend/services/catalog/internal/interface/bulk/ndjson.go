@@ -0,0 +1,84 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// maxNDJSONLine: NDJSONの1行の最大長 (説明文の長い製品でも収まる大きさ)
+const maxNDJSONLine = 4 * 1024 * 1024
+
+// ndjsonReader: NDJSON (1行に1製品のJSON) の一括登録ファイルを1行ずつ読み取ります。
+// 空行は読み飛ばします。未知のキーがある行はエラーにします (キーの綴り間違いに気付けるように)。
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newNDJSONReader(r io.Reader) *ndjsonReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxNDJSONLine)
+	return &ndjsonReader{scanner: scanner}
+}
+
+// Next: 次の行を読み取ります。JSONとして不正な行は ProductRow.Err で返します。
+func (n *ndjsonReader) Next() (model.ProductRow, error) {
+	for n.scanner.Scan() {
+		n.line++
+		text := bytes.TrimSpace(n.scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		row := model.ProductRow{Line: n.line}
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.DisallowUnknownFields()
+		var rec record
+		if err := dec.Decode(&rec); err != nil {
+			// エラーの報告用に、SKUだけでも読み取れるなら読み取ります
+			var partial struct {
+				SKU string `json:"sku"`
+			}
+			_ = json.Unmarshal(text, &partial)
+			row.SKU = partial.SKU
+			row.Err = fmt.Errorf("invalid json: %v", err)
+			return row, nil
+		}
+		row.SKU = rec.SKU
+		row.Product, row.Err = rec.toProduct()
+		return row, nil
+	}
+	if err := n.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return model.ProductRow{}, fmt.Errorf("%w: ndjson line %d is longer than %d bytes", ErrMalformedFile, n.line+1, maxNDJSONLine)
+		}
+		return model.ProductRow{}, err
+	}
+	return model.ProductRow{}, io.EOF
+}
+
+// ndjsonWriter: 製品を1行ずつJSONで書き出します。
+type ndjsonWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	return &ndjsonWriter{w: bw, enc: enc}
+}
+
+func (n *ndjsonWriter) Write(p *model.Product) error {
+	return n.enc.Encode(fromProduct(p))
+}
+
+func (n *ndjsonWriter) Flush() error {
+	return n.w.Flush()
+}
@@ -0,0 +1,182 @@
+// Package bulk: 製品の一括登録・出力に使うファイル形式 (CSV / NDJSON) の読み書きです。
+// どちらの形式も同じ列 (キー) を持ち、出力したファイルをそのまま取り込み直せます。
+// 開発用のフィクスチャ (YAML / JSON) も同じキーで書きます。
+package bulk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// Format: ファイル形式
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson" // JSON Lines (1行に1製品のJSON)
+)
+
+// ParseFormat: 形式名を読み取ります。"jsonl" は "ndjson" として扱います。
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "csv":
+		return FormatCSV, nil
+	case "ndjson", "jsonl":
+		return FormatNDJSON, nil
+	}
+	return "", fmt.Errorf("unsupported format %q (want csv or ndjson)", s)
+}
+
+// listSeparator: CSVで複数の値を1つのセルに入れるときの区切り文字
+const listSeparator = "|"
+
+// columns: ファイルの列 (NDJSONではキー) の一覧と順序です。
+// id は出力専用で、取り込み時は無視します (製品の特定には sku を使います)。
+var columns = []string{
+	"sku", "id", "name", "description", "manufacturer", "price", "purchase_link", "image_url",
+	"category", "installation_difficulty", "strong_points", "weak_points",
+	"chore_effects", "maintenance_minutes_per_month", "power_watts", "consumable_cost_per_month",
+	"protocols", "required_hub_protocols", "bridged_protocols",
+	"requires_drilling", "requires_electrical_work", "step_sensitive", "climbable_step_mm",
+	"supported_floor_types", "supported_residence_types",
+}
+
+// record: 1製品分の行 (NDJSONの1行と同じ構造)
+type record struct {
+	SKU                     string        `json:"sku" yaml:"sku"`
+	ID                      string        `json:"id,omitempty" yaml:"id"`
+	Name                    string        `json:"name" yaml:"name"`
+	Description             string        `json:"description,omitempty" yaml:"description"`
+	Manufacturer            string        `json:"manufacturer,omitempty" yaml:"manufacturer"`
+	Price                   int32         `json:"price" yaml:"price"`
+	PurchaseLink            string        `json:"purchase_link,omitempty" yaml:"purchase_link"`
+	ImageURL                string        `json:"image_url,omitempty" yaml:"image_url"`
+	Category                string        `json:"category" yaml:"category"`
+	InstallationDifficulty  string        `json:"installation_difficulty,omitempty" yaml:"installation_difficulty"`
+	StrongPoints            []string      `json:"strong_points,omitempty" yaml:"strong_points"`
+	WeakPoints              []string      `json:"weak_points,omitempty" yaml:"weak_points"`
+	ChoreEffects            []choreEffect `json:"chore_effects,omitempty" yaml:"chore_effects"`
+	MaintenanceMinutes      int           `json:"maintenance_minutes_per_month,omitempty" yaml:"maintenance_minutes_per_month"`
+	PowerWatts              float64       `json:"power_watts,omitempty" yaml:"power_watts"`
+	ConsumableCostPerMonth  int32         `json:"consumable_cost_per_month,omitempty" yaml:"consumable_cost_per_month"`
+	Protocols               []string      `json:"protocols,omitempty" yaml:"protocols"`
+	RequiredHubProtocols    []string      `json:"required_hub_protocols,omitempty" yaml:"required_hub_protocols"`
+	BridgedProtocols        []string      `json:"bridged_protocols,omitempty" yaml:"bridged_protocols"`
+	RequiresDrilling        bool          `json:"requires_drilling,omitempty" yaml:"requires_drilling"`
+	RequiresElectricalWork  bool          `json:"requires_electrical_work,omitempty" yaml:"requires_electrical_work"`
+	StepSensitive           bool          `json:"step_sensitive,omitempty" yaml:"step_sensitive"`
+	ClimbableStepMM         int           `json:"climbable_step_mm,omitempty" yaml:"climbable_step_mm"`
+	SupportedFloorTypes     []string      `json:"supported_floor_types,omitempty" yaml:"supported_floor_types"`
+	SupportedResidenceTypes []string      `json:"supported_residence_types,omitempty" yaml:"supported_residence_types"`
+}
+
+// choreEffect: 家事カテゴリごとの削減率。CSVでは "cleaning:80|laundry:30" のように書きます。
+type choreEffect struct {
+	Category             string `json:"category" yaml:"category"`
+	TimeReductionPercent int    `json:"time_reduction_percent" yaml:"time_reduction_percent"`
+}
+
+// toProduct: 行 -> 内部の型(model) に変換します。業務ルールのチェックはユースケースで行います。
+func (r *record) toProduct() (*model.Product, error) {
+	price, err := value.NewPrice(r.Price)
+	if err != nil {
+		return nil, err
+	}
+	p := &model.Product{
+		SKU:                    strings.TrimSpace(r.SKU),
+		Name:                   r.Name,
+		Description:            r.Description,
+		Price:                  price,
+		Manufacturer:           r.Manufacturer,
+		PurchaseLink:           r.PurchaseLink,
+		ImageURL:               r.ImageURL,
+		WeakPoints:             r.WeakPoints,
+		StrongPoints:           r.StrongPoints,
+		InstallationDifficulty: model.InstallationDifficulty(r.InstallationDifficulty),
+		Category:               model.ProductCategory(r.Category),
+		AutomationEffect: model.AutomationEffect{
+			MaintenanceMinutesPerMonth: r.MaintenanceMinutes,
+			PowerWatts:                 r.PowerWatts,
+			ConsumableCostPerMonth:     r.ConsumableCostPerMonth,
+		},
+		Connectivity: model.Connectivity{
+			Protocols:            toTyped[model.Protocol](r.Protocols),
+			RequiredHubProtocols: toTyped[model.Protocol](r.RequiredHubProtocols),
+			BridgedProtocols:     toTyped[model.Protocol](r.BridgedProtocols),
+		},
+		InstallationRequirements: model.InstallationRequirements{
+			RequiresDrilling:        r.RequiresDrilling,
+			RequiresElectricalWork:  r.RequiresElectricalWork,
+			StepSensitive:           r.StepSensitive,
+			ClimbableStepMM:         r.ClimbableStepMM,
+			SupportedFloorTypes:     toTyped[model.FloorType](r.SupportedFloorTypes),
+			SupportedResidenceTypes: toTyped[model.ResidenceType](r.SupportedResidenceTypes),
+		},
+	}
+	for _, c := range r.ChoreEffects {
+		p.AutomationEffect.ChoreEffects = append(p.AutomationEffect.ChoreEffects, model.ChoreEffect{
+			Category:             model.ChoreCategory(c.Category),
+			TimeReductionPercent: c.TimeReductionPercent,
+		})
+	}
+	return p, nil
+}
+
+// fromProduct: 内部の型(model) -> 行 に変換します。
+func fromProduct(p *model.Product) *record {
+	r := &record{
+		SKU:                     p.SKU,
+		ID:                      p.ID.String(),
+		Name:                    p.Name,
+		Description:             p.Description,
+		Manufacturer:            p.Manufacturer,
+		Price:                   p.Price.Amount(),
+		PurchaseLink:            p.PurchaseLink,
+		ImageURL:                p.ImageURL,
+		Category:                string(p.Category),
+		InstallationDifficulty:  string(p.InstallationDifficulty),
+		StrongPoints:            p.StrongPoints,
+		WeakPoints:              p.WeakPoints,
+		MaintenanceMinutes:      p.AutomationEffect.MaintenanceMinutesPerMonth,
+		PowerWatts:              p.AutomationEffect.PowerWatts,
+		ConsumableCostPerMonth:  p.AutomationEffect.ConsumableCostPerMonth,
+		Protocols:               fromTyped(p.Connectivity.Protocols),
+		RequiredHubProtocols:    fromTyped(p.Connectivity.RequiredHubProtocols),
+		BridgedProtocols:        fromTyped(p.Connectivity.BridgedProtocols),
+		RequiresDrilling:        p.InstallationRequirements.RequiresDrilling,
+		RequiresElectricalWork:  p.InstallationRequirements.RequiresElectricalWork,
+		StepSensitive:           p.InstallationRequirements.StepSensitive,
+		ClimbableStepMM:         p.InstallationRequirements.ClimbableStepMM,
+		SupportedFloorTypes:     fromTyped(p.InstallationRequirements.SupportedFloorTypes),
+		SupportedResidenceTypes: fromTyped(p.InstallationRequirements.SupportedResidenceTypes),
+	}
+	for _, c := range p.AutomationEffect.ChoreEffects {
+		r.ChoreEffects = append(r.ChoreEffects, choreEffect{Category: string(c.Category), TimeReductionPercent: c.TimeReductionPercent})
+	}
+	return r
+}
+
+func toTyped[T ~string](list []string) []T {
+	if len(list) == 0 {
+		return nil
+	}
+	typed := make([]T, len(list))
+	for i, v := range list {
+		typed[i] = T(v)
+	}
+	return typed
+}
+
+func fromTyped[T ~string](list []T) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = string(v)
+	}
+	return s
+}
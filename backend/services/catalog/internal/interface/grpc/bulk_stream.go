@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/kinoshitatakumi/opti/gen/go/catalog/v1"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/bulk"
+)
+
+// exportChunkSize: ExportProducts で1メッセージに詰める大きさの目安
+const exportChunkSize = 32 * 1024
+
+// chunkReader: ImportProducts のクライアントストリームで届く chunk を、1つのファイル (io.Reader) として読めるようにします。
+type chunkReader struct {
+	stream *connect.ClientStream[catalogv1.ImportProductsRequest]
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if r.stream.Msg().GetOptions() != nil {
+			return 0, connect.NewError(connect.CodeInvalidArgument, errors.New("import options must be sent only once"))
+		}
+		r.buf = r.stream.Msg().GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// chunkWriter: 書き込まれた内容を exportChunkSize ごとに ExportProducts のサーバーストリームへ送ります。
+type chunkWriter struct {
+	stream *connect.ServerStream[catalogv1.ExportProductsResponse]
+	buf    bytes.Buffer
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for w.buf.Len() >= exportChunkSize {
+		if err := w.send(w.buf.Next(exportChunkSize)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Close: 残りを送ります。
+func (w *chunkWriter) Close() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	return w.send(w.buf.Next(w.buf.Len()))
+}
+
+func (w *chunkWriter) send(chunk []byte) error {
+	// Send は送信が終わるまでバッファを使うので、コピーしてから渡します
+	return w.stream.Send(&catalogv1.ExportProductsResponse{Chunk: append([]byte(nil), chunk...)})
+}
+
+func toPbImportReport(r *model.ImportReport) *catalogv1.ImportProductsResponse {
+	res := &catalogv1.ImportProductsResponse{
+		DryRun:    r.DryRun,
+		Created:   int32(r.Created),
+		Updated:   int32(r.Updated),
+		Unchanged: int32(r.Unchanged),
+		Failed:    int32(r.Failed),
+	}
+	for _, e := range r.Errors {
+		res.Errors = append(res.Errors, &catalogv1.ImportRowError{Line: int32(e.Line), Sku: e.SKU, Message: e.Message})
+	}
+	return res
+}
+
+// toImportError: 一括登録のエラーを変換します。
+// ヘッダーの不正などファイル全体を読めないエラーは InvalidArgument にし、
+// ストリームの受信エラーはそのままのコードで返します。
+func toImportError(err error) error {
+	var connectErr *connect.Error
+	switch {
+	case errors.Is(err, bulk.ErrMalformedFile):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.As(err, &connectErr):
+		return connectErr
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	return toConnectError(err)
+}
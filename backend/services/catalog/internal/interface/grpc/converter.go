@@ -0,0 +1,372 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/kinoshitatakumi/opti/gen/go/catalog/v1"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// toPbProduct: 内部の型(model) -> 通信用(protobuf) に変換します。
+// 複数のAPIで同じ変換を行うため、ここにまとめています。
+func toPbProduct(p *model.Product) *catalogv1.Product {
+	return &catalogv1.Product{
+		Id:                       p.ID.String(),
+		Sku:                      p.SKU,
+		Name:                     p.Name,
+		Description:              p.Description,
+		Price:                    p.Price.Amount(),
+		Manufacturer:             p.Manufacturer,
+		PurchaseLink:             p.PurchaseLink,
+		ImageUrl:                 p.ImageURL,
+		WeakPoints:               p.WeakPoints,
+		StrongPoints:             p.StrongPoints,
+		InstallationDifficulty:   string(p.InstallationDifficulty),
+		Category:                 string(p.Category),
+		AutomationEffect:         toPbAutomationEffect(p.AutomationEffect),
+		Connectivity:             toPbConnectivity(p.Connectivity),
+		InstallationRequirements: toPbInstallationRequirements(p.InstallationRequirements),
+		Availability:             toPbAvailability(p.Availability),
+		Offers:                   toPbOffers(p.Offers),
+		Image:                    toPbProductImage(p.Image),
+	}
+}
+
+func toPbOffers(offers []model.MerchantOffer) []*catalogv1.MerchantOffer {
+	var pb []*catalogv1.MerchantOffer
+	for _, o := range offers {
+		pb = append(pb, &catalogv1.MerchantOffer{Merchant: o.Merchant, Url: o.URL, AffiliateTemplate: o.AffiliateTemplate})
+	}
+	return pb
+}
+
+// toOffers: 通信用(protobuf) -> 内部の型(model) に変換します。URLなどのチェックは Product.Validate で行います。
+func toOffers(pb []*catalogv1.MerchantOffer) []model.MerchantOffer {
+	var offers []model.MerchantOffer
+	for _, o := range pb {
+		offers = append(offers, model.MerchantOffer{Merchant: o.Merchant, URL: o.Url, AffiliateTemplate: o.AffiliateTemplate})
+	}
+	return offers
+}
+
+// toPbAvailability: 在庫状況は価格の取得でだけ更新するため、通信用からの変換 (toAvailability) はありません。
+func toPbAvailability(a model.Availability) *catalogv1.Availability {
+	pb := &catalogv1.Availability{Status: string(a.Status), Merchant: a.Merchant}
+	if !a.CheckedAt.IsZero() {
+		pb.CheckedAt = a.CheckedAt.Format(time.RFC3339)
+	}
+	return pb
+}
+
+// toPbProductImage: 取り込んだ画像は画像の取り込みでだけ更新するため、通信用からの変換はありません。
+func toPbProductImage(i *model.ProductImage) *catalogv1.ProductImage {
+	if i == nil {
+		return nil
+	}
+	pb := &catalogv1.ProductImage{
+		SourceHash: i.SourceHash,
+		SourceUrl:  i.SourceURL,
+		MimeType:   i.MIMEType,
+		Width:      int32(i.Width),
+		Height:     int32(i.Height),
+		IngestedAt: i.IngestedAt.Format(time.RFC3339),
+	}
+	for _, v := range i.Variants {
+		pb.Variants = append(pb.Variants, &catalogv1.ImageVariant{
+			Name:   v.Name,
+			Format: string(v.Format),
+			Width:  int32(v.Width),
+			Height: int32(v.Height),
+			Bytes:  int32(v.Bytes),
+			Url:    v.URL,
+		})
+	}
+	return pb
+}
+
+func toPbAutomationEffect(e model.AutomationEffect) *catalogv1.AutomationEffect {
+	pb := &catalogv1.AutomationEffect{
+		MaintenanceMinutesPerMonth: int32(e.MaintenanceMinutesPerMonth),
+		PowerWatts:                 e.PowerWatts,
+		ConsumableCostPerMonth:     e.ConsumableCostPerMonth,
+	}
+	for _, c := range e.ChoreEffects {
+		pb.ChoreEffects = append(pb.ChoreEffects, &catalogv1.ChoreEffect{
+			ChoreCategory:        string(c.Category),
+			TimeReductionPercent: int32(c.TimeReductionPercent),
+		})
+	}
+	return pb
+}
+
+// toAutomationEffect: 通信用(protobuf) -> 内部の型(model) に変換します。
+// 値の妥当性チェックはドメインモデル側 (AutomationEffect.Validate) で行います。
+func toAutomationEffect(pb *catalogv1.AutomationEffect) model.AutomationEffect {
+	if pb == nil {
+		return model.AutomationEffect{}
+	}
+	e := model.AutomationEffect{
+		MaintenanceMinutesPerMonth: int(pb.MaintenanceMinutesPerMonth),
+		PowerWatts:                 pb.PowerWatts,
+		ConsumableCostPerMonth:     pb.ConsumableCostPerMonth,
+	}
+	for _, c := range pb.ChoreEffects {
+		e.ChoreEffects = append(e.ChoreEffects, model.ChoreEffect{
+			Category:             model.ChoreCategory(c.ChoreCategory),
+			TimeReductionPercent: int(c.TimeReductionPercent),
+		})
+	}
+	return e
+}
+
+func toPbConnectivity(c model.Connectivity) *catalogv1.Connectivity {
+	return &catalogv1.Connectivity{
+		Protocols:            toPbProtocols(c.Protocols),
+		RequiredHubProtocols: toPbProtocols(c.RequiredHubProtocols),
+		BridgedProtocols:     toPbProtocols(c.BridgedProtocols),
+	}
+}
+
+// toConnectivity: 通信用(protobuf) -> 内部の型(model) に変換します。
+func toConnectivity(pb *catalogv1.Connectivity) model.Connectivity {
+	if pb == nil {
+		return model.Connectivity{}
+	}
+	return model.Connectivity{
+		Protocols:            toProtocols(pb.Protocols),
+		RequiredHubProtocols: toProtocols(pb.RequiredHubProtocols),
+		BridgedProtocols:     toProtocols(pb.BridgedProtocols),
+	}
+}
+
+func toPbInstallationRequirements(r model.InstallationRequirements) *catalogv1.InstallationRequirements {
+	pb := &catalogv1.InstallationRequirements{
+		RequiresDrilling:       r.RequiresDrilling,
+		RequiresElectricalWork: r.RequiresElectricalWork,
+		StepSensitive:          r.StepSensitive,
+		ClimbableStepMm:        int32(r.ClimbableStepMM),
+	}
+	for _, f := range r.SupportedFloorTypes {
+		pb.SupportedFloorTypes = append(pb.SupportedFloorTypes, string(f))
+	}
+	for _, t := range r.SupportedResidenceTypes {
+		pb.SupportedResidenceTypes = append(pb.SupportedResidenceTypes, string(t))
+	}
+	return pb
+}
+
+// toInstallationRequirements: 通信用(protobuf) -> 内部の型(model) に変換します。
+func toInstallationRequirements(pb *catalogv1.InstallationRequirements) model.InstallationRequirements {
+	if pb == nil {
+		return model.InstallationRequirements{}
+	}
+	r := model.InstallationRequirements{
+		RequiresDrilling:       pb.RequiresDrilling,
+		RequiresElectricalWork: pb.RequiresElectricalWork,
+		StepSensitive:          pb.StepSensitive,
+		ClimbableStepMM:        int(pb.ClimbableStepMm),
+		SupportedFloorTypes:    toFloorTypes(pb.SupportedFloorTypes),
+	}
+	for _, t := range pb.SupportedResidenceTypes {
+		r.SupportedResidenceTypes = append(r.SupportedResidenceTypes, model.ResidenceType(t))
+	}
+	return r
+}
+
+// toResidenceProfile: 通信用(protobuf) -> 内部の型(model) に変換します。
+// Wi-Fi環境が未指定の場合は nil のままにして、Wi-Fiの判定を行わないようにします。
+func toResidenceProfile(pb *catalogv1.Residence) model.ResidenceProfile {
+	if pb == nil {
+		return model.ResidenceProfile{}
+	}
+	r := model.ResidenceProfile{
+		Type:         model.ResidenceType(pb.Type),
+		Ownership:    model.Ownership(pb.Ownership),
+		HasSteps:     pb.HasSteps,
+		StepHeightMM: int(pb.StepHeightMm),
+		FloorTypes:   toFloorTypes(pb.FloorTypes),
+	}
+	if pb.Wifi != nil {
+		r.WiFi = &model.WiFiEnvironment{Available: pb.Wifi.Available, Bands: toProtocols(pb.Wifi.Bands)}
+	}
+	return r
+}
+
+func toFloorTypes(list []string) []model.FloorType {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([]model.FloorType, 0, len(list))
+	for _, f := range list {
+		out = append(out, model.FloorType(f))
+	}
+	return out
+}
+
+func toPbProtocols(list []model.Protocol) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(list))
+	for _, p := range list {
+		out = append(out, string(p))
+	}
+	return out
+}
+
+func toProtocols(list []string) []model.Protocol {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([]model.Protocol, 0, len(list))
+	for _, p := range list {
+		out = append(out, model.Protocol(p))
+	}
+	return out
+}
+
+// toProductFilter: 通信用(protobuf) -> 内部の型(model) に変換します。未指定なら絞り込みなしです。
+func toProductFilter(pb *catalogv1.ProductFilter) model.ProductFilter {
+	if pb == nil {
+		return model.ProductFilter{}
+	}
+	filter := model.ProductFilter{
+		Manufacturers: pb.Manufacturers,
+		MinPrice:      pb.MinPrice,
+		MaxPrice:      pb.MaxPrice,
+		Protocols:     toProtocols(pb.Protocols),
+	}
+	for _, c := range pb.Categories {
+		filter.Categories = append(filter.Categories, model.ProductCategory(c))
+	}
+	for _, d := range pb.InstallationDifficulties {
+		filter.Difficulties = append(filter.Difficulties, model.InstallationDifficulty(d))
+	}
+	return filter
+}
+
+func toPbProductFacets(f *model.ProductFacets) *catalogv1.ListProductFacetsResponse {
+	res := &catalogv1.ListProductFacetsResponse{TotalCount: int32(f.Total)}
+	for _, facet := range f.Facets {
+		pb := &catalogv1.Facet{Name: string(facet.Name)}
+		for _, v := range facet.Values {
+			value := &catalogv1.FacetValue{Value: v.Value, Count: int32(v.Count), Selected: v.Selected}
+			if v.Bucket != nil {
+				value.MinPrice = v.Bucket.MinPrice
+				value.MaxPrice = v.Bucket.MaxPrice
+			}
+			pb.Values = append(pb.Values, value)
+		}
+		res.Facets = append(res.Facets, pb)
+	}
+	return res
+}
+
+// parsePageToken: ページトークン (次のページの開始位置) を読み取ります。空なら先頭です。
+func parsePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(token)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: invalid page token %q", model.ErrInvalidSearchQuery, token)
+	}
+	return offset, nil
+}
+
+func toPbPricePoint(pt model.PricePoint) *catalogv1.PricePoint {
+	return &catalogv1.PricePoint{
+		Price:      pt.Amount,
+		Source:     pt.Source,
+		RecordedAt: pt.RecordedAt.Format(time.RFC3339),
+	}
+}
+
+func toPbPriceDrop(e *model.PriceDropEvent) *catalogv1.PriceDropEvent {
+	return &catalogv1.PriceDropEvent{
+		Id:            e.ID,
+		ProductId:     e.ProductID.String(),
+		ProductName:   e.ProductName,
+		Price:         e.Price,
+		PreviousPrice: e.PreviousPrice,
+		TrailingMin:   e.TrailingMin,
+		WindowDays:    int32(e.Window / (24 * time.Hour)),
+		DropPercent:   e.DropPercent(),
+		Source:        e.Source,
+		DetectedAt:    e.DetectedAt.Format(time.RFC3339),
+	}
+}
+
+func toPbPurchaseLink(l model.PurchaseLink) *catalogv1.PurchaseLink {
+	return &catalogv1.PurchaseLink{Merchant: l.Merchant, RedirectUrl: l.RedirectURL, TaggedUrl: l.TaggedURL}
+}
+
+func toPbClickReport(r *model.ClickReport) *catalogv1.GetClickReportResponse {
+	res := &catalogv1.GetClickReportResponse{Total: int32(r.Total)}
+	for _, c := range r.ByProduct {
+		res.ByProduct = append(res.ByProduct, toPbClickCount(c))
+	}
+	for _, c := range r.ByPlan {
+		res.ByPlan = append(res.ByPlan, toPbClickCount(c))
+	}
+	return res
+}
+
+func toPbClickCount(c model.ClickCount) *catalogv1.ClickCount {
+	pb := &catalogv1.ClickCount{
+		Key:              c.Key,
+		Clicks:           int32(c.Clicks),
+		UniqueUsers:      int32(c.UniqueUsers),
+		ClicksByMerchant: make(map[string]int32, len(c.ByMerchant)),
+	}
+	for merchant, n := range c.ByMerchant {
+		pb.ClicksByMerchant[merchant] = int32(n)
+	}
+	return pb
+}
+
+func toPbProductIssue(i model.ProductIssue) *catalogv1.ProductIssue {
+	return &catalogv1.ProductIssue{
+		ProductId:     i.ProductID.String(),
+		ProductName:   i.ProductName,
+		Merchant:      i.Merchant,
+		Url:           i.URL,
+		Status:        string(i.Status),
+		HttpStatus:    int32(i.HTTPStatus),
+		FinalUrl:      i.FinalURL,
+		Detail:        i.Detail,
+		FirstSeenAt:   i.FirstSeenAt.Format(time.RFC3339),
+		LastCheckedAt: i.LastCheckedAt.Format(time.RFC3339),
+	}
+}
+
+// parseTime: RFC 3339 の文字列を読み取ります。空文字はゼロ値 (指定なし) にします。
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: want RFC 3339", s)
+	}
+	return t, nil
+}
+
+// toConnectError: ドメインエラーを適切なRPCステータスコードに変換します。
+// 想定外のエラーはそのまま返します (Connectが Unknown として扱います)。
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidProduct), errors.Is(err, model.ErrInvalidSearchQuery), errors.Is(err, model.ErrTooManyProducts),
+		errors.Is(err, model.ErrInvalidImage):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, model.ErrProductNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, model.ErrPlanNotOwned):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return err
+}
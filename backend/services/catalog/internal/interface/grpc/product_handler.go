@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
 	"connectrpc.com/connect"
 	catalogv1 "github.com/kinoshitatakumi/opti/gen/go/catalog/v1"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
 	"github.com/kinoshitatakumi/opti/pkg/domain/value"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/interface/bulk"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
 )
 
@@ -14,12 +18,19 @@ import (
 // Clean Architectureにおける「Interface層」にあたります。
 // 外部からの通信(gRPC)と、内部のロジック(Usecase)の通訳を行います。
 type ProductHandler struct {
-	usecase *usecase.ProductUsecase // 実際の処理を行う人（依存性注入）
+	usecase       *usecase.ProductUsecase       // 実際の処理を行う人（依存性注入）
+	compatibility *usecase.CompatibilityUsecase // 互換性チェックを行う人
+	eligibility   *usecase.EligibilityUsecase   // 住環境との適合判定を行う人
+	search        *usecase.SearchUsecase        // 全文検索を行う人
+	prices        *usecase.PriceUsecase         // 価格の履歴と値下がりを扱う人
+	affiliate     *usecase.AffiliateUsecase     // 購入リンクの発行とクリックの集計を行う人
+	issues        *usecase.ProductIssueUsecase  // 購入リンクの問題を見せる人
+	images        *usecase.ImageUsecase         // 製品画像を取り込む人
 }
 
 // NewProductHandler: ハンドラの作成
-func NewProductHandler(u *usecase.ProductUsecase) *ProductHandler {
-	return &ProductHandler{usecase: u}
+func NewProductHandler(u *usecase.ProductUsecase, c *usecase.CompatibilityUsecase, e *usecase.EligibilityUsecase, s *usecase.SearchUsecase, p *usecase.PriceUsecase, a *usecase.AffiliateUsecase, i *usecase.ProductIssueUsecase, img *usecase.ImageUsecase) *ProductHandler {
+	return &ProductHandler{usecase: u, compatibility: c, eligibility: e, search: s, prices: p, affiliate: a, issues: i, images: img}
 }
 
 // ListProducts: 製品一覧取得API
@@ -33,19 +44,7 @@ func (h *ProductHandler) ListProducts(ctx context.Context, req *connect.Request[
 	// 2. 内部の型(model) -> 通信用(protobuf) に変換
 	var pbProducts []*catalogv1.Product
 	for _, p := range products {
-		pbProducts = append(pbProducts, &catalogv1.Product{
-			Id:                     p.ID.String(),
-			Name:                   p.Name,
-			Description:            p.Description,
-			Price:                  p.Price.Amount(),
-			Manufacturer:           p.Manufacturer,
-			PurchaseLink:           p.PurchaseLink,
-			ImageUrl:               p.ImageURL,
-			WeakPoints:             p.WeakPoints,
-			StrongPoints:           p.StrongPoints,
-			InstallationDifficulty: string(p.InstallationDifficulty),
-			Category:               string(p.Category),
-		})
+		pbProducts = append(pbProducts, toPbProduct(p))
 	}
 
 	return connect.NewResponse(&catalogv1.ListProductsResponse{
@@ -64,36 +63,29 @@ func (h *ProductHandler) CreateProduct(ctx context.Context, req *connect.Request
 
 	// 2. 通信用(protobuf) -> 内部の型(model) に変換
 	input := &model.Product{
-		Name:                 req.Msg.Name,
-		Description:            req.Msg.Description,
-		Price:                  price,
-		Manufacturer:           req.Msg.Manufacturer,
-		PurchaseLink:           req.Msg.PurchaseLink,
-		ImageURL:               req.Msg.ImageUrl,
-		WeakPoints:             req.Msg.WeakPoints,
-		StrongPoints:           req.Msg.StrongPoints,
-		InstallationDifficulty: model.InstallationDifficulty(req.Msg.InstallationDifficulty),
-		Category:               model.ProductCategory(req.Msg.Category),
+		SKU:                      req.Msg.Sku,
+		Name:                     req.Msg.Name,
+		Description:              req.Msg.Description,
+		Price:                    price,
+		Manufacturer:             req.Msg.Manufacturer,
+		PurchaseLink:             req.Msg.PurchaseLink,
+		ImageURL:                 req.Msg.ImageUrl,
+		WeakPoints:               req.Msg.WeakPoints,
+		StrongPoints:             req.Msg.StrongPoints,
+		InstallationDifficulty:   model.InstallationDifficulty(req.Msg.InstallationDifficulty),
+		Category:                 model.ProductCategory(req.Msg.Category),
+		AutomationEffect:         toAutomationEffect(req.Msg.AutomationEffect),
+		Connectivity:             toConnectivity(req.Msg.Connectivity),
+		InstallationRequirements: toInstallationRequirements(req.Msg.InstallationRequirements),
+		Offers:                   toOffers(req.Msg.Offers),
 	}
 
 	p, err := h.usecase.CreateProduct(ctx, input)
 	if err != nil {
-		return nil, err
+		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&catalogv1.Product{
-		Id:                     p.ID.String(),
-		Name:                   p.Name,
-		Description:            p.Description,
-		Price:                  p.Price.Amount(),
-		Manufacturer:           p.Manufacturer,
-		PurchaseLink:           p.PurchaseLink,
-		ImageUrl:               p.ImageURL,
-		WeakPoints:             p.WeakPoints,
-		StrongPoints:           p.StrongPoints,
-		InstallationDifficulty: string(p.InstallationDifficulty),
-		Category:               string(p.Category),
-	}), nil
+	return connect.NewResponse(toPbProduct(p)), nil
 }
 
 // GetProduct: 製品詳細取得API
@@ -109,26 +101,348 @@ func (h *ProductHandler) GetProduct(ctx context.Context, req *connect.Request[ca
 	}
 
 	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	return connect.NewResponse(toPbProduct(p)), nil
+}
 
-	return connect.NewResponse(&catalogv1.Product{
-		Id:                     p.ID.String(),
-		Name:                   p.Name,
-		Description:            p.Description,
-		Price:                  p.Price.Amount(),
-		Manufacturer:           p.Manufacturer,
-		PurchaseLink:           p.PurchaseLink,
-		ImageUrl:               p.ImageURL,
-		WeakPoints:             p.WeakPoints,
-		StrongPoints:           p.StrongPoints,
-		InstallationDifficulty: string(p.InstallationDifficulty),
-		Category:               string(p.Category),
-	}), nil
+// BatchGetProducts: 製品の一括取得API
+// 存在しない製品があってもエラーにはせず、missing_ids で返します。
+func (h *ProductHandler) BatchGetProducts(ctx context.Context, req *connect.Request[catalogv1.BatchGetProductsRequest]) (*connect.Response[catalogv1.BatchGetProductsResponse], error) {
+	// 1. ユースケースを呼び出す
+	batch, err := h.usecase.BatchGetProducts(ctx, req.Msg.Ids)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 2. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	res := &catalogv1.BatchGetProductsResponse{}
+	for _, p := range batch.Products {
+		res.Products = append(res.Products, toPbProduct(p))
+	}
+	for _, id := range batch.MissingIDs {
+		res.MissingIds = append(res.MissingIds, id.String())
+	}
+	return connect.NewResponse(res), nil
 }
 
+// UpdateProduct: 製品更新API (Admin)
+// リクエストの内容で製品情報を丸ごと置き換えます。
 func (h *ProductHandler) UpdateProduct(ctx context.Context, req *connect.Request[catalogv1.UpdateProductRequest]) (*connect.Response[catalogv1.Product], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, nil) // 未実装
+	// 1. バリデーション: 価格を値オブジェクトに変換
+	price, err := value.NewPrice(req.Msg.Price)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 2. 通信用(protobuf) -> 内部の型(model) に変換
+	input := &model.Product{
+		ID:                       model.ProductID(req.Msg.Id),
+		SKU:                      req.Msg.Sku,
+		Name:                     req.Msg.Name,
+		Description:              req.Msg.Description,
+		Price:                    price,
+		Manufacturer:             req.Msg.Manufacturer,
+		PurchaseLink:             req.Msg.PurchaseLink,
+		ImageURL:                 req.Msg.ImageUrl,
+		WeakPoints:               req.Msg.WeakPoints,
+		StrongPoints:             req.Msg.StrongPoints,
+		InstallationDifficulty:   model.InstallationDifficulty(req.Msg.InstallationDifficulty),
+		Category:                 model.ProductCategory(req.Msg.Category),
+		AutomationEffect:         toAutomationEffect(req.Msg.AutomationEffect),
+		Connectivity:             toConnectivity(req.Msg.Connectivity),
+		InstallationRequirements: toInstallationRequirements(req.Msg.InstallationRequirements),
+		Offers:                   toOffers(req.Msg.Offers),
+	}
+
+	// 3. 存在確認とルールチェックはユースケース側で行います
+	p, err := h.usecase.UpdateProduct(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbProduct(p)), nil
+}
+
+// ImportProducts: 製品の一括登録API (Admin)
+// 受け取ったファイルの中身をそのまま読み取り用のストリームとして扱い、1行ずつ取り込みます。
+func (h *ProductHandler) ImportProducts(ctx context.Context, stream *connect.ClientStream[catalogv1.ImportProductsRequest]) (*connect.Response[catalogv1.ImportProductsResponse], error) {
+	// 1. 最初のメッセージで形式を受け取る
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("import options are required"))
+	}
+	options := stream.Msg().GetOptions()
+	if options == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the first message must be import options"))
+	}
+	format, err := bulk.ParseFormat(options.Format)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 2. 続くメッセージ (chunk) をファイルとして読む
+	rows, err := bulk.NewReader(&chunkReader{stream: stream}, format)
+	if err != nil {
+		return nil, toImportError(err)
+	}
+
+	// 3. ユースケースを呼び出して取り込む
+	report, err := h.usecase.ImportProducts(ctx, rows, options.DryRun)
+	if err != nil {
+		return nil, toImportError(err)
+	}
+	return connect.NewResponse(toPbImportReport(report)), nil
+}
+
+// ExportProducts: 製品の一括出力API (Admin)
+// 書き出した内容を exportChunkSize ごとに区切って送ります。
+func (h *ProductHandler) ExportProducts(ctx context.Context, req *connect.Request[catalogv1.ExportProductsRequest], stream *connect.ServerStream[catalogv1.ExportProductsResponse]) error {
+	format, err := bulk.ParseFormat(req.Msg.Format)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	out := &chunkWriter{stream: stream}
+	w, err := bulk.NewWriter(out, format)
+	if err != nil {
+		return err
+	}
+	if err := h.usecase.ExportProducts(ctx, w.Write); err != nil {
+		return toConnectError(err)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return out.Close()
 }
 
 func (h *ProductHandler) DeleteProduct(ctx context.Context, req *connect.Request[catalogv1.DeleteProductRequest]) (*connect.Response[catalogv1.DeleteProductResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, nil) // 未実装
 }
+
+// CheckCompatibility: 互換性チェックAPI
+func (h *ProductHandler) CheckCompatibility(ctx context.Context, req *connect.Request[catalogv1.CheckCompatibilityRequest]) (*connect.Response[catalogv1.CheckCompatibilityResponse], error) {
+	// 1. 通信用(protobuf) -> 内部の型(model) に変換
+	// wifi を省略した場合は「Wi-Fiなし」ではなく不明として扱います
+	var wifi *model.WiFiEnvironment
+	if w := req.Msg.Wifi; w != nil {
+		wifi = &model.WiFiEnvironment{Available: w.Available, Bands: toProtocols(w.Bands)}
+	}
+
+	// 2. ユースケースを呼び出す
+	report, err := h.compatibility.CheckCompatibility(ctx, req.Msg.ProductIds, wifi)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	res := &catalogv1.CheckCompatibilityResponse{Compatible: report.Compatible()}
+	for _, i := range report.Issues {
+		res.Issues = append(res.Issues, &catalogv1.CompatibilityIssue{
+			ProductId: i.ProductID.String(),
+			Kind:      string(i.Kind),
+			Protocols: toPbProtocols(i.Protocols),
+			Message:   i.Message,
+		})
+	}
+	for _, hub := range report.ProposedHubs {
+		res.ProposedHubs = append(res.ProposedHubs, &catalogv1.HubProposal{
+			ProductId:     hub.ProductID.String(),
+			ProposalGroup: hub.ProposalGroup,
+			Covers:        toPbProtocols(hub.Covers),
+			Reason:        hub.Reason,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListEligibleProducts: 住環境に合う製品の一覧取得API
+func (h *ProductHandler) ListEligibleProducts(ctx context.Context, req *connect.Request[catalogv1.ListEligibleProductsRequest]) (*connect.Response[catalogv1.ListEligibleProductsResponse], error) {
+	// 1. ユースケースを呼び出して、全製品の判定結果を取得
+	results, err := h.eligibility.ListEligibleProducts(ctx, toResidenceProfile(req.Msg.Residence), model.ProductCategory(req.Msg.Category))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 2. 提案できる製品とできない製品に振り分けてレスポンス
+	res := &catalogv1.ListEligibleProductsResponse{}
+	for _, r := range results {
+		if r.Eligible() {
+			res.Eligible = append(res.Eligible, &catalogv1.EligibleProduct{
+				Product: toPbProduct(r.Product),
+				Notes:   r.Notes,
+			})
+			continue
+		}
+		rejected := &catalogv1.RejectedProduct{Product: toPbProduct(r.Product)}
+		for _, rej := range r.Rejections {
+			rejected.Rejections = append(rejected.Rejections, &catalogv1.Rejection{
+				Code:    string(rej.Code),
+				Message: rej.Message,
+			})
+		}
+		res.Rejected = append(res.Rejected, rejected)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SearchProducts: 製品の全文検索API
+func (h *ProductHandler) SearchProducts(ctx context.Context, req *connect.Request[catalogv1.SearchProductsRequest]) (*connect.Response[catalogv1.SearchProductsResponse], error) {
+	// 1. 通信用(protobuf) -> 内部の型(model) に変換
+	offset, err := parsePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	query := model.ProductSearchQuery{
+		Text:   req.Msg.Query,
+		Filter: toProductFilter(req.Msg.Filter),
+		Limit:  int(req.Msg.PageSize),
+		Offset: offset,
+	}
+
+	// 2. ユースケースを呼び出す
+	result, err := h.search.SearchProducts(ctx, query)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	res := &catalogv1.SearchProductsResponse{TotalCount: int32(result.Total)}
+	for _, hit := range result.Hits {
+		pb := &catalogv1.ProductSearchHit{Product: toPbProduct(hit.Product), Score: hit.Score}
+		for _, hl := range hit.Highlights {
+			pb.Highlights = append(pb.Highlights, &catalogv1.SearchHighlight{Field: string(hl.Field), Fragments: hl.Fragments})
+		}
+		res.Hits = append(res.Hits, pb)
+	}
+	if next := offset + len(result.Hits); len(result.Hits) > 0 && next < result.Total {
+		res.NextPageToken = strconv.Itoa(next)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListProductFacets: ファセット (絞り込み項目ごとの件数) の集計API
+func (h *ProductHandler) ListProductFacets(ctx context.Context, req *connect.Request[catalogv1.ListProductFacetsRequest]) (*connect.Response[catalogv1.ListProductFacetsResponse], error) {
+	facets, err := h.usecase.ListProductFacets(ctx, toProductFilter(req.Msg.Filter))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbProductFacets(facets)), nil
+}
+
+// GetPriceHistory: 価格の履歴取得API
+func (h *ProductHandler) GetPriceHistory(ctx context.Context, req *connect.Request[catalogv1.GetPriceHistoryRequest]) (*connect.Response[catalogv1.GetPriceHistoryResponse], error) {
+	// 1. バリデーション: 期間の開始日時
+	since, err := parseTime(req.Msg.Since)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 2. ユースケースを呼び出す
+	history, err := h.prices.GetPriceHistory(ctx, req.Msg.ProductId, since)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	res := &catalogv1.GetPriceHistoryResponse{ProductId: history.ProductID.String()}
+	for _, pt := range history.Points {
+		res.Points = append(res.Points, toPbPricePoint(pt))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListPriceDrops: 値下がりの一覧取得API
+func (h *ProductHandler) ListPriceDrops(ctx context.Context, req *connect.Request[catalogv1.ListPriceDropsRequest]) (*connect.Response[catalogv1.ListPriceDropsResponse], error) {
+	since, err := parseTime(req.Msg.Since)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	events, err := h.prices.ListPriceDrops(ctx, since, req.Msg.ProductIds)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &catalogv1.ListPriceDropsResponse{}
+	for _, e := range events {
+		res.Events = append(res.Events, toPbPriceDrop(e))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetPurchaseLinks: 購入リンクの発行API
+// トークンに入れる利用者はアクセストークンから決めます。リクエストの user_id が別人なら PermissionDenied にします。
+func (h *ProductHandler) GetPurchaseLinks(ctx context.Context, req *connect.Request[catalogv1.GetPurchaseLinksRequest]) (*connect.Response[catalogv1.GetPurchaseLinksResponse], error) {
+	caller, _ := auth.UserID(ctx)
+	if req.Msg.UserId != "" && req.Msg.UserId != caller {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("user_id does not match the logged-in user"))
+	}
+	links, err := h.affiliate.GetPurchaseLinks(ctx, req.Msg.ProductId, caller, req.Msg.PlanId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &catalogv1.GetPurchaseLinksResponse{}
+	for _, l := range links {
+		res.Links = append(res.Links, toPbPurchaseLink(l))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetClickReport: 購入リンクのクリックの集計API (Admin)
+func (h *ProductHandler) GetClickReport(ctx context.Context, req *connect.Request[catalogv1.GetClickReportRequest]) (*connect.Response[catalogv1.GetClickReportResponse], error) {
+	// 1. バリデーション: 期間
+	since, err := parseTime(req.Msg.Since)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	until, err := parseTime(req.Msg.Until)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	filter := model.ClickFilter{Since: since, Until: until, PlanIDs: req.Msg.PlanIds}
+	for _, id := range req.Msg.ProductIds {
+		filter.ProductIDs = append(filter.ProductIDs, model.ProductID(id))
+	}
+
+	// 2. ユースケースを呼び出す
+	report, err := h.affiliate.GetClickReport(ctx, filter)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	return connect.NewResponse(toPbClickReport(report)), nil
+}
+
+// ListProductIssues: 購入リンクに問題のある製品の一覧取得API (Admin)
+func (h *ProductHandler) ListProductIssues(ctx context.Context, req *connect.Request[catalogv1.ListProductIssuesRequest]) (*connect.Response[catalogv1.ListProductIssuesResponse], error) {
+	filter := model.ProductIssueFilter{}
+	for _, s := range req.Msg.Statuses {
+		filter.Statuses = append(filter.Statuses, model.LinkStatus(s))
+	}
+	issues, err := h.issues.ListProductIssues(ctx, filter)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &catalogv1.ListProductIssuesResponse{}
+	for _, i := range issues {
+		res.Issues = append(res.Issues, toPbProductIssue(i))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UploadProductImage: 製品画像のアップロードAPI (Admin)
+func (h *ProductHandler) UploadProductImage(ctx context.Context, req *connect.Request[catalogv1.UploadProductImageRequest]) (*connect.Response[catalogv1.Product], error) {
+	p, err := h.images.UploadProductImage(ctx, req.Msg.ProductId, req.Msg.Data)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbProduct(p)), nil
+}
+
+// ImportProductImage: URL からの製品画像の取り込みAPI (Admin)
+func (h *ProductHandler) ImportProductImage(ctx context.Context, req *connect.Request[catalogv1.ImportProductImageRequest]) (*connect.Response[catalogv1.Product], error) {
+	p, err := h.images.ImportProductImage(ctx, req.Msg.ProductId, req.Msg.SourceUrl)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbProduct(p)), nil
+}
@@ -0,0 +1,52 @@
+// Package media: ローカルに保存した製品画像のサムネイル (/images/{key}) を配信する HTTP ハンドラです。
+// <img> タグから直接読まれるため、Connect ではなく素の HTTP で受け付けます。
+// Cloud Storage に保存している場合、サムネイルのURLはバケット (または CDN) を指すので、ここは使われません。
+package media
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
+)
+
+// Handler: GET /images/{key}
+type Handler struct {
+	images *usecase.ImageUsecase
+}
+
+// NewHandler: ハンドラの作成
+func NewHandler(i *usecase.ImageUsecase) *Handler {
+	return &Handler{images: i}
+}
+
+// Register: mux にパスを登録します。
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle("GET "+usecase.ImagePath+"{key}", h)
+}
+
+// ServeHTTP: サムネイルを返します。
+// キーは内容のハッシュなので、同じURLの中身は変わりません。ずっとキャッシュさせ、ETag にもキーを使います。
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	data, contentType, err := h.images.GetImage(r.Context(), key)
+	switch {
+	case errors.Is(err, model.ErrBlobNotFound):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		log.Printf("failed to read image %s: %v", key, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("ETag", `"`+key+`"`)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, key, time.Time{}, bytes.NewReader(data))
+}
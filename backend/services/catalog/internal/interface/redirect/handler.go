@@ -0,0 +1,52 @@
+// Package redirect: 購入リンク (/r/{token}) のクリックを記録して販売サイトへ遷移させる HTTP ハンドラです。
+// ブラウザから直接開かれるため、Connect ではなく素の HTTP で受け付けます。
+package redirect
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/usecase"
+)
+
+// Handler: GET /r/{token}
+type Handler struct {
+	affiliate *usecase.AffiliateUsecase
+}
+
+// NewHandler: ハンドラの作成
+func NewHandler(a *usecase.AffiliateUsecase) *Handler {
+	return &Handler{affiliate: a}
+}
+
+// Register: mux にパスを登録します。
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle("GET "+usecase.RedirectPath+"{token}", h)
+}
+
+// ServeHTTP: クリックを記録して、販売サイトのアフィリエイトリンクへ 302 で遷移させます。
+// 1. トークンを検証し、クリックを記録する (ユースケース)
+// 2. 遷移先へリダイレクトする
+//
+// 不正・期限切れのトークンや、販売をやめた製品は 404 にします (どれに当たるかは見せません)。
+// トークンには利用者・採用計画のIDが入っているため、Referer で販売サイトに渡さないようにします。
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	target, err := h.affiliate.ResolveClick(r.Context(), r.PathValue("token"))
+	switch {
+	case errors.Is(err, model.ErrInvalidClickToken), errors.Is(err, model.ErrInvalidProduct),
+		errors.Is(err, model.ErrProductNotFound), errors.Is(err, model.ErrListingNotFound):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		log.Printf("failed to resolve purchase link: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store") // キャッシュされるとクリックを記録できない
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+	http.Redirect(w, r, target, http.StatusFound)
+}
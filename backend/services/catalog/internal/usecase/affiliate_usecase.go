@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+)
+
+// RedirectPath: 購入リンクのクリックを記録して商品ページへ遷移するエンドポイントのパス
+const RedirectPath = "/r/"
+
+// AffiliateUsecase: 購入リンク (アフィリエイトリンク) の発行と、クリックの記録・集計を扱うユースケースです。
+// 利用者には商品ページのURLではなく、署名したトークンを含む /r/{token} を見せ、
+// クリックを記録してから販売サイトのアフィリエイトリンクへ遷移させます。
+type AffiliateUsecase struct {
+	products repository.ProductRepository
+	clicks   repository.ClickRepository
+	plans    repository.PlanDirectory // nil なら採用計画を確かめられないので、トークンに入れない
+	signer   *service.ClickTokenSigner
+	baseURL  string // /r/{token} の前につける公開URL (例: "https://opti.example.com")
+}
+
+// NewAffiliateUsecase: ユースケースの作成
+// plans が nil なら、購入リンクには採用計画を入れません (クリックは採用計画なしで記録されます)。
+func NewAffiliateUsecase(products repository.ProductRepository, clicks repository.ClickRepository, plans repository.PlanDirectory, signer *service.ClickTokenSigner, baseURL string) *AffiliateUsecase {
+	return &AffiliateUsecase{products: products, clicks: clicks, plans: plans, signer: signer, baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// GetPurchaseLinks: 製品の購入リンクを販売サイトごとに発行するユースケース
+// userID はログイン中の利用者 (未ログインなら空) で、呼び出し側がアクセストークンから決めます。
+// 1. 製品を取得する
+// 2. 採用計画が userID のものか確かめる (他人の計画なら model.ErrPlanNotOwned)
+// 3. 販売サイトごとに、製品・販売サイト・利用者・採用計画を埋め込んだトークンに署名する
+// 4. トークンを含む遷移用のURLと、遷移先のアフィリエイトリンクを返す
+func (u *AffiliateUsecase) GetPurchaseLinks(ctx context.Context, productID, userID, planID string) ([]model.PurchaseLink, error) {
+	p, err := u.getProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	planID, err = u.verifiedPlan(ctx, userID, planID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var links []model.PurchaseLink
+	for _, offer := range p.PurchaseOffers() {
+		token, err := u.signer.Sign(model.ClickToken{ProductID: p.ID, Merchant: offer.Merchant, UserID: userID, PlanID: planID, IssuedAt: now})
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, model.PurchaseLink{
+			Merchant:    offer.Merchant,
+			RedirectURL: u.baseURL + RedirectPath + url.PathEscape(token),
+			TaggedURL:   offer.TaggedURL(p.ID),
+		})
+	}
+	return links, nil
+}
+
+// verifiedPlan: トークンに入れる採用計画を決めます。
+// 未ログイン、または所有者を確かめられない (Project Service に繋がらないなど) ときは、
+// 購入の妨げにならないよう採用計画を入れずに発行します。
+func (u *AffiliateUsecase) verifiedPlan(ctx context.Context, userID, planID string) (string, error) {
+	if planID == "" || userID == "" || u.plans == nil {
+		return "", nil
+	}
+	owner, err := u.plans.OwnerOf(ctx, planID)
+	if errors.Is(err, model.ErrPlanNotOwned) {
+		return "", err
+	}
+	if err != nil {
+		log.Printf("failed to verify owner of plan %s; issuing links without it: %v", planID, err)
+		return "", nil
+	}
+	if owner != userID {
+		return "", fmt.Errorf("%w: %s", model.ErrPlanNotOwned, planID)
+	}
+	return planID, nil
+}
+
+// ResolveClick: 購入リンクのクリックを記録し、遷移先のアフィリエイトリンクを返すユースケース
+// 1. トークンの署名と有効期限を確かめる (改ざん・期限切れは model.ErrInvalidClickToken)
+// 2. 遷移先はトークンではなく、カタログに今登録されている販売サイトの商品ページから作る
+// 3. クリックを記録する
+//
+// 記録に失敗しても、利用者の購入を妨げないよう遷移先は返します (失敗はログに残します)。
+func (u *AffiliateUsecase) ResolveClick(ctx context.Context, token string) (string, error) {
+	now := time.Now()
+	t, err := u.signer.Verify(token, now)
+	if err != nil {
+		return "", err
+	}
+	p, err := u.getProduct(ctx, t.ProductID.String())
+	if err != nil {
+		return "", err
+	}
+	offer, ok := p.Offer(t.Merchant)
+	if !ok {
+		return "", fmt.Errorf("%w: %s no longer sells %s", model.ErrListingNotFound, t.Merchant, p.ID)
+	}
+
+	event := &model.ClickEvent{
+		ID:        uuid.NewString(),
+		ProductID: p.ID,
+		Merchant:  offer.Merchant,
+		UserID:    t.UserID,
+		PlanID:    t.PlanID,
+		ClickedAt: now,
+	}
+	if err := u.clicks.Save(ctx, event); err != nil {
+		log.Printf("failed to record click on %s (%s): %v", p.ID, offer.Merchant, err)
+	}
+	return offer.TaggedURL(p.ID), nil
+}
+
+// GetClickReport: クリックを製品ごと・採用計画ごとに集計するユースケース
+func (u *AffiliateUsecase) GetClickReport(ctx context.Context, filter model.ClickFilter) (*model.ClickReport, error) {
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return nil, fmt.Errorf("%w: since must be before until", model.ErrInvalidSearchQuery)
+	}
+	events, err := u.clicks.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return model.CountClicks(events), nil
+}
+
+func (u *AffiliateUsecase) getProduct(ctx context.Context, id string) (*model.Product, error) {
+	pid, err := model.NewProductID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+	}
+	p, err := u.products.GetByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, model.ErrProductNotFound
+	}
+	return p, nil
+}
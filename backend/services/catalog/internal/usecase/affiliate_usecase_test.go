@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/db"
+)
+
+// stubPlans: 採用計画IDと所有者の対応を返す PlanDirectory
+type stubPlans struct {
+	owners map[string]string
+	err    error
+}
+
+func (s stubPlans) OwnerOf(_ context.Context, planID string) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	owner, ok := s.owners[planID]
+	if !ok {
+		return "", model.ErrPlanNotOwned
+	}
+	return owner, nil
+}
+
+func newAffiliateFixture(t *testing.T, plans stubPlans) (*AffiliateUsecase, *service.ClickTokenSigner) {
+	t.Helper()
+	f := newRefresherFixture()
+	f.create(t, "p1", 50000, "https://shop.example.com/items/p1")
+	signer, err := service.NewClickTokenSigner([]byte(strings.Repeat("k", 32)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewAffiliateUsecase(f.repo, db.NewMemoryClickRepository(), plans, signer, "https://opti.example.com"), signer
+}
+
+// signedToken: 発行された購入リンクのトークンを検証して取り出します。
+func signedToken(t *testing.T, signer *service.ClickTokenSigner, links []model.PurchaseLink) model.ClickToken {
+	t.Helper()
+	if len(links) != 1 {
+		t.Fatalf("links = %+v", links)
+	}
+	token, err := url.PathUnescape(strings.TrimPrefix(links[0].RedirectURL, "https://opti.example.com"+RedirectPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := signer.Verify(token, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestGetPurchaseLinksSignsOwnPlan(t *testing.T) {
+	u, signer := newAffiliateFixture(t, stubPlans{owners: map[string]string{"plan-1": "alice"}})
+	links, err := u.GetPurchaseLinks(context.Background(), "p1", "alice", "plan-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := signedToken(t, signer, links); got.UserID != "alice" || got.PlanID != "plan-1" {
+		t.Errorf("token = %+v", got)
+	}
+}
+
+func TestGetPurchaseLinksRejectsOthersPlan(t *testing.T) {
+	u, _ := newAffiliateFixture(t, stubPlans{owners: map[string]string{"plan-1": "alice"}})
+	for _, planID := range []string{"plan-1", "missing"} {
+		if _, err := u.GetPurchaseLinks(context.Background(), "p1", "mallory", planID); !errors.Is(err, model.ErrPlanNotOwned) {
+			t.Errorf("plan %s: err = %v, want ErrPlanNotOwned", planID, err)
+		}
+	}
+}
+
+func TestGetPurchaseLinksDropsUnverifiedPlan(t *testing.T) {
+	tests := []struct {
+		name   string
+		plans  stubPlans
+		userID string
+	}{
+		{name: "anonymous", plans: stubPlans{owners: map[string]string{"plan-1": "alice"}}},
+		{name: "project service unavailable", plans: stubPlans{err: errors.New("connection refused")}, userID: "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, signer := newAffiliateFixture(t, tt.plans)
+			links, err := u.GetPurchaseLinks(context.Background(), "p1", tt.userID, "plan-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := signedToken(t, signer, links); got.UserID != tt.userID || got.PlanID != "" {
+				t.Errorf("token = %+v", got)
+			}
+		})
+	}
+}
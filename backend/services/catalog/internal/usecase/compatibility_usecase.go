@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+)
+
+// CompatibilityUsecase: 製品の組み合わせの互換性チェックを行うユースケースです。
+// リポジトリから製品とハブ候補を集め、判定そのものはドメインサービスに任せます。
+type CompatibilityUsecase struct {
+	repo    repository.ProductRepository
+	checker *service.CompatibilityChecker
+}
+
+// NewCompatibilityUsecase: ユースケースの作成
+func NewCompatibilityUsecase(repo repository.ProductRepository, checker *service.CompatibilityChecker) *CompatibilityUsecase {
+	return &CompatibilityUsecase{repo: repo, checker: checker}
+}
+
+// CheckCompatibility: 指定した製品の組み合わせを住居のWi-Fi環境と照らし合わせます。
+// wifi が nil (不明) なら、Wi-Fiの判定はしません。
+// 1. 指定IDの製品を取得する (1つでも存在しなければエラー)
+// 2. カタログ内のハブ製品を提案候補として集める
+// 3. チェッカーで問題点と提案ハブを算出する
+func (u *CompatibilityUsecase) CheckCompatibility(ctx context.Context, ids []string, wifi *model.WiFiEnvironment) (*model.CompatibilityReport, error) {
+	products := make([]*model.Product, 0, len(ids))
+	for _, id := range ids {
+		pid, err := model.NewProductID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+		}
+		p, err := u.repo.GetByID(ctx, pid)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			return nil, fmt.Errorf("%w: %s", model.ErrProductNotFound, id)
+		}
+		products = append(products, p)
+	}
+
+	all, err := u.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var hubs []*model.Product
+	for _, p := range all {
+		if len(p.Connectivity.BridgedProtocols) > 0 {
+			hubs = append(hubs, p)
+		}
+	}
+
+	return u.checker.Check(products, wifi, hubs), nil
+}
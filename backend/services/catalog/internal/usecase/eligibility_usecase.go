@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+)
+
+// EligibilityUsecase: 住環境に合う製品だけを絞り込むユースケースです。
+// 提案ロジック (Simulation Service) は、ここで残った製品だけを候補として扱います。
+type EligibilityUsecase struct {
+	repo    repository.ProductRepository
+	matcher *service.EligibilityMatcher
+}
+
+// NewEligibilityUsecase: ユースケースの作成
+func NewEligibilityUsecase(repo repository.ProductRepository, matcher *service.EligibilityMatcher) *EligibilityUsecase {
+	return &EligibilityUsecase{repo: repo, matcher: matcher}
+}
+
+// ListEligibleProducts: 全製品を住環境と照らし合わせ、判定結果を返します。
+// category が空でなければ、そのカテゴリの製品だけを対象にします。
+// 却下された製品も理由付きで返すので、呼び出し側で「なぜ提案されないか」を説明できます。
+// 結果は製品IDの順で、同じカタログなら呼び出すたびに同じ順序になります。
+func (u *EligibilityUsecase) ListEligibleProducts(ctx context.Context, residence model.ResidenceProfile, category model.ProductCategory) ([]model.Eligibility, error) {
+	products, err := u.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if category != "" {
+		filtered := make([]*model.Product, 0, len(products))
+		for _, p := range products {
+			if p.Category == category {
+				filtered = append(filtered, p)
+			}
+		}
+		products = filtered
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].ID < products[j].ID })
+	return u.matcher.MatchAll(products, residence), nil
+}
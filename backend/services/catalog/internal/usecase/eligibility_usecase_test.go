@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+)
+
+func TestListEligibleProductsIsOrderedByID(t *testing.T) {
+	f := newRefresherFixture()
+	for _, i := range []int{7, 2, 9, 0, 5, 3, 8, 1, 6, 4} {
+		f.create(t, fmt.Sprintf("p%d", i), 10000, "")
+	}
+	u := NewEligibilityUsecase(f.repo, service.NewEligibilityMatcher())
+
+	for round := 0; round < 3; round++ {
+		results, err := u.ListEligibleProducts(context.Background(), model.ResidenceProfile{}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 10 {
+			t.Fatalf("got %d results, want 10", len(results))
+		}
+		for i, r := range results {
+			if want := model.ProductID(fmt.Sprintf("p%d", i)); r.Product.ID != want {
+				t.Fatalf("round %d: results[%d] = %s, want %s", round, i, r.Product.ID, want)
+			}
+		}
+	}
+}
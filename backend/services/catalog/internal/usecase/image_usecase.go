@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// ImagePath: ローカルに保存したサムネイルを配信するエンドポイントのパス
+const ImagePath = "/images/"
+
+// ImageUsecase: 製品画像の取り込み (アップロード・URL からの取得) と配信を扱うユースケースです。
+// 販売サイトなどの画像URLをそのまま表示すると、消えたり差し替わったりするうえ大きさもまちまちなので、
+// 取り込んで決まった大きさのサムネイルを作り、内容のハッシュを含むURLで配信します。
+type ImageUsecase struct {
+	products  repository.ProductRepository
+	blobs     repository.BlobStore
+	processor repository.ImageProcessor
+	fetcher   repository.ImageFetcher
+}
+
+// NewImageUsecase: ユースケースの作成
+func NewImageUsecase(products repository.ProductRepository, blobs repository.BlobStore, processor repository.ImageProcessor, fetcher repository.ImageFetcher) *ImageUsecase {
+	return &ImageUsecase{products: products, blobs: blobs, processor: processor, fetcher: fetcher}
+}
+
+// UploadProductImage: アップロードされた画像を製品画像にするユースケース
+func (u *ImageUsecase) UploadProductImage(ctx context.Context, productID string, data []byte) (*model.Product, error) {
+	p, err := u.getProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	return u.ingest(ctx, p, "", data)
+}
+
+// ImportProductImage: URL から画像を取得して製品画像にするユースケース
+func (u *ImageUsecase) ImportProductImage(ctx context.Context, productID, sourceURL string) (*model.Product, error) {
+	p, err := u.getProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := u.fetcher.Fetch(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	return u.ingest(ctx, p, sourceURL, data)
+}
+
+// ingest: 画像を取り込みます。
+// 1. 同じ画像を取り込み済みなら何もしない
+// 2. 画像を確かめてサムネイルを作る (不正な画像は model.ErrInvalidImage)
+// 3. サムネイルを内容のハッシュをキーにして保存する (内容が同じなら URL も同じ)
+// 4. 製品の画像を差し替え、ImageURL を代表のサムネイル (JPEG) の URL にする
+//
+// 差し替える前のサムネイルは消しません (同じ画像を他の製品でも使っていることがあるため)。
+func (u *ImageUsecase) ingest(ctx context.Context, p *model.Product, sourceURL string, data []byte) (*model.Product, error) {
+	// 1. 取り込み済みか
+	if p.Image != nil && p.Image.SourceHash == model.ContentHash(data) {
+		return p, nil
+	}
+
+	// 2. サムネイルを作る
+	processed, err := u.processor.Process(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 保存する
+	image := &model.ProductImage{
+		SourceHash: processed.SourceHash,
+		SourceURL:  sourceURL,
+		MIMEType:   processed.MIMEType,
+		Width:      processed.Width,
+		Height:     processed.Height,
+		IngestedAt: time.Now(),
+	}
+	for _, v := range processed.Variants {
+		key := v.Key()
+		if err := u.blobs.Put(ctx, key, v.Format.ContentType(), v.Data); err != nil {
+			return nil, err
+		}
+		image.Variants = append(image.Variants, model.ImageVariant{
+			Name:   v.Name,
+			Format: v.Format,
+			Width:  v.Width,
+			Height: v.Height,
+			Bytes:  len(v.Data),
+			Key:    key,
+			URL:    u.blobs.URL(key),
+		})
+	}
+	primary, ok := image.Variant(model.PrimaryImageVariant, model.ImageFormatJPEG)
+	if !ok {
+		return nil, fmt.Errorf("no %s jpeg variant was created", model.PrimaryImageVariant)
+	}
+
+	// 4. 製品を更新する (サムネイルを作っている間の他の更新を消さないよう、読み直してから保存します)
+	current, err := u.products.GetByID(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, model.ErrProductNotFound
+	}
+	updated := *current
+	updated.Image = image
+	updated.ImageURL = primary.URL
+	if err := u.products.Save(ctx, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// GetImage: 保存したサムネイルの中身と Content-Type を返すユースケース
+// サムネイルのキーの形をしていないものは、保存先に問い合わせずに model.ErrBlobNotFound にします。
+func (u *ImageUsecase) GetImage(ctx context.Context, key string) ([]byte, string, error) {
+	if !model.IsImageKey(key) {
+		return nil, "", model.ErrBlobNotFound
+	}
+	return u.blobs.Get(ctx, key)
+}
+
+func (u *ImageUsecase) getProduct(ctx context.Context, id string) (*model.Product, error) {
+	pid, err := model.NewProductID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+	}
+	p, err := u.products.GetByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, model.ErrProductNotFound
+	}
+	return p, nil
+}
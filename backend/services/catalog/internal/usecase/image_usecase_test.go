@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/blob"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/imaging"
+)
+
+// testPNG: 単色の PNG
+func testPNG(t *testing.T, w, h int, c color.Color) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func newTestImageUsecase(t *testing.T, f *refresherFixture) *ImageUsecase {
+	t.Helper()
+	store, err := blob.NewLocalStore(t.TempDir(), "http://localhost:8080/images")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// テストの画像サーバーはループバックにあるので、接続先を制限しないクライアントで取得します
+	return NewImageUsecase(f.repo, store, imaging.NewProcessor(), imaging.NewFetcher(http.DefaultClient, time.Second))
+}
+
+func TestImageUsecaseImportsImage(t *testing.T) {
+	ctx := context.Background()
+	photo := testPNG(t, 800, 600, color.RGBA{200, 30, 30, 255})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vacuum.png" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream") // 形式は中身から判定する
+		w.Write(photo)
+	}))
+	defer srv.Close()
+
+	f := newRefresherFixture()
+	f.create(t, "p1", 50000, "")
+	images := newTestImageUsecase(t, f)
+
+	p, err := images.ImportProductImage(ctx, "p1", srv.URL+"/vacuum.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Image == nil || len(p.Image.Variants) != 2*len(model.ImageVariantSpecs) {
+		t.Fatalf("image = %+v", p.Image)
+	}
+	if p.Image.MIMEType != "image/png" || p.Image.SourceURL != srv.URL+"/vacuum.png" || p.Image.SourceHash != model.ContentHash(photo) {
+		t.Errorf("image = %+v", p.Image)
+	}
+	large, _ := p.Image.Variant("large", model.ImageFormatJPEG)
+	if p.ImageURL != large.URL || large.Width != 800 || large.Height != 600 {
+		t.Errorf("image url = %q, large = %+v", p.ImageURL, large)
+	}
+	if saved := f.get(t, "p1"); saved.ImageURL != p.ImageURL || saved.Image == nil {
+		t.Errorf("saved product = %+v", saved)
+	}
+
+	// サムネイルは内容のハッシュを含むURLで配信される
+	for _, v := range p.Image.Variants {
+		key := strings.TrimPrefix(v.URL, "http://localhost:8080/images/")
+		data, contentType, err := images.GetImage(ctx, key)
+		if err != nil {
+			t.Fatalf("%s %s: %v", v.Name, v.Format, err)
+		}
+		if key != model.ContentHash(data)+v.Format.Extension() || contentType != v.Format.ContentType() || len(data) != v.Bytes {
+			t.Errorf("%s %s: key %s, content type %s, %d bytes", v.Name, v.Format, key, contentType, len(data))
+		}
+	}
+	if _, _, err := images.GetImage(ctx, "../../etc/passwd"); !errors.Is(err, model.ErrBlobNotFound) {
+		t.Errorf("GetImage with a path = %v, want ErrBlobNotFound", err)
+	}
+
+	// 同じ画像をアップロードし直しても作り直さない
+	again, err := images.UploadProductImage(ctx, "p1", photo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Image.IngestedAt.Equal(p.Image.IngestedAt) {
+		t.Error("the same image was ingested again")
+	}
+
+	// 取り込めない画像や取得できないURLでは、製品を変えない
+	for name, ingest := range map[string]func() error{
+		"not found": func() error { _, err := images.ImportProductImage(ctx, "p1", srv.URL+"/missing.png"); return err },
+		"not http":  func() error { _, err := images.ImportProductImage(ctx, "p1", "file:///etc/passwd"); return err },
+		"too small": func() error {
+			_, err := images.UploadProductImage(ctx, "p1", testPNG(t, 50, 50, color.White))
+			return err
+		},
+	} {
+		if err := ingest(); !errors.Is(err, model.ErrInvalidImage) {
+			t.Errorf("%s: err = %v, want ErrInvalidImage", name, err)
+		}
+	}
+	if got := f.get(t, "p1"); got.ImageURL != p.ImageURL {
+		t.Errorf("image url changed to %q", got.ImageURL)
+	}
+	if _, err := images.UploadProductImage(ctx, "unknown", photo); !errors.Is(err, model.ErrProductNotFound) {
+		t.Errorf("upload to unknown product = %v, want ErrProductNotFound", err)
+	}
+}
+
+func TestUpdateProductKeepsIngestedImage(t *testing.T) {
+	ctx := context.Background()
+	f := newRefresherFixture()
+	f.create(t, "p1", 50000, "")
+	images := newTestImageUsecase(t, f)
+	p, err := images.UploadProductImage(ctx, "p1", testPNG(t, 300, 300, color.White))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 管理画面から古い画像URLのまま更新しても、取り込んだ画像は変わらない
+	input := *f.get(t, "p1") // メモリのリポジトリは保存した製品そのものを返すのでコピーします
+	input.Image = nil
+	input.ImageURL = "https://example.com/old.jpg"
+	input.Description = "更新"
+	if _, err := f.products.UpdateProduct(ctx, &input); err != nil {
+		t.Fatal(err)
+	}
+	got := f.get(t, "p1")
+	if got.Description != "更新" || got.Image == nil || got.ImageURL != p.ImageURL {
+		t.Errorf("got description %q, image %v, image url %q", got.Description, got.Image != nil, got.ImageURL)
+	}
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// DefaultHostInterval: 同じホストへのリクエストの最小間隔
+const DefaultHostInterval = time.Second
+
+// maxConcurrentHosts: 同時に確認するホストの数の上限
+const maxConcurrentHosts = 8
+
+// LinkHealthChecker: 製品の購入リンク (販売サイトの商品ページ) が生きているかを定期的に確認し、
+// 問題のあった製品を管理者が確認すべきものとして記録します。
+// 販売サイトに負荷をかけないよう、ホストごとにリクエストの間隔を空けます (別のホストへは並行して確認します)。
+type LinkHealthChecker struct {
+	products     repository.ProductRepository
+	issues       repository.ProductIssueRepository
+	checker      repository.LinkChecker
+	hostInterval time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter // ホストごとのレート制限 (Run の間ずっと使い回す)
+}
+
+// NewLinkHealthChecker: hostInterval が0以下なら DefaultHostInterval を使います。
+func NewLinkHealthChecker(products repository.ProductRepository, issues repository.ProductIssueRepository, checker repository.LinkChecker, hostInterval time.Duration) *LinkHealthChecker {
+	if hostInterval <= 0 {
+		hostInterval = DefaultHostInterval
+	}
+	return &LinkHealthChecker{products: products, issues: issues, checker: checker, hostInterval: hostInterval, limiters: make(map[string]*rate.Limiter)}
+}
+
+// productLink: 確認する1つのリンク
+type productLink struct {
+	product  *model.Product
+	merchant string
+	url      string
+}
+
+// CheckAll: 全製品の購入リンクを確認し、製品ごとの問題を記録し直します。
+// 1. 製品ごとの購入リンク (販売サイトの商品ページ、なければ PurchaseLink) をホストごとに分ける
+// 2. ホストごとに並行して、レート制限を守りながら確認する
+// 3. 製品ごとに問題を置き換える (解消した問題は消え、続いている問題は最初に見つけた日時を引き継ぐ)
+//
+// 途中で ctx がキャンセルされた場合は、何も記録せずに終わります。
+func (c *LinkHealthChecker) CheckAll(ctx context.Context) (*model.LinkCheckReport, error) {
+	products, err := c.products.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. ホストごとに分ける
+	byHost := make(map[string][]productLink)
+	for _, p := range products {
+		for _, o := range p.PurchaseOffers() {
+			u, err := url.Parse(o.URL)
+			if err != nil {
+				continue
+			}
+			byHost[u.Host] = append(byHost[u.Host], productLink{product: p, merchant: o.Merchant, url: o.URL})
+		}
+	}
+
+	// 2. ホストごとに並行して確認する
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var stopped error // ctx の期限までに確認を終えられない、またはキャンセルされた
+	stop := func(err error) {
+		mu.Lock()
+		stopped = err
+		mu.Unlock()
+	}
+	results := make(map[model.ProductID][]model.ProductIssue)
+	report := &model.LinkCheckReport{ByStatus: make(map[model.LinkStatus]int)}
+	sem := make(chan struct{}, maxConcurrentHosts)
+	for host, links := range byHost {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				stop(ctx.Err())
+				return
+			}
+			limiter := c.limiter(host)
+			for _, l := range links {
+				if err := limiter.Wait(ctx); err != nil {
+					stop(err)
+					return
+				}
+				result, err := c.checker.Check(ctx, l.url)
+				if err != nil {
+					stop(err)
+					return
+				}
+				now := time.Now()
+				mu.Lock()
+				report.Checked++
+				report.ByStatus[result.Status]++
+				if result.Status.NeedsAttention() {
+					report.Issues++
+					results[l.product.ID] = append(results[l.product.ID], model.ProductIssue{
+						ProductID:     l.product.ID,
+						ProductName:   l.product.Name,
+						Merchant:      l.merchant,
+						URL:           l.url,
+						Status:        result.Status,
+						HTTPStatus:    result.HTTPStatus,
+						FinalURL:      result.FinalURL,
+						Detail:        result.Detail,
+						FirstSeenAt:   now,
+						LastCheckedAt: now,
+					})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if stopped != nil {
+		return report, stopped
+	}
+
+	// 3. 製品ごとに問題を置き換える
+	previous, err := c.issues.List(ctx)
+	if err != nil {
+		return report, err
+	}
+	firstSeen := make(map[issueKey]time.Time, len(previous))
+	stale := make(map[model.ProductID]bool)
+	for _, i := range previous {
+		firstSeen[keyOf(i)] = i.FirstSeenAt
+		stale[i.ProductID] = true
+	}
+	for id, issues := range results {
+		slices.SortFunc(issues, func(a, b model.ProductIssue) int { return cmp.Compare(a.URL, b.URL) })
+		for n := range issues {
+			if t, ok := firstSeen[keyOf(issues[n])]; ok {
+				issues[n].FirstSeenAt = t
+			}
+		}
+		if err := c.issues.Replace(ctx, id, issues); err != nil {
+			return report, err
+		}
+		delete(stale, id)
+	}
+	// 問題が解消した製品と、削除された製品
+	for id := range stale {
+		if err := c.issues.Replace(ctx, id, nil); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// issueKey: 同じリンクの同じ問題が続いているかの判定に使います。
+type issueKey struct {
+	productID model.ProductID
+	url       string
+	status    model.LinkStatus
+}
+
+func keyOf(i model.ProductIssue) issueKey {
+	return issueKey{productID: i.ProductID, url: i.URL, status: i.Status}
+}
+
+func (c *LinkHealthChecker) limiter(host string) *rate.Limiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l, ok := c.limiters[host]
+	if !ok {
+		l = rate.NewLimiter(rate.Every(c.hostInterval), 1)
+		c.limiters[host] = l
+	}
+	return l
+}
+
+// Run: ctx がキャンセルされるまで、interval ごとに CheckAll します。
+func (c *LinkHealthChecker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		report, err := c.CheckAll(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("failed to check purchase links: %v", err)
+		case err == nil:
+			log.Printf("checked purchase links: checked %d, issues %d", report.Checked, report.Issues)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/linkcheck"
+)
+
+// testShop: 商品ページを追加・削除できるサイト
+type testShop struct {
+	mu    sync.Mutex
+	pages map[string]string // パス -> 本文 (無いパスは 404)
+	srv   *httptest.Server
+}
+
+func newTestShop(t *testing.T) *testShop {
+	t.Helper()
+	s := &testShop{pages: make(map[string]string)}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		body, ok := s.pages[r.URL.Path]
+		s.mu.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintf(w, "<html><body>%s</body></html>", body)
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *testShop) set(path, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pages[path] = body
+}
+
+func (s *testShop) url(path string) string {
+	return s.srv.URL + path
+}
+
+func TestLinkHealthCheckerRecordsAndClearsIssues(t *testing.T) {
+	ctx := context.Background()
+	shop := newTestShop(t)
+	other := newTestShop(t)
+	shop.set("/items/ok", "在庫あり")
+	shop.set("/items/soft", "この商品は販売終了しました")
+	other.set("/items/stock", "ただいま在庫切れです")
+
+	f := newRefresherFixture()
+	f.create(t, "p1", 10000, shop.url("/items/ok"))
+	f.create(t, "p2", 10000, shop.url("/items/gone"))
+	f.create(t, "p3", 10000, "")
+	p3 := f.get(t, "p3")
+	p3.Offers = []model.MerchantOffer{
+		{Merchant: "shop", URL: shop.url("/items/soft")},
+		{Merchant: "other", URL: other.url("/items/stock")},
+	}
+	if _, err := f.products.UpdateProduct(ctx, p3); err != nil {
+		t.Fatal(err)
+	}
+
+	issueRepo := db.NewMemoryProductIssueRepository()
+	checker := NewLinkHealthChecker(f.repo, issueRepo, linkcheck.NewChecker(nil, time.Second), time.Millisecond)
+	issues := NewProductIssueUsecase(issueRepo)
+
+	report, err := checker.CheckAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 4 || report.Issues != 3 {
+		t.Fatalf("report = %+v", report)
+	}
+
+	list, err := issues.ListProductIssues(ctx, model.ProductIssueFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]model.LinkStatus)
+	for _, i := range list {
+		got[i.ProductID.String()+" "+i.Merchant] = i.Status
+	}
+	want := map[string]model.LinkStatus{
+		"p2 " + model.DirectMerchant: model.LinkNotFound,
+		"p3 shop":                    model.LinkSoftMissing,
+		"p3 other":                   model.LinkOutOfStock,
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("issues = %v, want %v", got, want)
+	}
+
+	// 絞り込み
+	list, err = issues.ListProductIssues(ctx, model.ProductIssueFilter{Statuses: []model.LinkStatus{model.LinkNotFound}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ProductID != "p2" {
+		t.Errorf("not_found issues = %+v", list)
+	}
+	if _, err := issues.ListProductIssues(ctx, model.ProductIssueFilter{Statuses: []model.LinkStatus{"broken"}}); err == nil {
+		t.Error("unknown status was accepted")
+	}
+
+	// 商品ページが戻った問題は消え、続いている問題は最初に見つけた日時を引き継ぐ
+	firstSeen := issueOf(t, issueRepo, "p3", "shop").FirstSeenAt
+	shop.set("/items/gone", "在庫あり")
+	report, err = checker.CheckAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Issues != 2 {
+		t.Fatalf("second report = %+v", report)
+	}
+	if got, _ := issueRepo.Get(ctx, "p2"); len(got) != 0 {
+		t.Errorf("p2 still has issues: %+v", got)
+	}
+	again := issueOf(t, issueRepo, "p3", "shop")
+	if !again.FirstSeenAt.Equal(firstSeen) || !again.LastCheckedAt.After(firstSeen) {
+		t.Errorf("first seen = %v, last checked = %v, want first seen kept at %v", again.FirstSeenAt, again.LastCheckedAt, firstSeen)
+	}
+
+	// カタログに無い (削除された) 製品の問題も消す
+	ghost := []model.ProductIssue{{ProductID: "ghost", URL: shop.url("/items/ghost"), Status: model.LinkNotFound}}
+	if err := issueRepo.Replace(ctx, "ghost", ghost); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := checker.CheckAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := issueRepo.Get(ctx, "ghost"); len(got) != 0 {
+		t.Errorf("issues of a deleted product = %+v", got)
+	}
+}
+
+func TestLinkHealthCheckerKeepsIssuesWhenCanceled(t *testing.T) {
+	shop := newTestShop(t)
+	f := newRefresherFixture()
+	f.create(t, "p1", 10000, shop.url("/items/a"))
+	f.create(t, "p2", 10000, shop.url("/items/b"))
+
+	issueRepo := db.NewMemoryProductIssueRepository()
+	previous := []model.ProductIssue{{ProductID: "p1", URL: shop.url("/items/a"), Status: model.LinkUnreachable}}
+	if err := issueRepo.Replace(context.Background(), "p1", previous); err != nil {
+		t.Fatal(err)
+	}
+
+	// 同じホストへの2件目は1時間後になるので、期限までに終わらない
+	checker := NewLinkHealthChecker(f.repo, issueRepo, linkcheck.NewChecker(nil, time.Second), time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := checker.CheckAll(ctx); err == nil {
+		t.Fatal("CheckAll returned no error")
+	}
+	if got := issueOf(t, issueRepo, "p1", ""); got.Status != model.LinkUnreachable {
+		t.Errorf("issue was overwritten by an unfinished check: %+v", got)
+	}
+}
+
+func issueOf(t *testing.T, repo repository.ProductIssueRepository, id model.ProductID, merchant string) model.ProductIssue {
+	t.Helper()
+	issues, err := repo.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range issues {
+		if i.Merchant == merchant {
+			return i
+		}
+	}
+	t.Fatalf("no issue for %s (%q) in %+v", id, merchant, issues)
+	return model.ProductIssue{}
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// DefaultMerchantInterval: 同じ販売サイトへのリクエストの最小間隔 (個別に指定しなかった販売サイト)
+const DefaultMerchantInterval = 2 * time.Second
+
+// PriceRefresher: 販売サイトから製品の価格と在庫状況を定期的に取得し、製品に反映します。
+// 価格が変わった場合は PriceUsecase を通して履歴に残すので、値下がりの検出も行われます。
+// 販売サイトに負荷をかけないよう、販売サイトごとにリクエストの間隔を空けます (別の販売サイトへは並行して取得します)。
+type PriceRefresher struct {
+	products repository.ProductRepository
+	prices   *PriceUsecase
+	sources  []repository.PriceSource
+	limiters map[string]*rate.Limiter // 販売サイトごとのレート制限 (Run の間ずっと使い回す)
+}
+
+// NewPriceRefresher: 作成
+// intervals には販売サイトごとのリクエストの最小間隔を指定します。指定のない販売サイトは DefaultMerchantInterval です。
+func NewPriceRefresher(products repository.ProductRepository, prices *PriceUsecase, sources []repository.PriceSource, intervals map[string]time.Duration) *PriceRefresher {
+	limiters := make(map[string]*rate.Limiter, len(sources))
+	for _, s := range sources {
+		interval, ok := intervals[s.Merchant()]
+		if !ok {
+			interval = DefaultMerchantInterval
+		}
+		limiters[s.Merchant()] = rate.NewLimiter(rate.Every(interval), 1)
+	}
+	return &PriceRefresher{products: products, prices: prices, sources: sources, limiters: limiters}
+}
+
+// RefreshAll: 全製品の価格と在庫状況を取得し直します。
+// 1. 商品ページに対応する販売サイトごとに製品を分ける (対応する販売サイトがなければ飛ばす)
+// 2. 販売サイトごとに並行して、レート制限を守りながら取得する
+// 3. 取得できた製品は、価格と在庫状況を更新して保存し、価格を履歴に残す
+//
+// 1件の取得・保存に失敗しても残りの製品は続けます (失敗した製品は前回の価格・在庫状況のまま)。
+// 商品ページが削除されていた場合は販売終了として扱います。
+func (r *PriceRefresher) RefreshAll(ctx context.Context) (*model.PriceRefreshReport, error) {
+	products, err := r.products.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 販売サイトごとに分ける
+	report := &model.PriceRefreshReport{}
+	queues := make(map[repository.PriceSource][]refreshTarget)
+	for _, p := range products {
+		source, link := r.sourceFor(p)
+		if source == nil {
+			report.Skipped++
+			continue
+		}
+		queues[source] = append(queues[source], refreshTarget{id: p.ID, link: link})
+	}
+
+	// 2. 販売サイトごとに並行して取得する
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var stopped error // ctx の期限までに次の取得ができない、またはキャンセルされた
+	for source, queue := range queues {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limiter := r.limiters[source.Merchant()]
+			for _, t := range queue {
+				if err := limiter.Wait(ctx); err != nil {
+					mu.Lock()
+					stopped = err
+					mu.Unlock()
+					return
+				}
+				quote, err := source.Fetch(ctx, t.link)
+				if errors.Is(err, model.ErrListingNotFound) {
+					quote, err = model.PriceQuote{Status: model.StockDiscontinued, FetchedAt: time.Now()}, nil
+				}
+
+				// 3. 製品に反映する
+				var changed bool
+				var drop *model.PriceDropEvent
+				if err == nil {
+					changed, drop, err = r.apply(ctx, source.Merchant(), t.id, quote)
+				}
+				mu.Lock()
+				switch {
+				case err != nil:
+					if ctx.Err() == nil {
+						log.Printf("failed to refresh price of %s from %s: %v", t.id, source.Merchant(), err)
+					}
+					report.Failed++
+				default:
+					report.Checked++
+					if changed {
+						report.PriceChanged++
+					}
+					if drop != nil {
+						report.Drops = append(report.Drops, drop)
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if stopped != nil {
+		return report, stopped
+	}
+	return report, ctx.Err()
+}
+
+// refreshTarget: 価格を取得する製品と、その販売サイトの商品ページ
+type refreshTarget struct {
+	id   model.ProductID
+	link string
+}
+
+// sourceFor: 製品の商品ページ (販売サイトごとの Offers、なければ購入リンク) のうち、取得できるものを返します。
+// 販売サイトの登録順に探すので、複数の販売サイトで売っていれば最初に登録した販売サイトから取得します。
+func (r *PriceRefresher) sourceFor(p *model.Product) (repository.PriceSource, string) {
+	offers := p.PurchaseOffers()
+	for _, s := range r.sources {
+		for _, o := range offers {
+			if s.Supports(o.URL) {
+				return s, o.URL
+			}
+		}
+	}
+	return nil, ""
+}
+
+// apply: 取得した価格と在庫状況を製品に反映します。
+// 取得している間に管理画面で更新されていることもあるため、保存の直前に読み直してから変更します。
+func (r *PriceRefresher) apply(ctx context.Context, merchant string, id model.ProductID, quote model.PriceQuote) (bool, *model.PriceDropEvent, error) {
+	current, err := r.products.GetByID(ctx, id)
+	if err != nil {
+		return false, nil, err
+	}
+	if current == nil {
+		// 取得している間に削除された
+		return false, nil, model.ErrProductNotFound
+	}
+	// リポジトリが保持している製品を直接書き換えないよう、コピーを変更します
+	updated := *current
+	updated.Availability = model.Availability{Status: quote.Status, Merchant: merchant, CheckedAt: quote.FetchedAt}
+	changed := quote.HasPrice && quote.Amount != current.Price.Amount()
+	if changed {
+		price, err := value.NewPrice(quote.Amount)
+		if err != nil {
+			return false, nil, err
+		}
+		updated.Price = price
+	}
+	if err := r.products.Save(ctx, &updated); err != nil {
+		return false, nil, err
+	}
+	drop, err := r.prices.RecordPrice(ctx, &updated, model.MerchantPriceSource(merchant), quote.FetchedAt)
+	if err != nil {
+		return false, nil, err
+	}
+	return changed, drop, nil
+}
+
+// Run: ctx がキャンセルされるまで、interval ごとに RefreshAll します。
+func (r *PriceRefresher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		report, err := r.RefreshAll(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("failed to refresh prices: %v", err)
+		case err == nil:
+			log.Printf("refreshed prices: checked %d, changed %d, drops %d, failed %d, skipped %d", report.Checked, report.PriceChanged, len(report.Drops), report.Failed, report.Skipped)
+			for _, drop := range report.Drops {
+				log.Printf("price drop: %s", drop)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/infrastructure/merchant"
+)
+
+// testMerchant: テスト用の販売サイト (StubMerchant) と、その取得方法
+type testMerchant struct {
+	stub   *merchant.StubMerchant
+	srv    *httptest.Server
+	source repository.PriceSource
+}
+
+func newTestMerchant(t *testing.T, name string) *testMerchant {
+	t.Helper()
+	stub := merchant.NewStubMerchant()
+	srv := httptest.NewServer(stub)
+	t.Cleanup(srv.Close)
+	return &testMerchant{stub: stub, srv: srv, source: merchant.NewStructuredDataSource(srv.Client(), name, srv.Listener.Addr().String())}
+}
+
+func (m *testMerchant) link(id string) string {
+	return m.srv.URL + "/items/" + id
+}
+
+type refresherFixture struct {
+	products *ProductUsecase
+	prices   *PriceUsecase
+	repo     repository.ProductRepository
+}
+
+func newRefresherFixture() *refresherFixture {
+	repo := db.NewMemoryProductRepository()
+	prices := NewPriceUsecase(db.NewMemoryPriceHistoryRepository(), db.NewMemoryPriceDropRepository(), service.NewPriceDropDetector(service.DefaultPriceDropWindow))
+	return &refresherFixture{products: NewProductUsecase(repo, prices), prices: prices, repo: repo}
+}
+
+func (f *refresherFixture) create(t *testing.T, id string, amount int32, link string) {
+	t.Helper()
+	price, err := value.NewPrice(amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &model.Product{ID: model.ProductID(id), Name: id, Price: price, PurchaseLink: link, Category: model.CategoryRobotVacuum}
+	if _, err := f.products.CreateProduct(context.Background(), p); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *refresherFixture) get(t *testing.T, id string) *model.Product {
+	t.Helper()
+	p, err := f.products.GetProduct(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPriceRefresherUpdatesPriceThroughHistory(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMerchant(t, "stub")
+	f := newRefresherFixture()
+	f.create(t, "p1", 50000, m.link("p1"))
+	m.stub.SetItem("p1", merchant.StubItem{Name: "p1", Price: 45000, Status: model.StockInStock})
+
+	r := NewPriceRefresher(f.repo, f.prices, []repository.PriceSource{m.source}, map[string]time.Duration{"stub": time.Millisecond})
+	report, err := r.RefreshAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 1 || report.PriceChanged != 1 || len(report.Drops) != 1 || report.Failed != 0 {
+		t.Fatalf("report = %+v", report)
+	}
+	if drop := report.Drops[0]; drop.PreviousPrice != 50000 || drop.Price != 45000 || drop.Source != "merchant:stub" {
+		t.Errorf("drop = %+v", drop)
+	}
+
+	p := f.get(t, "p1")
+	if p.Price.Amount() != 45000 {
+		t.Errorf("price = %d, want 45000", p.Price.Amount())
+	}
+	if p.Availability.Status != model.StockInStock || p.Availability.Merchant != "stub" || p.Availability.CheckedAt.IsZero() {
+		t.Errorf("availability = %+v", p.Availability)
+	}
+
+	history, err := f.prices.GetPriceHistory(ctx, "p1", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history.Points) != 2 || history.Points[0].Source != model.PriceSourceAdmin || history.Points[1].Source != "merchant:stub" || history.Points[1].Amount != 45000 {
+		t.Errorf("history = %+v", history.Points)
+	}
+
+	// 価格が変わらなければ、在庫状況だけを更新して履歴には残さない
+	m.stub.SetItem("p1", merchant.StubItem{Name: "p1", Price: 45000, Status: model.StockOutOfStock})
+	report, err = r.RefreshAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 1 || report.PriceChanged != 0 || len(report.Drops) != 0 {
+		t.Fatalf("second report = %+v", report)
+	}
+	if got := f.get(t, "p1").Availability.Status; got != model.StockOutOfStock {
+		t.Errorf("status = %q, want out_of_stock", got)
+	}
+	history, _ = f.prices.GetPriceHistory(ctx, "p1", time.Time{})
+	if len(history.Points) != 2 {
+		t.Errorf("history has %d points, want 2", len(history.Points))
+	}
+}
+
+func TestPriceRefresherMarksRemovedListingDiscontinued(t *testing.T) {
+	m := newTestMerchant(t, "stub")
+	f := newRefresherFixture()
+	f.create(t, "p1", 30000, m.link("p1"))
+
+	r := NewPriceRefresher(f.repo, f.prices, []repository.PriceSource{m.source}, map[string]time.Duration{"stub": time.Millisecond})
+	report, err := r.RefreshAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 1 || report.Failed != 0 {
+		t.Fatalf("report = %+v", report)
+	}
+	p := f.get(t, "p1")
+	if p.Availability.Status != model.StockDiscontinued {
+		t.Errorf("status = %q, want discontinued", p.Availability.Status)
+	}
+	if p.Price.Amount() != 30000 {
+		t.Errorf("price = %d, want the previous price 30000", p.Price.Amount())
+	}
+}
+
+func TestPriceRefresherSkipsUnsupportedLinks(t *testing.T) {
+	m := newTestMerchant(t, "stub")
+	f := newRefresherFixture()
+	f.create(t, "p1", 30000, "https://shop.example.com/items/p1")
+	f.create(t, "p2", 30000, "")
+	f.create(t, "p3", 30000, m.link("p3"))
+	m.stub.SetItem("p3", merchant.StubItem{Name: "p3", Price: 30000, Status: model.StockInStock})
+
+	r := NewPriceRefresher(f.repo, f.prices, []repository.PriceSource{m.source}, map[string]time.Duration{"stub": time.Millisecond})
+	report, err := r.RefreshAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Skipped != 2 || report.Checked != 1 {
+		t.Errorf("report = %+v", report)
+	}
+	if got := len(m.stub.Requests()); got != 1 {
+		t.Errorf("merchant received %d requests, want 1", got)
+	}
+}
+
+func TestPriceRefresherUsesMerchantOffers(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMerchant(t, "stub")
+	f := newRefresherFixture()
+	// 購入リンクを持たず、販売サイトごとの商品ページだけを持つ製品
+	price, _ := value.NewPrice(50000)
+	p := &model.Product{ID: "p1", Name: "p1", Price: price, Category: model.CategoryRobotVacuum, Offers: []model.MerchantOffer{
+		{Merchant: "other", URL: "https://shop.example.com/items/p1"},
+		{Merchant: "stub", URL: m.link("p1")},
+	}}
+	if _, err := f.products.CreateProduct(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+	m.stub.SetItem("p1", merchant.StubItem{Name: "p1", Price: 42000, Status: model.StockInStock})
+
+	r := NewPriceRefresher(f.repo, f.prices, []repository.PriceSource{m.source}, map[string]time.Duration{"stub": time.Millisecond})
+	report, err := r.RefreshAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 1 || report.Skipped != 0 || report.PriceChanged != 1 {
+		t.Fatalf("report = %+v", report)
+	}
+	if got := f.get(t, "p1"); got.Price.Amount() != 42000 || got.Availability.Merchant != "stub" {
+		t.Errorf("price = %d, availability = %+v", got.Price.Amount(), got.Availability)
+	}
+}
+
+func TestPriceRefresherRateLimitsPerMerchant(t *testing.T) {
+	const interval = 60 * time.Millisecond
+	a := newTestMerchant(t, "a")
+	b := newTestMerchant(t, "b")
+	f := newRefresherFixture()
+	for _, id := range []string{"a1", "a2", "a3"} {
+		f.create(t, id, 10000, a.link(id))
+		a.stub.SetItem(id, merchant.StubItem{Name: id, Price: 10000, Status: model.StockInStock})
+	}
+	for _, id := range []string{"b1", "b2", "b3"} {
+		f.create(t, id, 10000, b.link(id))
+		b.stub.SetItem(id, merchant.StubItem{Name: id, Price: 10000, Status: model.StockInStock})
+	}
+
+	r := NewPriceRefresher(f.repo, f.prices, []repository.PriceSource{a.source, b.source}, map[string]time.Duration{"a": interval, "b": interval})
+	report, err := r.RefreshAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 6 {
+		t.Fatalf("report = %+v", report)
+	}
+
+	// 同じ販売サイトへのリクエストは間隔を空ける
+	ra, rb := a.stub.Requests(), b.stub.Requests()
+	for _, requests := range [][]time.Time{ra, rb} {
+		if len(requests) != 3 {
+			t.Fatalf("got %d requests, want 3", len(requests))
+		}
+		for i := 1; i < len(requests); i++ {
+			// タイマーの誤差を見込んで少し緩めに確かめます
+			if gap := requests[i].Sub(requests[i-1]); gap < interval*3/4 {
+				t.Errorf("requests %d and %d were %v apart, want at least %v", i-1, i, gap, interval)
+			}
+		}
+	}
+	// 別の販売サイトへは並行して取得する (順番に取得すると、一方の最初のリクエストが他方の最後より後になる)
+	if !ra[0].Before(rb[2]) || !rb[0].Before(ra[2]) {
+		t.Errorf("merchants were not refreshed concurrently: a=%v b=%v", ra, rb)
+	}
+}
+
+func TestPriceRefresherStopsOnCancel(t *testing.T) {
+	m := newTestMerchant(t, "stub")
+	f := newRefresherFixture()
+	for _, id := range []string{"p1", "p2", "p3"} {
+		f.create(t, id, 10000, m.link(id))
+		m.stub.SetItem(id, merchant.StubItem{Name: id, Price: 10000, Status: model.StockInStock})
+	}
+
+	r := NewPriceRefresher(f.repo, f.prices, []repository.PriceSource{m.source}, map[string]time.Duration{"stub": time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	report, err := r.RefreshAll(ctx)
+	if err == nil {
+		t.Fatal("RefreshAll returned no error after the context was canceled")
+	}
+	if report.Checked != 1 {
+		t.Errorf("checked %d products, want 1 before the rate limit", report.Checked)
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/service"
+)
+
+// PriceUsecase: 価格の履歴と値下がりの検出を扱うユースケースです。
+// 製品の価格は value.Price で現在の値しか持たないため、変更のたびにここで履歴に残します。
+type PriceUsecase struct {
+	history  repository.PriceHistoryRepository
+	drops    repository.PriceDropRepository
+	detector *service.PriceDropDetector
+}
+
+// NewPriceUsecase: ユースケースの作成
+func NewPriceUsecase(history repository.PriceHistoryRepository, drops repository.PriceDropRepository, detector *service.PriceDropDetector) *PriceUsecase {
+	return &PriceUsecase{history: history, drops: drops, detector: detector}
+}
+
+// RecordPrice: 製品の現在の価格を履歴に記録するユースケース
+// 1. 直前の価格と同じなら何もしない (価格が変わったときだけ記録する)
+// 2. 履歴に追加する
+// 3. 直近の最安値を下回っていれば、値下がりイベントを保存して返す
+func (u *PriceUsecase) RecordPrice(ctx context.Context, p *model.Product, source string, at time.Time) (*model.PriceDropEvent, error) {
+	history, err := u.history.Get(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	point := model.PricePoint{ProductID: p.ID, Amount: p.Price.Amount(), Source: source, RecordedAt: at}
+	if latest, ok := history.Latest(); ok && latest.Amount == point.Amount {
+		return nil, nil
+	}
+	if err := u.history.Append(ctx, point); err != nil {
+		return nil, err
+	}
+
+	event, dropped := u.detector.Detect(p, history, point)
+	if !dropped {
+		return nil, nil
+	}
+	event.ID = uuid.NewString()
+	if err := u.drops.Save(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GetPriceHistory: 製品の価格の履歴を取得するユースケース
+// since を指定した場合は、それ以降の変更と、その時点でついていた価格を返します。
+func (u *PriceUsecase) GetPriceHistory(ctx context.Context, id string, since time.Time) (model.PriceHistory, error) {
+	pid, err := model.NewProductID(id)
+	if err != nil {
+		return model.PriceHistory{}, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+	}
+	history, err := u.history.Get(ctx, pid)
+	if err != nil {
+		return model.PriceHistory{}, err
+	}
+	return history.Since(since), nil
+}
+
+// ListPriceDrops: since より後に検出した値下がりイベントを取得するユースケース
+// 採用計画で購入前の製品を持つユーザーに知らせるため、製品IDで絞り込めます。
+func (u *PriceUsecase) ListPriceDrops(ctx context.Context, since time.Time, productIDs []string) ([]*model.PriceDropEvent, error) {
+	pids := make([]model.ProductID, 0, len(productIDs))
+	for _, id := range productIDs {
+		pid, err := model.NewProductID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+		}
+		pids = append(pids, pid)
+	}
+	return u.drops.List(ctx, since, pids)
+}
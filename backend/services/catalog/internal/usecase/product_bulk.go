@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// ProductRowReader: 一括登録ファイルを1行ずつ読み取ります。
+// ファイルの形式 (CSV / NDJSON) ごとの読み取りは Interface 層で実装します。
+// 読み終わったら io.EOF を、行単位で続行できないエラー (ヘッダーが不正など) はそのエラーを返します。
+type ProductRowReader interface {
+	Next() (model.ProductRow, error)
+}
+
+// ImportProducts: 製品を一括登録 (SKUで作成・更新) するユースケース
+// 1. 1行ずつ読み取り、形式の不正な行はエラーとして記録する
+// 2. 1回の取り込みの中でSKUが重複した行はエラーにする (どちらを採用すべきか判断できないため)
+// 3. SKUで既存の製品を探し、あれば同じIDで上書き、なければ新しいIDで作成する
+// 4. dryRun でなければ保存する
+//
+// 既存の製品と内容が同じ行は保存しません (同じファイルを何度取り込んでも結果が変わらないようにするため)。
+func (u *ProductUsecase) ImportProducts(ctx context.Context, rows ProductRowReader, dryRun bool) (*model.ImportReport, error) {
+	report := &model.ImportReport{DryRun: dryRun}
+	seen := make(map[string]model.ProductRow) // SKU -> 最初に出てきた行
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			return report, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// 行の内容の問題はその行だけを飛ばし、保存先の障害は取り込み全体を中断します
+		if err := validateRow(row, seen); err != nil {
+			report.Record(row, model.ImportFailed, err)
+			continue
+		}
+		action, err := u.upsertBySKU(ctx, row.Product, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s: %w", row.Location(), err)
+		}
+		report.Record(row, action, nil)
+	}
+}
+
+// validateRow: 行の内容をチェックします。
+func validateRow(row model.ProductRow, seen map[string]model.ProductRow) error {
+	if row.Err != nil {
+		return row.Err
+	}
+	p := row.Product
+	if err := p.ValidateImport(); err != nil {
+		return err
+	}
+	if first, ok := seen[p.SKU]; ok {
+		return fmt.Errorf("%w: duplicated sku %q (first seen at %s)", model.ErrInvalidProduct, p.SKU, first.Location())
+	}
+	seen[p.SKU] = row
+	return nil
+}
+
+// upsertBySKU: SKUで既存の製品を探して作成・更新します。
+func (u *ProductUsecase) upsertBySKU(ctx context.Context, p *model.Product, dryRun bool) (model.ImportAction, error) {
+	existing, err := u.repo.GetBySKU(ctx, p.SKU)
+	if err != nil {
+		return "", err
+	}
+	action := model.ImportCreated
+	if existing != nil {
+		p.ID = existing.ID
+		p.Availability = existing.Availability // 在庫状況は価格の取得でだけ更新します
+		p.Offers = existing.Offers             // 販売サイトはファイルの列にないため、管理画面で登録したものを引き継ぎます
+		keepImage(p, existing)
+		if p.SameImportableFields(existing) {
+			return model.ImportUnchanged, nil
+		}
+		action = model.ImportUpdated
+	} else {
+		id, err := model.NewProductID(uuid.NewString())
+		if err != nil {
+			return "", err
+		}
+		p.ID = id
+	}
+	if dryRun {
+		return action, nil
+	}
+	if err := u.repo.Save(ctx, p); err != nil {
+		return "", err
+	}
+	if err := u.recordPrice(ctx, p, model.PriceSourceImport); err != nil {
+		return "", err
+	}
+	return action, nil
+}
+
+// SeedProducts: 開発用のフィクスチャを読み込むユースケース
+// ImportProducts と同じくSKUで作成・更新するので、何度実行しても同じ状態になります。
+// 一部だけ読み込まれた状態にならないよう、先に全行を dry run で確かめ、
+// 不正な行が1つでもあれば何も保存せずに結果とエラーを返します。
+func (u *ProductUsecase) SeedProducts(ctx context.Context, rows ProductRowReader) (*model.ImportReport, error) {
+	// 1. 2回読むため、すべての行をメモリに読み込む (フィクスチャは小さい前提)
+	var all []model.ProductRow
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, row)
+	}
+
+	// 2. 保存せずに全行を確かめる
+	report, err := u.ImportProducts(ctx, &rowSlice{rows: all}, true)
+	if err != nil {
+		return nil, err
+	}
+	if report.Failed > 0 {
+		return report, fmt.Errorf("%w: %d of %d fixture rows are invalid", model.ErrInvalidProduct, report.Failed, len(all))
+	}
+
+	// 3. 保存する
+	return u.ImportProducts(ctx, &rowSlice{rows: all}, false)
+}
+
+// rowSlice: 読み込み済みの行を ProductRowReader として返します。
+type rowSlice struct {
+	rows []model.ProductRow
+	next int
+}
+
+func (s *rowSlice) Next() (model.ProductRow, error) {
+	if s.next >= len(s.rows) {
+		return model.ProductRow{}, io.EOF
+	}
+	s.next++
+	return s.rows[s.next-1], nil
+}
+
+// ExportProducts: 全製品をSKU順 (SKUのない製品はその後にID順) で1件ずつ emit に渡すユースケース
+func (u *ProductUsecase) ExportProducts(ctx context.Context, emit func(p *model.Product) error) error {
+	products, err := u.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(products, func(i, j int) bool {
+		a, b := products[i], products[j]
+		if (a.SKU == "") != (b.SKU == "") {
+			return a.SKU != ""
+		}
+		if a.SKU != b.SKU {
+			return a.SKU < b.SKU
+		}
+		return a.ID < b.ID
+	})
+	for _, p := range products {
+		if err := emit(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSKU: SKUが他の製品で使われていないかを確かめます (作成・更新時)。
+func (u *ProductUsecase) checkSKU(ctx context.Context, p *model.Product) error {
+	if p.SKU == "" {
+		return nil
+	}
+	other, err := u.repo.GetBySKU(ctx, p.SKU)
+	if err != nil {
+		return err
+	}
+	if other != nil && other.ID != p.ID {
+		return fmt.Errorf("%w: sku %q is already used by product %s", model.ErrInvalidProduct, p.SKU, other.ID)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+)
+
+// importRow: 一括登録ファイルの1行 (リーダーが返す形) を作ります。
+func importRow(line int, sku, name string, amount int32) model.ProductRow {
+	price, _ := value.NewPrice(amount)
+	return model.ProductRow{Line: line, SKU: sku, Product: &model.Product{
+		SKU:      sku,
+		Name:     name,
+		Price:    price,
+		Category: model.CategoryRobotVacuum,
+	}}
+}
+
+func rows(list ...model.ProductRow) ProductRowReader {
+	return &rowSlice{rows: list}
+}
+
+func TestImportProducts(t *testing.T) {
+	ctx := context.Background()
+	f := newRefresherFixture()
+
+	// 1. 作成。形式の不正な行と、SKUが重複した行は飛ばす
+	report, err := f.products.ImportProducts(ctx, rows(
+		importRow(2, "SKU-1", "掃除機A", 30000),
+		importRow(3, "SKU-2", "掃除機B", 40000),
+		model.ProductRow{Line: 4, SKU: "SKU-3", Err: errors.New("invalid integer \"abc\"")},
+		importRow(5, "SKU-1", "掃除機A (重複)", 31000),
+		importRow(6, "", "SKUなし", 1000),
+	), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Created != 2 || report.Failed != 3 || len(report.Errors) != 3 {
+		t.Fatalf("report = %+v", report)
+	}
+	if e := report.Errors[1]; e.Line != 5 || e.SKU != "SKU-1" {
+		t.Errorf("duplicate error = %+v", e)
+	}
+	created, err := f.repo.GetBySKU(ctx, "SKU-1")
+	if err != nil || created == nil {
+		t.Fatalf("SKU-1 = %v, %v", created, err)
+	}
+
+	// 2. 同じ内容の取り込み直しは保存しない。価格を変えた行は同じIDで更新する
+	report, err = f.products.ImportProducts(ctx, rows(
+		importRow(2, "SKU-1", "掃除機A", 30000),
+		importRow(3, "SKU-2", "掃除機B", 35000),
+	), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Unchanged != 1 || report.Updated != 1 || report.Created != 0 {
+		t.Fatalf("second report = %+v", report)
+	}
+	updated, _ := f.repo.GetBySKU(ctx, "SKU-2")
+	if updated.Price.Amount() != 35000 {
+		t.Errorf("SKU-2 price = %d, want 35000", updated.Price.Amount())
+	}
+	history, _ := f.prices.GetPriceHistory(ctx, string(updated.ID), time.Time{})
+	if len(history.Points) != 2 || history.Points[1].Source != model.PriceSourceImport {
+		t.Errorf("history = %+v", history.Points)
+	}
+
+	// 3. dry run では件数だけを返して保存しない
+	report, err = f.products.ImportProducts(ctx, rows(importRow(2, "SKU-9", "新製品", 1000)), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.DryRun || report.Created != 1 {
+		t.Fatalf("dry run report = %+v", report)
+	}
+	if p, _ := f.repo.GetBySKU(ctx, "SKU-9"); p != nil {
+		t.Errorf("dry run saved %+v", p)
+	}
+}
+
+// 管理画面で登録した製品は空のリストを持つことがあり、ファイルから読んだ行は nil を持ちます。
+// また在庫状況や販売サイトはファイルにないため、取り込み直しても変更とはみなしません。
+func TestImportProductsIgnoresNonImportableDifferences(t *testing.T) {
+	ctx := context.Background()
+	f := newRefresherFixture()
+	price, _ := value.NewPrice(30000)
+	existing := &model.Product{
+		ID:           "p1",
+		SKU:          "SKU-1",
+		Name:         "掃除機A",
+		Price:        price,
+		Category:     model.CategoryRobotVacuum,
+		StrongPoints: []string{},
+		WeakPoints:   []string{},
+		Connectivity: model.Connectivity{Protocols: []model.Protocol{}},
+		Offers:       []model.MerchantOffer{{Merchant: "rakuten", URL: "https://example.com/p1"}},
+		Availability: model.Availability{Status: model.StockInStock, Merchant: "rakuten", CheckedAt: time.Now().In(time.FixedZone("JST", 9*60*60))},
+	}
+	if _, err := f.products.CreateProduct(ctx, existing); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := f.products.ImportProducts(ctx, rows(importRow(2, "SKU-1", "掃除機A", 30000)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Unchanged != 1 || report.Updated != 0 {
+		t.Fatalf("report = %+v", report)
+	}
+	got := f.get(t, "p1")
+	if len(got.Offers) != 1 || got.Availability.Status != model.StockInStock {
+		t.Errorf("offers or availability were not kept: %+v", got)
+	}
+}
+
+func TestSeedProducts(t *testing.T) {
+	ctx := context.Background()
+	f := newRefresherFixture()
+
+	// 1. 不正な行が1つでもあれば何も保存しない
+	bad := model.ProductRow{Line: 3, SKU: "SKU-2", Product: &model.Product{SKU: "SKU-2", Name: "不明なカテゴリ", Category: "toaster"}}
+	report, err := f.products.SeedProducts(ctx, rows(importRow(2, "SKU-1", "掃除機A", 30000), bad))
+	if !errors.Is(err, model.ErrInvalidProduct) {
+		t.Fatalf("err = %v, want ErrInvalidProduct", err)
+	}
+	if report == nil || report.Failed != 1 {
+		t.Fatalf("report = %+v", report)
+	}
+	if p, _ := f.repo.GetBySKU(ctx, "SKU-1"); p != nil {
+		t.Errorf("valid row was saved although another row was invalid: %+v", p)
+	}
+
+	// 2. すべて正しければ保存し、何度実行しても同じ状態になる
+	for i, want := range []model.ImportReport{{Created: 2}, {Unchanged: 2}} {
+		report, err := f.products.SeedProducts(ctx, rows(importRow(2, "SKU-1", "掃除機A", 30000), importRow(3, "SKU-2", "掃除機B", 40000)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.DryRun || report.Created != want.Created || report.Unchanged != want.Unchanged || report.Failed != 0 {
+			t.Errorf("run %d: report = %+v", i+1, report)
+		}
+	}
+	list, _ := f.products.ListProducts(ctx)
+	if len(list) != 2 {
+		t.Errorf("products = %d, want 2", len(list))
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// ProductIssueUsecase: 購入リンクの確認で見つかった問題を管理者に見せるユースケースです。
+type ProductIssueUsecase struct {
+	issues repository.ProductIssueRepository
+}
+
+// NewProductIssueUsecase: ユースケースの作成
+func NewProductIssueUsecase(issues repository.ProductIssueRepository) *ProductIssueUsecase {
+	return &ProductIssueUsecase{issues: issues}
+}
+
+// ListProductIssues: 問題のある製品の一覧を取得するユースケース
+// 1. 絞り込みの条件をチェックする
+// 2. 問題を製品IDの順で返す
+func (u *ProductIssueUsecase) ListProductIssues(ctx context.Context, filter model.ProductIssueFilter) ([]model.ProductIssue, error) {
+	for _, s := range filter.Statuses {
+		if !s.NeedsAttention() || !s.IsValid() {
+			return nil, fmt.Errorf("%w: unknown issue status %q", model.ErrInvalidSearchQuery, s)
+		}
+	}
+	issues, err := u.issues.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list []model.ProductIssue
+	for _, i := range issues {
+		if filter.Matches(i) {
+			list = append(list, i)
+		}
+	}
+	return list, nil
+}
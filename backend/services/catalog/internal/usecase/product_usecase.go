@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
@@ -12,13 +14,14 @@ import (
 // API層（Handler）からリクエストを受け取り、ドメインモデルやリポジトリを使って
 // 業務ロジック（ID生成、保存、検索など）を組み立てて実行します。
 type ProductUsecase struct {
-	repo repository.ProductRepository // データをどこに保存するかを知っている人（依存性注入）
+	repo   repository.ProductRepository // データをどこに保存するかを知っている人（依存性注入）
+	prices *PriceUsecase                // 保存のたびに価格の変更を履歴に残す
 }
 
 // NewProductUsecase: ユースケースの作成
 // リポジトリの実装を受け取ることで、保存先がメモリでもDBでも気にせず動くようになっています。
-func NewProductUsecase(repo repository.ProductRepository) *ProductUsecase {
-	return &ProductUsecase{repo: repo}
+func NewProductUsecase(repo repository.ProductRepository, prices *PriceUsecase) *ProductUsecase {
+	return &ProductUsecase{repo: repo, prices: prices}
 }
 
 // CreateProduct: 製品作成のユースケース
@@ -36,10 +39,21 @@ func (u *ProductUsecase) CreateProduct(ctx context.Context, input *model.Product
 		input.ID = id
 	}
 
-	// データの保存
+	// 業務ルールのチェック (自動化効果の値域、SKUの重複など)
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	if err := u.checkSKU(ctx, input); err != nil {
+		return nil, err
+	}
+
+	// データの保存 (価格は履歴にも残します)
 	if err := u.repo.Save(ctx, input); err != nil {
 		return nil, err
 	}
+	if err := u.recordPrice(ctx, input, model.PriceSourceAdmin); err != nil {
+		return nil, err
+	}
 	return input, nil
 }
 
@@ -59,3 +73,102 @@ func (u *ProductUsecase) GetProduct(ctx context.Context, id string) (*model.Prod
 	}
 	return u.repo.GetByID(ctx, pid)
 }
+
+// MaxBatchGetProducts: BatchGetProducts で一度に指定できるIDの数の上限
+const MaxBatchGetProducts = 500
+
+// BatchGetProducts: 複数の製品をまとめて取得するユースケース
+// 提案プランの表示で製品ごとに GetProduct を呼ばずに済むようにするためのものです。
+// 1. IDをチェックし、重複を取り除く (最初に出てきた順序を保ちます)
+// 2. リポジトリからまとめて取得する
+// 3. 見つかった製品はリクエストの順序で、見つからなかったIDは別のリストで返す (一部が無くてもエラーにしません)
+func (u *ProductUsecase) BatchGetProducts(ctx context.Context, ids []string) (*model.ProductBatch, error) {
+	seen := make(map[model.ProductID]bool, len(ids))
+	pids := make([]model.ProductID, 0, len(ids))
+	for _, id := range ids {
+		pid, err := model.NewProductID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+		}
+		if !seen[pid] {
+			seen[pid] = true
+			pids = append(pids, pid)
+		}
+	}
+	if len(pids) > MaxBatchGetProducts {
+		return nil, fmt.Errorf("%w: %d ids (max %d)", model.ErrTooManyProducts, len(pids), MaxBatchGetProducts)
+	}
+	batch := &model.ProductBatch{}
+	if len(pids) == 0 {
+		return batch, nil
+	}
+
+	products, err := u.repo.GetByIDs(ctx, pids)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range products {
+		if p == nil {
+			batch.MissingIDs = append(batch.MissingIDs, pids[i])
+			continue
+		}
+		batch.Products = append(batch.Products, p)
+	}
+	return batch, nil
+}
+
+// UpdateProduct: 製品更新のユースケース
+// 1. 対象の製品が存在するか確認する
+// 2. 業務ルールをチェックしてから上書き保存する
+func (u *ProductUsecase) UpdateProduct(ctx context.Context, input *model.Product) (*model.Product, error) {
+	if _, err := model.NewProductID(input.ID.String()); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidProduct, err)
+	}
+	current, err := u.repo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, model.ErrProductNotFound
+	}
+	// 在庫状況は価格の取得でだけ、取り込んだ画像は画像の取り込みでだけ更新します
+	input.Availability = current.Availability
+	keepImage(input, current)
+
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	if err := u.checkSKU(ctx, input); err != nil {
+		return nil, err
+	}
+	if err := u.repo.Save(ctx, input); err != nil {
+		return nil, err
+	}
+	if err := u.recordPrice(ctx, input, model.PriceSourceAdmin); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// keepImage: 取り込んだ画像を引き継ぎます。画像を取り込んだ製品は、ImageURL もそのサムネイルのままにします。
+func keepImage(p, current *model.Product) {
+	p.Image = current.Image
+	if current.Image != nil {
+		p.ImageURL = current.ImageURL
+	}
+}
+
+// recordPrice: 保存した製品の価格が変わっていれば履歴に記録します。
+func (u *ProductUsecase) recordPrice(ctx context.Context, p *model.Product, source string) error {
+	_, err := u.prices.RecordPrice(ctx, p, source, time.Now())
+	return err
+}
+
+// ListProductFacets: 絞り込み条件に対する、項目ごとの製品数を集計するユースケース
+// カタログ画面の絞り込みメニューに「ロボット掃除機 (12)」のような件数を出すために使います。
+func (u *ProductUsecase) ListProductFacets(ctx context.Context, filter model.ProductFilter) (*model.ProductFacets, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return u.repo.CountFacets(ctx, filter)
+}
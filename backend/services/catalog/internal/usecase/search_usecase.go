@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/catalog/internal/domain/repository"
+)
+
+// SearchUsecase: 製品の全文検索を行うユースケースです。
+type SearchUsecase struct {
+	searcher repository.ProductSearcher
+}
+
+// NewSearchUsecase: ユースケースの作成
+func NewSearchUsecase(searcher repository.ProductSearcher) *SearchUsecase {
+	return &SearchUsecase{searcher: searcher}
+}
+
+// SearchProducts: キーワードと絞り込み条件で製品を検索します。
+// 1. 検索条件をチェックし、件数の既定値を補う
+// 2. 検索エンジン (ProductSearcher) に問い合わせる
+func (u *SearchUsecase) SearchProducts(ctx context.Context, query model.ProductSearchQuery) (*model.ProductSearchResult, error) {
+	query, err := query.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	return u.searcher.Search(ctx, query)
+}
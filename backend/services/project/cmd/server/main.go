@@ -0,0 +1,74 @@
+package main
+
+import (
+	"crypto/rand"
+	"log"
+	"net/http"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/kinoshitatakumi/opti/gen/go/project/v1/projectv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/project/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/project/internal/infrastructure/simulation"
+	"github.com/kinoshitatakumi/opti/services/project/internal/interface/calendar"
+	"github.com/kinoshitatakumi/opti/services/project/internal/interface/grpc"
+	"github.com/kinoshitatakumi/opti/services/project/internal/usecase"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	// 1. 外部サービスの接続先 (環境変数で切り替え)
+	simulationURL := os.Getenv("SIMULATION_SERVICE_URL")
+	if simulationURL == "" {
+		simulationURL = "http://localhost:8082"
+	}
+	planClient := simulation.NewResilientPlanClient(simulationURL)
+	// カレンダーフィードのURLに使う、外部から見たこのサービスのURL
+	calendarBaseURL := os.Getenv("CALENDAR_BASE_URL")
+	if calendarBaseURL == "" {
+		calendarBaseURL = "http://localhost:8083"
+	}
+
+	// 2. Dependency Injection (依存性の注入)
+	projectRepo := db.NewMemoryAdoptionPlanRepository()
+	projectUsecase := usecase.NewProjectUsecase(projectRepo, planClient)
+	roiTrackingUsecase := usecase.NewRoiTrackingUsecase(projectRepo, db.NewMemoryMeasurementRepository(), service.NewRoiTracker())
+	calendarUsecase := usecase.NewCalendarUsecase(projectRepo, db.NewMemoryCalendarFeedRepository(), service.NewCalendarPlanner())
+	handler := grpc.NewProjectHandler(projectUsecase, roiTrackingUsecase, calendarUsecase, calendarBaseURL)
+
+	// 3. サーバーのルーティング設定
+	mux := http.NewServeMux()
+	// アクセストークンがあれば検証し、ログイン中の利用者をハンドラーに渡します
+	path, connectHandler := projectv1connect.NewProjectServiceHandler(handler, connect.WithInterceptors(authInterceptor()))
+	mux.Handle(path, connectHandler)
+	// カレンダーアプリからの購読 (.ics) は素の HTTP で配信します
+	mux.Handle(calendar.Pattern, calendar.NewHandler(calendarUsecase))
+
+	// 4. サーバー起動 (catalogが:8080、simulationが:8082を使うため:8083で起動します)
+	log.Println("Starting project service on :8083")
+	err := http.ListenAndServe(":8083", h2c.NewHandler(mux, &http2.Server{}))
+	if err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// authInterceptor: アクセストークンの署名鍵は AUTH_TOKEN_KEY (32バイト以上、全サービス共通) で指定します。
+// 未設定なら起動のたびに鍵を作るのでどのトークンも受け付けず、ログインが必要なAPIは使えません (開発用)。
+func authInterceptor() connect.Interceptor {
+	key := []byte(os.Getenv("AUTH_TOKEN_KEY"))
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("failed to generate auth token key: %v", err)
+		}
+		log.Println("AUTH_TOKEN_KEY is not set; requests that need a logged-in user will be rejected")
+	}
+	signer, err := auth.NewTokenSigner(key, auth.DefaultTokenMaxAge)
+	if err != nil {
+		log.Fatalf("invalid AUTH_TOKEN_KEY: %v", err)
+	}
+	return auth.NewServerInterceptor(signer)
+}
@@ -0,0 +1,268 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ItemStatus: 採用した製品の購入・設置ステータス
+type ItemStatus string
+
+const (
+	ItemWontDo    ItemStatus = "wont_do"   // 導入しない
+	ItemPending   ItemStatus = "pending"   // 未購入
+	ItemBought    ItemStatus = "bought"    // 購入済み
+	ItemInstalled ItemStatus = "installed" // 設置完了
+)
+
+// Valid: 定義済みのステータスかを判定します。
+func (s ItemStatus) Valid() bool {
+	switch s {
+	case ItemWontDo, ItemPending, ItemBought, ItemInstalled:
+		return true
+	}
+	return false
+}
+
+// ProjectStatus: プロジェクト全体の進捗ステータス
+// 製品のステータスから導出される値で、直接変更することはできません。
+type ProjectStatus string
+
+const (
+	ProjectPlanning   ProjectStatus = "planning"    // まだ何も購入していない
+	ProjectInProgress ProjectStatus = "in_progress" // 購入・設置を進めている
+	ProjectCompleted  ProjectStatus = "completed"   // 導入する製品がすべて設置完了
+)
+
+// AdoptedItem: 採用した製品と、その進捗 (Value Object)
+type AdoptedItem struct {
+	ProductID       string
+	ProductName     string
+	Category        string // 提案グループのカテゴリ (家事カテゴリ、またはハブなどの "management")
+	ProductCategory string
+	Quantity        int
+	Price           int32
+	DependsOn       []string
+	Status          ItemStatus
+	Note            string           // ユーザーメモ (「Amazonで購入済み」など)
+	History         []ItemTransition // ステータス変更の記録 (古い順)
+	RoadmapMonth    int              // ロードマップで購入する月 (1 = 作成した月)。一括購入なら0
+	PurchaseDue     time.Time        // 購入予定日 (日本時間の0時)
+	InstallDue      time.Time        // 設置予定日 (日本時間の0時)
+	Revision        int              // 変更のたびに増える版数 (カレンダーの SEQUENCE に使います)
+	// CalibrationFactor: 提案時の予測に含まれていた較正係数 (較正なしなら1、不明なら0)
+	CalibrationFactor float64
+}
+
+// ItemSelection: 提案プランから導入すると決めた製品
+type ItemSelection struct {
+	ProductID string
+	Quantity  int // 0なら提案どおりの数量
+	Note      string
+}
+
+// AdoptionPlan: 導入プロジェクト (Aggregate Root)
+// 提案プランの中から「これをやる」と決めた製品と、その購入・設置の進捗を管理します。
+type AdoptionPlan struct {
+	ID           string
+	UserID       string
+	SourcePlanID string
+	Items        []AdoptedItem
+	Prediction   RoiPrediction // 作成時点の提案プランのROI予測
+	Status       ProjectStatus
+	Revision     int // 変更のたびに増える版数
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewAdoptionPlan: 提案プランと選択内容からプロジェクトを作成します (Factory)
+// 採用できるのは元の提案プランに含まれる製品だけです。選ばなかった製品は wont_do として残すので、
+// 後から「やっぱり導入する」と切り替えられます。
+func NewAdoptionPlan(id, userID string, source *SourcePlan, selections []ItemSelection, now time.Time) (*AdoptionPlan, error) {
+	// 1. 所有者のチェック
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+	if source.UserID != userID {
+		return nil, fmt.Errorf("%w: plan %s belongs to another user", ErrPermissionDenied, source.ID)
+	}
+
+	// 2. 選択内容のチェック
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("%w: at least one item must be selected", ErrInvalidInput)
+	}
+	selected := make(map[string]ItemSelection, len(selections))
+	for _, s := range selections {
+		if _, ok := source.Find(s.ProductID); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrItemNotInSourcePlan, s.ProductID)
+		}
+		if _, dup := selected[s.ProductID]; dup {
+			return nil, fmt.Errorf("%w: duplicated selection: %s", ErrInvalidInput, s.ProductID)
+		}
+		if s.Quantity < 0 {
+			return nil, fmt.Errorf("%w: quantity must not be negative: %s", ErrInvalidInput, s.ProductID)
+		}
+		selected[s.ProductID] = s
+	}
+
+	// 3. 提案プランの順序のまま、採用アイテムを作成
+	items := make([]AdoptedItem, 0, len(source.Items))
+	for _, src := range source.Items {
+		item := AdoptedItem{
+			ProductID:         src.ProductID,
+			ProductName:       src.ProductName,
+			Category:          src.Category,
+			ProductCategory:   src.ProductCategory,
+			Quantity:          src.Quantity,
+			Price:             src.Price,
+			DependsOn:         append([]string(nil), src.DependsOn...),
+			Status:            ItemWontDo,
+			RoadmapMonth:      src.RoadmapMonth,
+			CalibrationFactor: src.CalibrationFactor,
+		}
+		item.schedule(PurchaseDueDate(now, src.RoadmapMonth))
+		if s, ok := selected[src.ProductID]; ok {
+			item.Status = ItemPending
+			item.Note = s.Note
+			if s.Quantity > 0 {
+				item.Quantity = s.Quantity
+			}
+		}
+		item.History = []ItemTransition{{To: item.Status, At: now, Actor: userID}}
+		items = append(items, item)
+	}
+
+	p := &AdoptionPlan{
+		ID:           id,
+		UserID:       userID,
+		SourcePlanID: source.ID,
+		Items:        items,
+		Prediction:   source.Prediction,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	p.Status = p.deriveStatus()
+	return p, nil
+}
+
+// UpdateItemStatus: 状態遷移表に従って製品のステータスを変更し、変更を履歴に記録します。
+// 変更後にプロジェクト全体のステータスを再計算します。
+func (p *AdoptionPlan) UpdateItemStatus(productID string, change StatusChange, now time.Time) error {
+	// 1. 入力値と遷移のチェック
+	if err := change.validate(); err != nil {
+		return err
+	}
+	item := p.item(productID)
+	if item == nil {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, productID)
+	}
+	if !item.Status.CanTransitionTo(change.Status) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, item.Status, change.Status)
+	}
+	if item.Status == ItemPending && change.Status == ItemInstalled && !change.Confirmed {
+		return fmt.Errorf("%w: %s is not marked as bought yet", ErrConfirmationRequired, productID)
+	}
+	if item.Status == ItemWontDo && p.Status == ProjectCompleted {
+		return fmt.Errorf("%w: project is already completed", ErrInvalidTransition)
+	}
+
+	// 2. 変更と履歴の記録
+	actor := change.Actor
+	if actor == "" {
+		actor = p.UserID
+	}
+	transition := ItemTransition{
+		From:      item.Status,
+		To:        change.Status,
+		At:        now,
+		Actor:     actor,
+		PricePaid: change.PricePaid,
+		Store:     change.Store,
+	}
+	if change.Note != nil {
+		transition.Note = *change.Note
+		item.Note = *change.Note
+	}
+	item.Status = change.Status
+	item.History = append(item.History, transition)
+	item.Revision++
+
+	// 3. プロジェクト全体のステータスを再計算
+	p.Status = p.deriveStatus()
+	p.touch(now)
+	return nil
+}
+
+// RescheduleItem: 製品の購入予定日を変更します。設置予定日も合わせてずらします。
+func (p *AdoptionPlan) RescheduleItem(productID string, purchaseDue, now time.Time) error {
+	if purchaseDue.IsZero() {
+		return fmt.Errorf("%w: purchase due date is required", ErrInvalidInput)
+	}
+	item := p.item(productID)
+	if item == nil {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, productID)
+	}
+	item.schedule(startOfDay(purchaseDue))
+	item.Revision++
+	p.touch(now)
+	return nil
+}
+
+// CheckOwner: 利用者がプロジェクトの所有者かをチェックします。他の利用者なら ErrPermissionDenied を返します。
+func (p *AdoptionPlan) CheckOwner(userID string) error {
+	if userID == "" || p.UserID != userID {
+		return fmt.Errorf("%w: project %s belongs to another user", ErrPermissionDenied, p.ID)
+	}
+	return nil
+}
+
+// touch: 更新日時と版数を進めます。
+func (p *AdoptionPlan) touch(now time.Time) {
+	p.Revision++
+	p.UpdatedAt = now
+}
+
+// Item: 製品IDで採用アイテムを返します。
+func (p *AdoptionPlan) Item(productID string) (AdoptedItem, bool) {
+	if it := p.item(productID); it != nil {
+		return *it, true
+	}
+	return AdoptedItem{}, false
+}
+
+// item: 製品IDで採用アイテムを探します。
+func (p *AdoptionPlan) item(productID string) *AdoptedItem {
+	for i := range p.Items {
+		if p.Items[i].ProductID == productID {
+			return &p.Items[i]
+		}
+	}
+	return nil
+}
+
+// deriveStatus: 製品のステータスからプロジェクト全体のステータスを決めます。
+//   - 導入する製品 (wont_do 以外) がすべて installed なら completed
+//   - 1つでも bought / installed があれば in_progress
+//   - それ以外 (すべて pending、または導入する製品がない) は planning
+func (p *AdoptionPlan) deriveStatus() ProjectStatus {
+	active, started, installed := 0, 0, 0
+	for _, it := range p.Items {
+		switch it.Status {
+		case ItemWontDo:
+			continue
+		case ItemBought:
+			started++
+		case ItemInstalled:
+			started++
+			installed++
+		}
+		active++
+	}
+	switch {
+	case active > 0 && installed == active:
+		return ProjectCompleted
+	case started > 0:
+		return ProjectInProgress
+	}
+	return ProjectPlanning
+}
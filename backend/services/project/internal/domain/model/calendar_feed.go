@@ -0,0 +1,65 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// CalendarFeed: カレンダーアプリから購読する、ユーザーごとの秘密のURL (Entity)
+// URLに含めるトークンそのものは保存せず、ハッシュだけを保存します。
+// そのため作成時にしかURLを返せず、作り直すと以前のURLは無効になります。
+type CalendarFeed struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	CreatedAt time.Time
+	RevokedAt time.Time // 無効化していなければゼロ値
+}
+
+// NewCalendarFeed: 推測できないトークンを生成してフィードを作成します (Factory)
+// 戻り値の token はURLに埋め込むためのもので、この時点でしか取得できません。
+func NewCalendarFeed(id, userID string, now time.Time) (*CalendarFeed, string, error) {
+	if userID == "" {
+		return nil, "", fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return &CalendarFeed{ID: id, UserID: userID, TokenHash: HashFeedToken(token), CreatedAt: now}, token, nil
+}
+
+// HashFeedToken: 保存・照合に使うトークンのハッシュを返します。
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Active: 無効化されていないかを返します。
+func (f *CalendarFeed) Active() bool {
+	return f.RevokedAt.IsZero()
+}
+
+// Revoke: フィードを無効化します。以降このURLにはアクセスできません。
+func (f *CalendarFeed) Revoke(now time.Time) {
+	if f.Active() {
+		f.RevokedAt = now
+	}
+}
+
+// CalendarEvent: カレンダーに載せる終日の予定 (Value Object)
+// UID はプロジェクトと製品から決まるため、内容が変わっても同じ予定として上書きされます。
+type CalendarEvent struct {
+	UID          string
+	Summary      string
+	Description  string
+	Date         time.Time // 終日の予定の日付 (日本時間の0時)
+	Sequence     int       // 変更のたびに増える版数
+	Completed    bool
+	LastModified time.Time
+}
@@ -0,0 +1,30 @@
+package model
+
+import "errors"
+
+// ドメイン層で発生するエラーの定義です。
+// Handler層では errors.Is でこれらを判定し、適切なRPCステータスコードに変換します。
+var (
+	// ErrInvalidInput: 入力値が不正
+	ErrInvalidInput = errors.New("invalid project input")
+	// ErrProjectNotFound: 指定されたプロジェクトが存在しない
+	ErrProjectNotFound = errors.New("adoption project not found")
+	// ErrSourcePlanNotFound: 元になる提案プランが存在しない
+	ErrSourcePlanNotFound = errors.New("source optimization plan not found")
+	// ErrItemNotInSourcePlan: 元の提案プランに含まれない製品を採用しようとした
+	ErrItemNotInSourcePlan = errors.New("item is not part of the source plan")
+	// ErrItemNotFound: プロジェクトに含まれない製品を指定した
+	ErrItemNotFound = errors.New("item not found in project")
+	// ErrInvalidTransition: 状態遷移表にないステータス変更
+	ErrInvalidTransition = errors.New("invalid item status transition")
+	// ErrConfirmationRequired: 購入を記録せずに設置済みにするには確認が必要
+	ErrConfirmationRequired = errors.New("confirmation required to skip purchase")
+	// ErrNotMeasurable: 設置済みでない、または家事を直接減らさない製品の実測値を記録しようとした
+	ErrNotMeasurable = errors.New("item is not measurable")
+	// ErrFeedNotFound: カレンダーフィードが存在しない、または無効化されている
+	ErrFeedNotFound = errors.New("calendar feed not found")
+	// ErrConflict: 読み込んだ後に、同じプロジェクトへの別の変更が保存された
+	ErrConflict = errors.New("adoption project was modified concurrently")
+	// ErrPermissionDenied: 他のユーザーの提案プランやプロジェクトを操作しようとした
+	ErrPermissionDenied = errors.New("permission denied")
+)
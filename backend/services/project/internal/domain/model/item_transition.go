@@ -0,0 +1,95 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// itemTransitions: ステータスごとに、次に進めるステータスの一覧 (状態遷移表)
+//
+//	pending   → bought / wont_do / installed (購入を記録せずに設置済みにするには確認が必要)
+//	bought    → installed / pending (返品・キャンセル)
+//	installed → bought (取り外し・記録の訂正)
+//	wont_do   → pending (プロジェクトが完了していない間だけ)
+var itemTransitions = map[ItemStatus][]ItemStatus{
+	ItemPending:   {ItemBought, ItemWontDo, ItemInstalled},
+	ItemBought:    {ItemInstalled, ItemPending},
+	ItemInstalled: {ItemBought},
+	ItemWontDo:    {ItemPending},
+}
+
+// CanTransitionTo: 状態遷移表で next に進めるかを判定します。
+func (s ItemStatus) CanTransitionTo(next ItemStatus) bool {
+	for _, to := range itemTransitions[s] {
+		if to == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ItemTransition: ステータス変更の記録 (Value Object)
+// 購入の監査や、アイテムごとのタイムライン表示に使います。
+type ItemTransition struct {
+	From      ItemStatus // プロジェクト作成時の記録では空
+	To        ItemStatus
+	At        time.Time
+	Actor     string // 変更したユーザーのID
+	PricePaid *int32 // 実際に支払った単価 (bought / installed への変更時のみ)
+	Store     string // 購入した店舗 (bought / installed への変更時のみ)
+	Note      string
+}
+
+// StatusChange: ステータス変更の指示
+type StatusChange struct {
+	Status    ItemStatus
+	Actor     string
+	PricePaid *int32
+	Store     string
+	Note      *string // nil ならメモを変更しない
+	Confirmed bool    // pending から installed への直接の変更を確認済みか
+}
+
+// validate: 遷移先によらない入力値のチェックをします。
+func (c StatusChange) validate() error {
+	if !c.Status.Valid() {
+		return fmt.Errorf("%w: unknown item status: %q", ErrInvalidInput, c.Status)
+	}
+	purchase := c.Status == ItemBought || c.Status == ItemInstalled
+	if c.PricePaid != nil {
+		if !purchase {
+			return fmt.Errorf("%w: price paid can only be recorded when buying or installing", ErrInvalidInput)
+		}
+		if *c.PricePaid < 0 {
+			return fmt.Errorf("%w: price paid must not be negative", ErrInvalidInput)
+		}
+	}
+	if c.Store != "" && !purchase {
+		return fmt.Errorf("%w: store can only be recorded when buying or installing", ErrInvalidInput)
+	}
+	return nil
+}
+
+// InstalledAt: 設置済みの場合、最後に installed になった日時を返します。
+func (it AdoptedItem) InstalledAt() (time.Time, bool) {
+	if it.Status != ItemInstalled {
+		return time.Time{}, false
+	}
+	for i := len(it.History) - 1; i >= 0; i-- {
+		if it.History[i].To == ItemInstalled {
+			return it.History[i].At, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// LastPurchase: 最後に記録された購入 (支払額か店舗が記録された遷移) を返します。
+func (it AdoptedItem) LastPurchase() (ItemTransition, bool) {
+	for i := len(it.History) - 1; i >= 0; i-- {
+		t := it.History[i]
+		if t.PricePaid != nil || t.Store != "" {
+			return t, true
+		}
+	}
+	return ItemTransition{}, false
+}
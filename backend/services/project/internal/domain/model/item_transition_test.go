@@ -0,0 +1,97 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestPlan: a (pending) と b (pending) を採用したプロジェクトを作ります。
+func newTestPlan(t *testing.T) *AdoptionPlan {
+	t.Helper()
+	source := &SourcePlan{ID: "plan-1", UserID: "alice", Items: []SourceItem{
+		{ProductID: "a", ProductName: "ロボット掃除機", Quantity: 1, Price: 50000},
+		{ProductID: "b", ProductName: "スマートロック", Quantity: 1, Price: 20000},
+		{ProductID: "c", ProductName: "スマートスピーカー", Quantity: 1, Price: 5000},
+	}}
+	p, err := NewAdoptionPlan("project-1", "alice", source, []ItemSelection{{ProductID: "a"}, {ProductID: "b"}}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// advance: テストの前提となる状態まで製品を進めます。
+func advance(t *testing.T, p *AdoptionPlan, productID string, statuses ...ItemStatus) {
+	t.Helper()
+	for _, s := range statuses {
+		if err := p.UpdateItemStatus(productID, StatusChange{Status: s, Confirmed: true}, time.Now()); err != nil {
+			t.Fatalf("%s -> %s: %v", productID, s, err)
+		}
+	}
+}
+
+func TestUpdateItemStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, p *AdoptionPlan)
+		product string
+		change  StatusChange
+		wantErr error
+	}{
+		{name: "pending to bought", product: "a", change: StatusChange{Status: ItemBought}},
+		{name: "pending to installed needs confirmation", product: "a", change: StatusChange{Status: ItemInstalled}, wantErr: ErrConfirmationRequired},
+		{name: "pending to installed with confirmation", product: "a", change: StatusChange{Status: ItemInstalled, Confirmed: true}},
+		{name: "bought to installed", setup: func(t *testing.T, p *AdoptionPlan) { advance(t, p, "a", ItemBought) }, product: "a", change: StatusChange{Status: ItemInstalled}},
+		{name: "installed to pending is not allowed", setup: func(t *testing.T, p *AdoptionPlan) { advance(t, p, "a", ItemInstalled) }, product: "a", change: StatusChange{Status: ItemPending}, wantErr: ErrInvalidTransition},
+		{name: "wont_do to pending before completion", product: "c", change: StatusChange{Status: ItemPending}},
+		{
+			name: "wont_do to pending after completion",
+			setup: func(t *testing.T, p *AdoptionPlan) {
+				advance(t, p, "a", ItemInstalled)
+				advance(t, p, "b", ItemInstalled)
+			},
+			product: "c",
+			change:  StatusChange{Status: ItemPending},
+			wantErr: ErrInvalidTransition,
+		},
+		{name: "wont_do to bought is not allowed", product: "c", change: StatusChange{Status: ItemBought}, wantErr: ErrInvalidTransition},
+		{name: "unknown item", product: "z", change: StatusChange{Status: ItemBought}, wantErr: ErrItemNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlan(t)
+			if tt.setup != nil {
+				tt.setup(t, p)
+			}
+			err := p.UpdateItemStatus(tt.product, tt.change, time.Now())
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if it, _ := p.Item(tt.product); it.Status != tt.change.Status {
+				t.Errorf("status = %s, want %s", it.Status, tt.change.Status)
+			}
+		})
+	}
+}
+
+func TestUpdateItemStatusRecordsActor(t *testing.T) {
+	p := newTestPlan(t)
+	advance(t, p, "a", ItemBought)
+	if err := p.UpdateItemStatus("a", StatusChange{Status: ItemInstalled, Actor: "bob"}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	it, _ := p.Item("a")
+	if got := it.History[len(it.History)-1].Actor; got != "bob" {
+		t.Errorf("actor = %q, want bob", got)
+	}
+	if got := it.History[len(it.History)-2].Actor; got != "alice" {
+		t.Errorf("actor without change.Actor = %q, want the owner", got)
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// 実測値の入力をユーザーに依頼するタイミングです。
+const (
+	FirstMeasurementDelay = 14 * 24 * time.Hour // 設置から最初の依頼まで
+	MeasurementInterval   = 30 * 24 * time.Hour // 2回目以降の依頼の間隔
+)
+
+// TimeMeasurement: 導入後に家事にかかっている時間の実測値 (Entity)
+type TimeMeasurement struct {
+	ID                string
+	ProjectID         string
+	ProductID         string
+	ChoreCategory     string
+	MinutesPerSession int
+	FrequencyPerWeek  float64
+	MeasuredAt        time.Time
+}
+
+// MinutesPerWeek: 週あたりの所要時間 (分) を返します。
+func (m TimeMeasurement) MinutesPerWeek() float64 {
+	return float64(m.MinutesPerSession) * m.FrequencyPerWeek
+}
+
+// RecordMeasurement: 設置済みの製品について実測値を作成します (Factory)
+// 比べる予測がない製品 (ハブなど、家事を直接減らさない製品) には記録できません。
+func (p *AdoptionPlan) RecordMeasurement(id, productID string, minutesPerSession int, frequencyPerWeek float64, now time.Time) (*TimeMeasurement, error) {
+	if minutesPerSession < 0 || frequencyPerWeek < 0 {
+		return nil, fmt.Errorf("%w: measurement must not be negative", ErrInvalidInput)
+	}
+	item, ok := p.Item(productID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrItemNotFound, productID)
+	}
+	if item.Status != ItemInstalled {
+		return nil, fmt.Errorf("%w: %s is not installed yet", ErrNotMeasurable, productID)
+	}
+	if _, ok := p.Prediction.For(item.Category); !ok {
+		return nil, fmt.Errorf("%w: %s does not reduce any chore", ErrNotMeasurable, productID)
+	}
+	return &TimeMeasurement{
+		ID:                id,
+		ProjectID:         p.ID,
+		ProductID:         productID,
+		ChoreCategory:     item.Category,
+		MinutesPerSession: minutesPerSession,
+		FrequencyPerWeek:  frequencyPerWeek,
+		MeasuredAt:        now,
+	}, nil
+}
+
+// DueMeasurement: 実測値の入力を依頼すべき製品
+type DueMeasurement struct {
+	ProjectID      string
+	ProductID      string
+	ChoreCategory  string
+	InstalledAt    time.Time
+	LastMeasuredAt time.Time // 未入力ならゼロ値
+	DueAt          time.Time
+}
@@ -0,0 +1,79 @@
+package model
+
+import "time"
+
+// ItemRoiReport: 製品ごとの予測と実測の比較 (Value Object)
+type ItemRoiReport struct {
+	ProductID                 string
+	ProductCategory           string
+	ChoreCategory             string
+	InstalledAt               time.Time // 未設置ならゼロ値
+	MeasurementCount          int
+	LastMeasuredAt            time.Time // 未入力ならゼロ値
+	MinutesPerWeekBefore      float64
+	PredictedMinutesPerWeek   float64
+	ActualMinutesPerWeek      float64
+	PredictedReductionRate    float64
+	ActualReductionRate       float64
+	PredictedHoursSavedYearly float64
+	ActualHoursSavedYearly    float64
+	ReductionRateError        float64 // 実測の削減率 - 予測の削減率
+	CalibrationFactor         float64 // 予測に含まれていた較正係数 (不明なら0)
+}
+
+// Measured: 実測値があるかを返します。
+func (r ItemRoiReport) Measured() bool {
+	return r.MeasurementCount > 0
+}
+
+// Realization: 予測した削減率に対して、実際にどれだけ削減できたかの比率を返します (1.0で予測どおり)。
+func (r ItemRoiReport) Realization() (float64, bool) {
+	if !r.Measured() || r.PredictedReductionRate <= 0 {
+		return 0, false
+	}
+	return r.ActualReductionRate / r.PredictedReductionRate, true
+}
+
+// CatalogRealization: カタログの削減率 (較正前) に対する実績の比率を返します。
+// 予測に較正係数が含まれていた場合、Realization はその係数に対する実績なので、係数を掛けて較正前に戻します。
+// これを使わずに係数を求め直すと、正しく較正できているほど係数が1.0に戻ってしまいます。
+func (r ItemRoiReport) CatalogRealization() (float64, bool) {
+	realization, ok := r.Realization()
+	if !ok {
+		return 0, false
+	}
+	if r.CalibrationFactor > 0 {
+		realization *= r.CalibrationFactor
+	}
+	return realization, true
+}
+
+// RoiReport: プロジェクト全体の予測と実測の比較 (Value Object)
+type RoiReport struct {
+	ProjectID                 string
+	Items                     []ItemRoiReport
+	MeasuredItems             int
+	PredictedHoursSavedYearly float64
+	ActualHoursSavedYearly    float64
+	RealizationRate           float64
+	PredictedCost             int64
+	ActualCost                int64
+	HourlyWage                float64
+	PredictedPaybackMonths    float64
+	ActualPaybackMonths       float64
+	ActualPaybackReachable    bool
+}
+
+// CategoryCalibration: 製品カテゴリごとの較正係数
+type CategoryCalibration struct {
+	ProductCategory string
+	Factor          float64
+	SampleCount     int
+	MeanRealization float64
+}
+
+// RoiCalibration: 全プロジェクトの実測値から求めた較正係数 (Value Object)
+type RoiCalibration struct {
+	Categories []CategoryCalibration
+	ComputedAt time.Time
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScheduleDateLayout: 予定日をやり取りするときの書式 (日本時間の日付)
+const ScheduleDateLayout = "2006-01-02"
+
+// InstallLeadTime: 購入予定日から設置予定日までの目安 (配送と設置の準備期間)
+const InstallLeadTime = 7 * 24 * time.Hour
+
+// scheduleLocation: 予定日は日本時間の日付で扱います。
+var scheduleLocation = time.FixedZone("JST", 9*60*60)
+
+// PurchaseDueDate: ロードマップの月番号から購入予定日を求めます。
+// 1ヶ月目 (と一括購入) はプロジェクトを作成した日、2ヶ月目以降はその月の1日です。
+func PurchaseDueDate(createdAt time.Time, roadmapMonth int) time.Time {
+	day := startOfDay(createdAt)
+	if roadmapMonth <= 1 {
+		return day
+	}
+	return time.Date(day.Year(), day.Month()+time.Month(roadmapMonth-1), 1, 0, 0, 0, 0, scheduleLocation)
+}
+
+// ParseScheduleDate: "YYYY-MM-DD" 形式の文字列を日本時間の0時として解釈します。
+func ParseScheduleDate(s string) (time.Time, error) {
+	t, err := time.ParseInLocation(ScheduleDateLayout, s, scheduleLocation)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q (want YYYY-MM-DD)", ErrInvalidInput, s)
+	}
+	return t, nil
+}
+
+// schedule: 購入予定日と、そこから求めた設置予定日を設定します。
+func (it *AdoptedItem) schedule(purchaseDue time.Time) {
+	it.PurchaseDue = purchaseDue
+	it.InstallDue = purchaseDue.Add(InstallLeadTime)
+}
+
+// startOfDay: 日本時間でその日の0時を返します。
+func startOfDay(t time.Time) time.Time {
+	t = t.In(scheduleLocation)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, scheduleLocation)
+}
@@ -0,0 +1,69 @@
+package model
+
+// SourcePlan: プロジェクトの元になる提案プラン (Value Object)
+// Simulation Service の OptimizationPlan のうち、採用判定に必要な項目だけを持ちます。
+type SourcePlan struct {
+	ID         string
+	UserID     string
+	Items      []SourceItem
+	Prediction RoiPrediction
+}
+
+// SourceItem: 提案プランに含まれる製品
+type SourceItem struct {
+	ProductID       string
+	ProductName     string
+	Category        string // 提案グループのカテゴリ
+	ProductCategory string // 製品カテゴリ (例: "robot_vacuum")
+	RoadmapMonth    int    // 段階的導入ロードマップで購入する月 (1 = 今月)。一括購入なら0
+	Quantity        int
+	Price           int32    // 提案時点の単価
+	DependsOn       []string // 先に導入が必要な製品のID
+	// CalibrationFactor: 提案時のROI計算で削減率に掛けた較正係数 (較正なしなら1、古いプランでは0)
+	CalibrationFactor float64
+}
+
+// Find: 製品IDで提案された製品を探します。
+func (p *SourcePlan) Find(productID string) (SourceItem, bool) {
+	for _, it := range p.Items {
+		if it.ProductID == productID {
+			return it, true
+		}
+	}
+	return SourceItem{}, false
+}
+
+// DefaultHourlyWage: 提案プランから時給の前提が読み取れない場合に使う時給 (円)
+const DefaultHourlyWage = 1500
+
+// RoiPrediction: 提案プランが予測したROI (Value Object)
+// プロジェクト作成時点の予測をスナップショットとして保持し、導入後の実測値と比べるために使います。
+type RoiPrediction struct {
+	HourlyWage       float64
+	Chores           []ChorePrediction
+	HoursSavedYearly float64
+	PaybackReachable bool
+	PaybackMonths    float64
+}
+
+// ChorePrediction: 家事カテゴリごとの予測
+type ChorePrediction struct {
+	Category             string
+	MinutesPerWeekBefore float64 // 導入前の週あたり時間 (分)
+	ReductionRate        float64 // 予測した削減率 (0.0〜1.0)
+}
+
+// PredictedMinutesPerWeek: 予測した導入後の週あたり時間 (分)
+func (c ChorePrediction) PredictedMinutesPerWeek() float64 {
+	return c.MinutesPerWeekBefore * (1 - c.ReductionRate)
+}
+
+// For: 家事カテゴリの予測を返します。
+func (p RoiPrediction) For(category string) (ChorePrediction, bool) {
+	for _, c := range p.Chores {
+		if c.Category == category {
+			return c, true
+		}
+	}
+	return ChorePrediction{}, false
+}
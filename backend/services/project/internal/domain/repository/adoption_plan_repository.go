@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// AdoptionPlanRepository: 導入プロジェクトの永続化を担当します。
+type AdoptionPlanRepository interface {
+	// Save: プロジェクトを保存 (作成・更新) します。
+	// loadedRevision は読み込んだ時点の版数 (新規作成なら0) で、保存済みの版数と違えば
+	// (読み込んだ後に別の変更が保存されていれば) 上書きせずに model.ErrConflict を返します。
+	Save(ctx context.Context, plan *model.AdoptionPlan, loadedRevision int) error
+	// GetByID: 見つからない場合は model.ErrProjectNotFound を返します。
+	GetByID(ctx context.Context, id string) (*model.AdoptionPlan, error)
+	// ListByUser: ユーザーのプロジェクトを作成日時の新しい順に返します。
+	ListByUser(ctx context.Context, userID string) ([]*model.AdoptionPlan, error)
+	// ListAll: 全ユーザーのプロジェクトを返します (較正係数の集計用)。
+	ListAll(ctx context.Context) ([]*model.AdoptionPlan, error)
+}
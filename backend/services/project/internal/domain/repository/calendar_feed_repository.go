@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// CalendarFeedRepository: カレンダーフィードの永続化を担当します。
+type CalendarFeedRepository interface {
+	Save(ctx context.Context, f *model.CalendarFeed) error
+	// GetByTokenHash: トークンのハッシュからフィードを取得します。無効化済みのフィードも返します。
+	GetByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, error)
+	ListByUser(ctx context.Context, userID string) ([]*model.CalendarFeed, error)
+}
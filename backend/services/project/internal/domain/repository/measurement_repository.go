@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// MeasurementRepository: 導入後の家事時間の実測値の永続化を担当します。
+type MeasurementRepository interface {
+	Save(ctx context.Context, m *model.TimeMeasurement) error
+	// ListByProject: プロジェクトの実測値を記録日時の古い順に返します。
+	ListByProject(ctx context.Context, projectID string) ([]model.TimeMeasurement, error)
+}
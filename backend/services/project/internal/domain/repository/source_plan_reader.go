@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// SourcePlanReader: プロジェクトの元になる提案プランを取得します。
+// 提案プランは Simulation Service が持つため、実装は Infrastructure 層のRPCクライアントです。
+type SourcePlanReader interface {
+	// GetPlan: 見つからない場合は model.ErrSourcePlanNotFound を返します。
+	GetPlan(ctx context.Context, planID string) (*model.SourcePlan, error)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// calendarUIDDomain: 予定の UID の後ろに付けるドメイン部分です。
+const calendarUIDDomain = "opti"
+
+// CalendarPlanner: 導入プロジェクトを、カレンダーに載せる終日の予定に変換するドメインサービスです。
+//   - ロードマップの各月: 「この月に買う製品」をまとめた予定 (その月の製品で最も早い購入予定日)
+//   - 製品ごと: 購入予定日の「購入」と、設置予定日の「設置」
+//
+// UID はプロジェクトID・月番号・製品IDだけから決まるので、予定日や状態が変わっても
+// カレンダーアプリ側では同じ予定が上書きされます (SEQUENCE は版数で増えていきます)。
+// 導入しない (wont_do) 製品は予定に含めません。
+type CalendarPlanner struct{}
+
+// NewCalendarPlanner: 作成
+func NewCalendarPlanner() *CalendarPlanner {
+	return &CalendarPlanner{}
+}
+
+// Events: プロジェクトの予定を日付順に返します。
+func (c *CalendarPlanner) Events(projects []*model.AdoptionPlan) []model.CalendarEvent {
+	var events []model.CalendarEvent
+	for _, p := range projects {
+		events = append(events, c.monthEvents(p)...)
+		for _, it := range p.Items {
+			if it.Status == model.ItemWontDo {
+				continue
+			}
+			events = append(events, c.itemEvents(p, it)...)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Date.Equal(events[j].Date) {
+			return events[i].Date.Before(events[j].Date)
+		}
+		return events[i].UID < events[j].UID
+	})
+	return events
+}
+
+// monthEvents: ロードマップの月ごとに、その月に購入する製品をまとめた予定を作ります。
+func (c *CalendarPlanner) monthEvents(p *model.AdoptionPlan) []model.CalendarEvent {
+	byMonth := make(map[int][]model.AdoptedItem)
+	var months []int
+	for _, it := range p.Items {
+		if it.Status == model.ItemWontDo || it.RoadmapMonth == 0 {
+			continue
+		}
+		if _, ok := byMonth[it.RoadmapMonth]; !ok {
+			months = append(months, it.RoadmapMonth)
+		}
+		byMonth[it.RoadmapMonth] = append(byMonth[it.RoadmapMonth], it)
+	}
+	sort.Ints(months)
+
+	events := make([]model.CalendarEvent, 0, len(months))
+	for _, month := range months {
+		items := byMonth[month]
+		var total int32
+		var lines []string
+		var due time.Time
+		completed := true
+		for _, it := range items {
+			// 予定日を変更した製品があっても、その月の最初の購入予定日に合わせます
+			if !it.PurchaseDue.IsZero() && (due.IsZero() || it.PurchaseDue.Before(due)) {
+				due = it.PurchaseDue
+			}
+			total += it.Price * int32(it.Quantity)
+			lines = append(lines, fmt.Sprintf("・%s × %d (%d円)", itemName(it), it.Quantity, it.Price*int32(it.Quantity)))
+			if it.Status == model.ItemPending {
+				completed = false
+			}
+		}
+		if due.IsZero() {
+			due = model.PurchaseDueDate(p.CreatedAt, month)
+		}
+		events = append(events, model.CalendarEvent{
+			UID:          fmt.Sprintf("project-%s-month-%d@%s", p.ID, month, calendarUIDDomain),
+			Summary:      withDone(fmt.Sprintf("導入ロードマップ %dヶ月目: %d製品を購入 (合計 %d円)", month, len(items), total), completed),
+			Description:  strings.Join(lines, "\n"),
+			Date:         due,
+			Sequence:     p.Revision,
+			Completed:    completed,
+			LastModified: p.UpdatedAt,
+		})
+	}
+	return events
+}
+
+// itemEvents: 製品の購入と設置の予定を作ります。
+func (c *CalendarPlanner) itemEvents(p *model.AdoptionPlan, it model.AdoptedItem) []model.CalendarEvent {
+	bought := it.Status == model.ItemBought || it.Status == model.ItemInstalled
+	installed := it.Status == model.ItemInstalled
+	description := fmt.Sprintf("数量: %d\n予定価格: %d円", it.Quantity, it.Price)
+	if it.Note != "" {
+		description += "\nメモ: " + it.Note
+	}
+	base := fmt.Sprintf("project-%s-item-%s", p.ID, it.ProductID)
+	return []model.CalendarEvent{
+		{
+			UID:          fmt.Sprintf("%s-purchase@%s", base, calendarUIDDomain),
+			Summary:      withDone("購入: "+itemName(it), bought),
+			Description:  description,
+			Date:         it.PurchaseDue,
+			Sequence:     it.Revision,
+			Completed:    bought,
+			LastModified: p.UpdatedAt,
+		},
+		{
+			UID:          fmt.Sprintf("%s-install@%s", base, calendarUIDDomain),
+			Summary:      withDone("設置: "+itemName(it), installed),
+			Description:  description,
+			Date:         it.InstallDue,
+			Sequence:     it.Revision,
+			Completed:    installed,
+			LastModified: p.UpdatedAt,
+		},
+	}
+}
+
+// itemName: 表示用の製品名を返します。名前がない場合はIDを使います。
+func itemName(it model.AdoptedItem) string {
+	if it.ProductName != "" {
+		return it.ProductName
+	}
+	return it.ProductID
+}
+
+// withDone: 完了済みの予定は件名の先頭に印を付けます。
+func withDone(summary string, done bool) string {
+	if done {
+		return "[完了] " + summary
+	}
+	return summary
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+func TestCalendarMonthEventFollowsRescheduledItems(t *testing.T) {
+	created := time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC)
+	source := &model.SourcePlan{ID: "plan-1", UserID: "alice", Items: []model.SourceItem{
+		{ProductID: "a", ProductName: "ロボット掃除機", Quantity: 1, Price: 50000, RoadmapMonth: 2},
+		{ProductID: "b", ProductName: "スマートロック", Quantity: 1, Price: 20000, RoadmapMonth: 2},
+	}}
+	p, err := model.NewAdoptionPlan("project-1", "alice", source, []model.ItemSelection{{ProductID: "a"}, {ProductID: "b"}}, created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2ヶ月目の製品のうち1つを、月初より前に前倒しする
+	earlier, _ := model.ParseScheduleDate("2026-04-25")
+	if err := p.RescheduleItem("b", earlier, created); err != nil {
+		t.Fatal(err)
+	}
+
+	var month *model.CalendarEvent
+	events := NewCalendarPlanner().Events([]*model.AdoptionPlan{p})
+	for i, ev := range events {
+		if ev.UID == "project-project-1-month-2@"+calendarUIDDomain {
+			month = &events[i]
+		}
+	}
+	if month == nil {
+		t.Fatalf("no month event in %+v", events)
+	}
+	if !month.Date.Equal(earlier) {
+		t.Errorf("month event date = %s, want %s", month.Date, earlier)
+	}
+}
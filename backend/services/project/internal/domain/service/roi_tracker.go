@@ -0,0 +1,231 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// 較正係数の計算に使う定数です。
+const (
+	// calibrationPriorWeight: 実測値が少ないうちは係数を1.0 (補正なし) に寄せるための重み。
+	// 実測のある製品がこの数だけ集まると、実測の平均と1.0を半々で混ぜた値になります。
+	calibrationPriorWeight = 3.0
+	minCalibrationFactor   = 0.5
+	maxCalibrationFactor   = 1.5
+)
+
+// RoiTracker: 提案時のROI予測と導入後の実測値を比べるドメインサービスです。
+type RoiTracker struct{}
+
+// NewRoiTracker: 作成
+func NewRoiTracker() *RoiTracker {
+	return &RoiTracker{}
+}
+
+// DueMeasurements: 実測値の入力を依頼すべき製品を返します。
+// 設置から FirstMeasurementDelay 後に最初の依頼を、その後は最後の入力から MeasurementInterval ごとに依頼します。
+func (t *RoiTracker) DueMeasurements(p *model.AdoptionPlan, measurements []model.TimeMeasurement, now time.Time) []model.DueMeasurement {
+	latest := latestMeasurements(measurements)
+	var due []model.DueMeasurement
+	for _, it := range p.Items {
+		installedAt, ok := it.InstalledAt()
+		if !ok {
+			continue
+		}
+		if _, ok := p.Prediction.For(it.Category); !ok {
+			continue
+		}
+		d := model.DueMeasurement{
+			ProjectID:     p.ID,
+			ProductID:     it.ProductID,
+			ChoreCategory: it.Category,
+			InstalledAt:   installedAt,
+			DueAt:         installedAt.Add(model.FirstMeasurementDelay),
+		}
+		if m, ok := latest[it.ProductID]; ok && m.MeasuredAt.After(installedAt) {
+			d.LastMeasuredAt = m.MeasuredAt
+			d.DueAt = m.MeasuredAt.Add(model.MeasurementInterval)
+		}
+		if !now.Before(d.DueAt) {
+			due = append(due, d)
+		}
+	}
+	return due
+}
+
+// Report: プロジェクトの予測と実測を比べたレポートを作成します。
+//   - 製品ごと: 最新の実測値を、その製品の家事カテゴリの予測と比べる
+//   - 全体: 家事カテゴリ単位で時間を合計する (同じ家事に効く製品が複数あっても二重に数えないため)。
+//     実測値がないカテゴリは予測値で補完する
+//   - 回収期間: 予測・実測とも「費用 ÷ 削減時間の時給換算」で計算する簡易値
+func (t *RoiTracker) Report(p *model.AdoptionPlan, measurements []model.TimeMeasurement) *model.RoiReport {
+	latest := latestMeasurements(measurements)
+	counts := make(map[string]int)
+	for _, m := range measurements {
+		counts[m.ProductID]++
+	}
+
+	report := &model.RoiReport{ProjectID: p.ID, HourlyWage: p.Prediction.HourlyWage}
+	if report.HourlyWage <= 0 {
+		report.HourlyWage = model.DefaultHourlyWage
+	}
+
+	// 1. 製品ごとの比較と費用の集計
+	categoryActual := make(map[string]model.TimeMeasurement) // 家事カテゴリごとの最新の実測値
+	var categories []string
+	for _, it := range p.Items {
+		if it.Status == model.ItemWontDo {
+			continue
+		}
+		units := int64(max(it.Quantity, 1))
+		report.PredictedCost += int64(it.Price) * units
+		if purchase, ok := it.LastPurchase(); ok && purchase.PricePaid != nil {
+			report.ActualCost += int64(*purchase.PricePaid) * units
+		} else {
+			report.ActualCost += int64(it.Price) * units
+		}
+
+		prediction, ok := p.Prediction.For(it.Category)
+		if !ok {
+			continue
+		}
+		r := model.ItemRoiReport{
+			ProductID:                 it.ProductID,
+			ProductCategory:           it.ProductCategory,
+			ChoreCategory:             it.Category,
+			MeasurementCount:          counts[it.ProductID],
+			MinutesPerWeekBefore:      prediction.MinutesPerWeekBefore,
+			PredictedMinutesPerWeek:   round1(prediction.PredictedMinutesPerWeek()),
+			PredictedReductionRate:    prediction.ReductionRate,
+			PredictedHoursSavedYearly: hoursYearly(prediction.MinutesPerWeekBefore - prediction.PredictedMinutesPerWeek()),
+			CalibrationFactor:         it.CalibrationFactor,
+		}
+		if installedAt, ok := it.InstalledAt(); ok {
+			r.InstalledAt = installedAt
+		}
+		if m, ok := latest[it.ProductID]; ok {
+			actual := m.MinutesPerWeek()
+			r.LastMeasuredAt = m.MeasuredAt
+			r.ActualMinutesPerWeek = round1(actual)
+			r.ActualReductionRate = reductionRate(prediction.MinutesPerWeekBefore, actual)
+			r.ActualHoursSavedYearly = hoursYearly(prediction.MinutesPerWeekBefore - actual)
+			r.ReductionRateError = round3(r.ActualReductionRate - r.PredictedReductionRate)
+			report.MeasuredItems++
+			if prev, ok := categoryActual[it.Category]; !ok || m.MeasuredAt.After(prev.MeasuredAt) {
+				categoryActual[it.Category] = m
+			}
+		}
+		if !containsString(categories, it.Category) {
+			categories = append(categories, it.Category)
+		}
+		report.Items = append(report.Items, r)
+	}
+
+	// 2. 家事カテゴリ単位で時間を合計 (実測値がなければ予測値で補完)
+	var predicted, actual float64
+	for _, cat := range categories {
+		prediction, _ := p.Prediction.For(cat)
+		saved := prediction.MinutesPerWeekBefore - prediction.PredictedMinutesPerWeek()
+		predicted += saved
+		if m, ok := categoryActual[cat]; ok {
+			actual += prediction.MinutesPerWeekBefore - m.MinutesPerWeek()
+		} else {
+			actual += saved
+		}
+	}
+	report.PredictedHoursSavedYearly = hoursYearly(predicted)
+	report.ActualHoursSavedYearly = hoursYearly(actual)
+	if predicted > 0 {
+		report.RealizationRate = round3(actual / predicted)
+	}
+
+	// 3. 回収期間
+	report.PredictedPaybackMonths, _ = paybackMonths(report.PredictedCost, report.PredictedHoursSavedYearly, report.HourlyWage)
+	report.ActualPaybackMonths, report.ActualPaybackReachable = paybackMonths(report.ActualCost, report.ActualHoursSavedYearly, report.HourlyWage)
+	return report
+}
+
+// Calibrate: 全プロジェクトのレポートから、製品カテゴリごとの較正係数を求めます。
+// 係数は「実測の削減率 / カタログの削減率 (較正前)」の平均を、実測値の数に応じて1.0に寄せた値です。
+// 較正後に作ったプランの予測には当時の係数が含まれているので、その係数を掛けて較正前の比率に戻してから平均します
+// (そうしないと、新しい係数が古い係数を置き換えて1.0に戻り、係数が振動します)。
+// 外れ値で提案が極端に変わらないよう、minCalibrationFactor〜maxCalibrationFactor に収めます。
+func (t *RoiTracker) Calibrate(reports []*model.RoiReport, now time.Time) model.RoiCalibration {
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, r := range reports {
+		for _, it := range r.Items {
+			realization, ok := it.CatalogRealization()
+			if !ok || it.ProductCategory == "" {
+				continue
+			}
+			sums[it.ProductCategory] += realization
+			counts[it.ProductCategory]++
+		}
+	}
+
+	calibration := model.RoiCalibration{ComputedAt: now}
+	for cat, n := range counts {
+		mean := sums[cat] / float64(n)
+		factor := (mean*float64(n) + calibrationPriorWeight) / (float64(n) + calibrationPriorWeight)
+		calibration.Categories = append(calibration.Categories, model.CategoryCalibration{
+			ProductCategory: cat,
+			Factor:          round3(math.Min(maxCalibrationFactor, math.Max(minCalibrationFactor, factor))),
+			SampleCount:     n,
+			MeanRealization: round3(mean),
+		})
+	}
+	sort.Slice(calibration.Categories, func(i, j int) bool {
+		return calibration.Categories[i].ProductCategory < calibration.Categories[j].ProductCategory
+	})
+	return calibration
+}
+
+// latestMeasurements: 製品ごとの最新の実測値を返します。
+func latestMeasurements(measurements []model.TimeMeasurement) map[string]model.TimeMeasurement {
+	latest := make(map[string]model.TimeMeasurement)
+	for _, m := range measurements {
+		if prev, ok := latest[m.ProductID]; !ok || m.MeasuredAt.After(prev.MeasuredAt) {
+			latest[m.ProductID] = m
+		}
+	}
+	return latest
+}
+
+// reductionRate: 導入前後の週あたり時間から削減率を求めます。
+// 導入後の方が時間がかかっている場合はマイナスになります (-1 が下限)。
+func reductionRate(before, after float64) float64 {
+	if before <= 0 {
+		return 0
+	}
+	return round3(math.Max(-1, 1-after/before))
+}
+
+// paybackMonths: 費用を、削減時間の時給換算 (月あたり) で割った回収期間を返します。
+func paybackMonths(cost int64, hoursYearly, wage float64) (float64, bool) {
+	monthly := hoursYearly * wage / 12
+	if monthly <= 0 {
+		return 0, false
+	}
+	return round1(float64(cost) / monthly), true
+}
+
+// hoursYearly: 週あたりの分数を年あたりの時間に換算します。
+func hoursYearly(minutesPerWeek float64) float64 {
+	return round1(minutesPerWeek * 52 / 60)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func round1(v float64) float64 { return math.Round(v*10) / 10 }
+func round3(v float64) float64 { return math.Round(v*1000) / 1000 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// measuredItems: 予測の削減率 predicted に対して actual だけ削減できた製品を n 個作ります。
+func measuredItems(n int, factor, predicted, actual float64) []model.ItemRoiReport {
+	items := make([]model.ItemRoiReport, n)
+	for i := range items {
+		items[i] = model.ItemRoiReport{
+			ProductCategory:        "robot_vacuum",
+			MeasurementCount:       1,
+			PredictedReductionRate: predicted,
+			ActualReductionRate:    actual,
+			CalibrationFactor:      factor,
+		}
+	}
+	return items
+}
+
+func TestCalibrateComposesWithFactorInEffect(t *testing.T) {
+	tracker := NewRoiTracker()
+	now := time.Now()
+	factor := func(c model.RoiCalibration) float64 {
+		if len(c.Categories) != 1 {
+			t.Fatalf("categories = %+v", c.Categories)
+		}
+		return c.Categories[0].Factor
+	}
+
+	// カタログの削減率 0.6 に対して実際は 0.48 (8割) しか効かない製品
+	const catalogRate, actualRate = 0.6, 0.48
+	first := factor(tracker.Calibrate([]*model.RoiReport{{Items: measuredItems(30, 1, catalogRate, actualRate)}}, now))
+	if math.Abs(first-0.818) > 0.001 {
+		t.Fatalf("first factor = %v, want about 0.818", first)
+	}
+
+	// 較正後のプランは予測が実測に近くなるが、係数は1.0に戻らず、同じところに留まる
+	calibrated := factor(tracker.Calibrate([]*model.RoiReport{{Items: measuredItems(30, first, catalogRate*first, actualRate)}}, now))
+	if math.Abs(calibrated-first) > 0.001 {
+		t.Errorf("factor after a calibrated round = %v, want %v", calibrated, first)
+	}
+
+	// 係数の分からない古いプランは、予測に対する比率をそのまま使う
+	legacy := factor(tracker.Calibrate([]*model.RoiReport{{Items: measuredItems(30, 0, catalogRate, actualRate)}}, now))
+	if legacy != first {
+		t.Errorf("legacy factor = %v, want %v", legacy, first)
+	}
+}
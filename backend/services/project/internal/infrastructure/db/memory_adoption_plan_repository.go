@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+)
+
+// MemoryAdoptionPlanRepository: 導入プロジェクトをメモリ上のマップに保存する Repository 実装です。
+type MemoryAdoptionPlanRepository struct {
+	mu       sync.RWMutex
+	projects map[string]*model.AdoptionPlan
+}
+
+// NewMemoryAdoptionPlanRepository: リポジトリの作成
+func NewMemoryAdoptionPlanRepository() repository.AdoptionPlanRepository {
+	return &MemoryAdoptionPlanRepository{
+		projects: make(map[string]*model.AdoptionPlan),
+	}
+}
+
+// Save: プロジェクトを保存 (作成・更新) します。
+// 版数の確認と書き込みを同じロックの中で行うので、同時に保存しても後から来た方が ErrConflict になります。
+func (r *MemoryAdoptionPlanRepository) Save(ctx context.Context, p *model.AdoptionPlan, loadedRevision int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.projects[p.ID]; ok && stored.Revision != loadedRevision {
+		return fmt.Errorf("%w: %s is at revision %d, loaded %d", model.ErrConflict, p.ID, stored.Revision, loadedRevision)
+	}
+	r.projects[p.ID] = clonePlan(p)
+	return nil
+}
+
+// GetByID: IDを指定してプロジェクトを取得します。
+func (r *MemoryAdoptionPlanRepository) GetByID(ctx context.Context, id string) (*model.AdoptionPlan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.projects[id]
+	if !ok {
+		return nil, model.ErrProjectNotFound
+	}
+	return clonePlan(p), nil
+}
+
+// ListByUser: ユーザーのプロジェクトを作成日時の新しい順に返します。
+func (r *MemoryAdoptionPlanRepository) ListByUser(ctx context.Context, userID string) ([]*model.AdoptionPlan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var list []*model.AdoptionPlan
+	for _, p := range r.projects {
+		if p.UserID == userID {
+			list = append(list, clonePlan(p))
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.After(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+// ListAll: 全ユーザーのプロジェクトを返します。
+func (r *MemoryAdoptionPlanRepository) ListAll(ctx context.Context) ([]*model.AdoptionPlan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]*model.AdoptionPlan, 0, len(r.projects))
+	for _, p := range r.projects {
+		list = append(list, clonePlan(p))
+	}
+	return list, nil
+}
+
+// clonePlan: 保存後に呼び出し元が変更しても影響しないよう、アイテムごとコピーします。
+func clonePlan(p *model.AdoptionPlan) *model.AdoptionPlan {
+	copied := *p
+	copied.Prediction.Chores = append([]model.ChorePrediction(nil), p.Prediction.Chores...)
+	copied.Items = make([]model.AdoptedItem, len(p.Items))
+	for i, it := range p.Items {
+		it.DependsOn = append([]string(nil), it.DependsOn...)
+		it.History = append([]model.ItemTransition(nil), it.History...)
+		copied.Items[i] = it
+	}
+	return &copied
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+func TestMemoryAdoptionPlanSaveRejectsStaleRevision(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryAdoptionPlanRepository()
+	source := &model.SourcePlan{ID: "plan-1", UserID: "alice", Items: []model.SourceItem{{ProductID: "a", Quantity: 1}}}
+	p, err := model.NewAdoptionPlan("project-1", "alice", source, []model.ItemSelection{{ProductID: "a"}}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(ctx, p, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2つのリクエストが同じ版を読み込んで、それぞれ変更する
+	first, _ := repo.GetByID(ctx, "project-1")
+	second, _ := repo.GetByID(ctx, "project-1")
+	loaded := first.Revision
+	if err := first.UpdateItemStatus("a", model.StatusChange{Status: model.ItemBought}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.UpdateItemStatus("a", model.StatusChange{Status: model.ItemWontDo}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repo.Save(ctx, first, loaded); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(ctx, second, loaded); !errors.Is(err, model.ErrConflict) {
+		t.Fatalf("err = %v, want ErrConflict", err)
+	}
+	got, _ := repo.GetByID(ctx, "project-1")
+	if it, _ := got.Item("a"); it.Status != model.ItemBought {
+		t.Errorf("status = %s, want the first change to be kept", it.Status)
+	}
+}
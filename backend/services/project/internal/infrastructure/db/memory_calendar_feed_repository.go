@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+)
+
+// MemoryCalendarFeedRepository: カレンダーフィードをメモリ上に保存する Repository 実装です。
+type MemoryCalendarFeedRepository struct {
+	mu    sync.RWMutex
+	feeds map[string]model.CalendarFeed // key: ID
+}
+
+// NewMemoryCalendarFeedRepository: リポジトリの作成
+func NewMemoryCalendarFeedRepository() repository.CalendarFeedRepository {
+	return &MemoryCalendarFeedRepository{
+		feeds: make(map[string]model.CalendarFeed),
+	}
+}
+
+// Save: フィードを保存 (作成・更新) します。
+func (r *MemoryCalendarFeedRepository) Save(ctx context.Context, f *model.CalendarFeed) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.feeds[f.ID] = *f
+	return nil
+}
+
+// GetByTokenHash: トークンのハッシュからフィードを取得します。
+func (r *MemoryCalendarFeedRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, f := range r.feeds {
+		if f.TokenHash == tokenHash {
+			return &f, nil
+		}
+	}
+	return nil, model.ErrFeedNotFound
+}
+
+// ListByUser: ユーザーのフィードを返します。
+func (r *MemoryCalendarFeedRepository) ListByUser(ctx context.Context, userID string) ([]*model.CalendarFeed, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var list []*model.CalendarFeed
+	for _, f := range r.feeds {
+		if f.UserID == userID {
+			list = append(list, &f)
+		}
+	}
+	return list, nil
+}
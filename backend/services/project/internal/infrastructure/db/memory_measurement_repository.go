@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+)
+
+// MemoryMeasurementRepository: 実測値をメモリ上に保存する Repository 実装です。
+type MemoryMeasurementRepository struct {
+	mu        sync.RWMutex
+	byProject map[string][]model.TimeMeasurement
+}
+
+// NewMemoryMeasurementRepository: リポジトリの作成
+func NewMemoryMeasurementRepository() repository.MeasurementRepository {
+	return &MemoryMeasurementRepository{
+		byProject: make(map[string][]model.TimeMeasurement),
+	}
+}
+
+// Save: 実測値を追加します。
+func (r *MemoryMeasurementRepository) Save(ctx context.Context, m *model.TimeMeasurement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byProject[m.ProjectID] = append(r.byProject[m.ProjectID], *m)
+	return nil
+}
+
+// ListByProject: プロジェクトの実測値を記録日時の古い順に返します。
+func (r *MemoryMeasurementRepository) ListByProject(ctx context.Context, projectID string) ([]model.TimeMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := append([]model.TimeMeasurement(nil), r.byProject[projectID]...)
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].MeasuredAt.Before(list[j].MeasuredAt)
+	})
+	return list, nil
+}
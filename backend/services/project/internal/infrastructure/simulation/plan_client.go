@@ -0,0 +1,104 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"connectrpc.com/connect"
+	simulationv1 "github.com/kinoshitatakumi/opti/gen/go/simulation/v1"
+	"github.com/kinoshitatakumi/opti/gen/go/simulation/v1/simulationv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/pkg/resilience"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+)
+
+// PlanClient: Simulation Service の GetOptimizationPlan を呼び出す SourcePlanReader の実装です。
+type PlanClient struct {
+	client simulationv1connect.SimulationServiceClient
+}
+
+// NewPlanClient: クライアントの作成
+// baseURL は Simulation Service のURL (例: "http://localhost:8082") です。
+func NewPlanClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) repository.SourcePlanReader {
+	return &PlanClient{
+		client: simulationv1connect.NewSimulationServiceClient(httpClient, baseURL, opts...),
+	}
+}
+
+// NewResilientPlanClient: リトライ・タイムアウト・サーキットブレーカーを適用したクライアントを作成します。
+// 提案プランは利用者本人しか取得できないので、受け付けたリクエストのアクセストークンを引き継いで呼び出します。
+func NewResilientPlanClient(baseURL string) repository.SourcePlanReader {
+	executor := resilience.NewExecutor(resilience.Config{Default: resilience.DefaultPolicy()})
+	return NewPlanClient(http.DefaultClient, baseURL, connect.WithInterceptors(auth.NewForwardingInterceptor(), resilience.NewClientInterceptor(executor)))
+}
+
+// GetPlan: 提案プランを取得し、採用判定に必要な項目だけに変換します。
+func (c *PlanClient) GetPlan(ctx context.Context, planID string) (*model.SourcePlan, error) {
+	res, err := c.client.GetOptimizationPlan(ctx, connect.NewRequest(&simulationv1.GetOptimizationPlanRequest{Id: planID}))
+	if err != nil {
+		switch connect.CodeOf(err) {
+		case connect.CodeNotFound:
+			return nil, fmt.Errorf("%w: %s", model.ErrSourcePlanNotFound, planID)
+		case connect.CodePermissionDenied:
+			return nil, fmt.Errorf("%w: plan %s belongs to another user", model.ErrPermissionDenied, planID)
+		}
+		return nil, fmt.Errorf("failed to get optimization plan: %w", err)
+	}
+	return toSourcePlan(res.Msg), nil
+}
+
+// toSourcePlan: 通信用(protobuf) -> 内部の型(model) に変換します。
+func toSourcePlan(pb *simulationv1.OptimizationPlan) *model.SourcePlan {
+	plan := &model.SourcePlan{ID: pb.Id, UserID: pb.UserId, Prediction: toPrediction(pb.RoiProjection)}
+	// 月々の予算で分けて買うプランでは、製品ごとに購入する月が決まっています
+	months := make(map[string]int)
+	for _, m := range pb.GetRoadmap().GetMonths() {
+		for _, id := range m.ProductIds {
+			months[id] = int(m.Month)
+		}
+	}
+	for _, g := range pb.ProposalGroups {
+		for _, it := range g.Items {
+			plan.Items = append(plan.Items, model.SourceItem{
+				ProductID:         it.ProductId,
+				ProductName:       it.ProductName,
+				Category:          g.Category,
+				ProductCategory:   it.ProductCategory,
+				RoadmapMonth:      months[it.ProductId],
+				Quantity:          int(it.Quantity),
+				Price:             it.Price,
+				DependsOn:         it.DependsOn,
+				CalibrationFactor: it.CalibrationFactor,
+			})
+		}
+	}
+	return plan
+}
+
+// toPrediction: 提案プランのROI予測を、実測値との比較に必要な項目だけに変換します。
+// 時給の前提はレスポンスに含まれないため、家事カテゴリ別の「時給換算額 ÷ 削減時間」から求めます。
+func toPrediction(pb *simulationv1.RoiProjection) model.RoiPrediction {
+	if pb == nil {
+		return model.RoiPrediction{HourlyWage: model.DefaultHourlyWage}
+	}
+	prediction := model.RoiPrediction{
+		HourlyWage:       model.DefaultHourlyWage,
+		HoursSavedYearly: pb.EstimatedTimeSavedYearly,
+		PaybackReachable: pb.PaybackReachable,
+		PaybackMonths:    pb.PaybackMonths,
+	}
+	for _, s := range pb.ChoreSavings {
+		prediction.Chores = append(prediction.Chores, model.ChorePrediction{
+			Category:             s.Category,
+			MinutesPerWeekBefore: float64(s.MinutesPerWeekBefore),
+			ReductionRate:        s.ReductionRate,
+		})
+		if s.HoursSavedYearly > 0 && s.TimeValueYearly > 0 {
+			prediction.HourlyWage = math.Round(float64(s.TimeValueYearly) / s.HoursSavedYearly)
+		}
+	}
+	return prediction
+}
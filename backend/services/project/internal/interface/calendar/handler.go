@@ -0,0 +1,67 @@
+package calendar
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/usecase"
+)
+
+// Pattern: フィードを配信するパスのパターン (http.ServeMux 用)
+const Pattern = "GET /calendar/{file}"
+
+// FeedPath: トークンからフィードのパスを作ります。
+func FeedPath(token string) string {
+	return "/calendar/" + token + ".ics"
+}
+
+// Handler: カレンダーアプリからの購読リクエストに iCalendar を返す HTTP ハンドラです。
+// カレンダーアプリは Connect のクライアントではないため、gRPC とは別の素の HTTP で配信します。
+type Handler struct {
+	calendar *usecase.CalendarUsecase
+}
+
+// NewHandler: ハンドラの作成
+func NewHandler(calendar *usecase.CalendarUsecase) *Handler {
+	return &Handler{calendar: calendar}
+}
+
+// ServeHTTP: GET /calendar/{token}.ics
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// 1. パスからトークンを取り出す
+	token, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// 2. 予定を取得 (存在しない・無効化済みのトークンはどちらも 404)
+	events, err := h.calendar.RenderFeed(r.Context(), token)
+	if errors.Is(err, model.ErrFeedNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Printf("failed to render calendar feed: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// 3. 書き出しに失敗した場合に途中までのカレンダーを返さないよう、一度バッファに書きます
+	var buf bytes.Buffer
+	if err := Encode(&buf, events, time.Now()); err != nil {
+		log.Printf("failed to encode calendar feed: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	// URLそのものが秘密なので、検索エンジンに載らないようにします
+	w.Header().Set("X-Robots-Tag", "noindex")
+	_, _ = w.Write(buf.Bytes())
+}
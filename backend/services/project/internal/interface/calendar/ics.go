@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// iCalendar (RFC 5545) の出力に使う定数です。
+const (
+	productID     = "-//opti//Project Service//JA"
+	calendarName  = "スマートホーム導入プラン"
+	maxLineOctets = 75 // 1行の上限 (改行を除くオクテット数)
+	dateLayout    = "20060102"
+	utcLayout     = "20060102T150405Z"
+)
+
+// Encode: 予定を iCalendar 形式で書き出します。
+// 予定はすべて終日 (DTSTART;VALUE=DATE) で、空き時間を塞がないよう TRANSP:TRANSPARENT にします。
+func Encode(w io.Writer, events []model.CalendarEvent, now time.Time) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN:VCALENDAR")
+	e.line("VERSION:2.0")
+	e.line("PRODID:" + productID)
+	e.line("CALSCALE:GREGORIAN")
+	e.line("METHOD:PUBLISH")
+	e.line("X-WR-CALNAME:" + escapeText(calendarName))
+	e.line("X-WR-TIMEZONE:Asia/Tokyo")
+	for _, ev := range events {
+		// DTSTAMP は最終更新日時にします。取得のたびに変わると、変更がなくても更新扱いになるためです
+		stamp := ev.LastModified
+		if stamp.IsZero() {
+			stamp = now
+		}
+		e.line("BEGIN:VEVENT")
+		e.line("UID:" + ev.UID)
+		e.line("DTSTAMP:" + stamp.UTC().Format(utcLayout))
+		e.line("LAST-MODIFIED:" + stamp.UTC().Format(utcLayout))
+		e.line(fmt.Sprintf("SEQUENCE:%d", ev.Sequence))
+		e.line("DTSTART;VALUE=DATE:" + ev.Date.Format(dateLayout))
+		e.line("DTEND;VALUE=DATE:" + ev.Date.AddDate(0, 0, 1).Format(dateLayout))
+		e.line("SUMMARY:" + escapeText(ev.Summary))
+		if ev.Description != "" {
+			e.line("DESCRIPTION:" + escapeText(ev.Description))
+		}
+		e.line("TRANSP:TRANSPARENT")
+		e.line("STATUS:CONFIRMED")
+		e.line("END:VEVENT")
+	}
+	e.line("END:VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// encoder: 行の折り返しとCRLFの付与を行いながら書き出します。
+// 途中で書き込みに失敗した場合は、最初のエラーを保持して以降の書き込みを止めます。
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line: 1行 (content line) を書き出します。
+// 75オクテットを超える行は、UTF-8の文字の途中で切らないように折り返し、続きの行は空白1つで始めます。
+func (e *encoder) line(s string) {
+	if e.err != nil {
+		return
+	}
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		if _, e.err = e.w.WriteString(s[:cut] + "\r\n "); e.err != nil {
+			return
+		}
+		s = s[cut:]
+		limit = maxLineOctets - 1 // 先頭の空白の分
+	}
+	_, e.err = e.w.WriteString(s + "\r\n")
+}
+
+// textEscaper: TEXT 型の値で特別な意味を持つ文字をエスケープします。
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// unfold: 折り返された行を元に戻します (RFC 5545 3.1)。
+func unfold(s string) string {
+	return strings.ReplaceAll(s, "\r\n ", "")
+}
+
+func TestEncodeEscapesAndFoldsLongJapaneseText(t *testing.T) {
+	summary := "導入ロードマップ 2ヶ月目: ロボット掃除機, 食洗機; 乾燥機\\予備 を購入 (合計 248,600円) 設置場所の確認を忘れずに"
+	description := "・ロボット掃除機 × 1\n・食洗機 × 1\r\n・乾燥機 × 1"
+	date, _ := model.ParseScheduleDate("2026-05-01")
+	ev := model.CalendarEvent{UID: "project-1-month-2@opti", Summary: summary, Description: description, Date: date}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, []model.CalendarEvent{ev}, time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	// 1. どの行も CRLF で終わり、75オクテット以内で、UTF-8 の文字の途中で切れていない
+	if !strings.HasSuffix(out, "\r\n") {
+		t.Error("output does not end with CRLF")
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line is %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a character: %q", line)
+		}
+		if strings.ContainsAny(line, "\r\n") {
+			t.Errorf("line contains a raw newline: %q", line)
+		}
+	}
+
+	// 2. 折り返しを戻すと、特別な文字がエスケープされた値になる
+	unfolded := unfold(out)
+	wantSummary := `SUMMARY:導入ロードマップ 2ヶ月目: ロボット掃除機\, 食洗機\; 乾燥機\\予備 を購入 (合計 248\,600円) 設置場所の確認を忘れずに` + "\r\n"
+	if !strings.Contains(unfolded, wantSummary) {
+		t.Errorf("summary not found in:\n%s", unfolded)
+	}
+	wantDescription := `DESCRIPTION:・ロボット掃除機 × 1\n・食洗機 × 1\n・乾燥機 × 1` + "\r\n"
+	if !strings.Contains(unfolded, wantDescription) {
+		t.Errorf("description not found in:\n%s", unfolded)
+	}
+	if !strings.Contains(unfolded, "DTSTART;VALUE=DATE:20260501\r\nDTEND;VALUE=DATE:20260502\r\n") {
+		t.Errorf("dates not found in:\n%s", unfolded)
+	}
+}
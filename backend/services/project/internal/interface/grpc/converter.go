@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	projectv1 "github.com/kinoshitatakumi/opti/gen/go/project/v1"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+)
+
+// 通信用(protobuf)と内部の型(model)の変換をまとめたファイルです。
+// 複数のAPIで同じ変換を使うため、ハンドラ本体から切り出しています。
+
+func toSelections(pb []*projectv1.SelectedItemSelection) []model.ItemSelection {
+	selections := make([]model.ItemSelection, 0, len(pb))
+	for _, s := range pb {
+		selections = append(selections, model.ItemSelection{
+			ProductID: s.ProductId,
+			Quantity:  int(s.Quantity),
+			Note:      s.Note,
+		})
+	}
+	return selections
+}
+
+func toPbAdoptionPlan(p *model.AdoptionPlan) *projectv1.AdoptionPlan {
+	pb := &projectv1.AdoptionPlan{
+		Id:           p.ID,
+		UserId:       p.UserID,
+		Status:       string(p.Status),
+		SourcePlanId: p.SourcePlanID,
+		CreatedAt:    p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    p.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, it := range p.Items {
+		pb.Items = append(pb.Items, &projectv1.AdoptedItem{
+			ProductId:       it.ProductID,
+			ProductName:     it.ProductName,
+			Category:        it.Category,
+			ProductCategory: it.ProductCategory,
+			Quantity:        int32(it.Quantity),
+			Price:           it.Price,
+			DependsOn:       it.DependsOn,
+			Status:          string(it.Status),
+			Note:            it.Note,
+			History:         toPbTransitions(it.History),
+			RoadmapMonth:    int32(it.RoadmapMonth),
+			PurchaseDue:     formatDate(it.PurchaseDue),
+			InstallDue:      formatDate(it.InstallDue),
+		})
+	}
+	return pb
+}
+
+func toPbTransitions(history []model.ItemTransition) []*projectv1.ItemTransition {
+	pb := make([]*projectv1.ItemTransition, 0, len(history))
+	for _, t := range history {
+		pb = append(pb, &projectv1.ItemTransition{
+			From:      string(t.From),
+			To:        string(t.To),
+			At:        t.At.Format(time.RFC3339),
+			Actor:     t.Actor,
+			PricePaid: t.PricePaid,
+			Store:     t.Store,
+			Note:      t.Note,
+		})
+	}
+	return pb
+}
+
+func toPbMeasurement(m *model.TimeMeasurement) *projectv1.TimeMeasurement {
+	return &projectv1.TimeMeasurement{
+		Id:                m.ID,
+		ProjectId:         m.ProjectID,
+		ProductId:         m.ProductID,
+		ChoreCategory:     m.ChoreCategory,
+		MinutesPerSession: int32(m.MinutesPerSession),
+		FrequencyPerWeek:  m.FrequencyPerWeek,
+		MinutesPerWeek:    m.MinutesPerWeek(),
+		MeasuredAt:        m.MeasuredAt.Format(time.RFC3339),
+	}
+}
+
+func toPbDueMeasurement(d model.DueMeasurement) *projectv1.DueMeasurement {
+	return &projectv1.DueMeasurement{
+		ProjectId:      d.ProjectID,
+		ProductId:      d.ProductID,
+		ChoreCategory:  d.ChoreCategory,
+		InstalledAt:    formatTime(d.InstalledAt),
+		LastMeasuredAt: formatTime(d.LastMeasuredAt),
+		DueAt:          formatTime(d.DueAt),
+	}
+}
+
+func toPbRoiReport(r *model.RoiReport) *projectv1.RoiReport {
+	pb := &projectv1.RoiReport{
+		ProjectId:                 r.ProjectID,
+		MeasuredItems:             int32(r.MeasuredItems),
+		PredictedHoursSavedYearly: r.PredictedHoursSavedYearly,
+		ActualHoursSavedYearly:    r.ActualHoursSavedYearly,
+		RealizationRate:           r.RealizationRate,
+		PredictedCost:             int32(r.PredictedCost),
+		ActualCost:                int32(r.ActualCost),
+		HourlyWage:                int32(r.HourlyWage),
+		PredictedPaybackMonths:    r.PredictedPaybackMonths,
+		ActualPaybackMonths:       r.ActualPaybackMonths,
+		ActualPaybackReachable:    r.ActualPaybackReachable,
+	}
+	for _, it := range r.Items {
+		pb.Items = append(pb.Items, &projectv1.ItemRoiReport{
+			ProductId:                 it.ProductID,
+			ProductCategory:           it.ProductCategory,
+			ChoreCategory:             it.ChoreCategory,
+			InstalledAt:               formatTime(it.InstalledAt),
+			MeasurementCount:          int32(it.MeasurementCount),
+			LastMeasuredAt:            formatTime(it.LastMeasuredAt),
+			MinutesPerWeekBefore:      it.MinutesPerWeekBefore,
+			PredictedMinutesPerWeek:   it.PredictedMinutesPerWeek,
+			ActualMinutesPerWeek:      it.ActualMinutesPerWeek,
+			PredictedReductionRate:    it.PredictedReductionRate,
+			ActualReductionRate:       it.ActualReductionRate,
+			PredictedHoursSavedYearly: it.PredictedHoursSavedYearly,
+			ActualHoursSavedYearly:    it.ActualHoursSavedYearly,
+			ReductionRateError:        it.ReductionRateError,
+			Measured:                  it.Measured(),
+			CalibrationFactor:         it.CalibrationFactor,
+		})
+	}
+	return pb
+}
+
+func toPbCalibration(c model.RoiCalibration) *projectv1.RoiCalibration {
+	pb := &projectv1.RoiCalibration{ComputedAt: formatTime(c.ComputedAt)}
+	for _, cat := range c.Categories {
+		pb.Categories = append(pb.Categories, &projectv1.CategoryCalibration{
+			ProductCategory: cat.ProductCategory,
+			Factor:          cat.Factor,
+			SampleCount:     int32(cat.SampleCount),
+			MeanRealization: cat.MeanRealization,
+		})
+	}
+	return pb
+}
+
+// formatTime: RFC 3339 の文字列に変換します。ゼロ値 (未設定) は空文字にします。
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatDate: 予定日を "YYYY-MM-DD" に変換します。ゼロ値 (未設定) は空文字にします。
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(model.ScheduleDateLayout)
+}
+
+// toConnectError: ドメインのエラーをRPCのステータスコードに変換します。
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidInput), errors.Is(err, model.ErrItemNotInSourcePlan):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, model.ErrProjectNotFound), errors.Is(err, model.ErrSourcePlanNotFound), errors.Is(err, model.ErrItemNotFound),
+		errors.Is(err, model.ErrFeedNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, model.ErrInvalidTransition), errors.Is(err, model.ErrConfirmationRequired), errors.Is(err, model.ErrNotMeasurable):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, model.ErrPermissionDenied):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, model.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	// 他サービス (Simulation Service) から返ったステータスコードはそのまま引き継ぎます
+	if code := connect.CodeOf(err); code != connect.CodeUnknown {
+		return connect.NewError(code, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
@@ -0,0 +1,215 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	projectv1 "github.com/kinoshitatakumi/opti/gen/go/project/v1"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/interface/calendar"
+	"github.com/kinoshitatakumi/opti/services/project/internal/usecase"
+)
+
+// ProjectHandler: ProjectService のgRPCリクエストを受け付ける窓口です。
+// Protoメッセージとドメインモデルの変換と、エラーのステータスコードへの変換を担当します。
+type ProjectHandler struct {
+	project         *usecase.ProjectUsecase
+	roi             *usecase.RoiTrackingUsecase
+	calendar        *usecase.CalendarUsecase
+	calendarBaseURL string // フィードのURLの組み立てに使う、外部から見たこのサービスのURL
+}
+
+// NewProjectHandler: ハンドラの作成
+func NewProjectHandler(project *usecase.ProjectUsecase, roi *usecase.RoiTrackingUsecase, calendar *usecase.CalendarUsecase, calendarBaseURL string) *ProjectHandler {
+	return &ProjectHandler{project: project, roi: roi, calendar: calendar, calendarBaseURL: strings.TrimRight(calendarBaseURL, "/")}
+}
+
+// CreateAdoptionProject: プロジェクト作成API (UC-03)
+// ログインが必要で、プロジェクトの所有者はログイン中の利用者です。
+func (h *ProjectHandler) CreateAdoptionProject(ctx context.Context, req *connect.Request[projectv1.CreateAdoptionProjectRequest]) (*connect.Response[projectv1.AdoptionPlan], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	project, err := h.project.CreateAdoptionProject(ctx, userID, req.Msg.SourcePlanId, toSelections(req.Msg.Selections))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbAdoptionPlan(project)), nil
+}
+
+// GetAdoptionProject: プロジェクト取得API
+// ログインが必要で、取得できるのはプロジェクトの所有者だけです (Catalog Service の所有者確認もこのAPIを使います)。
+func (h *ProjectHandler) GetAdoptionProject(ctx context.Context, req *connect.Request[projectv1.GetAdoptionProjectRequest]) (*connect.Response[projectv1.AdoptionPlan], error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	project, err := h.project.GetAdoptionProject(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbAdoptionPlan(project)), nil
+}
+
+// ListAdoptionProjects: プロジェクト一覧API (Dashboard)
+// ログインが必要で、返すのはログイン中の利用者のプロジェクトだけです。
+func (h *ProjectHandler) ListAdoptionProjects(ctx context.Context, req *connect.Request[projectv1.ListAdoptionProjectsRequest]) (*connect.Response[projectv1.ListAdoptionProjectsResponse], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.project.ListAdoptionProjects(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &projectv1.ListAdoptionProjectsResponse{}
+	for _, p := range projects {
+		res.Projects = append(res.Projects, toPbAdoptionPlan(p))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateItemStatus: アイテムステータス更新API (UC-04)
+// 履歴に残す変更者はアクセストークンの利用者です。リクエストの actor が別人、またはプロジェクトの所有者でなければ PermissionDenied にします。
+func (h *ProjectHandler) UpdateItemStatus(ctx context.Context, req *connect.Request[projectv1.UpdateItemStatusRequest]) (*connect.Response[projectv1.AdoptionPlan], error) {
+	actor, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Actor != "" && req.Msg.Actor != actor {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("actor does not match the logged-in user"))
+	}
+	project, err := h.project.UpdateItemStatus(ctx, req.Msg.ProjectId, req.Msg.ProductId, model.StatusChange{
+		Status:    model.ItemStatus(req.Msg.Status),
+		Actor:     actor,
+		PricePaid: req.Msg.PricePaid,
+		Store:     req.Msg.Store,
+		Note:      req.Msg.Note,
+		Confirmed: req.Msg.ConfirmSkipPurchase,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbAdoptionPlan(project)), nil
+}
+
+// RecordTimeMeasurement: 導入後の家事時間の記録API
+// ログインが必要で、記録できるのはプロジェクトの所有者だけです。
+func (h *ProjectHandler) RecordTimeMeasurement(ctx context.Context, req *connect.Request[projectv1.RecordTimeMeasurementRequest]) (*connect.Response[projectv1.TimeMeasurement], error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := h.roi.RecordTimeMeasurement(ctx, userID, req.Msg.ProjectId, req.Msg.ProductId, int(req.Msg.MinutesPerSession), req.Msg.FrequencyPerWeek)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbMeasurement(m)), nil
+}
+
+// ListDueMeasurements: 実測値の入力依頼一覧API
+// ログインが必要で、返すのはログイン中の利用者の入力依頼だけです。
+func (h *ProjectHandler) ListDueMeasurements(ctx context.Context, req *connect.Request[projectv1.ListDueMeasurementsRequest]) (*connect.Response[projectv1.ListDueMeasurementsResponse], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	due, err := h.roi.ListDueMeasurements(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &projectv1.ListDueMeasurementsResponse{}
+	for _, d := range due {
+		res.Measurements = append(res.Measurements, toPbDueMeasurement(d))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetRoiReport: 予測と実測のROI比較API
+// ログインが必要で、見られるのはプロジェクトの所有者だけです。
+func (h *ProjectHandler) GetRoiReport(ctx context.Context, req *connect.Request[projectv1.GetRoiReportRequest]) (*connect.Response[projectv1.RoiReport], error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.roi.GetRoiReport(ctx, userID, req.Msg.ProjectId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbRoiReport(report)), nil
+}
+
+// GetRoiCalibration: 較正係数の取得API (Simulation Service 向け)
+func (h *ProjectHandler) GetRoiCalibration(ctx context.Context, req *connect.Request[projectv1.GetRoiCalibrationRequest]) (*connect.Response[projectv1.RoiCalibration], error) {
+	calibration, err := h.roi.GetRoiCalibration(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbCalibration(calibration)), nil
+}
+
+// RescheduleItem: 購入予定日の変更API
+// ログインが必要で、変更できるのはプロジェクトの所有者だけです。
+func (h *ProjectHandler) RescheduleItem(ctx context.Context, req *connect.Request[projectv1.RescheduleItemRequest]) (*connect.Response[projectv1.AdoptionPlan], error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	due, err := model.ParseScheduleDate(req.Msg.PurchaseDue)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	project, err := h.project.RescheduleItem(ctx, userID, req.Msg.ProjectId, req.Msg.ProductId, due)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbAdoptionPlan(project)), nil
+}
+
+// CreateCalendarFeed: カレンダーフィードの発行API
+// フィードはログイン中の利用者のものだけ発行できます。
+func (h *ProjectHandler) CreateCalendarFeed(ctx context.Context, req *connect.Request[projectv1.CreateCalendarFeedRequest]) (*connect.Response[projectv1.CalendarFeed], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	feed, token, err := h.calendar.CreateCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.CalendarFeed{
+		Url:       h.calendarBaseURL + calendar.FeedPath(token),
+		CreatedAt: formatTime(feed.CreatedAt),
+	}), nil
+}
+
+// RevokeCalendarFeed: カレンダーフィードの無効化API
+// フィードはログイン中の利用者のものだけ無効化できます。
+func (h *ProjectHandler) RevokeCalendarFeed(ctx context.Context, req *connect.Request[projectv1.RevokeCalendarFeedRequest]) (*connect.Response[projectv1.RevokeCalendarFeedResponse], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := h.calendar.RevokeCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&projectv1.RevokeCalendarFeedResponse{RevokedCount: int32(revoked)}), nil
+}
+
+// callerID: ログイン中の利用者のIDを返します。
+// リクエストの user_id は省略できますが、指定する場合はログイン中の利用者と同じでなければ PermissionDenied にします。
+func callerID(ctx context.Context, requested string) (string, error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if requested != "" && requested != userID {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.New("user_id does not match the logged-in user"))
+	}
+	return userID, nil
+}
@@ -0,0 +1,276 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	projectv1 "github.com/kinoshitatakumi/opti/gen/go/project/v1"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/project/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/project/internal/usecase"
+)
+
+// stubPlans: 用意した提案プランを返す SourcePlanReader
+type stubPlans map[string]*model.SourcePlan
+
+func (s stubPlans) GetPlan(_ context.Context, planID string) (*model.SourcePlan, error) {
+	if p, ok := s[planID]; ok {
+		return p, nil
+	}
+	return nil, model.ErrSourcePlanNotFound
+}
+
+// testHandler: メモリ上のリポジトリでハンドラを組み立てます。
+type testHandler struct {
+	*ProjectHandler
+	projects repository.AdoptionPlanRepository
+	feeds    repository.CalendarFeedRepository
+}
+
+func newTestHandler(t *testing.T) *testHandler {
+	t.Helper()
+	projects := db.NewMemoryAdoptionPlanRepository()
+	feeds := db.NewMemoryCalendarFeedRepository()
+	h := NewProjectHandler(
+		usecase.NewProjectUsecase(projects, stubPlans{
+			"plan-alice": {ID: "plan-alice", UserID: "alice", Items: []model.SourceItem{{ProductID: "a", ProductName: "ロボット掃除機", Quantity: 1, Price: 50000}}},
+		}),
+		usecase.NewRoiTrackingUsecase(projects, db.NewMemoryMeasurementRepository(), service.NewRoiTracker()),
+		usecase.NewCalendarUsecase(projects, feeds, service.NewCalendarPlanner()),
+		"https://project.example.com",
+	)
+	return &testHandler{ProjectHandler: h, projects: projects, feeds: feeds}
+}
+
+// seedProject: 利用者のプロジェクトを保存します。製品 a を採用し、b は採用しません。
+func (h *testHandler) seedProject(t *testing.T, id, owner string) *model.AdoptionPlan {
+	t.Helper()
+	source := &model.SourcePlan{ID: "plan-" + id, UserID: owner, Items: []model.SourceItem{
+		{ProductID: "a", ProductName: "ロボット掃除機", Category: "cleaning", ProductCategory: "robot_vacuum", Quantity: 1, Price: 50000},
+		{ProductID: "b", ProductName: "スマートロック", Category: "security", ProductCategory: "smart_lock", Quantity: 1, Price: 20000},
+	}, Prediction: model.RoiPrediction{Chores: []model.ChorePrediction{{Category: "cleaning", MinutesPerWeekBefore: 120, ReductionRate: 0.5}}}}
+	p, err := model.NewAdoptionPlan(id, owner, source, []model.ItemSelection{{ProductID: "a"}}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.projects.Save(context.Background(), p, 0); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// activeFeeds: 利用者の有効なフィードの数を返します。
+func (h *testHandler) activeFeeds(t *testing.T, userID string) int {
+	t.Helper()
+	feeds, err := h.feeds.ListByUser(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, f := range feeds {
+		if f.Active() {
+			n++
+		}
+	}
+	return n
+}
+
+func TestCalendarFeedIsOnlyForTheLoggedInUser(t *testing.T) {
+	h := newTestHandler(t)
+	alice := auth.WithUserID(context.Background(), "alice")
+	mallory := auth.WithUserID(context.Background(), "mallory")
+
+	if _, err := h.CreateCalendarFeed(alice, connect.NewRequest(&projectv1.CreateCalendarFeedRequest{})); err != nil {
+		t.Fatal(err)
+	}
+
+	// 1. 未ログインでは発行も無効化もできない
+	if _, err := h.CreateCalendarFeed(context.Background(), connect.NewRequest(&projectv1.CreateCalendarFeedRequest{UserId: "alice"})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("anonymous create: err = %v, want unauthenticated", err)
+	}
+	if _, err := h.RevokeCalendarFeed(context.Background(), connect.NewRequest(&projectv1.RevokeCalendarFeedRequest{UserId: "alice"})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("anonymous revoke: err = %v, want unauthenticated", err)
+	}
+
+	// 2. 別の利用者を指定すると拒否され、その利用者のフィードはそのまま残る
+	if _, err := h.CreateCalendarFeed(mallory, connect.NewRequest(&projectv1.CreateCalendarFeedRequest{UserId: "alice"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("cross-user create: err = %v, want permission denied", err)
+	}
+	if _, err := h.RevokeCalendarFeed(mallory, connect.NewRequest(&projectv1.RevokeCalendarFeedRequest{UserId: "alice"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("cross-user revoke: err = %v, want permission denied", err)
+	}
+	if got := h.activeFeeds(t, "alice"); got != 1 {
+		t.Errorf("alice has %d active feeds, want 1", got)
+	}
+	if got := h.activeFeeds(t, "mallory"); got != 0 {
+		t.Errorf("mallory has %d active feeds, want 0", got)
+	}
+
+	// 3. 自分のフィードは無効化できる
+	res, err := h.RevokeCalendarFeed(alice, connect.NewRequest(&projectv1.RevokeCalendarFeedRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Msg.RevokedCount != 1 {
+		t.Errorf("revoked = %d, want 1", res.Msg.RevokedCount)
+	}
+}
+
+func TestUpdateItemStatusRequiresProjectOwner(t *testing.T) {
+	h := newTestHandler(t)
+	h.seedProject(t, "project-1", "alice")
+	req := func() *connect.Request[projectv1.UpdateItemStatusRequest] {
+		return connect.NewRequest(&projectv1.UpdateItemStatusRequest{ProjectId: "project-1", ProductId: "a", Status: string(model.ItemBought)})
+	}
+
+	if _, err := h.UpdateItemStatus(auth.WithUserID(context.Background(), "mallory"), req()); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("other user: err = %v, want permission denied", err)
+	}
+	stored, _ := h.projects.GetByID(context.Background(), "project-1")
+	if it, _ := stored.Item("a"); it.Status != model.ItemPending || len(it.History) != 1 {
+		t.Errorf("item was changed by another user: %+v", it)
+	}
+
+	res, err := h.UpdateItemStatus(auth.WithUserID(context.Background(), "alice"), req())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Msg.Items[0].Status; got != string(model.ItemBought) {
+		t.Errorf("status = %s, want bought", got)
+	}
+}
+
+func TestRoiTrackingRequiresProjectOwner(t *testing.T) {
+	h := newTestHandler(t)
+	p := h.seedProject(t, "project-1", "alice")
+	if err := p.UpdateItemStatus("a", model.StatusChange{Status: model.ItemInstalled, Confirmed: true}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.projects.Save(context.Background(), p, p.Revision-1); err != nil {
+		t.Fatal(err)
+	}
+	record := connect.NewRequest(&projectv1.RecordTimeMeasurementRequest{ProjectId: "project-1", ProductId: "a", MinutesPerSession: 1, FrequencyPerWeek: 7})
+	report := connect.NewRequest(&projectv1.GetRoiReportRequest{ProjectId: "project-1"})
+
+	for name, ctx := range map[string]context.Context{
+		"anonymous":  context.Background(),
+		"other user": auth.WithUserID(context.Background(), "mallory"),
+	} {
+		want := connect.CodePermissionDenied
+		if name == "anonymous" {
+			want = connect.CodeUnauthenticated
+		}
+		if _, err := h.RecordTimeMeasurement(ctx, record); connect.CodeOf(err) != want {
+			t.Errorf("%s record: err = %v, want %v", name, err, want)
+		}
+		if _, err := h.GetRoiReport(ctx, report); connect.CodeOf(err) != want {
+			t.Errorf("%s report: err = %v, want %v", name, err, want)
+		}
+	}
+
+	alice := auth.WithUserID(context.Background(), "alice")
+	if _, err := h.RecordTimeMeasurement(alice, record); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.GetRoiReport(alice, report); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdoptionProjectsAreOnlyForTheOwner(t *testing.T) {
+	h := newTestHandler(t)
+	h.seedProject(t, "project-1", "alice")
+	alice := auth.WithUserID(context.Background(), "alice")
+	mallory := auth.WithUserID(context.Background(), "mallory")
+	create := func(userID string) *connect.Request[projectv1.CreateAdoptionProjectRequest] {
+		return connect.NewRequest(&projectv1.CreateAdoptionProjectRequest{
+			SourcePlanId: "plan-alice", UserId: userID, Selections: []*projectv1.SelectedItemSelection{{ProductId: "a"}},
+		})
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want connect.Code
+	}{
+		{name: "anonymous create", call: func() error { _, err := h.CreateAdoptionProject(context.Background(), create("alice")); return err }, want: connect.CodeUnauthenticated},
+		{name: "create as another user", call: func() error { _, err := h.CreateAdoptionProject(mallory, create("alice")); return err }, want: connect.CodePermissionDenied},
+		{name: "create from another user's plan", call: func() error { _, err := h.CreateAdoptionProject(mallory, create("")); return err }, want: connect.CodePermissionDenied},
+		{
+			name: "anonymous get",
+			call: func() error {
+				_, err := h.GetAdoptionProject(context.Background(), connect.NewRequest(&projectv1.GetAdoptionProjectRequest{Id: "project-1"}))
+				return err
+			},
+			want: connect.CodeUnauthenticated,
+		},
+		{
+			name: "get another user's project",
+			call: func() error {
+				_, err := h.GetAdoptionProject(mallory, connect.NewRequest(&projectv1.GetAdoptionProjectRequest{Id: "project-1"}))
+				return err
+			},
+			want: connect.CodePermissionDenied,
+		},
+		{
+			name: "list another user's projects",
+			call: func() error {
+				_, err := h.ListAdoptionProjects(mallory, connect.NewRequest(&projectv1.ListAdoptionProjectsRequest{UserId: "alice"}))
+				return err
+			},
+			want: connect.CodePermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); connect.CodeOf(err) != tt.want {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// 所有者本人は、user_id を省略しても自分のプロジェクトとして作成・取得できる
+	created, err := h.CreateAdoptionProject(alice, create(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Msg.UserId != "alice" {
+		t.Errorf("owner = %q, want alice", created.Msg.UserId)
+	}
+	if _, err := h.GetAdoptionProject(alice, connect.NewRequest(&projectv1.GetAdoptionProjectRequest{Id: "project-1"})); err != nil {
+		t.Fatal(err)
+	}
+	list, err := h.ListAdoptionProjects(alice, connect.NewRequest(&projectv1.ListAdoptionProjectsRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Msg.Projects) != 2 {
+		t.Errorf("listed %d projects, want 2", len(list.Msg.Projects))
+	}
+	if mine, _ := h.ListAdoptionProjects(mallory, connect.NewRequest(&projectv1.ListAdoptionProjectsRequest{})); len(mine.Msg.Projects) != 0 {
+		t.Errorf("mallory sees %d projects", len(mine.Msg.Projects))
+	}
+}
+
+func TestRescheduleItemRequiresProjectOwner(t *testing.T) {
+	h := newTestHandler(t)
+	h.seedProject(t, "project-1", "alice")
+	req := func() *connect.Request[projectv1.RescheduleItemRequest] {
+		return connect.NewRequest(&projectv1.RescheduleItemRequest{ProjectId: "project-1", ProductId: "a", PurchaseDue: "2026-12-01"})
+	}
+
+	if _, err := h.RescheduleItem(context.Background(), req()); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("anonymous: err = %v, want unauthenticated", err)
+	}
+	if _, err := h.RescheduleItem(auth.WithUserID(context.Background(), "mallory"), req()); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("other user: err = %v, want permission denied", err)
+	}
+	if _, err := h.RescheduleItem(auth.WithUserID(context.Background(), "alice"), req()); err != nil {
+		t.Fatal(err)
+	}
+}
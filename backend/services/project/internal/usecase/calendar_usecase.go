@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/service"
+)
+
+// CalendarUsecase: 導入の予定をカレンダーアプリから購読するための iCalendar フィードを扱うユースケースです。
+type CalendarUsecase struct {
+	projects repository.AdoptionPlanRepository
+	feeds    repository.CalendarFeedRepository
+	planner  *service.CalendarPlanner
+}
+
+// NewCalendarUsecase: ユースケースの作成
+func NewCalendarUsecase(projects repository.AdoptionPlanRepository, feeds repository.CalendarFeedRepository, planner *service.CalendarPlanner) *CalendarUsecase {
+	return &CalendarUsecase{projects: projects, feeds: feeds, planner: planner}
+}
+
+// CreateCalendarFeed: フィードを発行し、URLに埋め込むトークンを返します。
+// 1ユーザーが持てる有効なフィードは1つだけで、発行し直すと以前のフィードは無効になります。
+func (u *CalendarUsecase) CreateCalendarFeed(ctx context.Context, userID string) (*model.CalendarFeed, string, error) {
+	now := time.Now()
+
+	// 1. 新しいフィードとトークンを生成
+	feed, token, err := model.NewCalendarFeed(uuid.NewString(), userID, now)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 2. 以前のフィードを無効化 (URLが漏れた場合の再発行も兼ねます)
+	if _, err := u.revokeAll(ctx, userID, now); err != nil {
+		return nil, "", err
+	}
+
+	// 3. 保存
+	if err := u.feeds.Save(ctx, feed); err != nil {
+		return nil, "", fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+	return feed, token, nil
+}
+
+// RevokeCalendarFeed: ユーザーの有効なフィードをすべて無効化し、無効化した数を返します。
+func (u *CalendarUsecase) RevokeCalendarFeed(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, fmt.Errorf("%w: user id is required", model.ErrInvalidInput)
+	}
+	return u.revokeAll(ctx, userID, time.Now())
+}
+
+// RenderFeed: トークンに対応するユーザーの全プロジェクトの予定を返します。
+// 存在しない・無効化済みのトークンは区別せず ErrFeedNotFound にします。
+func (u *CalendarUsecase) RenderFeed(ctx context.Context, token string) ([]model.CalendarEvent, error) {
+	if token == "" {
+		return nil, model.ErrFeedNotFound
+	}
+
+	// 1. トークンのハッシュでフィードを探す
+	feed, err := u.feeds.GetByTokenHash(ctx, model.HashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if !feed.Active() {
+		return nil, model.ErrFeedNotFound
+	}
+
+	// 2. ユーザーのプロジェクトを予定に変換
+	projects, err := u.projects.ListByUser(ctx, feed.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return u.planner.Events(projects), nil
+}
+
+// revokeAll: ユーザーの有効なフィードを無効化します。
+func (u *CalendarUsecase) revokeAll(ctx context.Context, userID string, now time.Time) (int, error) {
+	feeds, err := u.feeds.ListByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, f := range feeds {
+		if !f.Active() {
+			continue
+		}
+		f.Revoke(now)
+		if err := u.feeds.Save(ctx, f); err != nil {
+			return revoked, fmt.Errorf("failed to revoke calendar feed: %w", err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
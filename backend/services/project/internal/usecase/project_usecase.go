@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+)
+
+// ProjectUsecase: 提案プランの採用と、導入の進捗管理を行うユースケースです (UC-03, UC-04)。
+type ProjectUsecase struct {
+	projects repository.AdoptionPlanRepository
+	plans    repository.SourcePlanReader
+}
+
+// NewProjectUsecase: ユースケースの作成
+func NewProjectUsecase(projects repository.AdoptionPlanRepository, plans repository.SourcePlanReader) *ProjectUsecase {
+	return &ProjectUsecase{projects: projects, plans: plans}
+}
+
+// CreateAdoptionProject: 提案プランから選んだ製品でプロジェクトを作成します。
+// userID はログイン中の利用者です。提案プランはその利用者のアクセストークンで取得するので、所有者の確認は Simulation Service でも行われます。
+func (u *ProjectUsecase) CreateAdoptionProject(ctx context.Context, userID, sourcePlanID string, selections []model.ItemSelection) (*model.AdoptionPlan, error) {
+	if sourcePlanID == "" {
+		return nil, fmt.Errorf("%w: source plan id is required", model.ErrInvalidInput)
+	}
+
+	// 1. 元になる提案プランを Simulation Service から取得
+	source, err := u.plans.GetPlan(ctx, sourcePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 採用内容のチェックとプロジェクトの作成はドメインモデルに任せる
+	project, err := model.NewAdoptionPlan(uuid.NewString(), userID, source, selections, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 保存
+	if err := u.projects.Save(ctx, project, 0); err != nil {
+		return nil, fmt.Errorf("failed to save project: %w", err)
+	}
+	return project, nil
+}
+
+// GetAdoptionProject: プロジェクトを取得します。取得できるのはプロジェクトの所有者だけです。
+func (u *ProjectUsecase) GetAdoptionProject(ctx context.Context, userID, id string) (*model.AdoptionPlan, error) {
+	project, err := u.projects.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ListAdoptionProjects: ユーザーのプロジェクトを新しい順に返します。
+func (u *ProjectUsecase) ListAdoptionProjects(ctx context.Context, userID string) ([]*model.AdoptionPlan, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", model.ErrInvalidInput)
+	}
+	return u.projects.ListByUser(ctx, userID)
+}
+
+// UpdateItemStatus: 製品のステータスを更新します。変更できるのはプロジェクトの所有者 (change.Actor) だけです。
+// 遷移のチェック・履歴の記録・プロジェクト全体のステータスの再計算はドメインモデルが行います。
+// 読み込んでから保存するまでに別の変更が保存されていたら、遷移のチェックをやり直せるよう model.ErrConflict を返します。
+func (u *ProjectUsecase) UpdateItemStatus(ctx context.Context, projectID, productID string, change model.StatusChange) (*model.AdoptionPlan, error) {
+	project, err := u.projects.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.CheckOwner(change.Actor); err != nil {
+		return nil, err
+	}
+	loaded := project.Revision
+	if err := project.UpdateItemStatus(productID, change, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := u.projects.Save(ctx, project, loaded); err != nil {
+		return nil, fmt.Errorf("failed to save project: %w", err)
+	}
+	return project, nil
+}
+
+// RescheduleItem: 製品の購入予定日 (と設置予定日) を変更します。変更できるのはプロジェクトの所有者だけです。
+func (u *ProjectUsecase) RescheduleItem(ctx context.Context, userID, projectID, productID string, purchaseDue time.Time) (*model.AdoptionPlan, error) {
+	project, err := u.projects.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+	loaded := project.Revision
+	if err := project.RescheduleItem(productID, purchaseDue, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := u.projects.Save(ctx, project, loaded); err != nil {
+		return nil, fmt.Errorf("failed to save project: %w", err)
+	}
+	return project, nil
+}
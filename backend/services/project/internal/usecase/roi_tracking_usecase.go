@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/project/internal/domain/service"
+)
+
+// RoiTrackingUsecase: 導入後の実測値を集め、提案時のROI予測と比べるユースケースです。
+// 集めた実測値は、製品カテゴリごとの較正係数として Simulation Service のROI計算に還元されます。
+type RoiTrackingUsecase struct {
+	projects     repository.AdoptionPlanRepository
+	measurements repository.MeasurementRepository
+	tracker      *service.RoiTracker
+}
+
+// NewRoiTrackingUsecase: ユースケースの作成
+func NewRoiTrackingUsecase(projects repository.AdoptionPlanRepository, measurements repository.MeasurementRepository, tracker *service.RoiTracker) *RoiTrackingUsecase {
+	return &RoiTrackingUsecase{projects: projects, measurements: measurements, tracker: tracker}
+}
+
+// RecordTimeMeasurement: 設置済みの製品について、導入後の家事時間を記録します。
+// 実測値は全利用者のROI計算の較正に使われるので、記録できるのはプロジェクトの所有者だけです。
+func (u *RoiTrackingUsecase) RecordTimeMeasurement(ctx context.Context, userID, projectID, productID string, minutesPerSession int, frequencyPerWeek float64) (*model.TimeMeasurement, error) {
+	project, err := u.projects.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+	m, err := project.RecordMeasurement(uuid.NewString(), productID, minutesPerSession, frequencyPerWeek, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := u.measurements.Save(ctx, m); err != nil {
+		return nil, fmt.Errorf("failed to save measurement: %w", err)
+	}
+	return m, nil
+}
+
+// ListDueMeasurements: ユーザーの全プロジェクトから、実測値の入力を依頼すべき製品を返します。
+func (u *RoiTrackingUsecase) ListDueMeasurements(ctx context.Context, userID string) ([]model.DueMeasurement, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", model.ErrInvalidInput)
+	}
+	projects, err := u.projects.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var due []model.DueMeasurement
+	for _, p := range projects {
+		measurements, err := u.measurements.ListByProject(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		due = append(due, u.tracker.DueMeasurements(p, measurements, now)...)
+	}
+	return due, nil
+}
+
+// GetRoiReport: プロジェクトの予測と実測を比べたレポートを返します。見られるのはプロジェクトの所有者だけです。
+func (u *RoiTrackingUsecase) GetRoiReport(ctx context.Context, userID, projectID string) (*model.RoiReport, error) {
+	project, err := u.projects.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+	measurements, err := u.measurements.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return u.tracker.Report(project, measurements), nil
+}
+
+// GetRoiCalibration: 全プロジェクトの実測値から、製品カテゴリごとの較正係数を求めます。
+func (u *RoiTrackingUsecase) GetRoiCalibration(ctx context.Context) (model.RoiCalibration, error) {
+	projects, err := u.projects.ListAll(ctx)
+	if err != nil {
+		return model.RoiCalibration{}, err
+	}
+	reports := make([]*model.RoiReport, 0, len(projects))
+	for _, p := range projects {
+		measurements, err := u.measurements.ListByProject(ctx, p.ID)
+		if err != nil {
+			return model.RoiCalibration{}, err
+		}
+		if len(measurements) == 0 {
+			continue
+		}
+		reports = append(reports, u.tracker.Report(p, measurements))
+	}
+	return u.tracker.Calibrate(reports, time.Now()), nil
+}
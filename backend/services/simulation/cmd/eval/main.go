@@ -0,0 +1,94 @@
+// eval: 正解付きシナリオでレコメンドの品質を評価するコマンドです。
+//
+//	go run ./cmd/eval -scenarios eval/scenarios -catalog eval/catalog.yaml -out report.json -baseline eval/baseline.json
+//
+// -catalog の代わりに -catalog-url を指定すると、起動中の Catalog Service を使います。
+// GEMINI_API_KEY を設定して -llm gemini を指定すると、本物のLLMで説明文を生成します。
+// -baseline を指定した場合、基準より悪化した項目があれば終了コード1で終わります。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/evaluation"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/catalog"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/llm"
+)
+
+func main() {
+	scenariosDir := flag.String("scenarios", "eval/scenarios", "正解付きシナリオのディレクトリ")
+	catalogFile := flag.String("catalog", "eval/catalog.yaml", "評価用カタログのファイル")
+	catalogURL := flag.String("catalog-url", "", "Catalog Service のURL (指定すると -catalog より優先)")
+	llmName := flag.String("llm", "fake", "説明文の生成に使うLLM (fake / gemini)")
+	out := flag.String("out", "", "レポートの出力先 (空なら標準出力)")
+	baselinePath := flag.String("baseline", "", "比較する基準レポート")
+	flag.Parse()
+
+	// 1. シナリオ・カタログ・LLMの準備
+	goldens, err := evaluation.LoadGoldenScenarios(*scenariosDir)
+	if err != nil {
+		log.Fatalf("failed to load scenarios: %v", err)
+	}
+	var products repository.ProductCatalog
+	if *catalogURL != "" {
+		products = catalog.NewDefaultCatalogClient(*catalogURL)
+	} else if products, err = evaluation.LoadFixtureCatalog(*catalogFile); err != nil {
+		log.Fatalf("failed to load catalog: %v", err)
+	}
+	var client repository.LLMClient
+	var modelName string
+	switch *llmName {
+	case "fake":
+		client, modelName = llm.NewFakeClient(), "fake"
+	case "gemini":
+		modelName = os.Getenv("GEMINI_MODEL")
+		if modelName == "" {
+			modelName = llm.DefaultGeminiModel
+		}
+		client = llm.NewGeminiClient(http.DefaultClient, os.Getenv("GEMINI_API_KEY"), modelName)
+	default:
+		log.Fatalf("unknown -llm %q (want fake or gemini)", *llmName)
+	}
+
+	// 2. 評価
+	report := evaluation.NewRunner(products, client, modelName).Run(context.Background(), goldens)
+
+	// 3. レポートの出力
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("failed to create report: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := report.WriteJSON(w); err != nil {
+		log.Fatalf("failed to write report: %v", err)
+	}
+	s := report.Summary
+	fmt.Fprintf(os.Stderr, "passed %d/%d, violations %d, budget adherence %.3f, precision %.3f, recall %.3f, roi %.1f\n",
+		s.Passed, s.Scenarios, s.ConstraintViolation, s.BudgetAdherence, s.MeanPrecision, s.MeanRecall, s.MeanRoiScore)
+
+	// 4. 基準との比較
+	if *baselinePath == "" {
+		return
+	}
+	baseline, err := evaluation.LoadReport(*baselinePath)
+	if err != nil {
+		log.Fatalf("failed to load baseline: %v", err)
+	}
+	if regs := evaluation.Compare(baseline, report); len(regs) > 0 {
+		for _, r := range regs {
+			fmt.Fprintf(os.Stderr, "REGRESSION %s %s: %s -> %s\n", r.Scenario, r.Metric, r.Baseline, r.Current)
+		}
+		os.Exit(1)
+	}
+	fmt.Fprintln(os.Stderr, "no regressions against baseline")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/kinoshitatakumi/opti/gen/go/simulation/v1/simulationv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/catalog"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/llm"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/project"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/interface/grpc"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/usecase"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	// 1. 外部サービスの接続先 (環境変数で切り替え)
+	catalogURL := os.Getenv("CATALOG_SERVICE_URL")
+	if catalogURL == "" {
+		catalogURL = "http://localhost:8080"
+	}
+	// 他サービス・外部APIの呼び出しには、リトライ・タイムアウト・サーキットブレーカーを適用します
+	catalogClient := catalog.NewResilientCatalogClient(catalogURL)
+	var llmClient repository.LLMClient
+	if apiKey := os.Getenv("GEMINI_API_KEY"); apiKey != "" {
+		llmClient = llm.NewResilientClient(llm.NewGeminiClient(http.DefaultClient, apiKey, os.Getenv("GEMINI_MODEL")))
+		log.Println("Using Gemini for plan narratives")
+	} else {
+		llmClient = llm.NewFakeClient()
+		log.Println("GEMINI_API_KEY is not set, using fake LLM client")
+	}
+
+	// 導入後の実測値による較正係数は Project Service から定期的に取得します (未設定なら較正しない)
+	calculator := service.NewRoiCalculator()
+	if projectURL := os.Getenv("PROJECT_SERVICE_URL"); projectURL != "" {
+		refresher := usecase.NewCalibrationRefresher(project.NewResilientCalibrationClient(projectURL))
+		go refresher.Run(context.Background(), 10*time.Minute)
+		calculator = service.NewCalibratedRoiCalculator(refresher)
+		log.Println("Using roi calibration from project service")
+	}
+
+	// 2. Dependency Injection (依存性の注入)
+	scheduler := service.NewRoadmapScheduler(calculator)
+	optimizer := service.NewPlanOptimizer()
+	roiUsecase := usecase.NewRoiUsecase(calculator)
+	roadmapUsecase := usecase.NewRoadmapUsecase(scheduler)
+	accountedLLM := usecase.NewAccountedLLM(llmClient, db.NewMemoryUsageRepository(), db.NewMemoryNarrativeCache(db.DefaultNarrativeCacheEntries), service.NewCostEstimator(nil), quotaPolicyFromEnv())
+	scenarioRepo := db.NewMemoryScenarioRepository()
+	planRepo := db.NewMemoryPlanRepository()
+	simulationUsecase := usecase.NewSimulationUsecase(
+		scenarioRepo,
+		planRepo,
+		catalogClient,
+		accountedLLM,
+		optimizer,
+		service.NewNarrativeBuilder(),
+		calculator,
+		scheduler,
+	)
+	comparisonUsecase := usecase.NewComparisonUsecase(planRepo, scenarioRepo, service.NewPlanComparator())
+	sweepUsecase := usecase.NewSweepUsecase(scenarioRepo, catalogClient, optimizer, service.NewEfficientFrontier())
+	handler := grpc.NewSimulationHandler(roiUsecase, roadmapUsecase, simulationUsecase, comparisonUsecase, sweepUsecase)
+
+	// 3. サーバーのルーティング設定
+	mux := http.NewServeMux()
+	path, connectHandler := simulationv1connect.NewSimulationServiceHandler(handler, connect.WithInterceptors(authInterceptor()))
+	mux.Handle(path, connectHandler)
+
+	// 4. サーバー起動 (catalogが:8080を使うため:8082で起動します)
+	log.Println("Starting simulation service on :8082")
+	err := http.ListenAndServe(":8082", h2c.NewHandler(mux, &http2.Server{}))
+	if err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// quotaPolicyFromEnv: LLMの1日あたりの利用上限を環境変数から読み込みます。
+//   - LLM_DAILY_TOKEN_QUOTA: 全ユーザー共通のトークン上限 (未設定・0なら無制限)
+//   - LLM_DAILY_COST_QUOTA_YEN: 全ユーザー共通の費用上限 (円)
+//   - LLM_QUOTA_OVERRIDES: ユーザーごとのトークン上限 (例: "user-a=200000,user-b=50000"。0なら無制限)
+func quotaPolicyFromEnv() model.QuotaPolicy {
+	policy := model.QuotaPolicy{Overrides: make(map[string]model.DailyQuota)}
+	if v := os.Getenv("LLM_DAILY_TOKEN_QUOTA"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("invalid LLM_DAILY_TOKEN_QUOTA: %v", err)
+		}
+		policy.Default.Tokens = n
+	}
+	if v := os.Getenv("LLM_DAILY_COST_QUOTA_YEN"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			log.Fatalf("invalid LLM_DAILY_COST_QUOTA_YEN: %v", err)
+		}
+		policy.Default.CostYen = f
+	}
+	for _, entry := range strings.Split(os.Getenv("LLM_QUOTA_OVERRIDES"), ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		user, tokens, ok := strings.Cut(entry, "=")
+		n, err := strconv.Atoi(tokens)
+		if !ok || err != nil {
+			log.Fatalf("invalid LLM_QUOTA_OVERRIDES entry: %q", entry)
+		}
+		policy.Overrides[user] = model.DailyQuota{Tokens: n, CostYen: policy.Default.CostYen}
+	}
+	return policy
+}
+
+// authInterceptor: アクセストークンの署名鍵は AUTH_TOKEN_KEY (32バイト以上、全サービス共通) で指定します。
+// 未設定なら起動のたびに鍵を作るのでどのトークンも受け付けず、ログインが必要なAPIは使えません (開発用)。
+func authInterceptor() connect.Interceptor {
+	key := []byte(os.Getenv("AUTH_TOKEN_KEY"))
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("failed to generate auth token key: %v", err)
+		}
+		log.Println("AUTH_TOKEN_KEY is not set; requests that need a logged-in user will be rejected")
+	}
+	signer, err := auth.NewTokenSigner(key, auth.DefaultTokenMaxAge)
+	if err != nil {
+		log.Fatalf("invalid AUTH_TOKEN_KEY: %v", err)
+	}
+	return auth.NewServerInterceptor(signer)
+}
@@ -0,0 +1,28 @@
+package model
+
+import "fmt"
+
+// BudgetType: 予算の種類
+type BudgetType string
+
+const (
+	BudgetTotalInitial     BudgetType = "total_initial"     // 投資可能な総額
+	BudgetMonthlyAllowance BudgetType = "monthly_allowance" // 月々の許容コスト
+)
+
+// BudgetConstraint: 予算設定 (Value Object)
+type BudgetConstraint struct {
+	Amount int32
+	Type   BudgetType
+}
+
+// Validate: 予算の不変条件をチェックします。
+func (b BudgetConstraint) Validate() error {
+	if b.Type != BudgetTotalInitial && b.Type != BudgetMonthlyAllowance {
+		return fmt.Errorf("invalid budget type: %q", b.Type)
+	}
+	if b.Amount <= 0 {
+		return fmt.Errorf("budget amount must be positive: %d", b.Amount)
+	}
+	return nil
+}
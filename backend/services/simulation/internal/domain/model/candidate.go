@@ -0,0 +1,36 @@
+package model
+
+// CandidateProduct: 提案候補となる製品 (Value Object)
+// Catalog Service の製品情報のうち、最適化とROI計算に必要な項目だけを持ちます。
+type CandidateProduct struct {
+	Effect               ProductEffect
+	Name                 string
+	Category             string   // 製品カテゴリ (例: "robot_vacuum", "hub")
+	RequiredHubProtocols []string // 動作に必要なハブの規格 (いずれか1つ)
+	BridgedProtocols     []string // ハブとして仲介できる規格
+	Notes                []string // 住環境との適合判定で付いた注意点
+}
+
+// ID: 製品IDを返します。
+func (c CandidateProduct) ID() string {
+	return c.Effect.ProductID
+}
+
+// CanBridge: この製品がハブとして other を動かせるかを判定します。
+func (c CandidateProduct) CanBridge(other CandidateProduct) bool {
+	for _, req := range other.RequiredHubProtocols {
+		for _, b := range c.BridgedProtocols {
+			if req == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RejectedCandidate: 住環境に合わず候補から外れた製品
+type RejectedCandidate struct {
+	ProductID string
+	Name      string
+	Reasons   []string
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// ChoreCategory: 家事カテゴリを表す型
+type ChoreCategory string
+
+const (
+	ChoreCleaning ChoreCategory = "cleaning"
+	ChoreLaundry  ChoreCategory = "laundry"
+	ChoreCooking  ChoreCategory = "cooking"
+	ChoreSecurity ChoreCategory = "security"
+	ChoreOther    ChoreCategory = "other"
+)
+
+// IsValid: 定義済みのカテゴリかどうかを判定します。
+func (c ChoreCategory) IsValid() bool {
+	switch c {
+	case ChoreCleaning, ChoreLaundry, ChoreCooking, ChoreSecurity, ChoreOther:
+		return true
+	}
+	return false
+}
+
+// ChoreInput: 家事負担の入力 (Value Object)
+// 「どの家事に、1回何分、週に何回かけていて、どれくらい辛いか」を表します。
+type ChoreInput struct {
+	Category          ChoreCategory
+	MinutesPerSession int
+	FrequencyPerWeek  float64
+	PainLevel         int // 1〜5
+	PainReason        string
+}
+
+// MinutesPerWeek: 週あたりの合計時間 (分) を返します。
+func (c ChoreInput) MinutesPerWeek() float64 {
+	return float64(c.MinutesPerSession) * c.FrequencyPerWeek
+}
+
+// Validate: 入力値の不変条件をチェックします。
+func (c ChoreInput) Validate() error {
+	if !c.Category.IsValid() {
+		return fmt.Errorf("invalid chore category: %q", c.Category)
+	}
+	if c.MinutesPerSession < 0 {
+		return fmt.Errorf("minutes per session cannot be negative: %d", c.MinutesPerSession)
+	}
+	if math.IsNaN(c.FrequencyPerWeek) || math.IsInf(c.FrequencyPerWeek, 0) {
+		return fmt.Errorf("frequency per week must be a finite number: %v", c.FrequencyPerWeek)
+	}
+	if c.FrequencyPerWeek < 0 {
+		return fmt.Errorf("frequency per week cannot be negative: %v", c.FrequencyPerWeek)
+	}
+	if c.PainLevel < 1 || c.PainLevel > 5 {
+		return fmt.Errorf("pain level must be between 1 and 5: %d", c.PainLevel)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChoreInputValidate(t *testing.T) {
+	valid := ChoreInput{Category: ChoreCleaning, MinutesPerSession: 30, FrequencyPerWeek: 3, PainLevel: 4}
+	with := func(f func(*ChoreInput)) ChoreInput {
+		c := valid
+		f(&c)
+		return c
+	}
+	tests := []struct {
+		name    string
+		in      ChoreInput
+		wantErr bool
+	}{
+		{name: "valid", in: valid},
+		{name: "zero frequency", in: with(func(c *ChoreInput) { c.FrequencyPerWeek = 0 })},
+		{name: "unknown category", in: with(func(c *ChoreInput) { c.Category = "gardening" }), wantErr: true},
+		{name: "negative minutes", in: with(func(c *ChoreInput) { c.MinutesPerSession = -1 }), wantErr: true},
+		{name: "negative frequency", in: with(func(c *ChoreInput) { c.FrequencyPerWeek = -1 }), wantErr: true},
+		{name: "NaN frequency", in: with(func(c *ChoreInput) { c.FrequencyPerWeek = math.NaN() }), wantErr: true},
+		{name: "infinite frequency", in: with(func(c *ChoreInput) { c.FrequencyPerWeek = math.Inf(1) }), wantErr: true},
+		{name: "pain level out of range", in: with(func(c *ChoreInput) { c.PainLevel = 6 }), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.in.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+// ItemChangeKind: 提案製品の変化の種類
+type ItemChangeKind string
+
+const (
+	ItemAdded   ItemChangeKind = "added"   // 比較先のプランで新たに提案された
+	ItemRemoved ItemChangeKind = "removed" // 比較先のプランでは提案されなくなった
+	ItemChanged ItemChangeKind = "changed" // 両方に含まれるが、個数・価格などが変わった
+)
+
+// CauseCatalog: 入力条件ではなく、カタログ側 (製品の価格など) の変化が原因であることを表します。
+const CauseCatalog = "catalog"
+
+// InputChange: 2つのシナリオ間で変わった入力条件1項目分
+type InputChange struct {
+	Field  string // "budget.amount", "chores.cleaning", "residence.ownership" など
+	Before string // 変更前の値 (表示用)
+	After  string // 変更後の値 (表示用)
+}
+
+// ItemChange: 提案製品1つ分の変化
+type ItemChange struct {
+	ProductID     string
+	Kind          ItemChangeKind
+	Before        *ProposedItem // ItemAdded の場合は nil
+	After         *ProposedItem // ItemRemoved の場合は nil
+	ChangedFields []string      // ItemChanged の場合に変わった項目 ("quantity", "price", "depends_on")
+	CausedBy      []string      // 原因と考えられる入力条件 (InputChange.Field) または CauseCatalog
+}
+
+// GroupDiff: 提案グループごとの差分
+type GroupDiff struct {
+	Category ProposalCategory
+	Added    []ItemChange
+	Removed  []ItemChange
+	Changed  []ItemChange
+}
+
+// RoiDelta: ROI指標の差 (比較先 - 基準)
+type RoiDelta struct {
+	RoiScore                 int32
+	EstimatedTimeSavedYearly float64
+	NetBenefitYearly         int32
+	NpvFiveYears             int32
+	PaybackMonths            float64 // どちらかが回収不能な場合は 0
+	PaybackReachableBefore   bool
+	PaybackReachableAfter    bool
+}
+
+// PlanDiff: 基準プランと比較先プランの差分
+type PlanDiff struct {
+	BasePlanID   string
+	TargetPlanID string
+	Groups       []GroupDiff
+	CostDelta    int64
+	RoiDelta     RoiDelta
+	InputChanges []InputChange
+}
+
+// ComparedPlan: 比較に使う、提案プランとその元になったシナリオの組
+type ComparedPlan struct {
+	Plan     *OptimizationPlan
+	Scenario *SimulationScenario
+}
@@ -0,0 +1,18 @@
+package model
+
+import "errors"
+
+// ドメイン層で発生するエラーの定義です。
+// Handler層では errors.Is でこれらを判定し、適切なRPCステータスコードに変換します。
+var (
+	// ErrInvalidInput: シミュレーションの入力条件が不正
+	ErrInvalidInput = errors.New("invalid simulation input")
+	// ErrScenarioNotFound: 指定されたシナリオが存在しない
+	ErrScenarioNotFound = errors.New("simulation scenario not found")
+	// ErrPlanNotFound: 指定された提案プランが存在しない
+	ErrPlanNotFound = errors.New("optimization plan not found")
+	// ErrScenarioRunning: 同じシナリオのプラン生成が既に実行中
+	ErrScenarioRunning = errors.New("simulation scenario is already running")
+	// ErrQuotaExceeded: ユーザーの1日あたりのLLM利用上限に達した
+	ErrQuotaExceeded = errors.New("daily llm quota exceeded")
+)
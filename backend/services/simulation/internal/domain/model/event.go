@@ -0,0 +1,36 @@
+package model
+
+// SimulationEventKind: プラン生成パイプラインが発行するイベントの種類
+type SimulationEventKind string
+
+const (
+	EventScenarioAccepted   SimulationEventKind = "scenario_accepted"   // 入力を受け付けた
+	EventCandidatesFiltered SimulationEventKind = "candidates_filtered" // 候補製品を絞り込んだ
+	EventOptimizerDone      SimulationEventKind = "optimizer_done"      // 製品構成が決まった
+	EventNarrativeToken     SimulationEventKind = "narrative_token"     // グループ説明文の断片
+	EventRoiComputed        SimulationEventKind = "roi_computed"        // ROIを計算した
+	EventPlanPersisted      SimulationEventKind = "plan_persisted"      // 提案プランを保存した
+)
+
+// NarrativeToken: LLMが生成したグループ説明文の断片
+type NarrativeToken struct {
+	Category ProposalCategory
+	Text     string
+	Done     bool // このグループの説明文が最後まで届いたら true
+}
+
+// SimulationEvent: パイプラインの進捗イベント
+// Kind に応じて、対応するフィールドだけが設定されます。
+type SimulationEvent struct {
+	ScenarioID string
+	Sequence   int  // シナリオ内での通し番号 (1始まり)
+	Replayed   bool // 再開時に、保存済みの結果から再送したイベントなら true
+	Kind       SimulationEventKind
+
+	EligibleCount int                 // EventCandidatesFiltered
+	Rejected      []RejectedCandidate // EventCandidatesFiltered
+	Draft         *OptimizationPlan   // EventOptimizerDone
+	Token         *NarrativeToken     // EventNarrativeToken
+	Roi           *RoiProjection      // EventRoiComputed
+	Plan          *OptimizationPlan   // EventPlanPersisted
+}
@@ -0,0 +1,101 @@
+package model
+
+import "time"
+
+// quotaLocation: 1日の区切りに使うタイムゾーン (日本時間の0時でリセット)
+var quotaLocation = time.FixedZone("JST", 9*60*60)
+
+// StartOfDay: t が属する日 (日本時間) の0時を返します。
+func StartOfDay(t time.Time) time.Time {
+	y, m, d := t.In(quotaLocation).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, quotaLocation)
+}
+
+// LlmUsage: LLM呼び出し1回分の利用記録
+type LlmUsage struct {
+	UserID           string
+	ScenarioID       string
+	Model            string
+	PromptVersion    string
+	PromptTokens     int
+	CompletionTokens int
+	EstimatedCostYen float64
+	Cached           bool // キャッシュから返した場合は true (トークン・費用は0)
+	CreatedAt        time.Time
+}
+
+// UsageReservation: LLM呼び出しの前に確保する、利用上限の枠
+// 呼び出し中の分も上限に数えるので、同じユーザーの並行した呼び出しで上限を超えて使うことはありません。
+type UsageReservation struct {
+	ID               string
+	UserID           string
+	Tokens           int     // 見積もりのトークン数 (入力 + 出力の上限)
+	EstimatedCostYen float64 // 見積もりの費用
+	CreatedAt        time.Time
+}
+
+// UsageTotals: 利用記録の集計
+type UsageTotals struct {
+	Calls            int
+	CachedResponses  int
+	PromptTokens     int
+	CompletionTokens int
+	ReservedTokens   int // 呼び出し中で、まだ入力と出力に分けられない確保中の枠
+	EstimatedCostYen float64
+}
+
+// Tokens: 入力・出力と確保中の枠の合計トークン数を返します。
+func (t UsageTotals) Tokens() int {
+	return t.PromptTokens + t.CompletionTokens + t.ReservedTokens
+}
+
+// Add: 利用記録1件分を集計に加えます。
+func (t *UsageTotals) Add(u LlmUsage) {
+	t.Calls++
+	if u.Cached {
+		t.CachedResponses++
+	}
+	t.PromptTokens += u.PromptTokens
+	t.CompletionTokens += u.CompletionTokens
+	t.EstimatedCostYen += u.EstimatedCostYen
+}
+
+// AddReservation: 確保中の枠を集計に加えます (呼び出し回数には数えません)。
+func (t *UsageTotals) AddReservation(r UsageReservation) {
+	t.ReservedTokens += r.Tokens
+	t.EstimatedCostYen += r.EstimatedCostYen
+}
+
+// ModelPricing: モデルごとの料金 (円 / 100万トークン)
+type ModelPricing struct {
+	InputPerMillion  float64
+	OutputPerMillion float64
+}
+
+// DailyQuota: 1ユーザーあたり1日のLLM利用上限 (0なら無制限)
+type DailyQuota struct {
+	Tokens  int
+	CostYen float64
+}
+
+// Exceeded: 集計がこの上限を超えているかを判定します (上限ちょうどまでは使えます)。
+func (q DailyQuota) Exceeded(t UsageTotals) bool {
+	if q.Tokens > 0 && t.Tokens() > q.Tokens {
+		return true
+	}
+	return q.CostYen > 0 && t.EstimatedCostYen > q.CostYen
+}
+
+// QuotaPolicy: 利用上限の設定。ユーザーごとの上書きがなければ Default を使います。
+type QuotaPolicy struct {
+	Default   DailyQuota
+	Overrides map[string]DailyQuota
+}
+
+// For: ユーザーに適用する上限を返します。
+func (p QuotaPolicy) For(userID string) DailyQuota {
+	if q, ok := p.Overrides[userID]; ok {
+		return q
+	}
+	return p.Default
+}
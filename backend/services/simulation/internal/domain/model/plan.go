@@ -0,0 +1,73 @@
+package model
+
+import "time"
+
+// ProposalCategory: 提案グループのカテゴリ
+// 家事カテゴリに加えて、ハブなどシステム全体に必要な製品をまとめる "management" があります。
+type ProposalCategory string
+
+const (
+	ProposalCleaning   ProposalCategory = "cleaning"
+	ProposalLaundry    ProposalCategory = "laundry"
+	ProposalCooking    ProposalCategory = "cooking"
+	ProposalSecurity   ProposalCategory = "security"
+	ProposalManagement ProposalCategory = "management"
+	ProposalOther      ProposalCategory = "other"
+)
+
+// ProposedItem: 提案する製品への参照 (Value Object)
+// 製品情報そのものは持たず、IDだけを保持します。詳細は Catalog Service から取得します。
+type ProposedItem struct {
+	ProductID       string
+	ProductName     string // 提案時点の製品名 (表示用)
+	ProductCategory string // 製品カテゴリ (例: "robot_vacuum")。導入後のROI較正に使います
+	Quantity        int
+	Price           int32    // 提案時点の単価 (比較・再計算用)
+	Reason          string   // なぜこの製品か
+	DependsOn       []string // 先に導入が必要な製品のID (ハブなど)
+	// CalibrationFactor: 提案時のROI計算で、この製品の削減率に掛けた較正係数 (較正しなかった場合は1)
+	// 導入後の実測から次の係数を求めるとき、カタログの削減率に対する実績に戻すために使います。
+	CalibrationFactor float64
+}
+
+// ProposalGroup: 課題カテゴリごとの提案グループ (Value Object)
+type ProposalGroup struct {
+	Category    ProposalCategory
+	Priority    int    // 1が最優先
+	Description string // このグループでの解決方針 (LLMが生成)
+	Items       []ProposedItem
+}
+
+// OptimizationPlan: シミュレーションシナリオに対する提案プラン (Aggregate Root)
+type OptimizationPlan struct {
+	ID                   string
+	UserID               string
+	SimulationScenarioID string
+	CreatedAt            time.Time
+	Concept              string
+	ProposalGroups       []ProposalGroup
+	RoiProjection        *RoiProjection
+	Roadmap              *AdoptionRoadmap // 予算が monthly_allowance の場合のみ
+}
+
+// Items: 全グループの提案製品を順に返します。
+func (p *OptimizationPlan) Items() []ProposedItem {
+	var items []ProposedItem
+	for _, g := range p.ProposalGroups {
+		items = append(items, g.Items...)
+	}
+	return items
+}
+
+// TotalCost: 提案製品の合計金額を返します。
+func (p *OptimizationPlan) TotalCost() int64 {
+	var total int64
+	for _, it := range p.Items() {
+		q := it.Quantity
+		if q <= 0 {
+			q = 1
+		}
+		total += int64(it.Price) * int64(q)
+	}
+	return total
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Prompt: LLMに渡すプロンプト
+type Prompt struct {
+	Version string // プロンプトテンプレートのバージョン
+	System  string
+	User    string
+}
+
+// CacheKey: 生成結果のキャッシュのキーを返します。
+// 出力を決めるもの (テンプレートのバージョン、システム・ユーザープロンプト、モデル) をまとめてハッシュにします。
+// カタログの製品名を変えたりモデルを切り替えたりすればプロンプトやモデルが変わるので、古い生成結果は使われません。
+func (p Prompt) CacheKey(modelName string) string {
+	h := sha256.New()
+	for _, part := range []string{p.Version, modelName, p.System, p.User} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Completion: LLMの生成結果
+type Completion struct {
+	Text             string
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+}
@@ -0,0 +1,37 @@
+package model
+
+// RoadmapMaxMonths: ロードマップを組む最大の月数です。
+// これを超えても購入できない製品は「予算内に収まらない」として残します。
+const RoadmapMaxMonths = 36
+
+// RoadmapItem: ロードマップに並べる製品 (Value Object)
+// ROI計算用の効果データに加えて、「先に買っておく必要がある製品」(ハブなど) を持ちます。
+type RoadmapItem struct {
+	Effect    ProductEffect
+	DependsOn []string // 先に導入が必要な製品のID
+}
+
+// RoadmapMonth: ロードマップの1ヶ月分
+type RoadmapMonth struct {
+	Month           int      // 1始まりの月番号 (1 = 今月)
+	ProductIDs      []string // この月に購入する製品 (依存関係順)
+	Spend           int32    // この月の支出
+	CumulativeSpend int32    // ここまでの累計支出
+	CarryOver       int32    // 翌月に繰り越す予算
+	Roi             *RoiProjection
+	CumulativeValue int32 // ここまでに得られた便益 (時給換算) から累計支出を引いた額
+}
+
+// UnscheduledItem: ロードマップに入れられなかった製品と、その理由
+type UnscheduledItem struct {
+	ProductID string
+	Reason    string
+}
+
+// AdoptionRoadmap: 段階的導入ロードマップ
+// 「今月はこれ、来月はこれ」という購入計画と、月ごとの累計ROIの推移を表します。
+type AdoptionRoadmap struct {
+	Budget      BudgetConstraint
+	Months      []RoadmapMonth
+	Unscheduled []UnscheduledItem
+}
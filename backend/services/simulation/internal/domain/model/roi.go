@@ -0,0 +1,168 @@
+package model
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+)
+
+// ROI計算のデフォルト前提値です。入力で0が指定された場合 (割引率は未指定の場合) に使います。
+const (
+	DefaultHourlyWage      = 1500 // 時給 (円)
+	DefaultElectricityRate = 31.0 // 電気料金単価 (円/kWh、全国家庭電気製品公正取引協議会の目安単価)
+	DefaultDiscountRate    = 0.03 // NPV計算用の年間割引率
+	RoiHorizonYears        = 5    // NPVを計算する期間 (年)
+)
+
+// ProductEffect: ROI計算に必要な製品1つ分の効果とコスト (Value Object)
+// カタログの製品情報のうち、計算に必要な数値だけを抜き出したものです。
+type ProductEffect struct {
+	ProductID                  string
+	Category                   string // 製品カテゴリ (例: "robot_vacuum")。較正係数の適用に使います
+	Price                      value.Price
+	Quantity                   int
+	TimeReductions             map[ChoreCategory]float64 // 家事カテゴリごとの削減率 (0.0〜1.0)
+	MaintenanceMinutesPerMonth int
+	PowerWatts                 float64 // 平均消費電力 (W)
+	ConsumableCostPerMonth     int32   // 消耗品コスト (円/月)
+}
+
+// Units: 個数を返します。未指定(0)の場合は1個として扱います。
+func (p ProductEffect) Units() int {
+	if p.Quantity <= 0 {
+		return 1
+	}
+	return p.Quantity
+}
+
+// Validate: 入力値の不変条件をチェックします。
+func (p ProductEffect) Validate() error {
+	for c, rate := range p.TimeReductions {
+		if !c.IsValid() {
+			return fmt.Errorf("invalid chore category for product %s: %q", p.ProductID, c)
+		}
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("time reduction rate must be between 0 and 1 for product %s: %v", p.ProductID, rate)
+		}
+	}
+	if p.MaintenanceMinutesPerMonth < 0 {
+		return fmt.Errorf("maintenance minutes cannot be negative for product %s: %d", p.ProductID, p.MaintenanceMinutesPerMonth)
+	}
+	if p.PowerWatts < 0 {
+		return fmt.Errorf("power consumption cannot be negative for product %s: %v", p.ProductID, p.PowerWatts)
+	}
+	if p.ConsumableCostPerMonth < 0 {
+		return fmt.Errorf("consumable cost cannot be negative for product %s: %d", p.ProductID, p.ConsumableCostPerMonth)
+	}
+	return nil
+}
+
+// RoiAssumptions: ROI計算の前提条件 (Value Object)
+type RoiAssumptions struct {
+	HourlyWage      int32    // 時給 (円)
+	ElectricityRate float64  // 電気料金単価 (円/kWh)
+	DiscountRate    *float64 // 年間割引率。nilならデフォルト値 (0は割引なしとして扱います)
+}
+
+// WithDefaults: 0の項目 (割引率は未指定の場合) をデフォルト値で埋めたコピーを返します。
+func (a RoiAssumptions) WithDefaults() RoiAssumptions {
+	if a.HourlyWage <= 0 {
+		a.HourlyWage = DefaultHourlyWage
+	}
+	if a.ElectricityRate <= 0 {
+		a.ElectricityRate = DefaultElectricityRate
+	}
+	if a.DiscountRate == nil {
+		rate := DefaultDiscountRate
+		a.DiscountRate = &rate
+	}
+	return a
+}
+
+// RoiCalibration: 導入後の実測値から求めた、製品カテゴリごとの削減率の較正係数 (Value Object)
+// 係数が 0.8 なら「このカテゴリの製品は、カタログの削減率の8割しか効いていない」ことを表します。
+type RoiCalibration struct {
+	Factors map[string]float64 // 製品カテゴリ -> 係数
+}
+
+// Factor: 製品カテゴリの係数を返します。係数がなければ1 (補正なし) です。
+func (c RoiCalibration) Factor(category string) float64 {
+	f, ok := c.Factors[category]
+	if !ok || f <= 0 {
+		return 1
+	}
+	return f
+}
+
+// Apply: 製品カテゴリの係数を削減率に掛けます。係数がなければそのまま返し、結果は0〜1に収めます。
+func (c RoiCalibration) Apply(category string, rate float64) float64 {
+	f, ok := c.Factors[category]
+	if !ok || f <= 0 {
+		return rate
+	}
+	return math.Min(1, math.Max(0, rate*f))
+}
+
+// RoiInput: ROI計算の入力一式
+type RoiInput struct {
+	Chores      []ChoreInput
+	Products    []ProductEffect
+	Assumptions RoiAssumptions
+}
+
+// Validate: 入力全体の不変条件をチェックします。
+func (in RoiInput) Validate() error {
+	for _, c := range in.Chores {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, p := range in.Products {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	if r := in.Assumptions.DiscountRate; r != nil && *r <= -1 {
+		return fmt.Errorf("discount rate must be greater than -1: %v", *r)
+	}
+	return nil
+}
+
+// ChoreSaving: 家事カテゴリごとの削減効果
+type ChoreSaving struct {
+	Category             ChoreCategory
+	MinutesPerWeekBefore float64
+	ReductionRate        float64
+	HoursSavedYearly     float64
+	TimeValueYearly      int32
+}
+
+// ScoreComponent: ROIスコアの内訳1項目分
+// 「なぜこのスコアなのか」をユーザーに説明できるよう、加点理由を文章で持ちます。
+type ScoreComponent struct {
+	Key       string
+	Label     string
+	Points    float64
+	MaxPoints float64
+	Detail    string
+}
+
+// RoiProjection: ROI計算結果 (Value Object)
+// docs/domain_modeling.md の OptimizationPlan.roiProjection に対応します。
+type RoiProjection struct {
+	TotalInitialCost         int32
+	EstimatedTimeSavedYearly float64 // 時間/年 (メンテナンス時間控除後)
+	RoiScore                 int32   // 0〜100
+	MentalImpact             string
+	MentalImpactScore        int32 // 0〜100
+	TimeValueYearly          int32
+	RunningCostYearly        int32
+	NetBenefitYearly         int32
+	PaybackReachable         bool
+	PaybackMonths            float64
+	NpvFiveYears             int32
+	ChoreSavings             []ChoreSaving
+	ScoreBreakdown           []ScoreComponent
+	Calibration              RoiCalibration // 計算に使った較正係数 (較正しなかった場合は空)
+}
@@ -0,0 +1,26 @@
+package model
+
+import "testing"
+
+func TestRoiAssumptionsWithDefaultsKeepsExplicitZeroDiscountRate(t *testing.T) {
+	zero := 0.0
+	tests := []struct {
+		name string
+		in   RoiAssumptions
+		want float64
+	}{
+		{name: "unset", in: RoiAssumptions{}, want: DefaultDiscountRate},
+		{name: "explicit zero", in: RoiAssumptions{DiscountRate: &zero}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.in.WithDefaults()
+			if *got.DiscountRate != tt.want {
+				t.Errorf("discount rate = %v, want %v", *got.DiscountRate, tt.want)
+			}
+			if got.HourlyWage != DefaultHourlyWage || got.ElectricityRate != DefaultElectricityRate {
+				t.Errorf("zero fields were not defaulted: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScenarioStatus: シミュレーションシナリオの状態
+type ScenarioStatus string
+
+const (
+	ScenarioDraft     ScenarioStatus = "draft"     // 受付済み・生成中 (中断された場合もこの状態のまま)
+	ScenarioCompleted ScenarioStatus = "completed" // 提案プランの保存まで完了
+)
+
+// SimulationStage: プラン生成パイプラインの進捗段階
+// 中断されたシナリオを再開する際、どこまで終わっているかの目印になります。
+type SimulationStage int
+
+const (
+	StageAccepted  SimulationStage = iota + 1 // 入力を受け付けた
+	StageFiltered                             // 住環境で候補製品を絞り込んだ
+	StageOptimized                            // 予算内の製品構成を決めた
+	StageNarrated                             // 全グループの説明文を生成した
+	StageRoiDone                              // ROIを計算した
+	StagePersisted                            // 提案プランを保存した
+)
+
+// WiFiSituation: 住居のWi-Fi環境
+type WiFiSituation struct {
+	Available bool
+	Bands     []string // "wifi_2_4ghz" / "wifi_5ghz"。空なら両方
+}
+
+// ResidenceSnapshot: 診断実行時点の住環境 (Value Object)
+// スナップショットパターンにより、後から UserContext が変わってもシナリオの前提は変わりません。
+type ResidenceSnapshot struct {
+	Type         string
+	Ownership    string
+	HasSteps     bool
+	StepHeightMM int
+	FloorTypes   []string
+	WiFi         *WiFiSituation // nilなら不明
+}
+
+// ScenarioInput: シナリオの入力条件
+type ScenarioInput struct {
+	Budget      BudgetConstraint
+	Chores      []ChoreInput
+	Residence   ResidenceSnapshot
+	Assumptions RoiAssumptions
+}
+
+// Validate: 入力条件の不変条件をチェックします。
+func (in ScenarioInput) Validate() error {
+	if err := in.Budget.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if len(in.Chores) == 0 {
+		return fmt.Errorf("%w: at least one chore is required", ErrInvalidInput)
+	}
+	for _, c := range in.Chores {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	return nil
+}
+
+// ScenarioProgress: パイプラインの途中経過 (チェックポイント)
+// 各段階が終わるたびに保存し、再開時はここから続きを実行します。
+type ScenarioProgress struct {
+	Stage      SimulationStage
+	Candidates []CandidateProduct  // StageFiltered 以降: 住環境に合う候補製品
+	Rejected   []RejectedCandidate // StageFiltered 以降: 住環境に合わず除外された製品
+	Draft      *OptimizationPlan   // StageOptimized 以降: 作成途中のプラン
+}
+
+// SimulationScenario: 1回の診断・提案の単位 (Aggregate Root)
+type SimulationScenario struct {
+	ID        string
+	UserID    string
+	Status    ScenarioStatus
+	Input     ScenarioInput
+	Progress  ScenarioProgress
+	PlanID    string // 完了後に生成されたプランのID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Advance: 進捗段階を進めます。段階が戻ることはありません。
+func (s *SimulationScenario) Advance(stage SimulationStage, now time.Time) {
+	if stage > s.Progress.Stage {
+		s.Progress.Stage = stage
+	}
+	s.UpdatedAt = now
+}
+
+// Complete: プランの保存が終わったシナリオを完了状態にします。
+func (s *SimulationScenario) Complete(planID string, now time.Time) {
+	s.PlanID = planID
+	s.Status = ScenarioCompleted
+	s.Advance(StagePersisted, now)
+}
@@ -0,0 +1,31 @@
+package model
+
+// MonthlyPlanningMonths: 予算が monthly_allowance の場合に、製品構成を選ぶ際に見込む月数です。
+// 「月々の予算 × 12ヶ月分」までの製品を選び、実際の購入順はロードマップで決めます。
+const MonthlyPlanningMonths = 12
+
+// SelectedProduct: 最適化で選ばれた製品1つ分
+type SelectedProduct struct {
+	Candidate CandidateProduct
+	Group     ProposalCategory
+	DependsOn []string // 先に導入が必要な製品のID
+	Relief    float64  // 追加したことで増えたペイン解消量
+	Reason    string
+}
+
+// Selection: 最適化の結果 (予算内で選ばれた製品構成)
+type Selection struct {
+	Items         []SelectedProduct // 選ばれた順 (依存先が先)
+	Groups        []ProposalGroup   // 提案グループ (説明文は空)
+	TotalCost     int64
+	PainRelief    float64 // 構成全体でのペイン解消量 (苦痛度 × 週あたり分 × 削減率)
+	MaxPainRelief float64 // 全ての家事が100%削減された場合のペイン解消量
+}
+
+// SpendingCap: 製品構成を選ぶ際の予算上限を返します。
+func (b BudgetConstraint) SpendingCap() int64 {
+	if b.Type == BudgetMonthlyAllowance {
+		return int64(b.Amount) * MonthlyPlanningMonths
+	}
+	return int64(b.Amount)
+}
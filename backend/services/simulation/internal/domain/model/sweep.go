@@ -0,0 +1,71 @@
+package model
+
+import "fmt"
+
+// SweepMaxPoints: 1回の予算スイープで評価する予算額の上限です。
+const SweepMaxPoints = 200
+
+// BudgetRange: 予算スイープの範囲 (Value Object)
+type BudgetRange struct {
+	Type BudgetType
+	Min  int32
+	Max  int32
+	Step int32
+}
+
+// Validate: 範囲の不変条件をチェックします。
+func (r BudgetRange) Validate() error {
+	if r.Type != BudgetTotalInitial && r.Type != BudgetMonthlyAllowance {
+		return fmt.Errorf("%w: invalid budget type: %q", ErrInvalidInput, r.Type)
+	}
+	if r.Min <= 0 || r.Max < r.Min {
+		return fmt.Errorf("%w: invalid budget range: %d - %d", ErrInvalidInput, r.Min, r.Max)
+	}
+	if r.Step <= 0 {
+		return fmt.Errorf("%w: budget step must be positive: %d", ErrInvalidInput, r.Step)
+	}
+	if n := r.Points(); len(n) > SweepMaxPoints {
+		return fmt.Errorf("%w: too many budget points: %d (max %d)", ErrInvalidInput, len(n), SweepMaxPoints)
+	}
+	return nil
+}
+
+// Points: 評価する予算額を小さい順に返します。Max が Step の倍数でなくても、Max は必ず含めます。
+func (r BudgetRange) Points() []int32 {
+	if r.Step <= 0 || r.Max < r.Min {
+		return nil
+	}
+	var points []int32
+	for v := int64(r.Min); v < int64(r.Max); v += int64(r.Step) {
+		points = append(points, int32(v))
+		if len(points) > SweepMaxPoints {
+			return points
+		}
+	}
+	return append(points, r.Max)
+}
+
+// SweepPoint: 予算額1つ分の最適化結果
+type SweepPoint struct {
+	Budget    BudgetConstraint
+	Selection *Selection
+}
+
+// BudgetBreakpoint: 効率的フロンティア上の1点
+// 予算を MinBudget 以上にすると、この製品構成が選ばれるようになります。
+type BudgetBreakpoint struct {
+	MinBudget         int32
+	TotalCost         int64
+	PainRelief        float64
+	PainReliefPercent float64 // 全ての家事が100%削減された場合を100とした割合
+	ProductIDs        []string
+	Added             []string // 1つ前のブレークポイントから増えた製品
+	Removed           []string // 1つ前のブレークポイントから外れた製品
+}
+
+// BudgetSweep: 予算スイープの結果
+type BudgetSweep struct {
+	Range       BudgetRange
+	Evaluated   int                // 評価した予算額の数
+	Breakpoints []BudgetBreakpoint // コストの小さい順
+}
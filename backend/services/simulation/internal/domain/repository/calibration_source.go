@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// CalibrationSource: 導入後の実測値から求めたROIの較正係数を取得します。
+// 実測値は Project Service が持つため、実装は Infrastructure 層のRPCクライアントです。
+type CalibrationSource interface {
+	Fetch(ctx context.Context) (model.RoiCalibration, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// LLMClient: 提案文を生成する言語モデルへのクライアントです。
+// 実装 (本物のAPI / 開発用のフェイク) は Infrastructure 層にあります。
+type LLMClient interface {
+	// Generate: プロンプトから文章を生成します。
+	// 生成された断片は届いた順に onToken に渡されます。onToken がエラーを返したら生成を中止します。
+	Generate(ctx context.Context, prompt model.Prompt, onToken func(text string) error) (*model.Completion, error)
+	// Model: 生成に使うモデル名を返します (生成結果のキャッシュのキーや費用の見積もりに使います)。
+	Model() string
+}
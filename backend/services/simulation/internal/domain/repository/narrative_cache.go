@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// NarrativeCache: LLMが生成した説明文を、入力内容のハッシュをキーに保存するキャッシュです。
+// 同じ条件での再実行では LLM を呼ばずに済むので、費用がかかりません。
+type NarrativeCache interface {
+	// Get: キーに対応する文章を返します。無ければ ok = false です。
+	Get(ctx context.Context, key string) (text string, ok bool, err error)
+	Put(ctx context.Context, key, text string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// PlanRepository: 提案プランの永続化を担当します。
+type PlanRepository interface {
+	Save(ctx context.Context, plan *model.OptimizationPlan) error
+	// GetByID: 見つからない場合は model.ErrPlanNotFound を返します。
+	GetByID(ctx context.Context, id string) (*model.OptimizationPlan, error)
+}
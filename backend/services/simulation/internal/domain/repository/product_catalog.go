@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// ProductCatalog: Catalog Service から提案候補の製品を取得します。
+// 外部サービスへの依存も Repository と同じくインターフェースとして定義し、実装は Infrastructure 層に置きます。
+type ProductCatalog interface {
+	// ListCandidates: 住環境に合う製品と、合わずに除外された製品を返します。
+	ListCandidates(ctx context.Context, residence model.ResidenceSnapshot) ([]model.CandidateProduct, []model.RejectedCandidate, error)
+}
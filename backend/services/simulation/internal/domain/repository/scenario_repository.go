@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// ScenarioRepository: シミュレーションシナリオの永続化を担当します。
+type ScenarioRepository interface {
+	Save(ctx context.Context, scenario *model.SimulationScenario) error
+	// GetByID: 見つからない場合は model.ErrScenarioNotFound を返します。
+	GetByID(ctx context.Context, id string) (*model.SimulationScenario, error)
+}
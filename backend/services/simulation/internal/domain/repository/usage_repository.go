@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// UsageRepository: LLMの利用記録の永続化を担当します。
+type UsageRepository interface {
+	Record(ctx context.Context, usage model.LlmUsage) error
+	// TotalsByUserSince: ユーザーの since 以降の利用を集計します。
+	TotalsByUserSince(ctx context.Context, userID string, since time.Time) (model.UsageTotals, error)
+	// Reserve: ユーザーの since 以降の利用と確保中の枠に r を足しても quota を超えなければ、枠 r を確保します。
+	// 超える場合は model.ErrQuotaExceeded を返します。確認と確保は不可分に行います。
+	Reserve(ctx context.Context, r model.UsageReservation, quota model.DailyQuota, since time.Time) error
+	// Settle: 確保した枠を解放し、usage を記録します。usage が nil なら解放だけ行います (呼び出しが失敗した場合)。
+	Settle(ctx context.Context, reservationID string, usage *model.LlmUsage) error
+	// ListByScenario: シナリオの利用記録を古い順に返します。
+	ListByScenario(ctx context.Context, scenarioID string) ([]model.LlmUsage, error)
+}
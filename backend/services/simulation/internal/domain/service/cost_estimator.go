@@ -0,0 +1,36 @@
+package service
+
+import (
+	"math"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// DefaultModelPricing: 主なモデルの料金 (円 / 100万トークン、1ドル = 150円で換算した目安)
+// 一覧にないモデルは費用0として扱うので、新しいモデルを使う場合はここに追加してください。
+var DefaultModelPricing = map[string]model.ModelPricing{
+	"gemini-2.0-flash":      {InputPerMillion: 15, OutputPerMillion: 60},
+	"gemini-2.0-flash-lite": {InputPerMillion: 11.25, OutputPerMillion: 45},
+	"gemini-1.5-pro":        {InputPerMillion: 187.5, OutputPerMillion: 750},
+	"fake":                  {},
+}
+
+// CostEstimator: トークン数からLLMの費用を見積もるドメインサービスです。
+type CostEstimator struct {
+	pricing map[string]model.ModelPricing
+}
+
+// NewCostEstimator: 見積もりサービスの作成 (pricing が nil なら DefaultModelPricing を使います)
+func NewCostEstimator(pricing map[string]model.ModelPricing) *CostEstimator {
+	if pricing == nil {
+		pricing = DefaultModelPricing
+	}
+	return &CostEstimator{pricing: pricing}
+}
+
+// Estimate: 費用 (円) を小数第4位までで返します。
+func (e *CostEstimator) Estimate(modelName string, promptTokens, completionTokens int) float64 {
+	p := e.pricing[modelName]
+	cost := float64(promptTokens)/1e6*p.InputPerMillion + float64(completionTokens)/1e6*p.OutputPerMillion
+	return math.Round(cost*10000) / 10000
+}
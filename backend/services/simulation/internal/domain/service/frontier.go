@@ -0,0 +1,74 @@
+package service
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// EfficientFrontier: 予算額ごとの最適化結果から、ペイン解消量とコストの効率的フロンティアを求めるドメインサービスです。
+// 1. 予算の小さい順に並べ、同じ製品構成が続く区間は1つのブレークポイントにまとめる
+// 2. より安いブレークポイント以下のペイン解消量しか得られない点 (支配された点) は除く
+type EfficientFrontier struct{}
+
+// NewEfficientFrontier: サービスの作成
+func NewEfficientFrontier() *EfficientFrontier {
+	return &EfficientFrontier{}
+}
+
+// Build: スイープ結果からブレークポイントを作ります。
+func (f *EfficientFrontier) Build(points []model.SweepPoint) []model.BudgetBreakpoint {
+	sorted := slices.Clone(points)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Budget.Amount < sorted[j].Budget.Amount })
+
+	var breakpoints []model.BudgetBreakpoint
+	var prev []string
+	bestRelief := 0.0
+	for _, p := range sorted {
+		ids := selectedIDs(p.Selection)
+		if len(breakpoints) > 0 && slices.Equal(ids, prev) {
+			continue
+		}
+		if len(breakpoints) > 0 && p.Selection.PainRelief <= bestRelief+1e-9 {
+			continue
+		}
+		percent := 0.0
+		if p.Selection.MaxPainRelief > 0 {
+			percent = math.Round(p.Selection.PainRelief/p.Selection.MaxPainRelief*1000) / 10
+		}
+		breakpoints = append(breakpoints, model.BudgetBreakpoint{
+			MinBudget:         p.Budget.Amount,
+			TotalCost:         p.Selection.TotalCost,
+			PainRelief:        round1(p.Selection.PainRelief),
+			PainReliefPercent: percent,
+			ProductIDs:        ids,
+			Added:             difference(ids, prev),
+			Removed:           difference(prev, ids),
+		})
+		prev = ids
+		bestRelief = p.Selection.PainRelief
+	}
+	return breakpoints
+}
+
+func selectedIDs(s *model.Selection) []string {
+	ids := make([]string, 0, len(s.Items))
+	for _, it := range s.Items {
+		ids = append(ids, it.Candidate.ID())
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// difference: a にあって b にないIDを返します。
+func difference(a, b []string) []string {
+	var diff []string
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			diff = append(diff, id)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// sweepPoint: 予算 amount で ids が選ばれ、relief だけペインが減った結果
+func sweepPoint(amount int32, relief float64, ids ...string) model.SweepPoint {
+	selection := &model.Selection{PainRelief: relief, MaxPainRelief: 1000}
+	for _, id := range ids {
+		selection.Items = append(selection.Items, model.SelectedProduct{Candidate: candidate(id, id, 1000, nil)})
+	}
+	return model.SweepPoint{Budget: model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: amount}, Selection: selection}
+}
+
+func TestEfficientFrontierBuild(t *testing.T) {
+	type breakpoint struct {
+		minBudget int32
+		ids       []string
+		added     []string
+		removed   []string
+	}
+	tests := []struct {
+		name   string
+		points []model.SweepPoint
+		want   []breakpoint
+	}{
+		{
+			name: "runs of the same selection become one breakpoint",
+			points: []model.SweepPoint{
+				sweepPoint(10000, 0),
+				sweepPoint(20000, 500, "a"),
+				sweepPoint(30000, 500, "a"),
+				sweepPoint(40000, 800, "a", "b"),
+			},
+			want: []breakpoint{
+				{minBudget: 10000, ids: []string{}},
+				{minBudget: 20000, ids: []string{"a"}, added: []string{"a"}},
+				{minBudget: 40000, ids: []string{"a", "b"}, added: []string{"b"}},
+			},
+		},
+		{
+			name: "points are sorted by budget",
+			points: []model.SweepPoint{
+				sweepPoint(40000, 800, "a", "b"),
+				sweepPoint(20000, 500, "a"),
+			},
+			want: []breakpoint{
+				{minBudget: 20000, ids: []string{"a"}, added: []string{"a"}},
+				{minBudget: 40000, ids: []string{"a", "b"}, added: []string{"b"}},
+			},
+		},
+		{
+			name: "a costlier selection without more relief is dominated",
+			points: []model.SweepPoint{
+				sweepPoint(20000, 500, "a"),
+				sweepPoint(30000, 500, "c"),
+				sweepPoint(40000, 400, "d"),
+				sweepPoint(50000, 900, "c", "d"),
+			},
+			want: []breakpoint{
+				{minBudget: 20000, ids: []string{"a"}, added: []string{"a"}},
+				{minBudget: 50000, ids: []string{"c", "d"}, added: []string{"c", "d"}, removed: []string{"a"}},
+			},
+		},
+	}
+	f := NewEfficientFrontier()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []breakpoint
+			for _, b := range f.Build(tt.points) {
+				got = append(got, breakpoint{minBudget: b.MinBudget, ids: b.ProductIDs, added: b.Added, removed: b.Removed})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("breakpoints = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// 最適化結果をスイープしたフロンティアは、予算もペイン解消量も単調に増えます。
+func TestEfficientFrontierIsMonotonic(t *testing.T) {
+	candidates := []model.CandidateProduct{premiumVacuum, budgetVacuum, matterHub, laundrySensor}
+	o := NewPlanOptimizer()
+	var points []model.SweepPoint
+	for amount := int32(5000); amount <= 60000; amount += 5000 {
+		budget := model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: amount}
+		selection, err := o.Optimize(context.Background(), candidates, testChores, budget)
+		if err != nil {
+			t.Fatal(err)
+		}
+		points = append(points, model.SweepPoint{Budget: budget, Selection: selection})
+	}
+
+	breakpoints := NewEfficientFrontier().Build(points)
+	if len(breakpoints) < 3 {
+		t.Fatalf("breakpoints = %+v, want at least 3", breakpoints)
+	}
+	for i := 1; i < len(breakpoints); i++ {
+		prev, cur := breakpoints[i-1], breakpoints[i]
+		if cur.MinBudget <= prev.MinBudget || cur.PainRelief <= prev.PainRelief || cur.PainReliefPercent < prev.PainReliefPercent {
+			t.Errorf("breakpoint %d %+v does not improve on %+v", i, cur, prev)
+		}
+		if int64(cur.MinBudget) < cur.TotalCost {
+			t.Errorf("breakpoint %d costs %d over its budget %d", i, cur.TotalCost, cur.MinBudget)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// NarrativePromptVersion: 説明文プロンプトのバージョンです。
+// テンプレートを変えたら必ず上げてください (生成結果のキャッシュのキーや評価の比較に使います)。
+const NarrativePromptVersion = "narrative-v1"
+
+// proposalLabels: 提案グループの日本語名です。
+var proposalLabels = map[model.ProposalCategory]string{
+	model.ProposalCleaning:   "掃除",
+	model.ProposalLaundry:    "洗濯",
+	model.ProposalCooking:    "料理",
+	model.ProposalSecurity:   "防犯",
+	model.ProposalManagement: "管理・連携",
+	model.ProposalOther:      "その他",
+}
+
+const narrativeSystemPrompt = "あなたは家電とスマートホームに詳しいアドバイザーです。" +
+	"ユーザーの家事の悩みと住環境を踏まえ、提案する製品でどう解決するかを、やさしい日本語で2〜3文にまとめてください。" +
+	"製品の価格や型番を新たに作り出してはいけません。"
+
+// NarrativeBuilder: LLMに渡すプロンプトと、プラン全体のコンセプト文を組み立てるドメインサービスです。
+type NarrativeBuilder struct{}
+
+// NewNarrativeBuilder: ビルダーの作成
+func NewNarrativeBuilder() *NarrativeBuilder {
+	return &NarrativeBuilder{}
+}
+
+// GroupPrompt: 提案グループ1つ分の説明文を生成するためのプロンプトを作ります。
+// names は製品IDから製品名を引くためのマップです。
+func (b *NarrativeBuilder) GroupPrompt(group model.ProposalGroup, names map[string]string, input model.ScenarioInput) model.Prompt {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "提案グループ: %s\n", proposalLabels[group.Category])
+
+	sb.WriteString("家事の悩み:\n")
+	for _, ch := range input.Chores {
+		if model.ProposalCategory(ch.Category) != group.Category && group.Category != model.ProposalManagement {
+			continue
+		}
+		fmt.Fprintf(&sb, "- %s: 週%.0f分, 苦痛度%d/5", choreLabels[ch.Category], ch.MinutesPerWeek(), ch.PainLevel)
+		if ch.PainReason != "" {
+			fmt.Fprintf(&sb, " (%s)", ch.PainReason)
+		}
+		sb.WriteString("\n")
+	}
+
+	r := input.Residence
+	fmt.Fprintf(&sb, "住環境: 種別=%s, 所有形態=%s", valueOr(r.Type, "不明"), valueOr(r.Ownership, "不明"))
+	if r.HasSteps {
+		fmt.Fprintf(&sb, ", 段差あり(%dmm)", r.StepHeightMM)
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("提案する製品:\n")
+	for _, it := range group.Items {
+		fmt.Fprintf(&sb, "- %s ×%d: %s\n", valueOr(names[it.ProductID], it.ProductID), it.Quantity, it.Reason)
+	}
+
+	return model.Prompt{
+		Version: NarrativePromptVersion,
+		System:  narrativeSystemPrompt,
+		User:    sb.String(),
+	}
+}
+
+// Concept: プラン全体のコンセプト文を作ります。LLMを使わず、選ばれた構成から決定的に組み立てます。
+func (b *NarrativeBuilder) Concept(selection *model.Selection) string {
+	if len(selection.Items) == 0 {
+		return "予算と住環境に合う製品が見つかりませんでした。予算や条件を見直してみてください。"
+	}
+	first := proposalLabels[selection.Groups[0].Category]
+	percent := 0
+	if selection.MaxPainRelief > 0 {
+		percent = int(selection.PainRelief / selection.MaxPainRelief * 100)
+	}
+	return fmt.Sprintf("いちばん負担の大きい「%s」から着手し、%d製品・合計%d円で家事の負担を約%d%%軽くするプランです。",
+		first, len(selection.Items), selection.TotalCost, percent)
+}
+
+func valueOr(v, fallback string) string {
+	if v == "" {
+		return fallback
+	}
+	return v
+}
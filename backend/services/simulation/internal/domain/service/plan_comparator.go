@@ -0,0 +1,280 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// PlanComparator: 2つの提案プランの差分を求めるドメインサービスです。
+// 製品の増減をグループごとに並べ、入力条件のどの変更が原因になったかを推定します。
+// 1. 住環境で一方のシナリオだけ除外されていた製品 → 住環境の変更
+// 2. 家事グループの製品 → そのカテゴリの家事入力の変更
+// 3. 予算が変わっていれば、予算の変更 (増えたなら追加、減ったなら削除の原因)
+// 4. 管理グループ (ハブ) → それを必要とする製品の原因を引き継ぐ
+// 5. 価格が変わった製品 → カタログの変更
+type PlanComparator struct{}
+
+// NewPlanComparator: コンパレータの作成
+func NewPlanComparator() *PlanComparator {
+	return &PlanComparator{}
+}
+
+// Compare: base を基準に target との差分を求めます。
+func (c *PlanComparator) Compare(base, target model.ComparedPlan) model.PlanDiff {
+	diff := model.PlanDiff{
+		BasePlanID:   base.Plan.ID,
+		TargetPlanID: target.Plan.ID,
+		CostDelta:    target.Plan.TotalCost() - base.Plan.TotalCost(),
+		RoiDelta:     roiDelta(base.Plan.RoiProjection, target.Plan.RoiProjection),
+		InputChanges: inputChanges(base.Scenario.Input, target.Scenario.Input),
+	}
+
+	baseGroups, targetGroups := groupItems(base.Plan), groupItems(target.Plan)
+	for _, cat := range groupOrder(base.Plan, target.Plan) {
+		g := model.GroupDiff{Category: cat}
+		before, after := baseGroups[cat], targetGroups[cat]
+		for _, id := range sortedKeys(before, after) {
+			b, inBase := before[id]
+			a, inTarget := after[id]
+			switch {
+			case inBase && !inTarget:
+				g.Removed = append(g.Removed, model.ItemChange{
+					ProductID: id, Kind: model.ItemRemoved, Before: &b,
+					CausedBy: causes(model.ItemRemoved, id, cat, base, target, diff.InputChanges),
+				})
+			case !inBase && inTarget:
+				g.Added = append(g.Added, model.ItemChange{
+					ProductID: id, Kind: model.ItemAdded, After: &a,
+					CausedBy: causes(model.ItemAdded, id, cat, base, target, diff.InputChanges),
+				})
+			default:
+				fields := changedFields(b, a)
+				if len(fields) == 0 {
+					continue
+				}
+				change := model.ItemChange{
+					ProductID: id, Kind: model.ItemChanged, Before: &b, After: &a, ChangedFields: fields,
+					CausedBy: causes(model.ItemChanged, id, cat, base, target, diff.InputChanges),
+				}
+				if slices.Contains(fields, "price") && !slices.Contains(change.CausedBy, model.CauseCatalog) {
+					change.CausedBy = append(change.CausedBy, model.CauseCatalog)
+				}
+				g.Changed = append(g.Changed, change)
+			}
+		}
+		if len(g.Added)+len(g.Removed)+len(g.Changed) > 0 {
+			diff.Groups = append(diff.Groups, g)
+		}
+	}
+	return diff
+}
+
+// causes: 製品の変化の原因と考えられる入力条件を返します。
+func causes(kind model.ItemChangeKind, productID string, cat model.ProposalCategory, base, target model.ComparedPlan, changes []model.InputChange) []string {
+	var result []string
+	add := func(prefix string) {
+		for _, ch := range changes {
+			if strings.HasPrefix(ch.Field, prefix) && !slices.Contains(result, ch.Field) {
+				result = append(result, ch.Field)
+			}
+		}
+	}
+
+	// 1. 住環境: 一方のシナリオでだけ除外されていた
+	if rejected(base.Scenario, productID) != rejected(target.Scenario, productID) {
+		add("residence.")
+	}
+	// 2. 家事: 同じカテゴリの家事入力が変わった
+	if cat != model.ProposalManagement && cat != model.ProposalOther {
+		add("chores." + string(cat))
+	}
+	// 3. 予算: 増えれば追加、減れば削除の原因になりうる (種類の変更はどちらにも影響する)
+	bb, tb := base.Scenario.Input.Budget, target.Scenario.Input.Budget
+	switch {
+	case bb.Type != tb.Type:
+		add("budget.")
+	case kind == model.ItemAdded && tb.SpendingCap() > bb.SpendingCap(),
+		kind == model.ItemRemoved && tb.SpendingCap() < bb.SpendingCap(),
+		kind == model.ItemChanged && tb.SpendingCap() != bb.SpendingCap():
+		add("budget.")
+	}
+	// 管理グループ (ハブ) は、それを必要とする製品の変化に連動するため、その製品の原因を引き継ぎます
+	if cat == model.ProposalManagement {
+		plan := target.Plan
+		if kind == model.ItemRemoved {
+			plan = base.Plan
+		}
+		for _, g := range plan.ProposalGroups {
+			for _, it := range g.Items {
+				if !slices.Contains(it.DependsOn, productID) {
+					continue
+				}
+				for _, f := range causes(kind, it.ProductID, g.Category, base, target, changes) {
+					if !slices.Contains(result, f) {
+						result = append(result, f)
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+func rejected(s *model.SimulationScenario, productID string) bool {
+	for _, r := range s.Progress.Rejected {
+		if r.ProductID == productID {
+			return true
+		}
+	}
+	return false
+}
+
+func changedFields(before, after model.ProposedItem) []string {
+	var fields []string
+	if before.Quantity != after.Quantity {
+		fields = append(fields, "quantity")
+	}
+	if before.Price != after.Price {
+		fields = append(fields, "price")
+	}
+	if !slices.Equal(before.DependsOn, after.DependsOn) {
+		fields = append(fields, "depends_on")
+	}
+	return fields
+}
+
+func groupItems(p *model.OptimizationPlan) map[model.ProposalCategory]map[string]model.ProposedItem {
+	groups := make(map[model.ProposalCategory]map[string]model.ProposedItem)
+	for _, g := range p.ProposalGroups {
+		items := make(map[string]model.ProposedItem, len(g.Items))
+		for _, it := range g.Items {
+			items[it.ProductID] = it
+		}
+		groups[g.Category] = items
+	}
+	return groups
+}
+
+// groupOrder: 比較先プランのグループ順に、基準プランにしかないグループを続けます。
+func groupOrder(base, target *model.OptimizationPlan) []model.ProposalCategory {
+	var order []model.ProposalCategory
+	for _, p := range []*model.OptimizationPlan{target, base} {
+		for _, g := range p.ProposalGroups {
+			if !slices.Contains(order, g.Category) {
+				order = append(order, g.Category)
+			}
+		}
+	}
+	return order
+}
+
+func sortedKeys(a, b map[string]model.ProposedItem) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func roiDelta(before, after *model.RoiProjection) model.RoiDelta {
+	if before == nil {
+		before = &model.RoiProjection{}
+	}
+	if after == nil {
+		after = &model.RoiProjection{}
+	}
+	d := model.RoiDelta{
+		RoiScore:                 after.RoiScore - before.RoiScore,
+		EstimatedTimeSavedYearly: round1(after.EstimatedTimeSavedYearly - before.EstimatedTimeSavedYearly),
+		NetBenefitYearly:         after.NetBenefitYearly - before.NetBenefitYearly,
+		NpvFiveYears:             after.NpvFiveYears - before.NpvFiveYears,
+		PaybackReachableBefore:   before.PaybackReachable,
+		PaybackReachableAfter:    after.PaybackReachable,
+	}
+	if before.PaybackReachable && after.PaybackReachable {
+		d.PaybackMonths = round1(after.PaybackMonths - before.PaybackMonths)
+	}
+	return d
+}
+
+// inputChanges: 2つのシナリオの入力条件を比べ、変わった項目を返します。
+func inputChanges(before, after model.ScenarioInput) []model.InputChange {
+	var changes []model.InputChange
+	add := func(field, b, a string) {
+		if b != a {
+			changes = append(changes, model.InputChange{Field: field, Before: b, After: a})
+		}
+	}
+
+	add("budget.type", string(before.Budget.Type), string(after.Budget.Type))
+	add("budget.amount", fmt.Sprint(before.Budget.Amount), fmt.Sprint(after.Budget.Amount))
+
+	bc, ac := choresByCategory(before.Chores), choresByCategory(after.Chores)
+	for _, cat := range []model.ChoreCategory{model.ChoreCleaning, model.ChoreLaundry, model.ChoreCooking, model.ChoreSecurity, model.ChoreOther} {
+		add("chores."+string(cat), bc[cat], ac[cat])
+	}
+
+	br, ar := before.Residence, after.Residence
+	add("residence.type", br.Type, ar.Type)
+	add("residence.ownership", br.Ownership, ar.Ownership)
+	add("residence.steps", stepsText(br), stepsText(ar))
+	add("residence.floor_types", strings.Join(sortedCopy(br.FloorTypes), ","), strings.Join(sortedCopy(ar.FloorTypes), ","))
+	add("residence.wifi", wifiText(br.WiFi), wifiText(ar.WiFi))
+
+	ba, aa := before.Assumptions.WithDefaults(), after.Assumptions.WithDefaults()
+	add("assumptions.hourly_wage", fmt.Sprint(ba.HourlyWage), fmt.Sprint(aa.HourlyWage))
+	add("assumptions.electricity_rate", fmt.Sprint(ba.ElectricityRate), fmt.Sprint(aa.ElectricityRate))
+	add("assumptions.discount_rate", fmt.Sprint(*ba.DiscountRate), fmt.Sprint(*aa.DiscountRate))
+	return changes
+}
+
+// choresByCategory: 家事入力をカテゴリごとの表示用文字列にまとめます。入力がないカテゴリは空文字です。
+func choresByCategory(chores []model.ChoreInput) map[model.ChoreCategory]string {
+	parts := make(map[model.ChoreCategory][]string)
+	for _, ch := range chores {
+		parts[ch.Category] = append(parts[ch.Category],
+			fmt.Sprintf("週%d分・苦痛度%d", int(math.Round(ch.MinutesPerWeek())), ch.PainLevel))
+	}
+	result := make(map[model.ChoreCategory]string, len(parts))
+	for cat, p := range parts {
+		sort.Strings(p)
+		result[cat] = strings.Join(p, " / ")
+	}
+	return result
+}
+
+func stepsText(r model.ResidenceSnapshot) string {
+	if !r.HasSteps {
+		return "なし"
+	}
+	return fmt.Sprintf("あり(%dmm)", r.StepHeightMM)
+}
+
+func wifiText(w *model.WiFiSituation) string {
+	switch {
+	case w == nil:
+		return "不明"
+	case !w.Available:
+		return "なし"
+	case len(w.Bands) == 0:
+		return "あり"
+	}
+	return strings.Join(sortedCopy(w.Bands), ",")
+}
+
+func sortedCopy(s []string) []string {
+	c := slices.Clone(s)
+	sort.Strings(c)
+	return c
+}
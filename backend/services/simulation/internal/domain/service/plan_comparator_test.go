@@ -0,0 +1,141 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// comparedInput: 比較テスト用の入力条件 (総額予算、掃除と洗濯、賃貸)
+func comparedInput() model.ScenarioInput {
+	return model.ScenarioInput{
+		Budget:    model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000},
+		Chores:    []model.ChoreInput{testChores[0], testChores[1]},
+		Residence: model.ResidenceSnapshot{Type: "apartment", Ownership: "rented"},
+	}
+}
+
+func comparedPlan(id string, input model.ScenarioInput, rejected []string, groups ...model.ProposalGroup) model.ComparedPlan {
+	scenario := &model.SimulationScenario{ID: "s-" + id, Input: input}
+	for _, r := range rejected {
+		scenario.Progress.Rejected = append(scenario.Progress.Rejected, model.RejectedCandidate{ProductID: r})
+	}
+	return model.ComparedPlan{Plan: &model.OptimizationPlan{ID: id, ProposalGroups: groups}, Scenario: scenario}
+}
+
+func group(cat model.ProposalCategory, items ...model.ProposedItem) model.ProposalGroup {
+	return model.ProposalGroup{Category: cat, Items: items}
+}
+
+func proposed(id string, price int32, dependsOn ...string) model.ProposedItem {
+	return model.ProposedItem{ProductID: id, Quantity: 1, Price: price, DependsOn: dependsOn}
+}
+
+// summarize: 差分を "カテゴリ 種類 製品ID [原因]" の行にまとめます。
+func summarize(diff model.PlanDiff) []string {
+	var lines []string
+	for _, g := range diff.Groups {
+		for _, list := range [][]model.ItemChange{g.Added, g.Removed, g.Changed} {
+			for _, ch := range list {
+				lines = append(lines, fmt.Sprintf("%s %s %s [%s]", g.Category, ch.Kind, ch.ProductID, strings.Join(ch.CausedBy, ",")))
+			}
+		}
+	}
+	return lines
+}
+
+func TestPlanComparatorAttributesChanges(t *testing.T) {
+	vac := proposed("vacuum", 30000)
+	washer := proposed("washer", 15000)
+	base := comparedPlan("base", comparedInput(), nil, group(model.ProposalCleaning, vac))
+
+	moreBudget := comparedInput()
+	moreBudget.Budget.Amount = 80000
+	lessBudget := comparedInput()
+	lessBudget.Budget.Amount = 20000
+	owned := comparedInput()
+	owned.Residence.Ownership = "owned"
+	morePain := comparedInput()
+	morePain.Chores[1].PainLevel = 5
+
+	tests := []struct {
+		name   string
+		base   model.ComparedPlan
+		target model.ComparedPlan
+		want   []string
+		inputs []string // InputChanges の Field
+	}{
+		{
+			name:   "same plan and input",
+			base:   base,
+			target: comparedPlan("target", comparedInput(), nil, group(model.ProposalCleaning, vac)),
+		},
+		{
+			name:   "bigger budget adds a product",
+			base:   base,
+			target: comparedPlan("target", moreBudget, nil, group(model.ProposalCleaning, vac), group(model.ProposalLaundry, washer)),
+			want:   []string{"laundry added washer [budget.amount]"},
+			inputs: []string{"budget.amount"},
+		},
+		{
+			name:   "smaller budget removes a product",
+			base:   base,
+			target: comparedPlan("target", lessBudget, nil),
+			want:   []string{"cleaning removed vacuum [budget.amount]"},
+			inputs: []string{"budget.amount"},
+		},
+		{
+			name:   "bigger budget is not blamed for a removal",
+			base:   base,
+			target: comparedPlan("target", moreBudget, nil),
+			want:   []string{"cleaning removed vacuum []"},
+			inputs: []string{"budget.amount"},
+		},
+		{
+			name:   "residence change filtered the product out",
+			base:   base,
+			target: comparedPlan("target", owned, []string{"vacuum"}),
+			want:   []string{"cleaning removed vacuum [residence.ownership]"},
+			inputs: []string{"residence.ownership"},
+		},
+		{
+			name: "chore change adds a product and the hub it needs",
+			base: base,
+			target: comparedPlan("target", morePain, nil,
+				group(model.ProposalCleaning, vac),
+				group(model.ProposalLaundry, proposed("sensor", 10000, "hub")),
+				group(model.ProposalManagement, proposed("hub", 5000)),
+			),
+			want:   []string{"laundry added sensor [chores.laundry]", "management added hub [chores.laundry]"},
+			inputs: []string{"chores.laundry"},
+		},
+		{
+			name:   "price change is blamed on the catalog",
+			base:   base,
+			target: comparedPlan("target", comparedInput(), nil, group(model.ProposalCleaning, proposed("vacuum", 28000))),
+			want:   []string{"cleaning changed vacuum [catalog]"},
+		},
+	}
+	c := NewPlanComparator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := c.Compare(tt.base, tt.target)
+			if got := summarize(diff); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changes = %q, want %q", got, tt.want)
+			}
+			var inputs []string
+			for _, ch := range diff.InputChanges {
+				inputs = append(inputs, ch.Field)
+			}
+			if !reflect.DeepEqual(inputs, tt.inputs) {
+				t.Errorf("input changes = %v, want %v", inputs, tt.inputs)
+			}
+			if want := tt.target.Plan.TotalCost() - tt.base.Plan.TotalCost(); diff.CostDelta != want {
+				t.Errorf("cost delta = %d, want %d", diff.CostDelta, want)
+			}
+		})
+	}
+}
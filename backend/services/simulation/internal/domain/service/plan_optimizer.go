@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// choreLabels: 提案理由の文章に使う家事カテゴリの日本語名です。
+var choreLabels = map[model.ChoreCategory]string{
+	model.ChoreCleaning: "掃除",
+	model.ChoreLaundry:  "洗濯",
+	model.ChoreCooking:  "料理",
+	model.ChoreSecurity: "防犯",
+	model.ChoreOther:    "その他の家事",
+}
+
+// PlanOptimizer: 予算内で最もペインを減らせる製品構成を選ぶドメインサービスです。
+// 同じ入力には必ず同じ結果を返します (LLMは使いません)。
+// 1. 「1円あたりの追加ペイン解消量」が最も大きい製品を、予算が尽きるまで1つずつ選ぶ (貪欲法)
+// 2. ハブが必要な製品は、まだ動かせるハブが無ければ最安のハブとセットで費用を見積もる
+// 3. 同じ製品カテゴリ (例: ロボット掃除機) からは1つだけ選ぶ (ハブは除く)
+type PlanOptimizer struct{}
+
+// NewPlanOptimizer: オプティマイザの作成
+func NewPlanOptimizer() *PlanOptimizer {
+	return &PlanOptimizer{}
+}
+
+// option: 次に追加する候補 (製品本体 + 必要ならハブ)
+type option struct {
+	product model.CandidateProduct
+	hub     *model.CandidateProduct
+	cost    int64
+	relief  float64
+}
+
+// Optimize: 候補製品から予算内の製品構成を選びます。
+// 候補が多いと選定に時間がかかるので、1つ選ぶごとに ctx の期限切れ・キャンセルを確認します。
+func (o *PlanOptimizer) Optimize(ctx context.Context, candidates []model.CandidateProduct, chores []model.ChoreInput, budget model.BudgetConstraint) (*model.Selection, error) {
+	if err := budget.Validate(); err != nil {
+		return nil, err
+	}
+	for _, c := range chores {
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	// 入力順に左右されないよう、ID順に並べてから処理します
+	sorted := make([]model.CandidateProduct, 0, len(candidates))
+	seen := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		if err := c.Effect.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[c.ID()] {
+			return nil, fmt.Errorf("duplicated candidate product: %s", c.ID())
+		}
+		seen[c.ID()] = true
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID() < sorted[j].ID() })
+
+	selection := &model.Selection{}
+	for _, ch := range chores {
+		selection.MaxPainRelief += float64(ch.PainLevel) * ch.MinutesPerWeek()
+	}
+
+	remaining := budget.SpendingCap()
+	chosen := make(map[string]bool)
+	takenCategory := make(map[string]bool)
+	var owned []model.CandidateProduct
+	current := 0.0
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		best, ok := o.bestOption(sorted, owned, chosen, takenCategory, chores, current, remaining)
+		if !ok {
+			break
+		}
+		if best.hub != nil {
+			owned = append(owned, *best.hub)
+			chosen[best.hub.ID()] = true
+			selection.Items = append(selection.Items, model.SelectedProduct{
+				Candidate: *best.hub,
+				Group:     model.ProposalManagement,
+				Reason:    fmt.Sprintf("「%s」を動かすために必要なハブです。", best.product.Name),
+			})
+		}
+		owned = append(owned, best.product)
+		chosen[best.product.ID()] = true
+		if !isHub(best.product) && best.product.Category != "" {
+			takenCategory[best.product.Category] = true
+		}
+		selection.Items = append(selection.Items, model.SelectedProduct{
+			Candidate: best.product,
+			Group:     groupOf(best.product, chores),
+			DependsOn: dependenciesOf(best.product, owned),
+			Relief:    best.relief,
+			Reason:    reasonFor(best.product, chores),
+		})
+		remaining -= best.cost
+		current += best.relief
+	}
+
+	for _, it := range selection.Items {
+		selection.TotalCost += productCost(it.Candidate)
+	}
+	selection.PainRelief = current
+	selection.Groups = buildGroups(selection.Items, chores)
+	return selection, nil
+}
+
+// bestOption: 予算内で「1円あたりの追加ペイン解消量」が最大の候補を返します。
+// 同点の場合は安い方、さらに同じならID順で先の方を選びます。
+func (o *PlanOptimizer) bestOption(candidates, owned []model.CandidateProduct, chosen, takenCategory map[string]bool, chores []model.ChoreInput, current float64, remaining int64) (option, bool) {
+	var best option
+	found := false
+	for _, c := range candidates {
+		if chosen[c.ID()] || isHub(c) || takenCategory[c.Category] {
+			continue
+		}
+		opt := option{product: c, cost: productCost(c)}
+		if len(c.RequiredHubProtocols) > 0 && !bridged(c, owned) {
+			hub, ok := cheapestHub(c, candidates, chosen)
+			if !ok {
+				continue
+			}
+			opt.hub = &hub
+			opt.cost += productCost(hub)
+		}
+		if opt.cost > remaining {
+			continue
+		}
+		opt.relief = totalRelief(append(append([]model.CandidateProduct{}, owned...), c), chores) - current
+		if opt.relief <= 1e-9 {
+			continue
+		}
+		if !found || better(opt, best) {
+			best, found = opt, true
+		}
+	}
+	return best, found
+}
+
+func better(a, b option) bool {
+	ra, rb := perYen(a), perYen(b)
+	if ra != rb {
+		return ra > rb
+	}
+	if a.cost != b.cost {
+		return a.cost < b.cost
+	}
+	return a.product.ID() < b.product.ID()
+}
+
+func perYen(o option) float64 {
+	if o.cost == 0 {
+		return math.Inf(1)
+	}
+	return o.relief / float64(o.cost)
+}
+
+// totalRelief: 製品構成全体のペイン解消量です。同じ家事への効果は 1 - Π(1 - r) で合成します。
+func totalRelief(products []model.CandidateProduct, chores []model.ChoreInput) float64 {
+	reduction := make(map[model.ChoreCategory]float64)
+	for _, p := range products {
+		for cat, rate := range p.Effect.TimeReductions {
+			reduction[cat] = 1 - (1-reduction[cat])*(1-rate)
+		}
+	}
+	var relief float64
+	for _, ch := range chores {
+		relief += float64(ch.PainLevel) * ch.MinutesPerWeek() * reduction[ch.Category]
+	}
+	return relief
+}
+
+func productCost(c model.CandidateProduct) int64 {
+	return int64(c.Effect.Price.Amount()) * int64(c.Effect.Units())
+}
+
+func isHub(c model.CandidateProduct) bool {
+	return len(c.BridgedProtocols) > 0
+}
+
+func bridged(c model.CandidateProduct, owned []model.CandidateProduct) bool {
+	for _, o := range owned {
+		if o.CanBridge(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// cheapestHub: c を動かせるハブのうち最安のものを返します。
+func cheapestHub(c model.CandidateProduct, candidates []model.CandidateProduct, chosen map[string]bool) (model.CandidateProduct, bool) {
+	var best model.CandidateProduct
+	found := false
+	for _, h := range candidates {
+		if chosen[h.ID()] || !h.CanBridge(c) {
+			continue
+		}
+		if !found || productCost(h) < productCost(best) {
+			best, found = h, true
+		}
+	}
+	return best, found
+}
+
+// dependenciesOf: c を動かすハブ (選ばれた製品の中で最初に見つかったもの) のIDを返します。
+func dependenciesOf(c model.CandidateProduct, owned []model.CandidateProduct) []string {
+	if len(c.RequiredHubProtocols) == 0 {
+		return nil
+	}
+	for _, o := range owned {
+		if o.ID() != c.ID() && o.CanBridge(c) {
+			return []string{o.ID()}
+		}
+	}
+	return nil
+}
+
+// groupOf: 製品が最もペインを減らす家事カテゴリを、提案グループとして返します。
+func groupOf(c model.CandidateProduct, chores []model.ChoreInput) model.ProposalCategory {
+	var best model.ChoreCategory
+	bestRelief := 0.0
+	for _, cat := range choreOrder(chores) {
+		relief := categoryWeight(cat, chores) * c.Effect.TimeReductions[cat]
+		if relief > bestRelief {
+			best, bestRelief = cat, relief
+		}
+	}
+	if best == "" {
+		return model.ProposalOther
+	}
+	return model.ProposalCategory(best)
+}
+
+// reasonFor: 「どの家事を週に何分減らすか」を提案理由の文章にします。
+func reasonFor(c model.CandidateProduct, chores []model.ChoreInput) string {
+	cat := model.ChoreCategory(groupOf(c, chores))
+	minutes := 0.0
+	for _, ch := range chores {
+		if ch.Category == cat {
+			minutes += ch.MinutesPerWeek()
+		}
+	}
+	rate := c.Effect.TimeReductions[cat]
+	label, ok := choreLabels[cat]
+	if !ok {
+		return "生活全般の負担を減らします。"
+	}
+	return fmt.Sprintf("%sの時間を約%d%%削減し、週あたり約%d分を取り戻せます。", label, int(math.Round(rate*100)), int(math.Round(minutes*rate)))
+}
+
+// categoryWeight: 家事カテゴリの「苦痛度 × 週あたり分」の合計です。
+func categoryWeight(cat model.ChoreCategory, chores []model.ChoreInput) float64 {
+	var w float64
+	for _, ch := range chores {
+		if ch.Category == cat {
+			w += float64(ch.PainLevel) * ch.MinutesPerWeek()
+		}
+	}
+	return w
+}
+
+// choreOrder: 家事カテゴリを「苦痛度 × 週あたり分」の大きい順に返します。
+func choreOrder(chores []model.ChoreInput) []model.ChoreCategory {
+	var order []model.ChoreCategory
+	seen := make(map[model.ChoreCategory]bool)
+	for _, ch := range chores {
+		if !seen[ch.Category] {
+			seen[ch.Category] = true
+			order = append(order, ch.Category)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return categoryWeight(order[i], chores) > categoryWeight(order[j], chores)
+	})
+	return order
+}
+
+// buildGroups: 選ばれた製品を提案グループにまとめます。
+// 優先度は家事のペインが大きい順で、その他 → 管理 (ハブ) の順に続きます。
+func buildGroups(items []model.SelectedProduct, chores []model.ChoreInput) []model.ProposalGroup {
+	var order []model.ProposalCategory
+	for _, cat := range choreOrder(chores) {
+		if cat != model.ChoreOther {
+			order = append(order, model.ProposalCategory(cat))
+		}
+	}
+	order = append(order, model.ProposalOther, model.ProposalManagement)
+
+	var groups []model.ProposalGroup
+	for _, cat := range order {
+		g := model.ProposalGroup{Category: cat}
+		for _, it := range items {
+			if it.Group != cat {
+				continue
+			}
+			g.Items = append(g.Items, model.ProposedItem{
+				ProductID:       it.Candidate.ID(),
+				ProductName:     it.Candidate.Name,
+				ProductCategory: it.Candidate.Category,
+				Quantity:        it.Candidate.Effect.Units(),
+				Price:           it.Candidate.Effect.Price.Amount(),
+				Reason:          it.Reason,
+				DependsOn:       it.DependsOn,
+			})
+		}
+		if len(g.Items) > 0 {
+			g.Priority = len(groups) + 1
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+func candidate(id, category string, price int32, reductions map[model.ChoreCategory]float64) model.CandidateProduct {
+	return model.CandidateProduct{Effect: testEffect(id, price, reductions), Name: id, Category: category}
+}
+
+// 1円あたりの解消量は 高級掃除機 840/30000 > 安価な掃除機 525/20000 > ハブ込みの洗濯センサー 210/15000 です。
+var (
+	premiumVacuum = candidate("vacuum-premium", "robot_vacuum", 30000, map[model.ChoreCategory]float64{model.ChoreCleaning: 0.8})
+	budgetVacuum  = candidate("vacuum-budget", "robot_vacuum", 20000, map[model.ChoreCategory]float64{model.ChoreCleaning: 0.5})
+	matterHub     = model.CandidateProduct{Effect: testEffect("hub", 5000, nil), Name: "hub", Category: "hub", BridgedProtocols: []string{"matter"}}
+	laundrySensor = model.CandidateProduct{
+		Effect:               testEffect("sensor", 10000, map[model.ChoreCategory]float64{model.ChoreLaundry: 0.5}),
+		Name:                 "sensor",
+		Category:             "sensor",
+		RequiredHubProtocols: []string{"matter"},
+	}
+)
+
+func TestPlanOptimizerSelectsWithinBudget(t *testing.T) {
+	all := []model.CandidateProduct{laundrySensor, matterHub, budgetVacuum, premiumVacuum}
+	tests := []struct {
+		name       string
+		candidates []model.CandidateProduct
+		budget     model.BudgetConstraint
+		ids        []string // 選ばれた順
+		dependsOn  map[string][]string
+		totalCost  int64
+		relief     float64
+	}{
+		{
+			name:       "best value first, then the sensor bundled with its hub",
+			candidates: all,
+			budget:     model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000},
+			ids:        []string{"vacuum-premium", "hub", "sensor"},
+			dependsOn:  map[string][]string{"sensor": {"hub"}},
+			totalCost:  45000,
+			relief:     1050,
+		},
+		{
+			name:       "cheaper product of the same category when the better one does not fit",
+			candidates: all,
+			budget:     model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 25000},
+			ids:        []string{"vacuum-budget"},
+			totalCost:  20000,
+			relief:     525,
+		},
+		{
+			name:       "sensor is skipped when the bundle with its hub is over the budget",
+			candidates: []model.CandidateProduct{laundrySensor, matterHub},
+			budget:     model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 14000},
+		},
+		{
+			name:       "sensor is skipped when no hub can drive it",
+			candidates: []model.CandidateProduct{laundrySensor},
+			budget:     model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000},
+		},
+		{
+			name:       "monthly allowance plans for a year of spending",
+			candidates: all,
+			budget:     model.BudgetConstraint{Type: model.BudgetMonthlyAllowance, Amount: 4000},
+			ids:        []string{"vacuum-premium", "hub", "sensor"},
+			dependsOn:  map[string][]string{"sensor": {"hub"}},
+			totalCost:  45000,
+			relief:     1050,
+		},
+	}
+	o := NewPlanOptimizer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selection, err := o.Optimize(context.Background(), tt.candidates, testChores, tt.budget)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, it := range selection.Items {
+				ids = append(ids, it.Candidate.ID())
+				if want := tt.dependsOn[it.Candidate.ID()]; !reflect.DeepEqual(it.DependsOn, want) {
+					t.Errorf("%s depends on %v, want %v", it.Candidate.ID(), it.DependsOn, want)
+				}
+			}
+			if !reflect.DeepEqual(ids, tt.ids) {
+				t.Errorf("ids = %v, want %v", ids, tt.ids)
+			}
+			if selection.TotalCost != tt.totalCost || selection.PainRelief != tt.relief {
+				t.Errorf("cost = %d, relief = %v, want %d, %v", selection.TotalCost, selection.PainRelief, tt.totalCost, tt.relief)
+			}
+			if selection.TotalCost > tt.budget.SpendingCap() {
+				t.Errorf("cost %d is over the cap %d", selection.TotalCost, tt.budget.SpendingCap())
+			}
+			if selection.MaxPainRelief != 1470 {
+				t.Errorf("max relief = %v, want 1470", selection.MaxPainRelief)
+			}
+		})
+	}
+}
+
+func TestPlanOptimizerIgnoresCandidateOrder(t *testing.T) {
+	o := NewPlanOptimizer()
+	budget := model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000}
+	want, err := o.Optimize(context.Background(), []model.CandidateProduct{premiumVacuum, budgetVacuum, matterHub, laundrySensor}, testChores, budget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := o.Optimize(context.Background(), []model.CandidateProduct{laundrySensor, matterHub, budgetVacuum, premiumVacuum}, testChores, budget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("selection depends on the candidate order:\n got  %+v\n want %+v", got, want)
+	}
+}
+
+func TestPlanOptimizerRejectsInvalidInput(t *testing.T) {
+	budget := model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000}
+	tests := []struct {
+		name       string
+		candidates []model.CandidateProduct
+		chores     []model.ChoreInput
+		budget     model.BudgetConstraint
+	}{
+		{"zero budget", []model.CandidateProduct{premiumVacuum}, testChores, model.BudgetConstraint{Type: model.BudgetTotalInitial}},
+		{"duplicated candidate", []model.CandidateProduct{premiumVacuum, premiumVacuum}, testChores, budget},
+		{"reduction over 100%", []model.CandidateProduct{candidate("x", "x", 1000, map[model.ChoreCategory]float64{model.ChoreCleaning: 1.5})}, testChores, budget},
+		{"pain level out of range", []model.CandidateProduct{premiumVacuum}, []model.ChoreInput{{Category: model.ChoreCleaning, MinutesPerSession: 30, FrequencyPerWeek: 7, PainLevel: 9}}, budget},
+	}
+	o := NewPlanOptimizer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := o.Optimize(context.Background(), tt.candidates, tt.chores, tt.budget); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestPlanOptimizerStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	budget := model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000}
+	if _, err := NewPlanOptimizer().Optimize(ctx, []model.CandidateProduct{premiumVacuum}, testChores, budget); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// RoadmapScheduler: 選ばれた製品を月ごとの購入計画に並べるドメインサービスです。
+// 1. ハブなどの依存先は、それを必要とする製品より前 (または同じ月の先) に買う
+// 2. 月々の予算を超えない (使わなかった分は翌月に繰り越して積み立てる)
+// 3. 「1円あたりのペイン解消量」が大きいものから順に買う
+type RoadmapScheduler struct {
+	calculator *RoiCalculator
+}
+
+// NewRoadmapScheduler: スケジューラの作成
+// 月ごとの累計ROIを出すために RoiCalculator を使います。
+func NewRoadmapScheduler(calculator *RoiCalculator) *RoadmapScheduler {
+	return &RoadmapScheduler{calculator: calculator}
+}
+
+// Schedule: ロードマップを作成します。
+// 予算タイプが total_initial の場合は「今月」1ヶ月だけの計画になります。
+func (s *RoadmapScheduler) Schedule(items []model.RoadmapItem, chores []model.ChoreInput, budget model.BudgetConstraint, assumptions model.RoiAssumptions) (*model.AdoptionRoadmap, error) {
+	if err := budget.Validate(); err != nil {
+		return nil, err
+	}
+	for _, c := range chores {
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	byID := make(map[string]model.RoadmapItem, len(items))
+	for _, it := range items {
+		if err := it.Effect.Validate(); err != nil {
+			return nil, err
+		}
+		if _, dup := byID[it.Effect.ProductID]; dup {
+			return nil, fmt.Errorf("duplicated product in roadmap: %s", it.Effect.ProductID)
+		}
+		byID[it.Effect.ProductID] = it
+	}
+
+	roadmap := &model.AdoptionRoadmap{Budget: budget}
+	pending := s.dropUnschedulable(items, byID, roadmap)
+
+	months, maxFunds := model.RoadmapMaxMonths, int64(budget.Amount)*model.RoadmapMaxMonths
+	if budget.Type == model.BudgetTotalInitial {
+		months, maxFunds = 1, int64(budget.Amount)
+	}
+
+	bought := make(map[string]bool)
+	dropped := make(map[string]bool)
+	for _, u := range roadmap.Unscheduled {
+		dropped[u.ProductID] = true
+	}
+	var owned []model.ProductEffect
+	var funds, cumulativeSpend int64
+	var cumulativeBenefit float64
+
+	for m := 1; m <= months && len(pending) > 0; m++ {
+		funds += int64(budget.Amount)
+		month := model.RoadmapMonth{Month: m}
+
+		for len(pending) > 0 {
+			bundles := s.rankBundles(pending, byID, bought, chores)
+			top := bundles[0]
+			// 依存先を諦めた製品は、それ単体でも導入できない
+			if dep, ok := top.droppedDependency(dropped); ok {
+				roadmap.Unscheduled = append(roadmap.Unscheduled, model.UnscheduledItem{
+					ProductID: top.target(),
+					Reason:    fmt.Sprintf("先に必要な製品 %s を導入できません", dep),
+				})
+				dropped[top.target()] = true
+				pending = removeIDs(pending, []string{top.target()})
+				continue
+			}
+			// ハブのように他の製品のためだけに選ばれたものは、それを使う製品が全て見送られたら買わない
+			if top.relief == 0 && allDependentsDropped(top.target(), byID, dropped) {
+				roadmap.Unscheduled = append(roadmap.Unscheduled, model.UnscheduledItem{
+					ProductID: top.target(),
+					Reason:    "この製品を必要とする製品が導入されないため見送ります",
+				})
+				dropped[top.target()] = true
+				pending = removeIDs(pending, []string{top.target()})
+				continue
+			}
+			// どれだけ積み立てても買えない組み合わせは諦める
+			// (依存先は他の製品のために残しておき、諦めるのは本体だけにします)
+			if top.cost > maxFunds {
+				roadmap.Unscheduled = append(roadmap.Unscheduled, model.UnscheduledItem{
+					ProductID: top.target(),
+					Reason:    fmt.Sprintf("依存製品を含めた費用 %d円 が予算の上限を超えています", top.cost),
+				})
+				dropped[top.target()] = true
+				pending = removeIDs(pending, []string{top.target()})
+				continue
+			}
+			if top.cost > funds {
+				// 総額予算では翌月がないので、残りの予算で買えるものに進む
+				if budget.Type == model.BudgetTotalInitial {
+					roadmap.Unscheduled = append(roadmap.Unscheduled, model.UnscheduledItem{
+						ProductID: top.target(),
+						Reason:    fmt.Sprintf("優先度の高い製品を買った後の残り予算 %d円 では購入できません", funds),
+					})
+					dropped[top.target()] = true
+					pending = removeIDs(pending, []string{top.target()})
+					continue
+				}
+				// 優先度の順番を守るため、一番手が買えない月は積み立てに回す
+				break
+			}
+			for _, id := range top.ids {
+				it := byID[id]
+				bought[id] = true
+				owned = append(owned, it.Effect)
+				month.ProductIDs = append(month.ProductIDs, id)
+			}
+			funds -= top.cost
+			month.Spend += int32(top.cost)
+			cumulativeSpend += top.cost
+			pending = removeIDs(pending, top.ids)
+		}
+
+		roi, err := s.calculator.Calculate(model.RoiInput{Chores: chores, Products: owned, Assumptions: assumptions})
+		if err != nil {
+			return nil, err
+		}
+		// 月初に買った製品は、その月から効果が出るとみなします
+		cumulativeBenefit += float64(roi.NetBenefitYearly) / 12
+		month.CumulativeSpend = int32(cumulativeSpend)
+		month.CarryOver = int32(funds)
+		month.Roi = roi
+		month.CumulativeValue = int32(math.Round(cumulativeBenefit - float64(cumulativeSpend)))
+		roadmap.Months = append(roadmap.Months, month)
+	}
+
+	for _, id := range pending {
+		roadmap.Unscheduled = append(roadmap.Unscheduled, model.UnscheduledItem{
+			ProductID: id,
+			Reason:    "予算内で購入できる月が見つかりませんでした",
+		})
+	}
+	return roadmap, nil
+}
+
+// dropUnschedulable: 依存先が選ばれていない・依存が循環しているなど、並べようがない製品を取り除きます。
+// 取り除いた製品は理由付きで roadmap.Unscheduled に入ります。
+func (s *RoadmapScheduler) dropUnschedulable(items []model.RoadmapItem, byID map[string]model.RoadmapItem, roadmap *model.AdoptionRoadmap) []string {
+	invalid := make(map[string]string)
+	for _, it := range items {
+		for _, dep := range it.DependsOn {
+			if _, ok := byID[dep]; !ok {
+				invalid[it.Effect.ProductID] = fmt.Sprintf("先に必要な製品 %s が選ばれていません", dep)
+			}
+		}
+		if hasCycle(it.Effect.ProductID, byID, map[string]bool{}) {
+			invalid[it.Effect.ProductID] = "依存関係が循環しています"
+		}
+	}
+	// 依存先が除外された製品も連鎖的に除外する
+	for changed := true; changed; {
+		changed = false
+		for _, it := range items {
+			if _, ok := invalid[it.Effect.ProductID]; ok {
+				continue
+			}
+			for _, dep := range it.DependsOn {
+				if _, ok := invalid[dep]; ok {
+					invalid[it.Effect.ProductID] = fmt.Sprintf("先に必要な製品 %s を導入できません", dep)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	var pending []string
+	for _, it := range items {
+		id := it.Effect.ProductID
+		if reason, ok := invalid[id]; ok {
+			roadmap.Unscheduled = append(roadmap.Unscheduled, model.UnscheduledItem{ProductID: id, Reason: reason})
+			continue
+		}
+		pending = append(pending, id)
+	}
+	return pending
+}
+
+// bundle: 製品と、まだ買っていない依存先をひとまとめにしたもの
+type bundle struct {
+	ids    []string // 依存先が先に来る順
+	cost   int64
+	relief float64
+}
+
+// target: 組み合わせの本体 (依存先ではない、最後に買う製品) のIDを返します。
+func (b bundle) target() string {
+	return b.ids[len(b.ids)-1]
+}
+
+// droppedDependency: 組み合わせに、既に諦めた製品が含まれていればそのIDを返します。
+func (b bundle) droppedDependency(dropped map[string]bool) (string, bool) {
+	for _, id := range b.ids {
+		if dropped[id] {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// rankBundles: 未購入の製品ごとに「依存先込みの組み合わせ」を作り、1円あたりのペイン解消量の大きい順に並べます。
+// ハブ単体は解消量0ですが、ハブを必要とする製品の組み合わせの一部として優先度が決まります。
+func (s *RoadmapScheduler) rankBundles(pending []string, byID map[string]model.RoadmapItem, bought map[string]bool, chores []model.ChoreInput) []bundle {
+	bundles := make([]bundle, 0, len(pending))
+	for _, id := range pending {
+		var b bundle
+		collectBundle(id, byID, bought, map[string]bool{}, &b.ids)
+		for _, bid := range b.ids {
+			e := byID[bid].Effect
+			b.cost += int64(e.Price.Amount()) * int64(e.Units())
+			b.relief += painRelief(e, chores)
+		}
+		bundles = append(bundles, b)
+	}
+	sort.SliceStable(bundles, func(i, j int) bool {
+		ri, rj := reliefPerYen(bundles[i]), reliefPerYen(bundles[j])
+		if ri != rj {
+			return ri > rj
+		}
+		if bundles[i].cost != bundles[j].cost {
+			return bundles[i].cost < bundles[j].cost
+		}
+		return bundles[i].target() < bundles[j].target()
+	})
+	return bundles
+}
+
+// collectBundle: id の未購入の依存先を深さ優先でたどり、依存先が先に来る順で ids に追加します。
+func collectBundle(id string, byID map[string]model.RoadmapItem, bought, seen map[string]bool, ids *[]string) {
+	if bought[id] || seen[id] {
+		return
+	}
+	seen[id] = true
+	for _, dep := range byID[id].DependsOn {
+		collectBundle(dep, byID, bought, seen, ids)
+	}
+	*ids = append(*ids, id)
+}
+
+// painRelief: 製品が解消するペインの量です。「苦痛度 × 週あたりの時間(分) × 削減率」の合計で表します。
+func painRelief(e model.ProductEffect, chores []model.ChoreInput) float64 {
+	var relief float64
+	for _, c := range chores {
+		relief += float64(c.PainLevel) * c.MinutesPerWeek() * e.TimeReductions[c.Category]
+	}
+	return relief
+}
+
+func reliefPerYen(b bundle) float64 {
+	if b.cost == 0 {
+		return math.Inf(1)
+	}
+	return b.relief / float64(b.cost)
+}
+
+// allDependentsDropped: id に依存する製品があり、それらが全て見送られていれば true を返します。
+func allDependentsDropped(id string, byID map[string]model.RoadmapItem, dropped map[string]bool) bool {
+	hasDependent := false
+	for other, it := range byID {
+		for _, dep := range it.DependsOn {
+			if dep != id {
+				continue
+			}
+			hasDependent = true
+			if !dropped[other] {
+				return false
+			}
+		}
+	}
+	return hasDependent
+}
+
+func hasCycle(id string, byID map[string]model.RoadmapItem, visiting map[string]bool) bool {
+	if visiting[id] {
+		return true
+	}
+	visiting[id] = true
+	defer delete(visiting, id)
+	for _, dep := range byID[id].DependsOn {
+		if hasCycle(dep, byID, visiting) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeIDs(list, ids []string) []string {
+	drop := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		drop[id] = true
+	}
+	out := list[:0]
+	for _, id := range list {
+		if !drop[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// testChores: 掃除 (苦痛度5、週210分) と洗濯 (苦痛度3、週140分)
+var testChores = []model.ChoreInput{
+	{Category: model.ChoreCleaning, MinutesPerSession: 30, FrequencyPerWeek: 7, PainLevel: 5},
+	{Category: model.ChoreLaundry, MinutesPerSession: 20, FrequencyPerWeek: 7, PainLevel: 3},
+}
+
+func testEffect(id string, price int32, reductions map[model.ChoreCategory]float64) model.ProductEffect {
+	p, _ := value.NewPrice(price)
+	return model.ProductEffect{ProductID: id, Price: p, TimeReductions: reductions}
+}
+
+func roadmapItem(id string, price int32, reductions map[model.ChoreCategory]float64, dependsOn ...string) model.RoadmapItem {
+	return model.RoadmapItem{Effect: testEffect(id, price, reductions), DependsOn: dependsOn}
+}
+
+// 掃除機は1円あたりの解消量が 840/30000、ハブ込みのセンサーは 210/15000 です。
+var (
+	vacuum = roadmapItem("vacuum", 30000, map[model.ChoreCategory]float64{model.ChoreCleaning: 0.8})
+	hub    = roadmapItem("hub", 5000, nil)
+	sensor = roadmapItem("sensor", 10000, map[model.ChoreCategory]float64{model.ChoreLaundry: 0.5}, "hub")
+)
+
+func TestRoadmapSchedulerStagesPurchasesWithinBudget(t *testing.T) {
+	type month struct {
+		ids       []string
+		spend     int32
+		carryOver int32
+	}
+	tests := []struct {
+		name        string
+		items       []model.RoadmapItem
+		budget      model.BudgetConstraint
+		months      []month
+		unscheduled []string
+		reason      string // 最初の見送り理由に含まれる文言
+	}{
+		{
+			name:   "monthly allowance saves up for the top priority first",
+			items:  []model.RoadmapItem{sensor, hub, vacuum},
+			budget: model.BudgetConstraint{Type: model.BudgetMonthlyAllowance, Amount: 20000},
+			months: []month{
+				{ids: nil, spend: 0, carryOver: 20000},
+				{ids: []string{"vacuum"}, spend: 30000, carryOver: 10000},
+				{ids: []string{"hub", "sensor"}, spend: 15000, carryOver: 15000},
+			},
+		},
+		{
+			name:   "monthly allowance buys everything in the first month when affordable",
+			items:  []model.RoadmapItem{sensor, hub, vacuum},
+			budget: model.BudgetConstraint{Type: model.BudgetMonthlyAllowance, Amount: 50000},
+			months: []month{{ids: []string{"vacuum", "hub", "sensor"}, spend: 45000, carryOver: 5000}},
+		},
+		{
+			name:        "total budget drops what the rest cannot buy, and the hub with it",
+			items:       []model.RoadmapItem{sensor, hub, vacuum},
+			budget:      model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 35000},
+			months:      []month{{ids: []string{"vacuum"}, spend: 30000, carryOver: 5000}},
+			unscheduled: []string{"sensor", "hub"},
+			reason:      "残り予算",
+		},
+		{
+			name:        "bundle over the budget limit is given up",
+			items:       []model.RoadmapItem{vacuum},
+			budget:      model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 10000},
+			months:      []month{{ids: nil, spend: 0, carryOver: 10000}},
+			unscheduled: []string{"vacuum"},
+			reason:      "予算の上限",
+		},
+		{
+			name:        "missing dependency",
+			items:       []model.RoadmapItem{sensor, vacuum},
+			budget:      model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000},
+			months:      []month{{ids: []string{"vacuum"}, spend: 30000, carryOver: 20000}},
+			unscheduled: []string{"sensor"},
+			reason:      "選ばれていません",
+		},
+		{
+			name: "dependency cycle",
+			items: []model.RoadmapItem{
+				roadmapItem("a", 1000, nil, "b"),
+				roadmapItem("b", 1000, nil, "a"),
+				vacuum,
+			},
+			budget:      model.BudgetConstraint{Type: model.BudgetTotalInitial, Amount: 50000},
+			months:      []month{{ids: []string{"vacuum"}, spend: 30000, carryOver: 20000}},
+			unscheduled: []string{"a", "b"},
+			reason:      "循環",
+		},
+	}
+	s := NewRoadmapScheduler(NewRoiCalculator())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roadmap, err := s.Schedule(tt.items, testChores, tt.budget, model.RoiAssumptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(roadmap.Months) != len(tt.months) {
+				t.Fatalf("months = %+v, want %d months", roadmap.Months, len(tt.months))
+			}
+			var cumulative int32
+			for i, want := range tt.months {
+				got := roadmap.Months[i]
+				cumulative += want.spend
+				if got.Month != i+1 || !reflect.DeepEqual(got.ProductIDs, want.ids) || got.Spend != want.spend || got.CarryOver != want.carryOver || got.CumulativeSpend != cumulative {
+					t.Errorf("month %d = {ids %v spend %d carry %d cumulative %d}, want %+v", i+1, got.ProductIDs, got.Spend, got.CarryOver, got.CumulativeSpend, want)
+				}
+				if got.Roi == nil {
+					t.Errorf("month %d has no roi", i+1)
+				}
+			}
+			var unscheduled []string
+			for _, u := range roadmap.Unscheduled {
+				unscheduled = append(unscheduled, u.ProductID)
+			}
+			if !reflect.DeepEqual(unscheduled, tt.unscheduled) {
+				t.Errorf("unscheduled = %v, want %v", unscheduled, tt.unscheduled)
+			}
+			if tt.reason != "" && !strings.Contains(roadmap.Unscheduled[0].Reason, tt.reason) {
+				t.Errorf("reason = %q, want it to mention %q", roadmap.Unscheduled[0].Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestRoadmapSchedulerRejectsInvalidInput(t *testing.T) {
+	s := NewRoadmapScheduler(NewRoiCalculator())
+	budget := model.BudgetConstraint{Type: model.BudgetMonthlyAllowance, Amount: 10000}
+	tests := []struct {
+		name   string
+		items  []model.RoadmapItem
+		budget model.BudgetConstraint
+	}{
+		{"zero budget", []model.RoadmapItem{vacuum}, model.BudgetConstraint{Type: model.BudgetMonthlyAllowance}},
+		{"duplicated product", []model.RoadmapItem{vacuum, vacuum}, budget},
+		{"reduction over 100%", []model.RoadmapItem{roadmapItem("x", 1000, map[model.ChoreCategory]float64{model.ChoreCleaning: 1.5})}, budget},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.Schedule(tt.items, testChores, tt.budget, model.RoiAssumptions{}); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// ROIスコアの配点です。合計で100点になります。
+const (
+	paybackMaxPoints = 40.0
+	npvMaxPoints     = 30.0
+	mentalMaxPoints  = 30.0
+
+	// 回収期間がこの月数以下なら満点、上限以上なら0点とします。
+	paybackFullScoreMonths = 12.0
+	paybackZeroScoreMonths = 60.0
+)
+
+// CalibrationProvider: ROI計算に使う較正係数の提供元です。
+// 導入後の実測値から求めた最新の係数を返します。
+type CalibrationProvider interface {
+	Current() model.RoiCalibration
+}
+
+// RoiCalculator: 時間・お金・精神面の3軸でROIを計算するドメインサービスです。
+// 特定のエンティティに属さない計算ロジックなので、状態を持たない独立したサービスとして定義しています。
+type RoiCalculator struct {
+	calibration CalibrationProvider // nil なら較正しない
+}
+
+// NewRoiCalculator: 計算機の作成
+func NewRoiCalculator() *RoiCalculator {
+	return &RoiCalculator{}
+}
+
+// NewCalibratedRoiCalculator: 製品の削減率に較正係数を掛けてから計算する計算機を作成します。
+// カタログの削減率は製品の公称値なので、実際の導入結果に合わせて補正するために使います。
+func NewCalibratedRoiCalculator(calibration CalibrationProvider) *RoiCalculator {
+	return &RoiCalculator{calibration: calibration}
+}
+
+// currentCalibration: 最新の較正係数を返します。較正しない計算機では空です。
+func (c *RoiCalculator) currentCalibration() model.RoiCalibration {
+	if c.calibration == nil {
+		return model.RoiCalibration{}
+	}
+	return c.calibration.Current()
+}
+
+// Calculate: 入力からROIを計算します。
+// 1. 家事カテゴリごとに、導入製品による削減率を合成して年間削減時間を求める
+// 2. 時給換算した価値からランニングコストを引いて純便益を求める
+// 3. 回収期間・5年NPV・精神的インパクトからスコアを算出する
+func (c *RoiCalculator) Calculate(input model.RoiInput) (*model.RoiProjection, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	a := input.Assumptions.WithDefaults()
+
+	// 初期費用・ランニングコスト・メンテナンス時間の集計
+	var initialCost, runningCost, maintenanceHours float64
+	for _, p := range input.Products {
+		units := float64(p.Units())
+		initialCost += float64(p.Price.Amount()) * units
+		// 電気代: W × 24時間 × 365日 / 1000 = kWh/年
+		runningCost += p.PowerWatts * 24 * 365 / 1000 * a.ElectricityRate * units
+		runningCost += float64(p.ConsumableCostPerMonth) * 12 * units
+		maintenanceHours += float64(p.MaintenanceMinutesPerMonth) * 12 / 60 * units
+	}
+
+	// 家事カテゴリごとの削減時間 (較正係数は計算の途中で変わらないよう、最初に一度だけ取得します)
+	calibration := c.currentCalibration()
+	savings, hoursSaved, mentalScore := c.choreSavings(input.Chores, input.Products, a, calibration)
+	hoursSaved -= maintenanceHours
+
+	timeValue := hoursSaved * float64(a.HourlyWage)
+	netBenefit := timeValue - runningCost
+
+	proj := &model.RoiProjection{
+		TotalInitialCost:         int32(math.Round(initialCost)),
+		EstimatedTimeSavedYearly: round1(hoursSaved),
+		MentalImpactScore:        int32(math.Round(mentalScore)),
+		MentalImpact:             mentalImpactText(mentalScore),
+		TimeValueYearly:          int32(math.Round(timeValue)),
+		RunningCostYearly:        int32(math.Round(runningCost)),
+		NetBenefitYearly:         int32(math.Round(netBenefit)),
+		ChoreSavings:             savings,
+		Calibration:              calibration,
+	}
+
+	// 投資回収期間: 月あたりの純便益で初期費用を割る
+	if netBenefit > 0 {
+		proj.PaybackReachable = true
+		proj.PaybackMonths = round1(initialCost / (netBenefit / 12))
+	}
+
+	// 5年NPV: 毎年末に純便益が得られると仮定して割り引く
+	npv := -initialCost
+	for y := 1; y <= model.RoiHorizonYears; y++ {
+		npv += netBenefit / math.Pow(1+*a.DiscountRate, float64(y))
+	}
+	proj.NpvFiveYears = int32(math.Round(npv))
+
+	proj.ScoreBreakdown = []model.ScoreComponent{
+		paybackComponent(proj.PaybackReachable, proj.PaybackMonths),
+		npvComponent(npv, initialCost),
+		mentalComponent(mentalScore),
+	}
+	var total float64
+	for _, s := range proj.ScoreBreakdown {
+		total += s.Points
+	}
+	proj.RoiScore = int32(math.Round(total))
+	return proj, nil
+}
+
+// choreSavings: 家事ごとの削減効果を計算し、カテゴリ単位にまとめて返します。
+// 同じカテゴリに複数の製品が効く場合、削減率は 1 - Π(1 - r) で合成します（100%を超えないように）。
+// 較正係数がある場合は、合成の前に製品ごとの削減率に掛けます。
+// 精神的スコアは「苦痛度 × 週あたり時間」で重み付けした削減率の平均 (0〜100) です。
+func (c *RoiCalculator) choreSavings(chores []model.ChoreInput, products []model.ProductEffect, a model.RoiAssumptions, calibration model.RoiCalibration) ([]model.ChoreSaving, float64, float64) {
+	reduction := make(map[model.ChoreCategory]float64)
+	for _, p := range products {
+		for cat, rate := range p.TimeReductions {
+			rate = calibration.Apply(p.Category, rate)
+			remaining := 1 - reduction[cat]
+			reduction[cat] = 1 - remaining*(1-rate)
+		}
+	}
+
+	var order []model.ChoreCategory
+	byCategory := make(map[model.ChoreCategory]*model.ChoreSaving)
+	var totalHours, painWeighted, painTotal float64
+	for _, ch := range chores {
+		rate := reduction[ch.Category]
+		weekly := ch.MinutesPerWeek()
+		hours := weekly * 52 / 60 * rate
+
+		s, ok := byCategory[ch.Category]
+		if !ok {
+			s = &model.ChoreSaving{Category: ch.Category, ReductionRate: round3(rate)}
+			byCategory[ch.Category] = s
+			order = append(order, ch.Category)
+		}
+		s.MinutesPerWeekBefore += weekly
+		s.HoursSavedYearly += hours
+		totalHours += hours
+
+		weight := float64(ch.PainLevel) * weekly
+		painWeighted += weight * rate
+		painTotal += weight
+	}
+
+	savings := make([]model.ChoreSaving, 0, len(order))
+	for _, cat := range order {
+		s := byCategory[cat]
+		s.TimeValueYearly = int32(math.Round(s.HoursSavedYearly * float64(a.HourlyWage)))
+		s.HoursSavedYearly = round1(s.HoursSavedYearly)
+		savings = append(savings, *s)
+	}
+
+	var mental float64
+	if painTotal > 0 {
+		mental = painWeighted / painTotal * 100
+	}
+	return savings, totalHours, mental
+}
+
+func paybackComponent(reachable bool, months float64) model.ScoreComponent {
+	s := model.ScoreComponent{Key: "payback", Label: "投資回収期間", MaxPoints: paybackMaxPoints}
+	switch {
+	case !reachable:
+		s.Detail = "削減できる時間の価値がランニングコストを上回らないため、投資は回収できません"
+	case months <= paybackFullScoreMonths:
+		s.Points = paybackMaxPoints
+		s.Detail = fmt.Sprintf("約%.1fヶ月で回収でき、1年以内の回収なので満点です", months)
+	case months >= paybackZeroScoreMonths:
+		s.Detail = fmt.Sprintf("回収に約%.1fヶ月かかり、5年を超えるため加点なしです", months)
+	default:
+		ratio := (paybackZeroScoreMonths - months) / (paybackZeroScoreMonths - paybackFullScoreMonths)
+		s.Points = round1(paybackMaxPoints * ratio)
+		s.Detail = fmt.Sprintf("約%.1fヶ月で回収できます (1年以内で満点、5年以上で0点)", months)
+	}
+	return s
+}
+
+func npvComponent(npv, initialCost float64) model.ScoreComponent {
+	s := model.ScoreComponent{Key: "npv", Label: "5年間の正味現在価値", MaxPoints: npvMaxPoints}
+	switch {
+	case npv <= 0:
+		s.Detail = fmt.Sprintf("5年間で得られる価値が初期費用を%.0f円下回ります", -npv)
+	case initialCost <= 0:
+		s.Points = npvMaxPoints
+		s.Detail = fmt.Sprintf("初期費用がかからず、5年間で%.0f円の価値が生まれるため満点です", npv)
+	case npv >= initialCost:
+		s.Points = npvMaxPoints
+		s.Detail = fmt.Sprintf("5年間で初期費用の2倍以上の価値 (純価値%.0f円) が生まれるため満点です", npv)
+	default:
+		s.Points = round1(npvMaxPoints * npv / initialCost)
+		s.Detail = fmt.Sprintf("5年間で初期費用を%.0f円上回る価値が生まれます", npv)
+	}
+	return s
+}
+
+func mentalComponent(score float64) model.ScoreComponent {
+	return model.ScoreComponent{
+		Key:       "mental",
+		Label:     "精神的負担の軽減",
+		Points:    round1(mentalMaxPoints * score / 100),
+		MaxPoints: mentalMaxPoints,
+		Detail:    fmt.Sprintf("苦痛度と所要時間で重み付けした家事負担のうち、約%.0f%%が解消されます", score),
+	}
+}
+
+// mentalImpactText: 精神的ROIをユーザー向けの文章に変換します。
+func mentalImpactText(score float64) string {
+	switch {
+	case score >= 70:
+		return "特に辛いと感じていた家事の大部分から解放されます"
+	case score >= 40:
+		return "辛い家事の負担がはっきりと軽くなります"
+	case score > 0:
+		return "辛い家事の負担が少し軽くなります"
+	default:
+		return "苦痛を感じている家事への効果は見込めません"
+	}
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+func round3(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
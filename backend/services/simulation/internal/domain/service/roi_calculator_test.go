@@ -0,0 +1,33 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNpvComponent(t *testing.T) {
+	tests := []struct {
+		name        string
+		npv         float64
+		initialCost float64
+		points      float64
+		detail      string // Detail に含まれる文言
+		notDetail   string // Detail に含まれてはいけない文言
+	}{
+		{name: "below the initial cost", npv: -5000, initialCost: 30000, points: 0, detail: "5000円下回ります"},
+		{name: "partially recovered", npv: 15000, initialCost: 30000, points: 15, detail: "15000円上回る"},
+		{name: "worth twice the initial cost", npv: 30000, initialCost: 30000, points: npvMaxPoints, detail: "2倍以上"},
+		{name: "no initial cost", npv: 12000, initialCost: 0, points: npvMaxPoints, detail: "初期費用がかからず", notDetail: "2倍以上"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := npvComponent(tt.npv, tt.initialCost)
+			if got.Points != tt.points {
+				t.Errorf("points = %v, want %v", got.Points, tt.points)
+			}
+			if !strings.Contains(got.Detail, tt.detail) || (tt.notDetail != "" && strings.Contains(got.Detail, tt.notDetail)) {
+				t.Errorf("detail = %q, want it to mention %q", got.Detail, tt.detail)
+			}
+		})
+	}
+}
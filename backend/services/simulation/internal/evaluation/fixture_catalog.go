@@ -0,0 +1,146 @@
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/kinoshitatakumi/opti/pkg/domain/eligibility"
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"gopkg.in/yaml.v3"
+)
+
+// fixtureProduct: 評価用カタログの製品1件分
+type fixtureProduct struct {
+	ID                         string         `yaml:"id"`
+	Name                       string         `yaml:"name"`
+	Category                   string         `yaml:"category"`
+	Price                      int32          `yaml:"price"`
+	ChoreEffects               map[string]int `yaml:"chore_effects"` // 家事カテゴリ -> 削減率 (%)
+	MaintenanceMinutesPerMonth int            `yaml:"maintenance_minutes_per_month"`
+	PowerWatts                 float64        `yaml:"power_watts"`
+	ConsumableCostPerMonth     int32          `yaml:"consumable_cost_per_month"`
+	Protocols                  []string       `yaml:"protocols"` // 対応している通信規格 (Wi-Fi 環境の判定に使う)
+	RequiredHubProtocols       []string       `yaml:"required_hub_protocols"`
+	BridgedProtocols           []string       `yaml:"bridged_protocols"`
+	Installation               struct {
+		RequiresDrilling        bool     `yaml:"requires_drilling"`
+		RequiresElectricalWork  bool     `yaml:"requires_electrical_work"`
+		StepSensitive           bool     `yaml:"step_sensitive"`
+		ClimbableStepMM         int      `yaml:"climbable_step_mm"`
+		SupportedFloorTypes     []string `yaml:"supported_floor_types"`
+		SupportedResidenceTypes []string `yaml:"supported_residence_types"`
+	} `yaml:"installation"`
+}
+
+// FixtureCatalog: ファイルから読み込んだ製品で ProductCatalog を実装した、評価用のカタログです。
+// Catalog Service を起動せずに評価を回すために使います。
+// 住環境による除外は Catalog Service の EligibilityMatcher と同じルール (pkg/domain/eligibility) で判定します。
+type FixtureCatalog struct {
+	products []fixtureProduct
+}
+
+// LoadFixtureCatalog: YAML / JSON の製品一覧を読み込みます。
+func LoadFixtureCatalog(path string) (repository.ProductCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var products []fixtureProduct
+	if err := yaml.Unmarshal(data, &products); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	seen := make(map[string]bool, len(products))
+	for _, p := range products {
+		if p.ID == "" || seen[p.ID] {
+			return nil, fmt.Errorf("%s: product id is empty or duplicated: %q", path, p.ID)
+		}
+		seen[p.ID] = true
+	}
+	return &FixtureCatalog{products: products}, nil
+}
+
+// ListCandidates: 住環境に合う製品を提案候補として返します。
+func (c *FixtureCatalog) ListCandidates(ctx context.Context, r model.ResidenceSnapshot) ([]model.CandidateProduct, []model.RejectedCandidate, error) {
+	var candidates []model.CandidateProduct
+	var rejected []model.RejectedCandidate
+	for _, p := range c.products {
+		if reasons := rejections(p, r); len(reasons) > 0 {
+			rejected = append(rejected, model.RejectedCandidate{ProductID: p.ID, Name: p.Name, Reasons: reasons})
+			continue
+		}
+		candidate, err := p.candidate()
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rejected, nil
+}
+
+func (p fixtureProduct) candidate() (model.CandidateProduct, error) {
+	price, err := value.NewPrice(p.Price)
+	if err != nil {
+		return model.CandidateProduct{}, fmt.Errorf("invalid price for product %s: %w", p.ID, err)
+	}
+	reductions := make(map[model.ChoreCategory]float64, len(p.ChoreEffects))
+	cats := make([]string, 0, len(p.ChoreEffects))
+	for cat := range p.ChoreEffects {
+		cats = append(cats, cat)
+	}
+	sort.Strings(cats)
+	for _, cat := range cats {
+		reductions[model.ChoreCategory(cat)] = float64(p.ChoreEffects[cat]) / 100
+	}
+	effect := model.ProductEffect{
+		ProductID:                  p.ID,
+		Category:                   p.Category,
+		Price:                      price,
+		Quantity:                   1,
+		TimeReductions:             reductions,
+		MaintenanceMinutesPerMonth: p.MaintenanceMinutesPerMonth,
+		PowerWatts:                 p.PowerWatts,
+		ConsumableCostPerMonth:     p.ConsumableCostPerMonth,
+	}
+	if err := effect.Validate(); err != nil {
+		return model.CandidateProduct{}, err
+	}
+	return model.CandidateProduct{
+		Effect:               effect,
+		Name:                 p.Name,
+		Category:             p.Category,
+		RequiredHubProtocols: p.RequiredHubProtocols,
+		BridgedProtocols:     p.BridgedProtocols,
+	}, nil
+}
+
+// rejections: 住環境に合わない理由を、Catalog Service と同じ却下理由のコードで返します。
+func rejections(p fixtureProduct, r model.ResidenceSnapshot) []string {
+	req := eligibility.Requirements{
+		RequiresDrilling:        p.Installation.RequiresDrilling,
+		RequiresElectricalWork:  p.Installation.RequiresElectricalWork,
+		StepSensitive:           p.Installation.StepSensitive,
+		ClimbableStepMM:         p.Installation.ClimbableStepMM,
+		SupportedFloorTypes:     p.Installation.SupportedFloorTypes,
+		SupportedResidenceTypes: p.Installation.SupportedResidenceTypes,
+		Protocols:               p.Protocols,
+	}
+	residence := eligibility.Residence{
+		Type:         r.Type,
+		Ownership:    r.Ownership,
+		HasSteps:     r.HasSteps,
+		StepHeightMM: r.StepHeightMM,
+		FloorTypes:   r.FloorTypes,
+	}
+	if r.WiFi != nil {
+		residence.WiFi = &eligibility.WiFiEnvironment{Available: r.WiFi.Available, Bands: r.WiFi.Bands}
+	}
+	var reasons []string
+	for _, code := range eligibility.Check(req, residence) {
+		reasons = append(reasons, string(code))
+	}
+	return reasons
+}
@@ -0,0 +1,142 @@
+package evaluation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"gopkg.in/yaml.v3"
+)
+
+// GoldenScenario: 評価用の正解付きシナリオ
+// YAML / JSON のどちらでも書けます (JSON は YAML のサブセットとして読み込みます)。
+type GoldenScenario struct {
+	Name        string          `yaml:"name"`
+	Description string          `yaml:"description"`
+	Residence   goldenResidence `yaml:"residence"`
+	Chores      []goldenChore   `yaml:"chores"`
+	Budget      goldenBudget    `yaml:"budget"`
+	Expected    []string        `yaml:"expected"`      // 提案されるべき製品ID
+	Forbidden   []string        `yaml:"forbidden"`     // 提案されてはいけない製品ID
+	MinRoiScore int32           `yaml:"min_roi_score"` // 下回ったら不合格 (0なら判定しない)
+}
+
+type goldenResidence struct {
+	Type         string      `yaml:"type"`
+	Ownership    string      `yaml:"ownership"`
+	HasSteps     bool        `yaml:"has_steps"`
+	StepHeightMM int         `yaml:"step_height_mm"`
+	FloorTypes   []string    `yaml:"floor_types"`
+	WiFi         *goldenWiFi `yaml:"wifi"`
+}
+
+type goldenWiFi struct {
+	Available bool     `yaml:"available"`
+	Bands     []string `yaml:"bands"`
+}
+
+type goldenChore struct {
+	Category          string  `yaml:"category"`
+	MinutesPerSession int     `yaml:"minutes_per_session"`
+	FrequencyPerWeek  float64 `yaml:"frequency_per_week"`
+	PainLevel         int     `yaml:"pain_level"`
+	PainReason        string  `yaml:"pain_reason"`
+}
+
+type goldenBudget struct {
+	Amount int32  `yaml:"amount"`
+	Type   string `yaml:"type"`
+}
+
+// Input: シミュレーションの入力条件に変換します。
+func (g GoldenScenario) Input() model.ScenarioInput {
+	in := model.ScenarioInput{
+		Budget: model.BudgetConstraint{Amount: g.Budget.Amount, Type: model.BudgetType(g.Budget.Type)},
+		Residence: model.ResidenceSnapshot{
+			Type:         g.Residence.Type,
+			Ownership:    g.Residence.Ownership,
+			HasSteps:     g.Residence.HasSteps,
+			StepHeightMM: g.Residence.StepHeightMM,
+			FloorTypes:   g.Residence.FloorTypes,
+		},
+	}
+	if w := g.Residence.WiFi; w != nil {
+		in.Residence.WiFi = &model.WiFiSituation{Available: w.Available, Bands: w.Bands}
+	}
+	for _, c := range g.Chores {
+		in.Chores = append(in.Chores, model.ChoreInput{
+			Category:          model.ChoreCategory(c.Category),
+			MinutesPerSession: c.MinutesPerSession,
+			FrequencyPerWeek:  c.FrequencyPerWeek,
+			PainLevel:         c.PainLevel,
+			PainReason:        c.PainReason,
+		})
+	}
+	return in
+}
+
+// LoadGoldenScenarios: ディレクトリ内の *.yaml / *.yml / *.json を全て読み込みます (ファイル名順)。
+// 1ファイルにはシナリオ1つ、またはシナリオの配列を書けます。
+func LoadGoldenScenarios(dir string) ([]GoldenScenario, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && isFixtureFile(e.Name()) {
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	var scenarios []GoldenScenario
+	names := make(map[string]string)
+	for _, f := range files {
+		loaded, err := loadGoldenFile(f)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range loaded {
+			if s.Name == "" {
+				return nil, fmt.Errorf("%s: scenario name is required", f)
+			}
+			if prev, dup := names[s.Name]; dup {
+				return nil, fmt.Errorf("%s: duplicated scenario name %q (also in %s)", f, s.Name, prev)
+			}
+			if err := s.Input().Validate(); err != nil {
+				return nil, fmt.Errorf("%s: scenario %q: %w", f, s.Name, err)
+			}
+			names[s.Name] = f
+			scenarios = append(scenarios, s)
+		}
+	}
+	return scenarios, nil
+}
+
+func loadGoldenFile(path string) ([]GoldenScenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var list []GoldenScenario
+	if err := yaml.Unmarshal(data, &list); err == nil {
+		return list, nil
+	}
+	var single GoldenScenario
+	if err := yaml.Unmarshal(data, &single); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return []GoldenScenario{single}, nil
+}
+
+func isFixtureFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package evaluation
+
+import (
+	"math"
+	"slices"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// Violation: 守られるべき制約への違反1件分
+type Violation struct {
+	Kind      string `json:"kind"` // "forbidden_product", "over_budget", "rejected_product", "missing_dependency"
+	ProductID string `json:"product_id,omitempty"`
+	Detail    string `json:"detail"`
+}
+
+// ScenarioResult: シナリオ1つ分の評価結果
+type ScenarioResult struct {
+	Name              string      `json:"name"`
+	Passed            bool        `json:"passed"`
+	Error             string      `json:"error,omitempty"`
+	ProposedProducts  []string    `json:"proposed_products"`
+	Violations        []Violation `json:"violations"`
+	TotalCost         int64       `json:"total_cost"`
+	SpendingCap       int64       `json:"spending_cap"`
+	WithinBudget      bool        `json:"within_budget"`
+	BudgetUtilization float64     `json:"budget_utilization"` // 合計金額 / 予算上限
+	Precision         float64     `json:"precision"`          // 提案のうち expected に含まれる割合
+	Recall            float64     `json:"recall"`             // expected のうち提案された割合
+	RoiScore          int32       `json:"roi_score"`
+	PaybackMonths     float64     `json:"payback_months"`
+	HoursSavedYearly  float64     `json:"hours_saved_yearly"`
+	NarrativeComplete bool        `json:"narrative_complete"` // 全グループに説明文がある
+	NarrativeTokens   int         `json:"narrative_tokens"`
+	DurationMillis    int64       `json:"duration_millis"`
+}
+
+// evaluate: 生成されたプランを正解と照らし合わせて指標を計算します。
+func evaluate(g GoldenScenario, scenario *model.SimulationScenario, plan *model.OptimizationPlan) ScenarioResult {
+	result := ScenarioResult{Name: g.Name, Violations: []Violation{}}
+	proposed := make([]string, 0)
+	for _, it := range plan.Items() {
+		proposed = append(proposed, it.ProductID)
+	}
+	result.ProposedProducts = proposed
+
+	// 制約違反
+	for _, id := range proposed {
+		if slices.Contains(g.Forbidden, id) {
+			result.Violations = append(result.Violations, Violation{Kind: "forbidden_product", ProductID: id, Detail: "提案してはいけない製品が含まれています"})
+		}
+		if slices.ContainsFunc(scenario.Progress.Rejected, func(r model.RejectedCandidate) bool { return r.ProductID == id }) {
+			result.Violations = append(result.Violations, Violation{Kind: "rejected_product", ProductID: id, Detail: "住環境で除外された製品が含まれています"})
+		}
+	}
+	for _, it := range plan.Items() {
+		for _, dep := range it.DependsOn {
+			if !slices.Contains(proposed, dep) {
+				result.Violations = append(result.Violations, Violation{Kind: "missing_dependency", ProductID: it.ProductID, Detail: "依存先 " + dep + " が提案に含まれていません"})
+			}
+		}
+	}
+
+	// 予算
+	result.TotalCost = plan.TotalCost()
+	result.SpendingCap = scenario.Input.Budget.SpendingCap()
+	result.WithinBudget = result.TotalCost <= result.SpendingCap
+	if !result.WithinBudget {
+		result.Violations = append(result.Violations, Violation{Kind: "over_budget", Detail: "予算上限を超えています"})
+	}
+	if result.SpendingCap > 0 {
+		result.BudgetUtilization = round3(float64(result.TotalCost) / float64(result.SpendingCap))
+	}
+
+	// 正解との一致
+	hits := 0
+	for _, id := range proposed {
+		if slices.Contains(g.Expected, id) {
+			hits++
+		}
+	}
+	result.Precision, result.Recall = 1, 1
+	if len(proposed) > 0 {
+		result.Precision = round3(float64(hits) / float64(len(proposed)))
+	} else if len(g.Expected) > 0 {
+		result.Precision = 0
+	}
+	if len(g.Expected) > 0 {
+		result.Recall = round3(float64(hits) / float64(len(g.Expected)))
+	}
+
+	// ROI
+	if roi := plan.RoiProjection; roi != nil {
+		result.RoiScore = roi.RoiScore
+		result.PaybackMonths = roi.PaybackMonths
+		result.HoursSavedYearly = roi.EstimatedTimeSavedYearly
+	}
+
+	result.NarrativeComplete = true
+	for _, grp := range plan.ProposalGroups {
+		if grp.Description == "" {
+			result.NarrativeComplete = false
+		}
+	}
+
+	result.Passed = len(result.Violations) == 0 && result.NarrativeComplete && result.RoiScore >= g.MinRoiScore
+	return result
+}
+
+func round3(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
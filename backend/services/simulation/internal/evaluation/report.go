@@ -0,0 +1,132 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// 基準レポートと比べて「悪化した」とみなす許容幅です。
+const (
+	precisionTolerance = 0.01
+	recallTolerance    = 0.01
+	roiScoreTolerance  = 1.0
+)
+
+// Summary: 全シナリオの集計
+type Summary struct {
+	Scenarios           int     `json:"scenarios"`
+	Passed              int     `json:"passed"`
+	Errors              int     `json:"errors"`
+	ConstraintViolation int     `json:"constraint_violations"`
+	BudgetAdherence     float64 `json:"budget_adherence"` // 予算内に収まったシナリオの割合
+	MeanPrecision       float64 `json:"mean_precision"`
+	MeanRecall          float64 `json:"mean_recall"`
+	MeanRoiScore        float64 `json:"mean_roi_score"`
+}
+
+// Report: 評価レポート (JSONで保存し、次回の基準として使います)
+type Report struct {
+	GeneratedAt   time.Time        `json:"generated_at"`
+	PromptVersion string           `json:"prompt_version"`
+	LLMModel      string           `json:"llm_model"`
+	Summary       Summary          `json:"summary"`
+	Scenarios     []ScenarioResult `json:"scenarios"`
+}
+
+func summarize(results []ScenarioResult) Summary {
+	s := Summary{Scenarios: len(results)}
+	evaluated := 0
+	var within, precision, recall, roi float64
+	for _, r := range results {
+		if r.Error != "" {
+			s.Errors++
+			continue
+		}
+		evaluated++
+		if r.Passed {
+			s.Passed++
+		}
+		s.ConstraintViolation += len(r.Violations)
+		if r.WithinBudget {
+			within++
+		}
+		precision += r.Precision
+		recall += r.Recall
+		roi += float64(r.RoiScore)
+	}
+	if evaluated > 0 {
+		n := float64(evaluated)
+		s.BudgetAdherence = round3(within / n)
+		s.MeanPrecision = round3(precision / n)
+		s.MeanRecall = round3(recall / n)
+		s.MeanRoiScore = round3(roi / n)
+	}
+	return s
+}
+
+// WriteJSON: レポートをJSONで書き出します。
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// LoadReport: 保存済みのレポート (基準) を読み込みます。
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// Regression: 基準から悪化した項目1件分
+type Regression struct {
+	Scenario string `json:"scenario,omitempty"` // 空なら全体の集計
+	Metric   string `json:"metric"`
+	Baseline string `json:"baseline"`
+	Current  string `json:"current"`
+}
+
+// Compare: 基準レポートと比べて悪化した項目を返します。
+// 全体の集計に加えて、基準で合格していたシナリオが不合格になったものを挙げます。
+func Compare(baseline, current *Report) []Regression {
+	var regs []Regression
+	b, c := baseline.Summary, current.Summary
+	if c.ConstraintViolation > b.ConstraintViolation {
+		regs = append(regs, Regression{Metric: "constraint_violations", Baseline: fmt.Sprint(b.ConstraintViolation), Current: fmt.Sprint(c.ConstraintViolation)})
+	}
+	if c.Errors > b.Errors {
+		regs = append(regs, Regression{Metric: "errors", Baseline: fmt.Sprint(b.Errors), Current: fmt.Sprint(c.Errors)})
+	}
+	if c.BudgetAdherence < b.BudgetAdherence {
+		regs = append(regs, Regression{Metric: "budget_adherence", Baseline: fmt.Sprint(b.BudgetAdherence), Current: fmt.Sprint(c.BudgetAdherence)})
+	}
+	if c.MeanPrecision < b.MeanPrecision-precisionTolerance {
+		regs = append(regs, Regression{Metric: "mean_precision", Baseline: fmt.Sprint(b.MeanPrecision), Current: fmt.Sprint(c.MeanPrecision)})
+	}
+	if c.MeanRecall < b.MeanRecall-recallTolerance {
+		regs = append(regs, Regression{Metric: "mean_recall", Baseline: fmt.Sprint(b.MeanRecall), Current: fmt.Sprint(c.MeanRecall)})
+	}
+	if c.MeanRoiScore < b.MeanRoiScore-roiScoreTolerance {
+		regs = append(regs, Regression{Metric: "mean_roi_score", Baseline: fmt.Sprint(b.MeanRoiScore), Current: fmt.Sprint(c.MeanRoiScore)})
+	}
+
+	passed := make(map[string]bool, len(baseline.Scenarios))
+	for _, s := range baseline.Scenarios {
+		passed[s.Name] = s.Passed
+	}
+	for _, s := range current.Scenarios {
+		if passed[s.Name] && !s.Passed {
+			regs = append(regs, Regression{Scenario: s.Name, Metric: "passed", Baseline: "true", Current: "false"})
+		}
+	}
+	return regs
+}
@@ -0,0 +1,82 @@
+package evaluation
+
+import (
+	"context"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/db"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/usecase"
+)
+
+// evaluationUserID: 評価で作るシナリオの所有者です。
+const evaluationUserID = "evaluation"
+
+// Runner: 正解付きシナリオを本番と同じパイプライン (SimulationUsecase) に通して評価します。
+// シナリオやプランはメモリ上にだけ保存し、評価が終われば捨てます。
+type Runner struct {
+	scenarios  repository.ScenarioRepository
+	simulation *usecase.SimulationUsecase
+	llmModel   string
+}
+
+// NewRunner: ランナーの作成
+// llmModel はレポートに記録するモデル名です (例: "fake", "gemini-2.0-flash")。
+func NewRunner(catalog repository.ProductCatalog, llm repository.LLMClient, llmModel string) *Runner {
+	scenarios := db.NewMemoryScenarioRepository()
+	calculator := service.NewRoiCalculator()
+	return &Runner{
+		scenarios: scenarios,
+		simulation: usecase.NewSimulationUsecase(
+			scenarios,
+			db.NewMemoryPlanRepository(),
+			catalog,
+			usecase.NewAccountedLLM(llm, db.NewMemoryUsageRepository(), db.NewMemoryNarrativeCache(db.DefaultNarrativeCacheEntries), service.NewCostEstimator(nil), model.QuotaPolicy{}),
+			service.NewPlanOptimizer(),
+			service.NewNarrativeBuilder(),
+			calculator,
+			service.NewRoadmapScheduler(calculator),
+		),
+		llmModel: llmModel,
+	}
+}
+
+// Run: 全シナリオを順に評価し、レポートを返します。
+// 1つのシナリオが失敗しても残りは続け、失敗はそのシナリオの Error に記録します。
+func (r *Runner) Run(ctx context.Context, goldens []GoldenScenario) *Report {
+	report := &Report{
+		GeneratedAt:   time.Now().UTC(),
+		PromptVersion: service.NarrativePromptVersion,
+		LLMModel:      r.llmModel,
+	}
+	for _, g := range goldens {
+		report.Scenarios = append(report.Scenarios, r.runOne(ctx, g))
+	}
+	report.Summary = summarize(report.Scenarios)
+	return report
+}
+
+func (r *Runner) runOne(ctx context.Context, g GoldenScenario) ScenarioResult {
+	started := time.Now()
+	tokens := 0
+	plan, err := r.simulation.RunSimulation(ctx, evaluationUserID, g.Input(), func(e model.SimulationEvent) error {
+		if e.Kind == model.EventNarrativeToken && e.Token.Text != "" {
+			tokens++
+		}
+		return nil
+	})
+	if err != nil {
+		return ScenarioResult{Name: g.Name, Error: err.Error(), Violations: []Violation{}}
+	}
+	scenario, err := r.scenarios.GetByID(ctx, plan.SimulationScenarioID)
+	if err != nil {
+		return ScenarioResult{Name: g.Name, Error: err.Error(), Violations: []Violation{}}
+	}
+
+	result := evaluate(g, scenario, plan)
+	result.NarrativeTokens = tokens
+	result.DurationMillis = time.Since(started).Milliseconds()
+	return result
+}
@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	catalogv1 "github.com/kinoshitatakumi/opti/gen/go/catalog/v1"
+	"github.com/kinoshitatakumi/opti/gen/go/catalog/v1/catalogv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/pkg/resilience"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// CatalogClient: Catalog Service を Connect RPC で呼び出す ProductCatalog の実装です。
+type CatalogClient struct {
+	client catalogv1connect.ProductServiceClient
+}
+
+// NewCatalogClient: クライアントの作成
+// baseURL は Catalog Service のURL (例: "http://localhost:8080") です。
+func NewCatalogClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) repository.ProductCatalog {
+	return &CatalogClient{
+		client: catalogv1connect.NewProductServiceClient(httpClient, baseURL, opts...),
+	}
+}
+
+// NewDefaultCatalogClient: http.DefaultClient を使うクライアントを作成します。
+func NewDefaultCatalogClient(baseURL string) repository.ProductCatalog {
+	return NewCatalogClient(http.DefaultClient, baseURL)
+}
+
+// NewResilientCatalogClient: リトライ・タイムアウト・サーキットブレーカー・ヘッジを適用したクライアントを作成します。
+// 設定は ResilienceConfig を参照してください。
+func NewResilientCatalogClient(baseURL string) repository.ProductCatalog {
+	executor := resilience.NewExecutor(ResilienceConfig())
+	httpClient := &http.Client{Transport: resilience.NewTransport(executor, nil)}
+	return NewCatalogClient(httpClient, baseURL, connect.WithInterceptors(resilience.NewClientInterceptor(executor)))
+}
+
+// ResilienceConfig: Catalog Service 呼び出しの耐障害性の設定です。
+// ListEligibleProducts は副作用のない読み取りで、プラン生成や予算スイープで何度も呼ばれるため、
+// 遅い応答を待ち続けないようヘッジを有効にしています。
+func ResilienceConfig() resilience.Config {
+	read := resilience.DefaultPolicy()
+	read.Timeout = 3 * time.Second
+	read.Hedge = &resilience.HedgePolicy{Delay: 300 * time.Millisecond, MaxHedges: 1}
+	return resilience.Config{
+		Default: resilience.DefaultPolicy(),
+		Procedures: map[string]resilience.Policy{
+			catalogv1connect.ProductServiceListEligibleProductsProcedure: read,
+		},
+	}
+}
+
+// ListCandidates: 住環境に合う製品を ListEligibleProducts で取得し、提案候補に変換します。
+func (c *CatalogClient) ListCandidates(ctx context.Context, residence model.ResidenceSnapshot) ([]model.CandidateProduct, []model.RejectedCandidate, error) {
+	res, err := c.client.ListEligibleProducts(ctx, connect.NewRequest(&catalogv1.ListEligibleProductsRequest{
+		Residence: toPbResidence(residence),
+	}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list eligible products: %w", err)
+	}
+
+	candidates := make([]model.CandidateProduct, 0, len(res.Msg.Eligible))
+	for _, e := range res.Msg.Eligible {
+		c, err := toCandidate(e.Product, e.Notes)
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	rejected := make([]model.RejectedCandidate, 0, len(res.Msg.Rejected))
+	for _, r := range res.Msg.Rejected {
+		reasons := make([]string, 0, len(r.Rejections))
+		for _, rj := range r.Rejections {
+			reasons = append(reasons, rj.Message)
+		}
+		rejected = append(rejected, model.RejectedCandidate{
+			ProductID: r.Product.GetId(),
+			Name:      r.Product.GetName(),
+			Reasons:   reasons,
+		})
+	}
+	return candidates, rejected, nil
+}
+
+func toPbResidence(r model.ResidenceSnapshot) *catalogv1.Residence {
+	pb := &catalogv1.Residence{
+		Type:         r.Type,
+		Ownership:    r.Ownership,
+		HasSteps:     r.HasSteps,
+		StepHeightMm: int32(r.StepHeightMM),
+		FloorTypes:   r.FloorTypes,
+	}
+	if r.WiFi != nil {
+		pb.Wifi = &catalogv1.WifiEnvironment{
+			Available: r.WiFi.Available,
+			Bands:     r.WiFi.Bands,
+		}
+	}
+	return pb
+}
+
+// toCandidate: カタログの製品を提案候補に変換します。
+// 自動化効果が登録されていない製品は、家事を減らさない製品 (ハブなど) として扱います。
+func toCandidate(p *catalogv1.Product, notes []string) (model.CandidateProduct, error) {
+	price, err := value.NewPrice(p.GetPrice())
+	if err != nil {
+		return model.CandidateProduct{}, fmt.Errorf("invalid price for product %s: %w", p.GetId(), err)
+	}
+	effect := model.ProductEffect{
+		ProductID:      p.GetId(),
+		Category:       p.GetCategory(),
+		Price:          price,
+		Quantity:       1,
+		TimeReductions: make(map[model.ChoreCategory]float64),
+	}
+	if ae := p.GetAutomationEffect(); ae != nil {
+		for _, ce := range ae.ChoreEffects {
+			effect.TimeReductions[model.ChoreCategory(ce.ChoreCategory)] = float64(ce.TimeReductionPercent) / 100
+		}
+		effect.MaintenanceMinutesPerMonth = int(ae.MaintenanceMinutesPerMonth)
+		effect.PowerWatts = ae.PowerWatts
+		effect.ConsumableCostPerMonth = ae.ConsumableCostPerMonth
+	}
+	return model.CandidateProduct{
+		Effect:               effect,
+		Name:                 p.GetName(),
+		Category:             p.GetCategory(),
+		RequiredHubProtocols: p.GetConnectivity().GetRequiredHubProtocols(),
+		BridgedProtocols:     p.GetConnectivity().GetBridgedProtocols(),
+		Notes:                notes,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// DefaultNarrativeCacheEntries: 説明文のキャッシュに残す件数の既定値
+const DefaultNarrativeCacheEntries = 10_000
+
+// MemoryNarrativeCache: 説明文のキャッシュをメモリ上に保存する実装です。
+// 件数が上限を超えたら、最後に使われてから最も時間が経った説明文から捨てます (LRU)。
+type MemoryNarrativeCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List               // 先頭ほど最近使われた説明文
+	entries    map[string]*list.Element // キー -> order の要素 (値は *narrativeEntry)
+}
+
+type narrativeEntry struct {
+	key  string
+	text string
+}
+
+// NewMemoryNarrativeCache: キャッシュの作成
+// maxEntries が0以下なら DefaultNarrativeCacheEntries 件まで保存します。
+func NewMemoryNarrativeCache(maxEntries int) repository.NarrativeCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultNarrativeCacheEntries
+	}
+	return &MemoryNarrativeCache{maxEntries: maxEntries, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// Get: キーに対応する文章を返します。
+func (c *MemoryNarrativeCache) Get(ctx context.Context, key string) (string, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return "", false, nil
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*narrativeEntry).text, true, nil
+}
+
+// Put: 文章を保存します。上限を超えた分は古いものから捨てます。
+func (c *MemoryNarrativeCache) Put(ctx context.Context, key, text string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*narrativeEntry).text = text
+		c.order.MoveToFront(e)
+		return nil
+	}
+	c.entries[key] = c.order.PushFront(&narrativeEntry{key: key, text: text})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*narrativeEntry).key)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryNarrativeCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryNarrativeCache(2)
+	cache.Put(ctx, "a", "説明A")
+	cache.Put(ctx, "b", "説明B")
+	// a を使ってから c を入れると、しばらく使われていない b が捨てられる
+	if _, ok, _ := cache.Get(ctx, "a"); !ok {
+		t.Fatal("a was not cached")
+	}
+	cache.Put(ctx, "c", "説明C")
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok, _ := cache.Get(ctx, key); ok != want {
+			t.Errorf("%s cached = %v, want %v", key, ok, want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// MemoryPlanRepository: 提案プランをメモリ上のマップに保存する Repository 実装です。
+type MemoryPlanRepository struct {
+	mu    sync.RWMutex
+	plans map[string]*model.OptimizationPlan
+}
+
+// NewMemoryPlanRepository: リポジトリの作成
+func NewMemoryPlanRepository() repository.PlanRepository {
+	return &MemoryPlanRepository{
+		plans: make(map[string]*model.OptimizationPlan),
+	}
+}
+
+// Save: 提案プランを保存 (作成・更新) します。
+// 呼び出し側が後から書き換えても保存内容が変わらないよう、スライスまで含めたコピーを保存します。
+func (r *MemoryPlanRepository) Save(ctx context.Context, p *model.OptimizationPlan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.plans[p.ID] = clonePlan(p)
+	return nil
+}
+
+// GetByID: IDを指定して提案プランを取得します。
+func (r *MemoryPlanRepository) GetByID(ctx context.Context, id string) (*model.OptimizationPlan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.plans[id]
+	if !ok {
+		return nil, model.ErrPlanNotFound
+	}
+	return clonePlan(p), nil
+}
+
+// clonePlan: 提案プランのディープコピーを作ります。
+func clonePlan(p *model.OptimizationPlan) *model.OptimizationPlan {
+	copied := *p
+	copied.ProposalGroups = make([]model.ProposalGroup, len(p.ProposalGroups))
+	for i, g := range p.ProposalGroups {
+		items := make([]model.ProposedItem, len(g.Items))
+		for j, it := range g.Items {
+			it.DependsOn = append([]string(nil), it.DependsOn...)
+			items[j] = it
+		}
+		g.Items = items
+		copied.ProposalGroups[i] = g
+	}
+	copied.RoiProjection = cloneProjection(p.RoiProjection)
+	if p.Roadmap != nil {
+		roadmap := *p.Roadmap
+		roadmap.Months = make([]model.RoadmapMonth, len(p.Roadmap.Months))
+		for i, m := range p.Roadmap.Months {
+			m.ProductIDs = append([]string(nil), m.ProductIDs...)
+			m.Roi = cloneProjection(m.Roi)
+			roadmap.Months[i] = m
+		}
+		roadmap.Unscheduled = append([]model.UnscheduledItem(nil), p.Roadmap.Unscheduled...)
+		copied.Roadmap = &roadmap
+	}
+	return &copied
+}
+
+func cloneProjection(p *model.RoiProjection) *model.RoiProjection {
+	if p == nil {
+		return nil
+	}
+	copied := *p
+	copied.ChoreSavings = append([]model.ChoreSaving(nil), p.ChoreSavings...)
+	copied.ScoreBreakdown = append([]model.ScoreComponent(nil), p.ScoreBreakdown...)
+	if p.Calibration.Factors != nil {
+		copied.Calibration.Factors = make(map[string]float64, len(p.Calibration.Factors))
+		for cat, f := range p.Calibration.Factors {
+			copied.Calibration.Factors[cat] = f
+		}
+	}
+	return &copied
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// MemoryScenarioRepository: シナリオをメモリ上のマップに保存する Repository 実装です。
+type MemoryScenarioRepository struct {
+	mu        sync.RWMutex
+	scenarios map[string]*model.SimulationScenario
+}
+
+// NewMemoryScenarioRepository: リポジトリの作成
+func NewMemoryScenarioRepository() repository.ScenarioRepository {
+	return &MemoryScenarioRepository{
+		scenarios: make(map[string]*model.SimulationScenario),
+	}
+}
+
+// Save: シナリオを保存 (作成・更新) します。
+// 呼び出し側が後から書き換えても保存内容が変わらないよう、スライスやマップまで含めたコピーを保存します。
+func (r *MemoryScenarioRepository) Save(ctx context.Context, s *model.SimulationScenario) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.scenarios[s.ID] = cloneScenario(s)
+	return nil
+}
+
+// GetByID: IDを指定してシナリオを取得します。
+func (r *MemoryScenarioRepository) GetByID(ctx context.Context, id string) (*model.SimulationScenario, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.scenarios[id]
+	if !ok {
+		return nil, model.ErrScenarioNotFound
+	}
+	return cloneScenario(s), nil
+}
+
+// cloneScenario: シナリオのディープコピーを作ります。
+// パイプラインは途中経過 (Progress.Draft など) を書き換えながら進むため、保存内容と共有しないようにします。
+func cloneScenario(s *model.SimulationScenario) *model.SimulationScenario {
+	copied := *s
+	copied.Input.Chores = append([]model.ChoreInput(nil), s.Input.Chores...)
+	copied.Input.Residence.FloorTypes = append([]string(nil), s.Input.Residence.FloorTypes...)
+	if s.Input.Residence.WiFi != nil {
+		wifi := *s.Input.Residence.WiFi
+		wifi.Bands = append([]string(nil), wifi.Bands...)
+		copied.Input.Residence.WiFi = &wifi
+	}
+	if s.Input.Assumptions.DiscountRate != nil {
+		rate := *s.Input.Assumptions.DiscountRate
+		copied.Input.Assumptions.DiscountRate = &rate
+	}
+	copied.Progress.Candidates = make([]model.CandidateProduct, len(s.Progress.Candidates))
+	for i, c := range s.Progress.Candidates {
+		c.Effect = cloneEffect(c.Effect)
+		c.RequiredHubProtocols = append([]string(nil), c.RequiredHubProtocols...)
+		c.BridgedProtocols = append([]string(nil), c.BridgedProtocols...)
+		c.Notes = append([]string(nil), c.Notes...)
+		copied.Progress.Candidates[i] = c
+	}
+	copied.Progress.Rejected = make([]model.RejectedCandidate, len(s.Progress.Rejected))
+	for i, r := range s.Progress.Rejected {
+		r.Reasons = append([]string(nil), r.Reasons...)
+		copied.Progress.Rejected[i] = r
+	}
+	if s.Progress.Draft != nil {
+		copied.Progress.Draft = clonePlan(s.Progress.Draft)
+	}
+	return &copied
+}
+
+func cloneEffect(e model.ProductEffect) model.ProductEffect {
+	if e.TimeReductions != nil {
+		reductions := make(map[model.ChoreCategory]float64, len(e.TimeReductions))
+		for c, rate := range e.TimeReductions {
+			reductions[c] = rate
+		}
+		e.TimeReductions = reductions
+	}
+	return e
+}
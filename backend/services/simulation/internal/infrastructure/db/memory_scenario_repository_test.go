@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+func TestMemoryScenarioRepositoryStoresDeepCopy(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryScenarioRepository()
+	discountRate := 0.0
+	s := &model.SimulationScenario{
+		ID: "s1",
+		Input: model.ScenarioInput{
+			Residence:   model.ResidenceSnapshot{FloorTypes: []string{"flooring"}, WiFi: &model.WiFiSituation{Available: true}},
+			Assumptions: model.RoiAssumptions{DiscountRate: &discountRate},
+		},
+		Progress: model.ScenarioProgress{
+			Candidates: []model.CandidateProduct{{Effect: model.ProductEffect{ProductID: "p1", TimeReductions: map[model.ChoreCategory]float64{model.ChoreCleaning: 0.5}}}},
+			Draft: &model.OptimizationPlan{
+				ProposalGroups: []model.ProposalGroup{{Items: []model.ProposedItem{{ProductID: "p1"}}}},
+				RoiProjection:  &model.RoiProjection{RoiScore: 50},
+			},
+		},
+	}
+	if err := repo.Save(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	// 保存後に呼び出し側が書き換えても、保存内容は変わらない (説明文の生成で Draft を書き換えるのと同じ)
+	s.Input.Residence.FloorTypes[0] = "tatami"
+	s.Input.Residence.WiFi.Available = false
+	discountRate = 0.1
+	s.Progress.Candidates[0].Effect.TimeReductions[model.ChoreCleaning] = 1
+	s.Progress.Draft.ProposalGroups[0].Description = "変更"
+	s.Progress.Draft.ProposalGroups[0].Items[0].Reason = "変更"
+	s.Progress.Draft.RoiProjection.RoiScore = 0
+
+	got, err := repo.GetByID(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	draft := got.Progress.Draft
+	if got.Input.Residence.FloorTypes[0] != "flooring" || !got.Input.Residence.WiFi.Available || *got.Input.Assumptions.DiscountRate != 0 ||
+		got.Progress.Candidates[0].Effect.TimeReductions[model.ChoreCleaning] != 0.5 ||
+		draft.ProposalGroups[0].Description != "" || draft.ProposalGroups[0].Items[0].Reason != "" || draft.RoiProjection.RoiScore != 50 {
+		t.Errorf("stored scenario was changed through the caller's copy: %+v", got)
+	}
+
+	// 取得した側が書き換えても、保存内容は変わらない
+	got.Progress.Draft.ProposalGroups[0].Items[0].ProductID = "p2"
+	again, _ := repo.GetByID(ctx, "s1")
+	if again.Progress.Draft.ProposalGroups[0].Items[0].ProductID != "p1" {
+		t.Error("stored scenario was changed through a returned copy")
+	}
+}
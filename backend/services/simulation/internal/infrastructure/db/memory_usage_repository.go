@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// MemoryUsageRepository: LLMの利用記録をメモリ上に保存する Repository 実装です。
+type MemoryUsageRepository struct {
+	mu           sync.RWMutex
+	usages       []model.LlmUsage
+	reservations map[string]model.UsageReservation
+}
+
+// NewMemoryUsageRepository: リポジトリの作成
+func NewMemoryUsageRepository() repository.UsageRepository {
+	return &MemoryUsageRepository{reservations: make(map[string]model.UsageReservation)}
+}
+
+// Record: 利用記録を追加します。
+func (r *MemoryUsageRepository) Record(ctx context.Context, u model.LlmUsage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.usages = append(r.usages, u)
+	return nil
+}
+
+// TotalsByUserSince: ユーザーの since 以降の利用を集計します。
+func (r *MemoryUsageRepository) TotalsByUserSince(ctx context.Context, userID string, since time.Time) (model.UsageTotals, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.totals(userID, since), nil
+}
+
+// Reserve: 利用と確保中の枠に新しい枠を足しても上限を超えないことを確かめてから、同じロックの中で枠を確保します。
+func (r *MemoryUsageRepository) Reserve(ctx context.Context, res model.UsageReservation, quota model.DailyQuota, since time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	totals := r.totals(res.UserID, since)
+	for _, pending := range r.reservations {
+		if pending.UserID == res.UserID {
+			totals.AddReservation(pending)
+		}
+	}
+	totals.AddReservation(res)
+	if quota.Exceeded(totals) {
+		return fmt.Errorf("%w: user %s would use %d tokens (%.2f yen) today", model.ErrQuotaExceeded, res.UserID, totals.Tokens(), totals.EstimatedCostYen)
+	}
+	r.reservations[res.ID] = res
+	return nil
+}
+
+// Settle: 枠を解放し、利用を記録します。
+func (r *MemoryUsageRepository) Settle(ctx context.Context, reservationID string, u *model.LlmUsage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.reservations, reservationID)
+	if u != nil {
+		r.usages = append(r.usages, *u)
+	}
+	return nil
+}
+
+func (r *MemoryUsageRepository) totals(userID string, since time.Time) model.UsageTotals {
+	var totals model.UsageTotals
+	for _, u := range r.usages {
+		if u.UserID == userID && !u.CreatedAt.Before(since) {
+			totals.Add(u)
+		}
+	}
+	return totals
+}
+
+// ListByScenario: シナリオの利用記録を古い順に返します。
+func (r *MemoryUsageRepository) ListByScenario(ctx context.Context, scenarioID string) ([]model.LlmUsage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var list []model.LlmUsage
+	for _, u := range r.usages {
+		if u.ScenarioID == scenarioID {
+			list = append(list, u)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+func TestMemoryUsageReserveCountsTheNewReservation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	since := model.StartOfDay(now)
+	quota := model.DailyQuota{Tokens: 1000}
+	repo := NewMemoryUsageRepository()
+	if err := repo.Record(ctx, model.LlmUsage{UserID: "u1", PromptTokens: 300, CompletionTokens: 100, CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 1. 1回で上限を超える枠は、まだ上限に達していなくても確保できない
+	if err := repo.Reserve(ctx, model.UsageReservation{ID: "big", UserID: "u1", Tokens: 601}, quota, since); !errors.Is(err, model.ErrQuotaExceeded) {
+		t.Errorf("overshooting reservation: err = %v, want ErrQuotaExceeded", err)
+	}
+	// 2. 上限ちょうどまでは確保できる
+	if err := repo.Reserve(ctx, model.UsageReservation{ID: "exact", UserID: "u1", Tokens: 600}, quota, since); err != nil {
+		t.Fatalf("reservation up to the quota: %v", err)
+	}
+	if err := repo.Reserve(ctx, model.UsageReservation{ID: "more", UserID: "u1", Tokens: 1}, quota, since); !errors.Is(err, model.ErrQuotaExceeded) {
+		t.Errorf("reservation past the quota: err = %v, want ErrQuotaExceeded", err)
+	}
+}
+
+func TestUsageTotalsKeepsReservedTokensApart(t *testing.T) {
+	var totals model.UsageTotals
+	totals.Add(model.LlmUsage{PromptTokens: 100, CompletionTokens: 50})
+	totals.AddReservation(model.UsageReservation{Tokens: 1000})
+	if totals.PromptTokens != 100 || totals.CompletionTokens != 50 || totals.ReservedTokens != 1000 {
+		t.Errorf("totals = %+v", totals)
+	}
+	if totals.Tokens() != 1150 {
+		t.Errorf("tokens = %d, want 1150", totals.Tokens())
+	}
+}
@@ -0,0 +1,80 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// fakeChunkRunes: フェイクが1回に返す文字数です。
+const fakeChunkRunes = 8
+
+// FakeClient: 開発・評価用のLLMクライアントです。
+// APIを呼ばず、プロンプトの「提案する製品」欄から決まった文章を組み立てて、少しずつ返します。
+// 同じプロンプトには必ず同じ文章を返すので、ストリーミングや評価の動作確認に使えます。
+type FakeClient struct{}
+
+// NewFakeClient: フェイククライアントの作成
+func NewFakeClient() repository.LLMClient {
+	return &FakeClient{}
+}
+
+// fakeModel: フェイクのモデル名
+const fakeModel = "fake"
+
+// Model: モデル名を返します。
+func (c *FakeClient) Model() string {
+	return fakeModel
+}
+
+// Generate: 定型の説明文を生成し、fakeChunkRunes 文字ずつ onToken に渡します。
+func (c *FakeClient) Generate(ctx context.Context, prompt model.Prompt, onToken func(text string) error) (*model.Completion, error) {
+	text := fakeNarrative(prompt.User)
+	var chunks int
+	for rest := text; rest != ""; chunks++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n := 0
+		for i := 0; i < fakeChunkRunes && n < len(rest); i++ {
+			_, size := utf8.DecodeRuneInString(rest[n:])
+			n += size
+		}
+		if err := onToken(rest[:n]); err != nil {
+			return nil, err
+		}
+		rest = rest[n:]
+	}
+	return &model.Completion{
+		Text:             text,
+		Model:            fakeModel,
+		PromptTokens:     utf8.RuneCountInString(prompt.System + prompt.User),
+		CompletionTokens: chunks,
+	}, nil
+}
+
+// fakeNarrative: プロンプトの「提案グループ」と「提案する製品」から文章を組み立てます。
+func fakeNarrative(user string) string {
+	var group string
+	var reasons []string
+	inProducts := false
+	for _, line := range strings.Split(user, "\n") {
+		switch {
+		case strings.HasPrefix(line, "提案グループ: "):
+			group = strings.TrimPrefix(line, "提案グループ: ")
+		case line == "提案する製品:":
+			inProducts = true
+		case inProducts && strings.HasPrefix(line, "- "):
+			reasons = append(reasons, strings.TrimPrefix(line, "- "))
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString(group + "の負担を減らすため、次の製品を提案します。")
+	for _, r := range reasons {
+		sb.WriteString(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,171 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// DefaultGeminiModel: 未指定の場合に使うモデルです。
+const DefaultGeminiModel = "gemini-2.0-flash"
+
+const geminiBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// GeminiClient: Gemini API (streamGenerateContent) を呼び出す LLMClient の実装です。
+// SDKは使わず、Server-Sent Events 形式のレスポンスを1行ずつ読み取ります。
+type GeminiClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+	model      string
+}
+
+// NewGeminiClient: クライアントの作成
+// modelName が空の場合は DefaultGeminiModel を使います。
+func NewGeminiClient(httpClient *http.Client, apiKey, modelName string) repository.LLMClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if modelName == "" {
+		modelName = DefaultGeminiModel
+	}
+	return &GeminiClient{httpClient: httpClient, baseURL: geminiBaseURL, apiKey: apiKey, model: modelName}
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiRequest struct {
+	SystemInstruction *geminiContent  `json:"systemInstruction,omitempty"`
+	Contents          []geminiContent `json:"contents"`
+}
+
+type geminiChunk struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+	UsageMetadata *struct {
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+	} `json:"usageMetadata"`
+}
+
+// Model: モデル名を返します。
+func (c *GeminiClient) Model() string {
+	return c.model
+}
+
+// Generate: プロンプトを送り、届いた断片を順に onToken に渡します。
+func (c *GeminiClient) Generate(ctx context.Context, prompt model.Prompt, onToken func(text string) error) (*model.Completion, error) {
+	body := geminiRequest{
+		Contents: []geminiContent{{Role: "user", Parts: []geminiPart{{Text: prompt.User}}}},
+	}
+	if prompt.System != "" {
+		body.SystemInstruction = &geminiContent{Parts: []geminiPart{{Text: prompt.System}}}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// APIキーはURLに含めずヘッダーで送ります (通信エラーのメッセージやログにURLが出てもキーが漏れないように)。
+	endpoint := fmt.Sprintf("%s/models/%s:streamGenerateContent?alt=sse", c.baseURL, url.PathEscape(c.model))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-goog-api-key", c.apiKey)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// 通信エラーの詳細は呼び出し元 (RPCのクライアント) に返さず、ログにだけ残します。
+		log.Printf("gemini request failed: %v", err)
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("gemini request failed"))
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		// エラーの本文にはリクエストの内容やプロジェクトの情報が含まれることがあるので、ログにだけ残します。
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		log.Printf("gemini returned %s: %s", res.Status, strings.TrimSpace(string(msg)))
+		return nil, connect.NewError(geminiStatusCode(res.StatusCode), fmt.Errorf("gemini returned %s", res.Status))
+	}
+
+	completion := &model.Completion{Model: c.model}
+	var text strings.Builder
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var chunk geminiChunk
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &chunk); err != nil {
+			return nil, fmt.Errorf("failed to decode gemini chunk: %w", err)
+		}
+		for _, cand := range chunk.Candidates {
+			for _, part := range cand.Content.Parts {
+				if part.Text == "" {
+					continue
+				}
+				text.WriteString(part.Text)
+				if err := onToken(part.Text); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if u := chunk.UsageMetadata; u != nil {
+			completion.PromptTokens = u.PromptTokenCount
+			completion.CompletionTokens = u.CandidatesTokenCount
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read gemini stream: %w", err)
+	}
+	completion.Text = text.String()
+	return completion, nil
+}
+
+// geminiStatusCode: Gemini API のHTTPステータスを、リトライ判定に使う Connect のコードに変換します。
+// レート制限 (429) とサーバー側の一時的な障害 (5xx) だけが再試行の対象になります。
+func geminiStatusCode(status int) connect.Code {
+	switch {
+	case status == http.StatusTooManyRequests:
+		return connect.CodeResourceExhausted
+	case status == http.StatusBadRequest:
+		return connect.CodeInvalidArgument
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return connect.CodePermissionDenied
+	case status == http.StatusNotFound:
+		return connect.CodeNotFound
+	case status == http.StatusServiceUnavailable || status == http.StatusBadGateway:
+		return connect.CodeUnavailable
+	case status == http.StatusGatewayTimeout:
+		return connect.CodeDeadlineExceeded
+	case status >= 500:
+		return connect.CodeInternal
+	}
+	return connect.CodeUnknown
+}
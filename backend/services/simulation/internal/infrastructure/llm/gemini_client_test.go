@@ -0,0 +1,73 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+func TestGeminiClientSendsAPIKeyInHeader(t *testing.T) {
+	const key = "secret-key"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("key") {
+			t.Errorf("api key in query: %s", r.URL.RawQuery)
+		}
+		if got := r.Header.Get("x-goog-api-key"); got != key {
+			t.Errorf("x-goog-api-key = %q", got)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintln(w, `data: {"candidates":[{"content":{"parts":[{"text":"こんにちは"}]}}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewGeminiClient(srv.Client(), key, "").(*GeminiClient)
+	c.baseURL = srv.URL
+	got, err := c.Generate(context.Background(), model.Prompt{User: "hi"}, func(string) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Text != "こんにちは" {
+		t.Errorf("text = %q", got.Text)
+	}
+}
+
+func TestGeminiClientTransportErrorDoesNotLeakAPIKey(t *testing.T) {
+	const key = "secret-key"
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close() // 接続できないサーバー
+
+	c := NewGeminiClient(nil, key, "").(*GeminiClient)
+	c.baseURL = url
+	_, err := c.Generate(context.Background(), model.Prompt{User: "hi"}, func(string) error { return nil })
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("err = %v, want unavailable", err)
+	}
+	if strings.Contains(err.Error(), key) || strings.Contains(err.Error(), url) {
+		t.Errorf("error leaks request details: %v", err)
+	}
+}
+
+func TestGeminiClientErrorResponseIsNotReturnedToCaller(t *testing.T) {
+	const detail = "API key not valid for project opti-prod-123"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":{"message":"`+detail+`"}}`, http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	c := NewGeminiClient(srv.Client(), "key", "").(*GeminiClient)
+	c.baseURL = srv.URL
+	_, err := c.Generate(context.Background(), model.Prompt{User: "hi"}, func(string) error { return nil })
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if strings.Contains(err.Error(), detail) {
+		t.Errorf("error leaks the response body: %v", err)
+	}
+}
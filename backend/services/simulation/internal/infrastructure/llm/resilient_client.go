@@ -0,0 +1,69 @@
+package llm
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/kinoshitatakumi/opti/pkg/resilience"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// generateProcedure: 耐障害性の設定を引くための呼び出し名
+const generateProcedure = "llm.Generate"
+
+// ResilientClient: LLMClient の呼び出しにリトライ・タイムアウト・サーキットブレーカーを適用するデコレーターです。
+// 既に一部のトークンを返した後に失敗した場合は、やり直すと説明文が重複するため再試行しません。
+type ResilientClient struct {
+	next     repository.LLMClient
+	executor *resilience.Executor
+}
+
+// NewResilientClient: デコレーターの作成
+func NewResilientClient(next repository.LLMClient) repository.LLMClient {
+	return &ResilientClient{next: next, executor: resilience.NewExecutor(ResilienceConfig())}
+}
+
+// ResilienceConfig: LLM呼び出しの耐障害性の設定です。
+// 生成には時間がかかるため制限時間を長めにし、レート制限に備えてバックオフも長めにしています。
+// 同じプロンプトを並行して送ると費用が倍になるため、ヘッジは使いません。
+func ResilienceConfig() resilience.Config {
+	return resilience.Config{
+		Default: resilience.Policy{
+			Timeout: 60 * time.Second,
+			Retry: resilience.RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: 500 * time.Millisecond,
+				MaxBackoff:     5 * time.Second,
+				Multiplier:     2,
+				RetryableCodes: []connect.Code{connect.CodeUnavailable, connect.CodeResourceExhausted, connect.CodeDeadlineExceeded},
+			},
+			Breaker: &resilience.BreakerPolicy{
+				FailureThreshold: 5,
+				OpenTimeout:      30 * time.Second,
+				FailureCodes:     resilience.DefaultFailureCodes,
+			},
+		},
+	}
+}
+
+// Model: 包んでいるクライアントのモデル名を返します。
+func (c *ResilientClient) Model() string {
+	return c.next.Model()
+}
+
+// Generate: next.Generate を呼び出します。トークンを1つも返していない失敗だけを再試行します。
+func (c *ResilientClient) Generate(ctx context.Context, prompt model.Prompt, onToken func(text string) error) (*model.Completion, error) {
+	return resilience.Call(ctx, c.executor, generateProcedure, func(ctx context.Context) (*model.Completion, error) {
+		streamed := false
+		completion, err := c.next.Generate(ctx, prompt, func(text string) error {
+			streamed = true
+			return onToken(text)
+		})
+		if err != nil && streamed {
+			return nil, resilience.Permanent(err)
+		}
+		return completion, err
+	})
+}
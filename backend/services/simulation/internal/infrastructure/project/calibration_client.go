@@ -0,0 +1,46 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	projectv1 "github.com/kinoshitatakumi/opti/gen/go/project/v1"
+	"github.com/kinoshitatakumi/opti/gen/go/project/v1/projectv1connect"
+	"github.com/kinoshitatakumi/opti/pkg/resilience"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// CalibrationClient: Project Service の GetRoiCalibration を呼び出す CalibrationSource の実装です。
+type CalibrationClient struct {
+	client projectv1connect.ProjectServiceClient
+}
+
+// NewCalibrationClient: クライアントの作成
+// baseURL は Project Service のURL (例: "http://localhost:8083") です。
+func NewCalibrationClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) repository.CalibrationSource {
+	return &CalibrationClient{
+		client: projectv1connect.NewProjectServiceClient(httpClient, baseURL, opts...),
+	}
+}
+
+// NewResilientCalibrationClient: リトライ・タイムアウト・サーキットブレーカーを適用したクライアントを作成します。
+func NewResilientCalibrationClient(baseURL string) repository.CalibrationSource {
+	executor := resilience.NewExecutor(resilience.Config{Default: resilience.DefaultPolicy()})
+	return NewCalibrationClient(http.DefaultClient, baseURL, connect.WithInterceptors(resilience.NewClientInterceptor(executor)))
+}
+
+// Fetch: 製品カテゴリごとの較正係数を取得します。
+func (c *CalibrationClient) Fetch(ctx context.Context) (model.RoiCalibration, error) {
+	res, err := c.client.GetRoiCalibration(ctx, connect.NewRequest(&projectv1.GetRoiCalibrationRequest{}))
+	if err != nil {
+		return model.RoiCalibration{}, fmt.Errorf("failed to get roi calibration: %w", err)
+	}
+	calibration := model.RoiCalibration{Factors: make(map[string]float64, len(res.Msg.Categories))}
+	for _, cat := range res.Msg.Categories {
+		calibration.Factors[cat.ProductCategory] = cat.Factor
+	}
+	return calibration, nil
+}
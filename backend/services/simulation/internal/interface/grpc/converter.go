@@ -0,0 +1,321 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	simulationv1 "github.com/kinoshitatakumi/opti/gen/go/simulation/v1"
+	"github.com/kinoshitatakumi/opti/pkg/domain/value"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+)
+
+// 通信用(protobuf)と内部の型(model)の変換をまとめたファイルです。
+// 複数のAPIで同じ変換を使うため、ハンドラ本体から切り出しています。
+
+func toChoreInputs(pb []*simulationv1.ChoreInput) []model.ChoreInput {
+	chores := make([]model.ChoreInput, 0, len(pb))
+	for _, c := range pb {
+		chores = append(chores, model.ChoreInput{
+			Category:          model.ChoreCategory(c.Category),
+			MinutesPerSession: int(c.MinutesPerSession),
+			FrequencyPerWeek:  c.FrequencyPerWeek,
+			PainLevel:         int(c.PainLevel),
+			PainReason:        c.PainReason,
+		})
+	}
+	return chores
+}
+
+// toProductEffect: 通信用(protobuf) -> 内部の型(model) に変換します。
+// 価格は値オブジェクトに変換するため、マイナス値ならエラーになります。
+func toProductEffect(p *simulationv1.RoiProduct) (model.ProductEffect, error) {
+	price, err := value.NewPrice(p.Price)
+	if err != nil {
+		return model.ProductEffect{}, err
+	}
+	reductions := make(map[model.ChoreCategory]float64, len(p.TimeReductions))
+	for _, r := range p.TimeReductions {
+		reductions[model.ChoreCategory(r.Category)] = r.Rate
+	}
+	return model.ProductEffect{
+		ProductID:                  p.ProductId,
+		Category:                   p.Category,
+		Price:                      price,
+		Quantity:                   int(p.Quantity),
+		TimeReductions:             reductions,
+		MaintenanceMinutesPerMonth: int(p.MaintenanceMinutesPerMonth),
+		PowerWatts:                 p.PowerWatts,
+		ConsumableCostPerMonth:     p.ConsumableCostPerMonth,
+	}, nil
+}
+
+func toAssumptions(a *simulationv1.RoiAssumptions) model.RoiAssumptions {
+	if a == nil {
+		return model.RoiAssumptions{}
+	}
+	return model.RoiAssumptions{
+		HourlyWage:      a.HourlyWage,
+		ElectricityRate: a.ElectricityRate,
+		DiscountRate:    a.DiscountRate,
+	}
+}
+
+func toBudget(b *simulationv1.BudgetConstraint) model.BudgetConstraint {
+	if b == nil {
+		return model.BudgetConstraint{}
+	}
+	return model.BudgetConstraint{Amount: b.Amount, Type: model.BudgetType(b.Type)}
+}
+
+func toRoiInput(msg *simulationv1.CalculateRoiRequest) (model.RoiInput, error) {
+	input := model.RoiInput{
+		Chores:      toChoreInputs(msg.Chores),
+		Assumptions: toAssumptions(msg.Assumptions),
+	}
+	for _, p := range msg.Products {
+		effect, err := toProductEffect(p)
+		if err != nil {
+			return model.RoiInput{}, err
+		}
+		input.Products = append(input.Products, effect)
+	}
+	return input, nil
+}
+
+func toPbRoiProjection(p *model.RoiProjection) *simulationv1.RoiProjection {
+	pb := &simulationv1.RoiProjection{
+		TotalInitialCost:         p.TotalInitialCost,
+		EstimatedTimeSavedYearly: p.EstimatedTimeSavedYearly,
+		RoiScore:                 p.RoiScore,
+		MentalImpact:             p.MentalImpact,
+		MentalImpactScore:        p.MentalImpactScore,
+		TimeValueYearly:          p.TimeValueYearly,
+		RunningCostYearly:        p.RunningCostYearly,
+		NetBenefitYearly:         p.NetBenefitYearly,
+		PaybackReachable:         p.PaybackReachable,
+		PaybackMonths:            p.PaybackMonths,
+		NpvFiveYears:             p.NpvFiveYears,
+	}
+	for _, s := range p.ChoreSavings {
+		pb.ChoreSavings = append(pb.ChoreSavings, &simulationv1.ChoreSaving{
+			Category:             string(s.Category),
+			MinutesPerWeekBefore: int32(s.MinutesPerWeekBefore),
+			ReductionRate:        s.ReductionRate,
+			HoursSavedYearly:     s.HoursSavedYearly,
+			TimeValueYearly:      s.TimeValueYearly,
+		})
+	}
+	for _, s := range p.ScoreBreakdown {
+		pb.ScoreBreakdown = append(pb.ScoreBreakdown, &simulationv1.ScoreComponent{
+			Key:       s.Key,
+			Label:     s.Label,
+			Points:    s.Points,
+			MaxPoints: s.MaxPoints,
+			Detail:    s.Detail,
+		})
+	}
+	return pb
+}
+
+func toPbRoadmap(r *model.AdoptionRoadmap) *simulationv1.AdoptionRoadmap {
+	pb := &simulationv1.AdoptionRoadmap{
+		Budget: &simulationv1.BudgetConstraint{Amount: r.Budget.Amount, Type: string(r.Budget.Type)},
+	}
+	for _, m := range r.Months {
+		pb.Months = append(pb.Months, &simulationv1.RoadmapMonth{
+			Month:           int32(m.Month),
+			ProductIds:      m.ProductIDs,
+			Spend:           m.Spend,
+			CumulativeSpend: m.CumulativeSpend,
+			CarryOver:       m.CarryOver,
+			Roi:             toPbRoiProjection(m.Roi),
+			CumulativeValue: m.CumulativeValue,
+		})
+	}
+	for _, u := range r.Unscheduled {
+		pb.Unscheduled = append(pb.Unscheduled, &simulationv1.UnscheduledItem{
+			ProductId: u.ProductID,
+			Reason:    u.Reason,
+		})
+	}
+	return pb
+}
+
+func toResidence(r *simulationv1.ResidenceSnapshot) model.ResidenceSnapshot {
+	if r == nil {
+		return model.ResidenceSnapshot{}
+	}
+	res := model.ResidenceSnapshot{
+		Type:         r.Type,
+		Ownership:    r.Ownership,
+		HasSteps:     r.HasSteps,
+		StepHeightMM: int(r.StepHeightMm),
+		FloorTypes:   r.FloorTypes,
+	}
+	if r.Wifi != nil {
+		res.WiFi = &model.WiFiSituation{Available: r.Wifi.Available, Bands: r.Wifi.Bands}
+	}
+	return res
+}
+
+func toScenarioInput(msg *simulationv1.RunSimulationRequest) model.ScenarioInput {
+	return model.ScenarioInput{
+		Budget:      toBudget(msg.Budget),
+		Chores:      toChoreInputs(msg.Chores),
+		Residence:   toResidence(msg.Residence),
+		Assumptions: toAssumptions(msg.Assumptions),
+	}
+}
+
+func toPbPlan(p *model.OptimizationPlan) *simulationv1.OptimizationPlan {
+	if p == nil {
+		return nil
+	}
+	pb := &simulationv1.OptimizationPlan{
+		Id:                   p.ID,
+		UserId:               p.UserID,
+		SimulationScenarioId: p.SimulationScenarioID,
+		Concept:              p.Concept,
+	}
+	if !p.CreatedAt.IsZero() {
+		pb.CreatedAt = p.CreatedAt.Format(time.RFC3339)
+	}
+	for _, g := range p.ProposalGroups {
+		group := &simulationv1.ProposalGroup{
+			Category:    string(g.Category),
+			Priority:    int32(g.Priority),
+			Description: g.Description,
+		}
+		for _, it := range g.Items {
+			group.Items = append(group.Items, toPbProposedItem(&it))
+		}
+		pb.ProposalGroups = append(pb.ProposalGroups, group)
+	}
+	if p.RoiProjection != nil {
+		pb.RoiProjection = toPbRoiProjection(p.RoiProjection)
+	}
+	if p.Roadmap != nil {
+		pb.Roadmap = toPbRoadmap(p.Roadmap)
+	}
+	return pb
+}
+
+func toPbEvent(e model.SimulationEvent) *simulationv1.SimulationEvent {
+	pb := &simulationv1.SimulationEvent{
+		ScenarioId: e.ScenarioID,
+		Sequence:   int32(e.Sequence),
+		Replayed:   e.Replayed,
+	}
+	switch e.Kind {
+	case model.EventScenarioAccepted:
+		pb.Event = &simulationv1.SimulationEvent_ScenarioAccepted{ScenarioAccepted: &simulationv1.ScenarioAccepted{}}
+	case model.EventCandidatesFiltered:
+		filtered := &simulationv1.CandidatesFiltered{EligibleCount: int32(e.EligibleCount)}
+		for _, r := range e.Rejected {
+			filtered.Rejected = append(filtered.Rejected, &simulationv1.RejectedCandidate{
+				ProductId: r.ProductID,
+				Name:      r.Name,
+				Reasons:   r.Reasons,
+			})
+		}
+		pb.Event = &simulationv1.SimulationEvent_CandidatesFiltered{CandidatesFiltered: filtered}
+	case model.EventOptimizerDone:
+		pb.Event = &simulationv1.SimulationEvent_OptimizerDone{OptimizerDone: &simulationv1.OptimizerDone{Draft: toPbPlan(e.Draft)}}
+	case model.EventNarrativeToken:
+		pb.Event = &simulationv1.SimulationEvent_NarrativeToken{NarrativeToken: &simulationv1.NarrativeToken{
+			Category: string(e.Token.Category),
+			Text:     e.Token.Text,
+			Done:     e.Token.Done,
+		}}
+	case model.EventRoiComputed:
+		pb.Event = &simulationv1.SimulationEvent_RoiComputed{RoiComputed: &simulationv1.RoiComputed{Roi: toPbRoiProjection(e.Roi)}}
+	case model.EventPlanPersisted:
+		pb.Event = &simulationv1.SimulationEvent_PlanPersisted{PlanPersisted: &simulationv1.PlanPersisted{Plan: toPbPlan(e.Plan)}}
+	}
+	return pb
+}
+
+func toPbProposedItem(it *model.ProposedItem) *simulationv1.ProposedItem {
+	if it == nil {
+		return nil
+	}
+	return &simulationv1.ProposedItem{
+		ProductId:         it.ProductID,
+		Quantity:          int32(it.Quantity),
+		Price:             it.Price,
+		Reason:            it.Reason,
+		DependsOn:         it.DependsOn,
+		ProductCategory:   it.ProductCategory,
+		ProductName:       it.ProductName,
+		CalibrationFactor: it.CalibrationFactor,
+	}
+}
+
+func toPbItemChanges(changes []model.ItemChange) []*simulationv1.ItemChange {
+	pb := make([]*simulationv1.ItemChange, 0, len(changes))
+	for _, c := range changes {
+		pb = append(pb, &simulationv1.ItemChange{
+			ProductId:     c.ProductID,
+			Kind:          string(c.Kind),
+			Before:        toPbProposedItem(c.Before),
+			After:         toPbProposedItem(c.After),
+			ChangedFields: c.ChangedFields,
+			CausedBy:      c.CausedBy,
+		})
+	}
+	return pb
+}
+
+func toPbPlanDiff(d model.PlanDiff) *simulationv1.PlanDiff {
+	pb := &simulationv1.PlanDiff{
+		BasePlanId:   d.BasePlanID,
+		TargetPlanId: d.TargetPlanID,
+		CostDelta:    d.CostDelta,
+		RoiDelta: &simulationv1.RoiDelta{
+			RoiScore:                 d.RoiDelta.RoiScore,
+			EstimatedTimeSavedYearly: d.RoiDelta.EstimatedTimeSavedYearly,
+			NetBenefitYearly:         d.RoiDelta.NetBenefitYearly,
+			NpvFiveYears:             d.RoiDelta.NpvFiveYears,
+			PaybackMonths:            d.RoiDelta.PaybackMonths,
+			PaybackReachableBefore:   d.RoiDelta.PaybackReachableBefore,
+			PaybackReachableAfter:    d.RoiDelta.PaybackReachableAfter,
+		},
+	}
+	for _, g := range d.Groups {
+		pb.Groups = append(pb.Groups, &simulationv1.GroupDiff{
+			Category: string(g.Category),
+			Added:    toPbItemChanges(g.Added),
+			Removed:  toPbItemChanges(g.Removed),
+			Changed:  toPbItemChanges(g.Changed),
+		})
+	}
+	for _, c := range d.InputChanges {
+		pb.InputChanges = append(pb.InputChanges, &simulationv1.InputChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	return pb
+}
+
+// toConnectError: ドメインのエラーを Connect のステータスコードに変換します。
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidInput):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, model.ErrScenarioNotFound), errors.Is(err, model.ErrPlanNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, model.ErrScenarioRunning):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, model.ErrQuotaExceeded):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	// 他サービス (Catalog Service など) から返ったステータスコードはそのまま引き継ぎます
+	if code := connect.CodeOf(err); code != connect.CodeUnknown {
+		return connect.NewError(code, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
@@ -0,0 +1,250 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	simulationv1 "github.com/kinoshitatakumi/opti/gen/go/simulation/v1"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/usecase"
+)
+
+// SimulationHandler: SimulationService のgRPCリクエストを受け付ける窓口です。
+// Protoメッセージとドメインモデルの変換と、エラーのステータスコードへの変換を担当します。
+type SimulationHandler struct {
+	roi        *usecase.RoiUsecase
+	roadmap    *usecase.RoadmapUsecase
+	simulation *usecase.SimulationUsecase
+	comparison *usecase.ComparisonUsecase
+	sweep      *usecase.SweepUsecase
+}
+
+// NewSimulationHandler: ハンドラの作成
+func NewSimulationHandler(roi *usecase.RoiUsecase, roadmap *usecase.RoadmapUsecase, simulation *usecase.SimulationUsecase, comparison *usecase.ComparisonUsecase, sweep *usecase.SweepUsecase) *SimulationHandler {
+	return &SimulationHandler{roi: roi, roadmap: roadmap, simulation: simulation, comparison: comparison, sweep: sweep}
+}
+
+// CalculateRoi: ROI計算API
+func (h *SimulationHandler) CalculateRoi(ctx context.Context, req *connect.Request[simulationv1.CalculateRoiRequest]) (*connect.Response[simulationv1.RoiProjection], error) {
+	// 1. 通信用(protobuf) -> 内部の型(model) に変換
+	input, err := toRoiInput(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 2. 計算。入力値の不正以外でエラーになることはありません。
+	proj, err := h.roi.CalculateRoi(ctx, input)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	return connect.NewResponse(toPbRoiProjection(proj)), nil
+}
+
+// PlanRoadmap: 段階的導入ロードマップ作成API
+func (h *SimulationHandler) PlanRoadmap(ctx context.Context, req *connect.Request[simulationv1.PlanRoadmapRequest]) (*connect.Response[simulationv1.AdoptionRoadmap], error) {
+	// 1. 通信用(protobuf) -> 内部の型(model) に変換
+	items := make([]model.RoadmapItem, 0, len(req.Msg.Items))
+	for _, it := range req.Msg.Items {
+		if it.Product == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("roadmap item requires product"))
+		}
+		effect, err := toProductEffect(it.Product)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		items = append(items, model.RoadmapItem{Effect: effect, DependsOn: it.DependsOn})
+	}
+
+	// 2. ロードマップ作成。入力値の不正以外でエラーになることはありません。
+	roadmap, err := h.roadmap.PlanRoadmap(ctx, items, toChoreInputs(req.Msg.Chores), toBudget(req.Msg.Budget), toAssumptions(req.Msg.Assumptions))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	return connect.NewResponse(toPbRoadmap(roadmap)), nil
+}
+
+// RunSimulation: 提案プラン生成API (進捗は通知せず、完成したプランだけを返します)
+// LLMの利用上限は利用者ごとに数えるので、ログインが必要です。
+func (h *SimulationHandler) RunSimulation(ctx context.Context, req *connect.Request[simulationv1.RunSimulationRequest]) (*connect.Response[simulationv1.OptimizationPlan], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := h.simulation.RunSimulation(ctx, userID, toScenarioInput(req.Msg), nil)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbPlan(plan)), nil
+}
+
+// RunSimulationStream: 提案プラン生成API (ストリーミング版)
+// クライアントが切断すると ctx がキャンセルされ、パイプラインはその時点で止まります。
+// それまでの結果はシナリオに保存されているので、resume_scenario_id で続きから再開できます。
+func (h *SimulationHandler) RunSimulationStream(ctx context.Context, req *connect.Request[simulationv1.RunSimulationStreamRequest], stream *connect.ServerStream[simulationv1.SimulationEvent]) error {
+	// 1. イベントを届いた順にクライアントへ送る sink を用意
+	sink := func(e model.SimulationEvent) error {
+		return stream.Send(toPbEvent(e))
+	}
+
+	// 2. 新規作成か再開かで呼び分け
+	var err error
+	switch target := req.Msg.Target.(type) {
+	case *simulationv1.RunSimulationStreamRequest_NewScenario:
+		userID, callerErr := callerID(ctx, target.NewScenario.UserId)
+		if callerErr != nil {
+			return callerErr
+		}
+		_, err = h.simulation.RunSimulation(ctx, userID, toScenarioInput(target.NewScenario), sink)
+	case *simulationv1.RunSimulationStreamRequest_ResumeScenarioId:
+		// 再開できるのはシナリオを作った本人だけです
+		userID, authErr := auth.RequireUserID(ctx)
+		if authErr != nil {
+			return authErr
+		}
+		_, err = h.simulation.ResumeSimulation(ctx, userID, target.ResumeScenarioId, sink)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("new_scenario or resume_scenario_id is required"))
+	}
+	if err != nil {
+		return toConnectError(err)
+	}
+	return nil
+}
+
+// GetOptimizationPlan: 提案プラン取得API
+// 取得できるのはログイン中の利用者のプランだけです (Project Service からの呼び出しでは利用者のトークンが転送されます)。
+func (h *SimulationHandler) GetOptimizationPlan(ctx context.Context, req *connect.Request[simulationv1.GetOptimizationPlanRequest]) (*connect.Response[simulationv1.OptimizationPlan], error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := h.simulation.GetOptimizationPlan(ctx, userID, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(toPbPlan(plan)), nil
+}
+
+// CompareScenarios: 提案プラン比較API
+// 比べられるのはログイン中の利用者のプランだけです。
+func (h *SimulationHandler) CompareScenarios(ctx context.Context, req *connect.Request[simulationv1.CompareScenariosRequest]) (*connect.Response[simulationv1.CompareScenariosResponse], error) {
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := h.comparison.CompareScenarios(ctx, userID, req.Msg.PlanIds)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &simulationv1.CompareScenariosResponse{}
+	for _, d := range diffs {
+		res.Diffs = append(res.Diffs, toPbPlanDiff(d))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SweepBudget: 予算スイープAPI
+// 条件を直接指定する場合はログイン不要で、既存のシナリオを使う場合はログインした所有者だけが呼べます。
+func (h *SimulationHandler) SweepBudget(ctx context.Context, req *connect.Request[simulationv1.SweepBudgetRequest]) (*connect.Response[simulationv1.SweepBudgetResponse], error) {
+	// 1. 通信用(protobuf) -> 内部の型(model) に変換
+	var source usecase.SweepSource
+	switch s := req.Msg.Source.(type) {
+	case *simulationv1.SweepBudgetRequest_ScenarioId:
+		// 既存のシナリオを使えるのは、シナリオを作った本人だけです
+		userID, err := auth.RequireUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		source.ScenarioID, source.UserID = s.ScenarioId, userID
+	case *simulationv1.SweepBudgetRequest_Conditions:
+		source.Input = toScenarioInput(s.Conditions)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("scenario_id or conditions is required"))
+	}
+	budgets := model.BudgetRange{
+		Type: model.BudgetType(req.Msg.BudgetType),
+		Min:  req.Msg.MinAmount,
+		Max:  req.Msg.MaxAmount,
+		Step: req.Msg.Step,
+	}
+
+	// 2. スイープ (制限時間を過ぎた場合は DeadlineExceeded)
+	sweep, err := h.sweep.SweepBudget(ctx, source, budgets)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	// 3. 内部の型(model) -> 通信用(protobuf) に変換してレスポンス
+	res := &simulationv1.SweepBudgetResponse{
+		BudgetType: string(sweep.Range.Type),
+		Evaluated:  int32(sweep.Evaluated),
+	}
+	for _, b := range sweep.Breakpoints {
+		res.Breakpoints = append(res.Breakpoints, &simulationv1.BudgetBreakpoint{
+			MinBudget:         b.MinBudget,
+			TotalCost:         b.TotalCost,
+			PainRelief:        b.PainRelief,
+			PainReliefPercent: b.PainReliefPercent,
+			ProductIds:        b.ProductIDs,
+			Added:             b.Added,
+			Removed:           b.Removed,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetLlmUsage: LLM利用状況取得API
+// ログインが必要で、返すのはログイン中の利用者の利用状況だけです。
+func (h *SimulationHandler) GetLlmUsage(ctx context.Context, req *connect.Request[simulationv1.GetLlmUsageRequest]) (*connect.Response[simulationv1.GetLlmUsageResponse], error) {
+	userID, err := callerID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := h.simulation.GetLlmUsage(ctx, userID, req.Msg.ScenarioId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	res := &simulationv1.GetLlmUsageResponse{
+		UserId:            userID,
+		Day:               summary.Day.Format(time.DateOnly),
+		Calls:             int32(summary.Today.Calls),
+		CachedResponses:   int32(summary.Today.CachedResponses),
+		PromptTokens:      int32(summary.Today.PromptTokens),
+		CompletionTokens:  int32(summary.Today.CompletionTokens),
+		EstimatedCostYen:  summary.Today.EstimatedCostYen,
+		DailyTokenQuota:   int32(summary.Quota.Tokens),
+		DailyCostQuotaYen: summary.Quota.CostYen,
+	}
+	for _, u := range summary.Scenario {
+		res.ScenarioRecords = append(res.ScenarioRecords, &simulationv1.LlmUsageRecord{
+			Model:            u.Model,
+			PromptVersion:    u.PromptVersion,
+			PromptTokens:     int32(u.PromptTokens),
+			CompletionTokens: int32(u.CompletionTokens),
+			EstimatedCostYen: u.EstimatedCostYen,
+			Cached:           u.Cached,
+			CreatedAt:        u.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// callerID: ログイン中の利用者のIDを返します (シナリオの所有者や、LLMの利用上限を数える利用者になります)。
+// 未ログインなら Unauthenticated にし、リクエストの user_id が別人なら PermissionDenied にします。
+func callerID(ctx context.Context, requested string) (string, error) {
+	caller, err := auth.RequireUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if requested != "" && requested != caller {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.New("user_id does not match the logged-in user"))
+	}
+	return caller, nil
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	simulationv1 "github.com/kinoshitatakumi/opti/gen/go/simulation/v1"
+	"github.com/kinoshitatakumi/opti/pkg/auth"
+)
+
+// 利用者の確認はユースケースを呼ぶ前に行うので、ユースケースなしのハンドラで確かめます。
+func TestSimulationHandlerRequiresTheLoggedInUser(t *testing.T) {
+	h := NewSimulationHandler(nil, nil, nil, nil, nil)
+	mallory := auth.WithUserID(context.Background(), "mallory")
+	tests := []struct {
+		name string
+		call func() error
+		want connect.Code
+	}{
+		{
+			name: "anonymous simulation",
+			call: func() error {
+				_, err := h.RunSimulation(context.Background(), connect.NewRequest(&simulationv1.RunSimulationRequest{UserId: "alice"}))
+				return err
+			},
+			want: connect.CodeUnauthenticated,
+		},
+		{
+			name: "simulation as another user",
+			call: func() error {
+				_, err := h.RunSimulation(mallory, connect.NewRequest(&simulationv1.RunSimulationRequest{UserId: "alice"}))
+				return err
+			},
+			want: connect.CodePermissionDenied,
+		},
+		{
+			name: "anonymous usage",
+			call: func() error {
+				_, err := h.GetLlmUsage(context.Background(), connect.NewRequest(&simulationv1.GetLlmUsageRequest{UserId: "alice"}))
+				return err
+			},
+			want: connect.CodeUnauthenticated,
+		},
+		{
+			name: "another user's usage",
+			call: func() error {
+				_, err := h.GetLlmUsage(mallory, connect.NewRequest(&simulationv1.GetLlmUsageRequest{UserId: "alice"}))
+				return err
+			},
+			want: connect.CodePermissionDenied,
+		},
+		{
+			name: "anonymous plan",
+			call: func() error {
+				_, err := h.GetOptimizationPlan(context.Background(), connect.NewRequest(&simulationv1.GetOptimizationPlanRequest{Id: "plan-1"}))
+				return err
+			},
+			want: connect.CodeUnauthenticated,
+		},
+		{
+			name: "anonymous comparison",
+			call: func() error {
+				_, err := h.CompareScenarios(context.Background(), connect.NewRequest(&simulationv1.CompareScenariosRequest{PlanIds: []string{"plan-1", "plan-2"}}))
+				return err
+			},
+			want: connect.CodeUnauthenticated,
+		},
+		{
+			name: "anonymous sweep of a scenario",
+			call: func() error {
+				_, err := h.SweepBudget(context.Background(), connect.NewRequest(&simulationv1.SweepBudgetRequest{Source: &simulationv1.SweepBudgetRequest_ScenarioId{ScenarioId: "s1"}}))
+				return err
+			},
+			want: connect.CodeUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); connect.CodeOf(err) != tt.want {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+)
+
+// NarrativeRequest: 説明文1つ分の生成依頼
+type NarrativeRequest struct {
+	UserID     string
+	ScenarioID string
+	Prompt     model.Prompt
+}
+
+// reservedCompletionTokens: 呼び出し前に確保する、説明文1つ分の出力トークンの見積もり
+const reservedCompletionTokens = 1024
+
+// AccountedLLM: LLM呼び出しに、キャッシュ・利用上限・利用記録を付け加えたものです。
+// 1. キャッシュにあれば LLM を呼ばずに返す (費用0、上限にも数えない)。キーはプロンプトとモデルから作る
+// 2. 利用上限の枠を見積もり分だけ確保する。今日の利用と呼び出し中の枠で上限に達していれば model.ErrQuotaExceeded
+// 3. LLM を呼び、枠を実際のトークン数と見積もり費用の記録に置き換えて、キャッシュに保存する
+type AccountedLLM struct {
+	llm       repository.LLMClient
+	usage     repository.UsageRepository
+	cache     repository.NarrativeCache
+	estimator *service.CostEstimator
+	quota     model.QuotaPolicy
+}
+
+// NewAccountedLLM: 作成
+func NewAccountedLLM(llm repository.LLMClient, usage repository.UsageRepository, cache repository.NarrativeCache, estimator *service.CostEstimator, quota model.QuotaPolicy) *AccountedLLM {
+	return &AccountedLLM{llm: llm, usage: usage, cache: cache, estimator: estimator, quota: quota}
+}
+
+// Generate: 説明文を生成し、断片を onToken に渡します。キャッシュから返す場合は全文を1回で渡します。
+func (a *AccountedLLM) Generate(ctx context.Context, req NarrativeRequest, onToken func(text string) error) (string, error) {
+	modelName := a.llm.Model()
+	key := req.Prompt.CacheKey(modelName)
+
+	// 1. キャッシュ
+	if text, ok, err := a.cache.Get(ctx, key); err != nil {
+		return "", err
+	} else if ok {
+		if err := onToken(text); err != nil {
+			return "", err
+		}
+		return text, a.usage.Record(ctx, model.LlmUsage{
+			UserID:        req.UserID,
+			ScenarioID:    req.ScenarioID,
+			Model:         modelName,
+			PromptVersion: req.Prompt.Version,
+			Cached:        true,
+			CreatedAt:     time.Now(),
+		})
+	}
+
+	// 2. 利用上限の枠を確保 (確認と確保を同時に行うので、並行したシミュレーションでも上限を超えません)
+	promptTokens := utf8.RuneCountInString(req.Prompt.System + req.Prompt.User)
+	now := time.Now()
+	reservation := model.UsageReservation{
+		ID:               uuid.NewString(),
+		UserID:           req.UserID,
+		Tokens:           promptTokens + reservedCompletionTokens,
+		EstimatedCostYen: a.estimator.Estimate(modelName, promptTokens, reservedCompletionTokens),
+		CreatedAt:        now,
+	}
+	if err := a.usage.Reserve(ctx, reservation, a.quota.For(req.UserID), model.StartOfDay(now)); err != nil {
+		return "", err
+	}
+
+	// 3. 生成と記録 (失敗した場合は枠を解放します。ctx が切れていても解放できるよう WithoutCancel を使います)
+	completion, err := a.llm.Generate(ctx, req.Prompt, onToken)
+	if err != nil {
+		if releaseErr := a.usage.Settle(context.WithoutCancel(ctx), reservation.ID, nil); releaseErr != nil {
+			return "", errors.Join(err, releaseErr)
+		}
+		return "", err
+	}
+	if err := a.usage.Settle(context.WithoutCancel(ctx), reservation.ID, &model.LlmUsage{
+		UserID:           req.UserID,
+		ScenarioID:       req.ScenarioID,
+		Model:            completion.Model,
+		PromptVersion:    req.Prompt.Version,
+		PromptTokens:     completion.PromptTokens,
+		CompletionTokens: completion.CompletionTokens,
+		EstimatedCostYen: a.estimator.Estimate(completion.Model, completion.PromptTokens, completion.CompletionTokens),
+		CreatedAt:        time.Now(),
+	}); err != nil {
+		return "", err
+	}
+	if completion.Text != "" {
+		if err := a.cache.Put(ctx, key, completion.Text); err != nil {
+			return "", err
+		}
+	}
+	return completion.Text, nil
+}
+
+// UsageSummary: ユーザーの今日の利用状況
+type UsageSummary struct {
+	Day      time.Time
+	Today    model.UsageTotals
+	Quota    model.DailyQuota
+	Scenario []model.LlmUsage // シナリオ指定時のみ
+}
+
+// Usage: ユーザーの今日の利用状況と、指定されたシナリオの利用記録を返します。
+func (a *AccountedLLM) Usage(ctx context.Context, userID, scenarioID string) (*UsageSummary, error) {
+	day := model.StartOfDay(time.Now())
+	totals, err := a.usage.TotalsByUserSince(ctx, userID, day)
+	if err != nil {
+		return nil, err
+	}
+	summary := &UsageSummary{Day: day, Today: totals, Quota: a.quota.For(userID)}
+	if scenarioID != "" {
+		if summary.Scenario, err = a.usage.ListByScenario(ctx, scenarioID); err != nil {
+			return nil, err
+		}
+	}
+	return summary, nil
+}
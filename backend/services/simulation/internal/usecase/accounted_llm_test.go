@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/db"
+)
+
+// stubLLM: 呼ばれた回数を数え、release が閉じられるまで返さない LLMClient
+type stubLLM struct {
+	model   string
+	started chan struct{}
+	release chan struct{}
+
+	mu    sync.Mutex
+	calls int
+}
+
+func newStubLLM(modelName string) *stubLLM {
+	return &stubLLM{model: modelName, started: make(chan struct{}, 16), release: make(chan struct{})}
+}
+
+func (s *stubLLM) Model() string { return s.model }
+
+func (s *stubLLM) Generate(ctx context.Context, prompt model.Prompt, onToken func(string) error) (*model.Completion, error) {
+	s.mu.Lock()
+	s.calls++
+	s.mu.Unlock()
+	s.started <- struct{}{}
+	<-s.release
+	return &model.Completion{Text: "説明 (" + s.model + ")", Model: s.model, PromptTokens: 100, CompletionTokens: 50}, nil
+}
+
+func (s *stubLLM) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func ignoreTokens(string) error { return nil }
+
+func TestAccountedLLMReservesQuotaBeforeCalling(t *testing.T) {
+	ctx := context.Background()
+	llm := newStubLLM("gemini-2.0-flash")
+	usage := db.NewMemoryUsageRepository()
+	accounted := NewAccountedLLM(llm, usage, db.NewMemoryNarrativeCache(db.DefaultNarrativeCacheEntries), service.NewCostEstimator(nil),
+		model.QuotaPolicy{Default: model.DailyQuota{Tokens: 1500}})
+
+	// 1回目の呼び出し中に2回目を始めると、1回目の枠 (1025トークン) と合わせて上限を超えるので断られる
+	first := make(chan error, 1)
+	go func() {
+		_, err := accounted.Generate(ctx, NarrativeRequest{UserID: "u1", Prompt: model.Prompt{User: "1"}}, ignoreTokens)
+		first <- err
+	}()
+	<-llm.started
+	if _, err := accounted.Generate(ctx, NarrativeRequest{UserID: "u1", Prompt: model.Prompt{User: "2"}}, ignoreTokens); !errors.Is(err, model.ErrQuotaExceeded) {
+		t.Errorf("concurrent call: err = %v, want ErrQuotaExceeded", err)
+	}
+	// 別のユーザーの枠は別
+	other := make(chan error, 1)
+	go func() {
+		_, err := accounted.Generate(ctx, NarrativeRequest{UserID: "u2", Prompt: model.Prompt{User: "3"}}, ignoreTokens)
+		other <- err
+	}()
+	<-llm.started
+	close(llm.release)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-other; err != nil {
+		t.Errorf("other user: %v", err)
+	}
+
+	// 呼び出しが終わると、枠は実際の利用 (150トークン) に置き換わる
+	totals, err := usage.TotalsByUserSince(ctx, "u1", model.StartOfDay(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totals.Calls != 1 || totals.Tokens() != 150 {
+		t.Errorf("totals = %+v", totals)
+	}
+	if _, err := accounted.Generate(ctx, NarrativeRequest{UserID: "u1", Prompt: model.Prompt{User: "4"}}, ignoreTokens); err != nil {
+		t.Errorf("call after the first finished: %v", err)
+	}
+}
+
+func TestAccountedLLMCacheKeyFollowsPromptAndModel(t *testing.T) {
+	ctx := context.Background()
+	cache := db.NewMemoryNarrativeCache(db.DefaultNarrativeCacheEntries)
+	usage := db.NewMemoryUsageRepository()
+	flash := newStubLLM("gemini-2.0-flash")
+	close(flash.release)
+	accounted := NewAccountedLLM(flash, usage, cache, service.NewCostEstimator(nil), model.QuotaPolicy{})
+
+	prompt := model.Prompt{Version: "v1", System: "system", User: "製品: ロボット掃除機A"}
+	generate := func(a *AccountedLLM, p model.Prompt) string {
+		t.Helper()
+		text, err := a.Generate(ctx, NarrativeRequest{UserID: "u1", Prompt: p}, ignoreTokens)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return text
+	}
+	generate(accounted, prompt)
+	generate(accounted, prompt)
+	if flash.Calls() != 1 {
+		t.Errorf("same prompt called the llm %d times, want 1 (cached)", flash.Calls())
+	}
+
+	// カタログで製品名が変わる (プロンプトが変わる) と、キャッシュは使わない
+	renamed := prompt
+	renamed.User = "製品: ロボット掃除機A (2026年モデル)"
+	generate(accounted, renamed)
+	if flash.Calls() != 2 {
+		t.Errorf("renamed product used the cache")
+	}
+
+	// モデルを切り替えると、キャッシュは使わない
+	pro := newStubLLM("gemini-1.5-pro")
+	close(pro.release)
+	if got := generate(NewAccountedLLM(pro, usage, cache, service.NewCostEstimator(nil), model.QuotaPolicy{}), prompt); got != "説明 (gemini-1.5-pro)" || pro.Calls() != 1 {
+		t.Errorf("switched model got %q (%d calls)", got, pro.Calls())
+	}
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+)
+
+// CalibrationRefresher: 較正係数を定期的に取得し、最新の値を保持します。
+// ROI計算のたびに他サービスを呼ばずに済むよう、RoiCalculator にはこの保持している値を渡します。
+// 取得に失敗した場合は前回の値を使い続けます。
+type CalibrationRefresher struct {
+	source repository.CalibrationSource
+
+	mu      sync.RWMutex
+	current model.RoiCalibration
+}
+
+// NewCalibrationRefresher: 作成
+func NewCalibrationRefresher(source repository.CalibrationSource) *CalibrationRefresher {
+	return &CalibrationRefresher{source: source}
+}
+
+// Current: 最後に取得できた較正係数を返します (service.CalibrationProvider の実装)。
+func (r *CalibrationRefresher) Current() model.RoiCalibration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current
+}
+
+// Refresh: 較正係数を取得し直します。
+func (r *CalibrationRefresher) Refresh(ctx context.Context) error {
+	calibration, err := r.source.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.current = calibration
+	r.mu.Unlock()
+	return nil
+}
+
+// Run: ctx がキャンセルされるまで、interval ごとに Refresh します。
+func (r *CalibrationRefresher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Refresh(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("failed to refresh roi calibration: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
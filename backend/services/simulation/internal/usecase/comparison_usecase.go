@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+)
+
+// ComparisonUsecase: 条件を変えた複数の提案プランを比較するユースケースです (UC-02)。
+type ComparisonUsecase struct {
+	plans      repository.PlanRepository
+	scenarios  repository.ScenarioRepository
+	comparator *service.PlanComparator
+}
+
+// NewComparisonUsecase: ユースケースの作成
+func NewComparisonUsecase(plans repository.PlanRepository, scenarios repository.ScenarioRepository, comparator *service.PlanComparator) *ComparisonUsecase {
+	return &ComparisonUsecase{plans: plans, scenarios: scenarios, comparator: comparator}
+}
+
+// CompareScenarios: 先頭のプランを基準に、残りのプランそれぞれとの差分を返します。
+// 比べられるのは userID のプランだけです。他の利用者のプランは、存在を知られないよう model.ErrPlanNotFound にします。
+func (u *ComparisonUsecase) CompareScenarios(ctx context.Context, userID string, planIDs []string) ([]model.PlanDiff, error) {
+	if len(planIDs) < 2 {
+		return nil, fmt.Errorf("%w: at least two plan ids are required", model.ErrInvalidInput)
+	}
+	seen := make(map[string]bool, len(planIDs))
+	compared := make([]model.ComparedPlan, 0, len(planIDs))
+	for _, id := range planIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("%w: duplicated plan id: %s", model.ErrInvalidInput, id)
+		}
+		seen[id] = true
+
+		plan, err := u.plans.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, id)
+		}
+		if plan.UserID != userID {
+			return nil, fmt.Errorf("%w: %s", model.ErrPlanNotFound, id)
+		}
+		scenario, err := u.scenarios.GetByID(ctx, plan.SimulationScenarioID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, plan.SimulationScenarioID)
+		}
+		compared = append(compared, model.ComparedPlan{Plan: plan, Scenario: scenario})
+	}
+
+	diffs := make([]model.PlanDiff, 0, len(compared)-1)
+	for _, target := range compared[1:] {
+		diffs = append(diffs, u.comparator.Compare(compared[0], target))
+	}
+	return diffs, nil
+}
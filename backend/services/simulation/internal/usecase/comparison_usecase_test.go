@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/db"
+)
+
+func TestCompareScenariosIsOnlyForTheOwner(t *testing.T) {
+	ctx := context.Background()
+	plans := db.NewMemoryPlanRepository()
+	scenarios := db.NewMemoryScenarioRepository()
+	for _, p := range []struct{ id, owner string }{{"a1", "alice"}, {"a2", "alice"}, {"m1", "mallory"}} {
+		if err := scenarios.Save(ctx, &model.SimulationScenario{ID: "s-" + p.id, UserID: p.owner}); err != nil {
+			t.Fatal(err)
+		}
+		if err := plans.Save(ctx, &model.OptimizationPlan{ID: p.id, UserID: p.owner, SimulationScenarioID: "s-" + p.id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	u := NewComparisonUsecase(plans, scenarios, service.NewPlanComparator())
+
+	diffs, err := u.CompareScenarios(ctx, "alice", []string{"a1", "a2"})
+	if err != nil || len(diffs) != 1 {
+		t.Fatalf("owner: diffs = %+v, err = %v", diffs, err)
+	}
+	for _, ids := range [][]string{{"a1", "m1"}, {"m1", "a1"}} {
+		if _, err := u.CompareScenarios(ctx, "alice", ids); !errors.Is(err, model.ErrPlanNotFound) {
+			t.Errorf("%v: err = %v, want ErrPlanNotFound", ids, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+)
+
+// RoadmapUsecase: 段階的導入ロードマップを作成するユースケースです。
+type RoadmapUsecase struct {
+	scheduler *service.RoadmapScheduler
+}
+
+// NewRoadmapUsecase: ユースケースの作成
+func NewRoadmapUsecase(scheduler *service.RoadmapScheduler) *RoadmapUsecase {
+	return &RoadmapUsecase{scheduler: scheduler}
+}
+
+// PlanRoadmap: 選ばれた製品を予算に合わせて月ごとに並べます。
+func (u *RoadmapUsecase) PlanRoadmap(ctx context.Context, items []model.RoadmapItem, chores []model.ChoreInput, budget model.BudgetConstraint, assumptions model.RoiAssumptions) (*model.AdoptionRoadmap, error) {
+	return u.scheduler.Schedule(items, chores, budget, assumptions)
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+)
+
+// RoiUsecase: ROI計算のユースケースです。
+// 保存を伴わない計算専用なので、フロントエンドのスライダー操作のたびに呼ばれても問題ありません。
+type RoiUsecase struct {
+	calculator *service.RoiCalculator
+}
+
+// NewRoiUsecase: ユースケースの作成
+func NewRoiUsecase(calculator *service.RoiCalculator) *RoiUsecase {
+	return &RoiUsecase{calculator: calculator}
+}
+
+// CalculateRoi: 入力からROIを計算して返します。
+func (u *RoiUsecase) CalculateRoi(ctx context.Context, input model.RoiInput) (*model.RoiProjection, error) {
+	return u.calculator.Calculate(input)
+}
@@ -0,0 +1,377 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+)
+
+// EventSink: パイプラインの進捗イベントを受け取る関数です。
+// エラーを返すと (例: クライアントが切断した) パイプラインはその場で中断します。
+type EventSink func(event model.SimulationEvent) error
+
+// discardEvents: 進捗を通知しない場合 (単発のRPC) に使う EventSink です。
+func discardEvents(model.SimulationEvent) error { return nil }
+
+// SimulationUsecase: 診断入力から提案プランを生成するユースケースです。
+// 1. 入力を受け付けてシナリオを保存する
+// 2. Catalog Service から住環境に合う候補製品を取得する
+// 3. オプティマイザで予算内の製品構成を決める
+// 4. 提案グループごとに LLM で説明文を生成する
+// 5. ROI (月額予算ならロードマップも) を計算する
+// 6. 提案プランを保存する
+// 各段階の結果はシナリオにチェックポイントとして保存するので、途中で切断されても続きから再開できます。
+type SimulationUsecase struct {
+	scenarios  repository.ScenarioRepository
+	plans      repository.PlanRepository
+	catalog    repository.ProductCatalog
+	llm        *AccountedLLM
+	optimizer  *service.PlanOptimizer
+	narrative  *service.NarrativeBuilder
+	calculator *service.RoiCalculator
+	scheduler  *service.RoadmapScheduler
+
+	mu      sync.Mutex
+	running map[string]bool // 実行中のシナリオID (同じシナリオの二重実行を防ぎます)
+}
+
+// NewSimulationUsecase: ユースケースの作成
+func NewSimulationUsecase(
+	scenarios repository.ScenarioRepository,
+	plans repository.PlanRepository,
+	catalog repository.ProductCatalog,
+	llm *AccountedLLM,
+	optimizer *service.PlanOptimizer,
+	narrative *service.NarrativeBuilder,
+	calculator *service.RoiCalculator,
+	scheduler *service.RoadmapScheduler,
+) *SimulationUsecase {
+	return &SimulationUsecase{
+		scenarios:  scenarios,
+		plans:      plans,
+		catalog:    catalog,
+		llm:        llm,
+		optimizer:  optimizer,
+		narrative:  narrative,
+		calculator: calculator,
+		scheduler:  scheduler,
+		running:    make(map[string]bool),
+	}
+}
+
+// RunSimulation: 新しいシナリオを作成し、提案プランを生成します。
+// sink が nil の場合、進捗イベントは通知しません。
+func (u *SimulationUsecase) RunSimulation(ctx context.Context, userID string, input model.ScenarioInput, sink EventSink) (*model.OptimizationPlan, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	scenario := &model.SimulationScenario{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Status:    model.ScenarioDraft,
+		Input:     input,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	return u.execute(ctx, scenario, sink)
+}
+
+// ResumeSimulation: 中断されたシナリオを続きから実行します。
+// 完了済みの段階は保存済みの結果をイベントとして再送 (Replayed = true) し、残りの段階だけを実行します。
+// 完了済みのシナリオを指定した場合は、全イベントを再送して保存済みのプランを返します。
+// 他の利用者のシナリオは、存在を知られないよう model.ErrScenarioNotFound にします。
+func (u *SimulationUsecase) ResumeSimulation(ctx context.Context, userID, scenarioID string, sink EventSink) (*model.OptimizationPlan, error) {
+	scenario, err := u.scenarios.GetByID(ctx, scenarioID)
+	if err != nil {
+		return nil, err
+	}
+	if scenario.UserID != userID {
+		return nil, model.ErrScenarioNotFound
+	}
+	return u.execute(ctx, scenario, sink)
+}
+
+// GetOptimizationPlan: 保存済みの提案プランを取得します。
+// 他の利用者のプランは、シナリオと同じく存在を知られないよう model.ErrPlanNotFound にします。
+func (u *SimulationUsecase) GetOptimizationPlan(ctx context.Context, userID, id string) (*model.OptimizationPlan, error) {
+	plan, err := u.plans.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if plan.UserID != userID {
+		return nil, fmt.Errorf("%w: %s", model.ErrPlanNotFound, id)
+	}
+	return plan, nil
+}
+
+// GetLlmUsage: ユーザーの今日のLLM利用状況 (scenarioID 指定時はそのシナリオの利用記録も) を返します。
+// 他の利用者のシナリオは、再開と同じく model.ErrScenarioNotFound にします。
+func (u *SimulationUsecase) GetLlmUsage(ctx context.Context, userID, scenarioID string) (*UsageSummary, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", model.ErrInvalidInput)
+	}
+	if scenarioID != "" {
+		scenario, err := u.scenarios.GetByID(ctx, scenarioID)
+		if err != nil {
+			return nil, err
+		}
+		if scenario.UserID != userID {
+			return nil, model.ErrScenarioNotFound
+		}
+	}
+	return u.llm.Usage(ctx, userID, scenarioID)
+}
+
+func (u *SimulationUsecase) acquire(id string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.running[id] {
+		return false
+	}
+	u.running[id] = true
+	return true
+}
+
+func (u *SimulationUsecase) release(id string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.running, id)
+}
+
+// emitter: イベントに通し番号を振って sink に渡します。
+type emitter struct {
+	scenarioID string
+	sequence   int
+	resumedAt  model.SimulationStage // 実行開始時点で完了していた段階
+	sink       EventSink
+}
+
+// emit: stage が実行開始前に完了していた段階なら、再送イベントとして渡します。
+func (e *emitter) emit(ctx context.Context, stage model.SimulationStage, event model.SimulationEvent) error {
+	return e.send(ctx, event, stage <= e.resumedAt)
+}
+
+func (e *emitter) send(ctx context.Context, event model.SimulationEvent, replayed bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.sequence++
+	event.ScenarioID = e.scenarioID
+	event.Sequence = e.sequence
+	event.Replayed = replayed
+	return e.sink(event)
+}
+
+// execute: パイプラインを実行します。完了済みの段階は計算せず、保存済みの結果を再送します。
+func (u *SimulationUsecase) execute(ctx context.Context, scenario *model.SimulationScenario, sink EventSink) (*model.OptimizationPlan, error) {
+	if !u.acquire(scenario.ID) {
+		return nil, model.ErrScenarioRunning
+	}
+	defer u.release(scenario.ID)
+
+	if sink == nil {
+		sink = discardEvents
+	}
+	em := &emitter{scenarioID: scenario.ID, resumedAt: scenario.Progress.Stage, sink: sink}
+	progress := &scenario.Progress
+
+	// 1. 入力の受け付け
+	if progress.Stage < model.StageAccepted {
+		scenario.Advance(model.StageAccepted, time.Now())
+		if err := u.scenarios.Save(ctx, scenario); err != nil {
+			return nil, err
+		}
+	}
+	if err := em.emit(ctx, model.StageAccepted, model.SimulationEvent{Kind: model.EventScenarioAccepted}); err != nil {
+		return nil, err
+	}
+
+	// 2. 候補製品の絞り込み
+	if progress.Stage < model.StageFiltered {
+		candidates, rejected, err := u.catalog.ListCandidates(ctx, scenario.Input.Residence)
+		if err != nil {
+			return nil, err
+		}
+		progress.Candidates, progress.Rejected = candidates, rejected
+		if err := u.checkpoint(ctx, scenario, model.StageFiltered); err != nil {
+			return nil, err
+		}
+	}
+	if err := em.emit(ctx, model.StageFiltered, model.SimulationEvent{
+		Kind:          model.EventCandidatesFiltered,
+		EligibleCount: len(progress.Candidates),
+		Rejected:      progress.Rejected,
+	}); err != nil {
+		return nil, err
+	}
+
+	// 3. 製品構成の最適化
+	if progress.Stage < model.StageOptimized {
+		selection, err := u.optimizer.Optimize(ctx, progress.Candidates, scenario.Input.Chores, scenario.Input.Budget)
+		if err != nil {
+			return nil, err
+		}
+		progress.Draft = &model.OptimizationPlan{
+			ID:                   uuid.NewString(),
+			UserID:               scenario.UserID,
+			SimulationScenarioID: scenario.ID,
+			Concept:              u.narrative.Concept(selection),
+			ProposalGroups:       selection.Groups,
+		}
+		if err := u.checkpoint(ctx, scenario, model.StageOptimized); err != nil {
+			return nil, err
+		}
+	}
+	draft := progress.Draft
+	if err := em.emit(ctx, model.StageOptimized, model.SimulationEvent{Kind: model.EventOptimizerDone, Draft: draft}); err != nil {
+		return nil, err
+	}
+
+	// 4. グループごとの説明文の生成 (1グループ終わるごとにチェックポイントを保存)
+	if err := u.narrate(ctx, scenario, em); err != nil {
+		return nil, err
+	}
+
+	// 5. ROI・ロードマップの計算
+	if progress.Stage < model.StageRoiDone {
+		if err := u.project(scenario); err != nil {
+			return nil, err
+		}
+		if err := u.checkpoint(ctx, scenario, model.StageRoiDone); err != nil {
+			return nil, err
+		}
+	}
+	if err := em.emit(ctx, model.StageRoiDone, model.SimulationEvent{Kind: model.EventRoiComputed, Roi: draft.RoiProjection}); err != nil {
+		return nil, err
+	}
+
+	// 6. 提案プランの保存
+	var plan *model.OptimizationPlan
+	if progress.Stage < model.StagePersisted {
+		plan = draft
+		plan.CreatedAt = time.Now()
+		if err := u.plans.Save(ctx, plan); err != nil {
+			return nil, err
+		}
+		scenario.Complete(plan.ID, time.Now())
+		if err := u.scenarios.Save(ctx, scenario); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if plan, err = u.plans.GetByID(ctx, scenario.PlanID); err != nil {
+			return nil, err
+		}
+	}
+	if err := em.emit(ctx, model.StagePersisted, model.SimulationEvent{Kind: model.EventPlanPersisted, Plan: plan}); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// checkpoint: 段階を進めてシナリオを保存します。
+func (u *SimulationUsecase) checkpoint(ctx context.Context, scenario *model.SimulationScenario, stage model.SimulationStage) error {
+	scenario.Advance(stage, time.Now())
+	return u.scenarios.Save(ctx, scenario)
+}
+
+// narrate: 説明文が未生成のグループについて LLM で文章を生成し、断片をそのままイベントとして流します。
+// 生成済みのグループは、保存済みの文章を1つのイベントにまとめて再送します。
+func (u *SimulationUsecase) narrate(ctx context.Context, scenario *model.SimulationScenario, em *emitter) error {
+	draft := scenario.Progress.Draft
+	names := make(map[string]string, len(scenario.Progress.Candidates))
+	for _, c := range scenario.Progress.Candidates {
+		names[c.ID()] = c.Name
+	}
+
+	for i := range draft.ProposalGroups {
+		group := &draft.ProposalGroups[i]
+		if group.Description != "" {
+			if err := em.send(ctx, model.SimulationEvent{
+				Kind:  model.EventNarrativeToken,
+				Token: &model.NarrativeToken{Category: group.Category, Text: group.Description, Done: true},
+			}, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		text, err := u.llm.Generate(ctx, NarrativeRequest{
+			UserID:     scenario.UserID,
+			ScenarioID: scenario.ID,
+			Prompt:     u.narrative.GroupPrompt(*group, names, scenario.Input),
+		}, func(text string) error {
+			return em.emit(ctx, model.StageNarrated, model.SimulationEvent{
+				Kind:  model.EventNarrativeToken,
+				Token: &model.NarrativeToken{Category: group.Category, Text: text},
+			})
+		})
+		if err != nil {
+			return err
+		}
+		group.Description = text
+		if err := u.scenarios.Save(ctx, scenario); err != nil {
+			return err
+		}
+		if err := em.emit(ctx, model.StageNarrated, model.SimulationEvent{
+			Kind:  model.EventNarrativeToken,
+			Token: &model.NarrativeToken{Category: group.Category, Done: true},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if scenario.Progress.Stage < model.StageNarrated {
+		return u.checkpoint(ctx, scenario, model.StageNarrated)
+	}
+	return nil
+}
+
+// project: 選ばれた製品構成のROIを計算し、月額予算ならロードマップも作成して下書きに設定します。
+func (u *SimulationUsecase) project(scenario *model.SimulationScenario) error {
+	effects := make(map[string]model.ProductEffect, len(scenario.Progress.Candidates))
+	for _, c := range scenario.Progress.Candidates {
+		effects[c.ID()] = c.Effect
+	}
+	draft := scenario.Progress.Draft
+	var products []model.ProductEffect
+	var items []model.RoadmapItem
+	for _, it := range draft.Items() {
+		e := effects[it.ProductID]
+		products = append(products, e)
+		items = append(items, model.RoadmapItem{Effect: e, DependsOn: it.DependsOn})
+	}
+
+	roi, err := u.calculator.Calculate(model.RoiInput{
+		Chores:      scenario.Input.Chores,
+		Products:    products,
+		Assumptions: scenario.Input.Assumptions,
+	})
+	if err != nil {
+		return err
+	}
+	draft.RoiProjection = roi
+	// 製品ごとに掛けた較正係数を残しておき、導入後の実測から係数を求め直すときに使います
+	for g := range draft.ProposalGroups {
+		for i := range draft.ProposalGroups[g].Items {
+			it := &draft.ProposalGroups[g].Items[i]
+			it.CalibrationFactor = roi.Calibration.Factor(it.ProductCategory)
+		}
+	}
+
+	if scenario.Input.Budget.Type == model.BudgetMonthlyAllowance {
+		roadmap, err := u.scheduler.Schedule(items, scenario.Input.Chores, scenario.Input.Budget, scenario.Input.Assumptions)
+		if err != nil {
+			return err
+		}
+		draft.Roadmap = roadmap
+	}
+	return nil
+}
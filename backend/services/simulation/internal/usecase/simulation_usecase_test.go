@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/db"
+)
+
+func TestGetOptimizationPlanIsOnlyForTheOwner(t *testing.T) {
+	ctx := context.Background()
+	plans := db.NewMemoryPlanRepository()
+	if err := plans.Save(ctx, &model.OptimizationPlan{ID: "plan-1", UserID: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	u := NewSimulationUsecase(nil, plans, nil, nil, nil, nil, nil, nil)
+
+	plan, err := u.GetOptimizationPlan(ctx, "alice", "plan-1")
+	if err != nil || plan.ID != "plan-1" {
+		t.Fatalf("owner: plan = %+v, err = %v", plan, err)
+	}
+	// 他の利用者には、存在しないプランと同じ結果を返します
+	for _, id := range []string{"plan-1", "missing"} {
+		if _, err := u.GetOptimizationPlan(ctx, "mallory", id); !errors.Is(err, model.ErrPlanNotFound) {
+			t.Errorf("%s: err = %v, want ErrPlanNotFound", id, err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/repository"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+)
+
+// 予算スイープの並列度と、1リクエストあたりの制限時間です。
+const (
+	SweepConcurrency = 4
+	SweepTimeout     = 10 * time.Second
+)
+
+// SweepSource: スイープの前提となる家事・住環境
+// ScenarioID を指定した場合は、そのシナリオの入力と絞り込み済みの候補製品を使います。
+type SweepSource struct {
+	ScenarioID string
+	UserID     string              // ScenarioID を指定した場合の利用者 (シナリオの所有者でなければ使えません)
+	Input      model.ScenarioInput // ScenarioID が空の場合に使う (予算は無視します)
+}
+
+// SweepUsecase: 予算を変えながら最適化を繰り返し、「あと2万円あれば何が買えるか」を求めるユースケースです。
+type SweepUsecase struct {
+	scenarios repository.ScenarioRepository
+	catalog   repository.ProductCatalog
+	optimizer *service.PlanOptimizer
+	frontier  *service.EfficientFrontier
+}
+
+// NewSweepUsecase: ユースケースの作成
+func NewSweepUsecase(scenarios repository.ScenarioRepository, catalog repository.ProductCatalog, optimizer *service.PlanOptimizer, frontier *service.EfficientFrontier) *SweepUsecase {
+	return &SweepUsecase{scenarios: scenarios, catalog: catalog, optimizer: optimizer, frontier: frontier}
+}
+
+// SweepBudget: 予算範囲の各点で最適化を行い、効率的フロンティアを返します。
+// 最適化は SweepConcurrency 並列で実行し、SweepTimeout を過ぎたら打ち切ります。
+func (u *SweepUsecase) SweepBudget(ctx context.Context, source SweepSource, budgets model.BudgetRange) (*model.BudgetSweep, error) {
+	if err := budgets.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, SweepTimeout)
+	defer cancel()
+
+	// 1. 家事入力と候補製品を用意
+	chores, candidates, err := u.prepare(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 予算額ごとに並列で最適化 (セマフォで同時実行数を制限)
+	amounts := budgets.Points()
+	points := make([]model.SweepPoint, len(amounts))
+	errs := make([]error, len(amounts))
+	sem := make(chan struct{}, SweepConcurrency)
+	var wg sync.WaitGroup
+	for i, amount := range amounts {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, amount int32) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return
+			}
+			budget := model.BudgetConstraint{Amount: amount, Type: budgets.Type}
+			selection, err := u.optimizer.Optimize(ctx, candidates, chores, budget)
+			points[i], errs[i] = model.SweepPoint{Budget: budget, Selection: selection}, err
+		}(i, amount)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. フロンティアの計算
+	return &model.BudgetSweep{
+		Range:       budgets,
+		Evaluated:   len(points),
+		Breakpoints: u.frontier.Build(points),
+	}, nil
+}
+
+// prepare: シナリオ指定ならその入力と候補を、そうでなければ Catalog Service から候補を取得します。
+func (u *SweepUsecase) prepare(ctx context.Context, source SweepSource) ([]model.ChoreInput, []model.CandidateProduct, error) {
+	input := source.Input
+	if source.ScenarioID != "" {
+		scenario, err := u.scenarios.GetByID(ctx, source.ScenarioID)
+		if err != nil {
+			return nil, nil, err
+		}
+		// 他の利用者のシナリオは、再開と同じく存在を知られないよう見つからない扱いにします
+		if scenario.UserID != source.UserID {
+			return nil, nil, model.ErrScenarioNotFound
+		}
+		if scenario.Progress.Stage >= model.StageFiltered {
+			return scenario.Input.Chores, scenario.Progress.Candidates, nil
+		}
+		input = scenario.Input
+	}
+
+	if len(input.Chores) == 0 {
+		return nil, nil, fmt.Errorf("%w: at least one chore is required", model.ErrInvalidInput)
+	}
+	for _, c := range input.Chores {
+		if err := c.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", model.ErrInvalidInput, err)
+		}
+	}
+	candidates, _, err := u.catalog.ListCandidates(ctx, input.Residence)
+	if err != nil {
+		return nil, nil, err
+	}
+	return input.Chores, candidates, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/model"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/domain/service"
+	"github.com/kinoshitatakumi/opti/services/simulation/internal/infrastructure/db"
+)
+
+func TestSweepBudgetScenarioIsOnlyForTheOwner(t *testing.T) {
+	ctx := context.Background()
+	scenarios := db.NewMemoryScenarioRepository()
+	scenario := &model.SimulationScenario{
+		ID:       "s1",
+		UserID:   "alice",
+		Input:    model.ScenarioInput{Chores: []model.ChoreInput{{Category: "cleaning", MinutesPerSession: 30, FrequencyPerWeek: 3, PainLevel: 4}}},
+		Progress: model.ScenarioProgress{Stage: model.StageFiltered},
+	}
+	if err := scenarios.Save(ctx, scenario); err != nil {
+		t.Fatal(err)
+	}
+	u := NewSweepUsecase(scenarios, nil, service.NewPlanOptimizer(), service.NewEfficientFrontier())
+	budgets := model.BudgetRange{Type: model.BudgetTotalInitial, Min: 10000, Max: 30000, Step: 10000}
+
+	sweep, err := u.SweepBudget(ctx, SweepSource{ScenarioID: "s1", UserID: "alice"}, budgets)
+	if err != nil || sweep.Evaluated != 3 {
+		t.Fatalf("owner: sweep = %+v, err = %v", sweep, err)
+	}
+	if _, err := u.SweepBudget(ctx, SweepSource{ScenarioID: "s1", UserID: "mallory"}, budgets); !errors.Is(err, model.ErrScenarioNotFound) {
+		t.Errorf("err = %v, want ErrScenarioNotFound", err)
+	}
+}
+
+func TestSweepBudgetStopsAtTheDeadline(t *testing.T) {
+	scenarios := db.NewMemoryScenarioRepository()
+	scenario := &model.SimulationScenario{
+		ID:       "s1",
+		UserID:   "alice",
+		Input:    model.ScenarioInput{Chores: []model.ChoreInput{{Category: "cleaning", MinutesPerSession: 30, FrequencyPerWeek: 3, PainLevel: 4}}},
+		Progress: model.ScenarioProgress{Stage: model.StageFiltered},
+	}
+	if err := scenarios.Save(context.Background(), scenario); err != nil {
+		t.Fatal(err)
+	}
+	u := NewSweepUsecase(scenarios, nil, service.NewPlanOptimizer(), service.NewEfficientFrontier())
+	budgets := model.BudgetRange{Type: model.BudgetTotalInitial, Min: 10000, Max: 30000, Step: 10000}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := u.SweepBudget(ctx, SweepSource{ScenarioID: "s1", UserID: "alice"}, budgets); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
@@ -7,12 +7,30 @@ type UserContext struct {
 }
 
 type ResidenceInfo struct {
-	Type      ResidenceType
-	Age       int
-	Layout    string
-	Ownership Ownership
+	Type        ResidenceType
+	Age         int
+	Layout      string
+	Ownership   Ownership
+	Constraints ResidenceConstraints
 }
 
+// ResidenceConstraints は段差・床材・Wi-Fiなど、製品の設置可否に関わる物理的制約です。
+type ResidenceConstraints struct {
+	HasSteps     bool
+	StepHeightMM int // 0なら不明
+	FloorTypes   []FloorType
+	HasWiFi      bool
+	WiFiBands    []string // "wifi_2_4ghz" / "wifi_5ghz"。空なら両方
+}
+
+type FloorType string
+
+const (
+	FloorTypeFlooring FloorType = "flooring"
+	FloorTypeTatami   FloorType = "tatami"
+	FloorTypeCarpet   FloorType = "carpet"
+)
+
 type ResidenceType string
 
 const (